@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/diff"
+)
+
+// ChangelogCommand is a command that writes the changes between two versions of a spec as release notes
+type ChangelogCommand struct {
+	Destination string `long:"dest" short:"d" description:"Output destination file or stdout" default:"stdout"`
+	Append      string `long:"append" short:"a" description:"Markdown file, e.g. CHANGELOG.md, to add the changes to, in the section of the info.version of the new spec"`
+	IgnoreFile  string `long:"ignore" short:"i" description:"Exception file of diffs to ignore (copy output from json diff format)" default:"none specified"`
+	GitBase     string `long:"git-base" description:"Compares the spec with its version at a git revision, e.g. v1.2.0"`
+}
+
+// Execute writes the changelog between the two specs provided
+func (c *ChangelogCommand) Execute(args []string) error {
+	if c.GitBase != "" {
+		if len(args) != 1 {
+			return errors.New(`the changelog command with --git-base expects a single spec (use --help for more info)`)
+		}
+		dir, base, err := specAtGitRevision(c.GitBase, args[0])
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = os.RemoveAll(dir)
+		}()
+		args = []string{base, args[0]}
+	}
+	if len(args) != 2 {
+		return errors.New(`missing arguments for changelog command (use --help for more info)`)
+	}
+
+	specDoc1, err := loadDiffSpec(args[0])
+	if err != nil {
+		return err
+	}
+	specDoc2, err := loadDiffSpec(args[1])
+	if err != nil {
+		return err
+	}
+	diffs, err := diff.Compare(specDoc1.Spec(), specDoc2.Spec())
+	if err != nil {
+		return err
+	}
+	ignores, err := readIgnores(c.IgnoreFile)
+	if err != nil {
+		return err
+	}
+	changelog := diff.NewChangelog(diffs.FilterIgnores(ignores), specDoc1.Spec(), specDoc2.Spec())
+	if changelog.IsEmpty() {
+		log.Printf("No changes identified")
+	}
+
+	if c.Append != "" {
+		document, err := ioutil.ReadFile(c.Append)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		log.Printf("Adding the changes to the %s section of %s", changelog.Version, c.Append)
+		return ioutil.WriteFile(c.Append, []byte(changelog.AppendTo(string(document))), 0644)
+	}
+
+	output := io.Writer(os.Stdout)
+	if c.Destination != "" && c.Destination != "stdout" {
+		f, err := os.Create(c.Destination)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		output = f
+	}
+	return changelog.WriteMarkdown(output)
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+)
+
+func TestChangelog(t *testing.T) {
+	diffRootPath := basePath + "/"
+	args := []string{diffRootPath + "changelog.v1.json", diffRootPath + "changelog.v2.json"}
+
+	cmd := ChangelogCommand{IgnoreFile: "none specified"}
+	changelog := catchStdOut(t, func() {
+		assertThat(t, cmd.Execute(args), is.Nil())
+	})
+	assertThat(t, changelog, is.ValueContaining("### pets"))
+	assertThat(t, changelog, is.ValueContaining("- `DELETE /pets/{id}`: Deprecated endpoint"))
+
+	dir, err := ioutil.TempDir("", "changelog")
+	dieOn(err, t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	file := filepath.Join(dir, "CHANGELOG.md")
+	dieOn(ioutil.WriteFile(file, []byte("# Changelog\n\n## 1.0.0\n\n- first release\n"), 0644), t)
+
+	cmd = ChangelogCommand{IgnoreFile: "none specified", Append: file}
+	assertThat(t, cmd.Execute(args), is.Nil())
+	assertThat(t, LinesInFile(file), is.ValueContaining("# Changelog\n\n## 1.1.0\n"))
+	assertThat(t, LinesInFile(file), is.ValueContaining("\n## 1.0.0\n\n- first release\n"))
+
+	assertThat(t, cmd.Execute(args[:1]), is.Not(is.Nil()))
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/oas3"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// ConvertSpec is a command that converts a swagger 2.0 document to OpenAPI 3.0,
+// or an OpenAPI 3.0 document to swagger 2.0.
+//
+// The direction of the conversion is determined by the version of the input document.
+type ConvertSpec struct {
+	Compact bool           `long:"compact" description:"applies to JSON formatted specs. When present, doesn't prettify the json"`
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write to"`
+	Format  string         `long:"format" description:"the format for the spec document" default:"json" choice:"yaml" choice:"json"`
+	Report  flags.Filename `long:"report" description:"the file to write a JSON report of lossy conversions to"`
+}
+
+// Execute converts the spec
+func (c *ConvertSpec) Execute(args []string) error {
+	if len(args) != 1 {
+		return errors.New("convert command requires the single swagger document url to be specified")
+	}
+
+	swaggerDoc := args[0]
+	raw, err := oas3.ReadRaw(swaggerDoc)
+	if err != nil {
+		return err
+	}
+
+	var (
+		converted interface{}
+		losses    []oas3.Loss
+	)
+	if oas3.IsOpenAPI3(raw) {
+		doc, erp := oas3.Parse(raw)
+		if erp != nil {
+			return erp
+		}
+		log.Printf("converting OpenAPI %s document %s to swagger 2.0", doc.OpenAPI, swaggerDoc)
+		converted, losses, err = oas3.ToSwagger(doc)
+	} else {
+		specDoc, erl := loads.Spec(swaggerDoc)
+		if erl != nil {
+			return erl
+		}
+		log.Printf("converting swagger %s document %s to OpenAPI %s", specDoc.Version(), swaggerDoc, oas3.Version)
+		converted, losses, err = oas3.FromSwagger(specDoc.Spec())
+	}
+
+	for _, loss := range losses {
+		log.Printf("lossy conversion: %v", loss)
+	}
+	if c.Report != "" {
+		if erw := writeLosses(losses, string(c.Report)); erw != nil {
+			return erw
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeToFile(converted, !c.Compact, c.Format, string(c.Output))
+}
+
+func writeLosses(losses []oas3.Loss, output string) error {
+	if losses == nil {
+		losses = []oas3.Loss{}
+	}
+	b, err := json.MarshalIndent(losses, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
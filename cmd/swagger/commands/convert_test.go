@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/oas3"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Commands requires at least one arg
+func TestCmd_Convert(t *testing.T) {
+	v := &ConvertSpec{}
+	testRequireParam(t, v)
+}
+
+func TestCmd_Convert_ToOpenAPI3(t *testing.T) {
+	specDoc := filepath.Join(fixtureBase, "codegen", "todolist.simpleform.yml")
+	outDir, output := getOutput(t, specDoc, "convert", "todolist.simpleform.oas3.yaml")
+	defer os.RemoveAll(outDir)
+	v := &ConvertSpec{
+		Format: "yaml",
+		Output: flags.Filename(output),
+		Report: flags.Filename(filepath.Join(outDir, "report.json")),
+	}
+	testProduceOutput(t, v, specDoc, output)
+
+	doc, err := oas3.Load(output)
+	require.NoError(t, err)
+	assert.Equal(t, oas3.Version, doc.OpenAPI)
+
+	b, err := ioutil.ReadFile(filepath.Join(outDir, "report.json"))
+	require.NoError(t, err)
+	var losses []oas3.Loss
+	assert.NoError(t, json.Unmarshal(b, &losses))
+}
+
+func TestCmd_Convert_ToSwagger(t *testing.T) {
+	specDoc := filepath.Join(fixtureBase, "oas3", "petstore.yaml")
+	outDir, output := getOutput(t, specDoc, "convert", "petstore.swagger.json")
+	defer os.RemoveAll(outDir)
+	v := &ConvertSpec{
+		Format: "json",
+		Output: flags.Filename(output),
+	}
+	testProduceOutput(t, v, specDoc, output)
+
+	converted, err := loads.Spec(output)
+	require.NoError(t, err)
+	assert.Equal(t, "2.0", converted.Version())
+	assert.Contains(t, converted.Spec().Definitions, "Pet")
+}
+
+func TestCmd_Convert_Error(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	v := &ConvertSpec{}
+	assert.Error(t, v.Execute([]string{filepath.Join(fixtureBase, "oas3", "unsupported.yaml")}))
+}
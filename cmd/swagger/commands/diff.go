@@ -3,12 +3,16 @@ package commands
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 
+	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/loads"
 	"github.com/go-swagger/go-swagger/cmd/swagger/commands/diff"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/split"
 )
 
 // JSONFormat for json
@@ -18,14 +22,34 @@ const JSONFormat = "json"
 //
 // There are no specific options for this expansion.
 type DiffCommand struct {
-	OnlyBreakingChanges bool   `long:"break" short:"b" description:"When present, only shows incompatible changes"`
-	Format              string `long:"format" short:"f" description:"When present, writes output as json" default:"txt" choice:"txt" choice:"json"`
-	IgnoreFile          string `long:"ignore" short:"i" description:"Exception file of diffs to ignore (copy output from json diff format)"  default:"none specified"`
-	Destination         string `long:"dest" short:"d" description:"Output destination file or stdout" default:"stdout"`
+	OnlyBreakingChanges bool    `long:"break" short:"b" description:"When present, only shows incompatible changes"`
+	Format              string  `long:"format" short:"f" description:"When present, writes output as json, markdown, html or junit" default:"txt" choice:"txt" choice:"json" choice:"markdown" choice:"html" choice:"junit"`
+	IgnoreFile          string  `long:"ignore" short:"i" description:"Exception file of diffs to ignore (copy output from json diff format)"  default:"none specified"`
+	Destination         string  `long:"dest" short:"d" description:"Output destination file or stdout" default:"stdout"`
+	PolicyFile          string  `long:"policy" short:"p" description:"YAML file of rules overriding the compatibility of changes"`
+	GitBase             string  `long:"git-base" description:"Compares the spec with its version at a git revision, e.g. origin/main"`
+	Similarity          float64 `long:"similarity" description:"Similarity, from 0 to 1, above which a deleted and an added definition are reported as a renamed definition" default:"0.9"`
+	SuggestVersion      bool    `long:"suggest-version" description:"Writes the version the changes require, after the info.version of spec1, instead of the report. Fails when the version of spec2 is bumped too little"`
+	WriteVersion        bool    `long:"write-version" description:"With --suggest-version, sets the info.version of spec2 to the suggested version, unless already bumped"`
+	FailOn              string  `long:"fail-on" description:"Fails when changes are at least this severe (default: none with the json format, breaking otherwise)" choice:"breaking" choice:"warning" choice:"any" choice:"none"`
 }
 
 // Execute diffs the two specs provided
 func (c *DiffCommand) Execute(args []string) error {
+	if c.GitBase != "" {
+		if len(args) != 1 {
+			return errors.New(`the diff command with --git-base expects a single spec (use --help for more info)`)
+		}
+		dir, base, err := specAtGitRevision(c.GitBase, args[0])
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = os.RemoveAll(dir)
+		}()
+		log.Printf("Base revision (--git-base) :%s", c.GitBase)
+		args = []string{base, args[0]}
+	}
 	if len(args) != 2 {
 		msg := `missing arguments for diff command (use --help for more info)`
 		return errors.New(msg)
@@ -38,8 +62,27 @@ func (c *DiffCommand) Execute(args []string) error {
 	log.Printf("OutputFormat (-f) :%s", c.Format)
 	log.Printf("IgnoreFile (-i) :%s", c.IgnoreFile)
 	log.Printf("Diff Report Destination (-d) :%s", c.Destination)
+	log.Printf("Policy (-p) :%s", c.PolicyFile)
+	log.Printf("Similarity (--similarity) :%v", c.Similarity)
+	log.Printf("FailOn (--fail-on) :%s", c.FailOn)
+	log.Printf("SuggestVersion (--suggest-version) :%v", c.SuggestVersion || c.WriteVersion)
+
+	var policy *diff.Policy
+	if c.PolicyFile != "" {
+		var err error
+		if policy, err = diff.ReadPolicy(c.PolicyFile); err != nil {
+			return err
+		}
+	}
 
-	diffs, err := getDiffs(args[0], args[1])
+	similarity := c.Similarity
+	if similarity == 0 {
+		similarity = diff.DefaultSimilarityThreshold
+	}
+	if similarity < 0 || similarity > 1 {
+		return fmt.Errorf("similarity must be between 0 and 1: %v", similarity)
+	}
+	diffs, err := getDiffs(args[0], args[1], policy, similarity)
 	if err != nil {
 		return err
 	}
@@ -56,19 +99,36 @@ func (c *DiffCommand) Execute(args []string) error {
 		}
 	}
 
-	if c.Format == JSONFormat {
-		err = diffs.ReportAllDiffs(true)
+	output := io.Writer(os.Stdout)
+	if c.Destination != "" && c.Destination != "stdout" {
+		f, err := os.Create(c.Destination)
 		if err != nil {
 			return err
 		}
-	} else {
-		if c.OnlyBreakingChanges {
-			err = diffs.ReportCompatibility()
-		} else {
-			err = diffs.ReportAllDiffs(false)
+		defer f.Close()
+		output = f
+	}
+	if c.SuggestVersion || c.WriteVersion {
+		return c.suggestVersion(output, diffs, args[0], args[1])
+	}
+	if err := diffs.WriteReport(output, c.Format, c.OnlyBreakingChanges); err != nil {
+		return err
+	}
+
+	failOn := c.FailOn
+	if failOn == "" {
+		failOn = diff.FailOnBreaking
+		if c.Format == JSONFormat {
+			failOn = diff.FailOnNone
 		}
 	}
-	return err
+	if err := diffs.Check(failOn); err != nil {
+		return err
+	}
+	if failOn == diff.FailOnBreaking {
+		log.Printf("Compatibility test OK. No breaking changes identified.")
+	}
+	return nil
 }
 
 func readIgnores(ignoreFile string) (diff.SpecDifferences, error) {
@@ -97,19 +157,43 @@ func readIgnores(ignoreFile string) (diff.SpecDifferences, error) {
 	return ignoreDiffs, nil
 }
 
-func getDiffs(oldSpecPath, newSpecPath string) (diff.SpecDifferences, error) {
+func getDiffs(oldSpecPath, newSpecPath string, policy *diff.Policy, similarity float64) (diff.SpecDifferences, error) {
 	swaggerDoc1 := oldSpecPath
-	specDoc1, err := loads.Spec(swaggerDoc1)
+	specDoc1, err := loadDiffSpec(swaggerDoc1)
 
 	if err != nil {
 		return nil, err
 	}
 
 	swaggerDoc2 := newSpecPath
-	specDoc2, err := loads.Spec(swaggerDoc2)
+	specDoc2, err := loadDiffSpec(swaggerDoc2)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := diff.CompareWithSimilarity(specDoc1.Spec(), specDoc2.Spec(), similarity)
 	if err != nil {
 		return nil, err
 	}
+	return policy.Apply(diffs, specDoc1.Spec(), specDoc2.Spec()), nil
+}
 
-	return diff.Compare(specDoc1.Spec(), specDoc2.Spec())
+// loadDiffSpec loads a spec with the schemas of the documents it references imported as definitions
+func loadDiffSpec(specPath string) (*loads.Document, error) {
+	specDoc, err := loads.Spec(specPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := split.Inline(specDoc.Spec(), specDoc.SpecFilePath()); err != nil {
+		return nil, err
+	}
+	err = analysis.Flatten(analysis.FlattenOpts{
+		Spec:     analysis.New(specDoc.Spec()),
+		BasePath: specDoc.SpecFilePath(),
+		Minimal:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return specDoc, nil
 }
@@ -0,0 +1,266 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// Sections of a changelog, after https://keepachangelog.com
+const (
+	ChangelogAdded      = "Added"
+	ChangelogChanged    = "Changed"
+	ChangelogDeprecated = "Deprecated"
+	ChangelogRemoved    = "Removed"
+)
+
+// GeneralTag groups the changes of the spec metadata and of the operations without tags
+const GeneralTag = "General"
+
+// UnreleasedVersion is the version of the changelogs of specs without info.version
+const UnreleasedVersion = "Unreleased"
+
+var changelogSections = []string{ChangelogAdded, ChangelogChanged, ChangelogDeprecated, ChangelogRemoved}
+
+// changelogSectionFor is the section of the changes other than ChangelogChanged
+var changelogSectionFor = map[SpecChangeCode]string{
+	AddedEndpoint:            ChangelogAdded,
+	AddedProperty:            ChangelogAdded,
+	AddedOptionalParam:       ChangelogAdded,
+	AddedRequiredParam:       ChangelogAdded,
+	AddedResponse:            ChangelogAdded,
+	AddedEnumValue:           ChangelogAdded,
+	AddedConsumesFormat:      ChangelogAdded,
+	AddedProducesFormat:      ChangelogAdded,
+	AddedSchemes:             ChangelogAdded,
+	AddedResponseHeader:      ChangelogAdded,
+	AddedSecurityRequirement: ChangelogAdded,
+	AddedSecurityDefinition:  ChangelogAdded,
+	AddedSecurityScope:       ChangelogAdded,
+
+	DeprecatedEndpoint: ChangelogDeprecated,
+
+	DeletedEndpoint:            ChangelogRemoved,
+	DeletedDeprecatedEndpoint:  ChangelogRemoved,
+	DeletedProperty:            ChangelogRemoved,
+	DeletedOptionalParam:       ChangelogRemoved,
+	DeletedRequiredParam:       ChangelogRemoved,
+	DeletedResponse:            ChangelogRemoved,
+	DeletedEnumValue:           ChangelogRemoved,
+	DeletedConsumesFormat:      ChangelogRemoved,
+	DeletedProducesFormat:      ChangelogRemoved,
+	DeletedSchemes:             ChangelogRemoved,
+	DeletedResponseHeader:      ChangelogRemoved,
+	DeletedSecurityRequirement: ChangelogRemoved,
+	DeletedSecurityDefinition:  ChangelogRemoved,
+	DeletedSecurityScope:       ChangelogRemoved,
+}
+
+// Changelog is a human oriented summary of the changes between two versions of a spec
+type Changelog struct {
+	// Version of the new spec
+	Version string
+	// Tags of the operations, the general changes first
+	Tags []ChangelogTag
+}
+
+// ChangelogTag are the changes of the operations of a tag
+type ChangelogTag struct {
+	Name string
+	// Entries by section: Added, Changed, Deprecated or Removed
+	Entries map[string][]string
+}
+
+// NewChangelog groups the changes between two specs by the tags of their operations, then by section.
+//
+// The changes of an operation with several tags are listed under each tag.
+func NewChangelog(diffs SpecDifferences, spec1, spec2 *spec.Swagger) *Changelog {
+	version := UnreleasedVersion
+	if spec2.Info != nil && spec2.Info.Version != "" {
+		version = spec2.Info.Version
+	}
+
+	// the tags of the new spec take precedence over the tags of the deleted operations
+	tags := make(map[URLMethod][]string)
+	for _, urlMethods := range []URLMethods{getURLMethodsFor(spec1), getURLMethodsFor(spec2)} {
+		for urlMethod, op := range urlMethods {
+			if len(op.Operation.Tags) > 0 {
+				tags[urlMethod] = op.Operation.Tags
+			}
+		}
+	}
+
+	byTag := make(map[string]map[string][]string)
+	for _, eachDiff := range diffs {
+		location := eachDiff.DifferenceLocation
+		entryTags := tags[URLMethod{Path: location.URL, Method: location.Method}]
+		if len(entryTags) == 0 {
+			entryTags = []string{GeneralTag}
+		}
+		section, ok := changelogSectionFor[eachDiff.Code]
+		if !ok {
+			section = ChangelogChanged
+		}
+		for _, tag := range entryTags {
+			if byTag[tag] == nil {
+				byTag[tag] = make(map[string][]string)
+			}
+			byTag[tag][section] = append(byTag[tag][section], changelogEntry(eachDiff))
+		}
+	}
+
+	names := make([]string, 0, len(byTag))
+	for name := range byTag {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == GeneralTag) != (names[j] == GeneralTag) {
+			return names[i] == GeneralTag
+		}
+		return names[i] < names[j]
+	})
+	changelog := &Changelog{Version: version}
+	for _, name := range names {
+		for _, entries := range byTag[name] {
+			sort.Strings(entries)
+		}
+		changelog.Tags = append(changelog.Tags, ChangelogTag{Name: name, Entries: byTag[name]})
+	}
+	return changelog
+}
+
+// changelogEntry describes a change, e.g. **Breaking:** `GET /pets` Query.limit: Narrowed type `integer -> string`
+func changelogEntry(diff SpecDifference) string {
+	var b strings.Builder
+	if diff.Compatibility == Breaking {
+		b.WriteString("**Breaking:** ")
+	}
+	location := diff.DifferenceLocation
+	parts := []string{}
+	if location.URL != "" {
+		parts = append(parts, "`"+strings.Replace(diff.Endpoint(), "`", "'", -1)+"`")
+	}
+	where := diff.Where()
+	if location.Response == 0 && location.Node != nil {
+		// the request is implied
+		where = location.Node.String()
+	}
+	if where != "" && where != "Request" {
+		parts = append(parts, markdownEscaper.Replace(where))
+	}
+	if len(parts) > 0 {
+		fmt.Fprintf(&b, "%s: ", strings.Join(parts, " "))
+	}
+	b.WriteString(markdownEscaper.Replace(diff.Code.Description()))
+	if diff.DiffInfo != "" {
+		fmt.Fprintf(&b, " `%s`", strings.Replace(diff.DiffInfo, "`", "'", -1))
+	}
+	return b.String()
+}
+
+// IsEmpty tells if there are no changes in the changelog
+func (c *Changelog) IsEmpty() bool {
+	return len(c.Tags) == 0
+}
+
+// WriteMarkdown writes the changelog as a markdown section, titled by its version
+func (c *Changelog) WriteMarkdown(w io.Writer) error {
+	_, err := io.WriteString(w, c.section(nil))
+	return err
+}
+
+// section renders the changelog as a markdown section, without the entries already in a document
+func (c *Changelog) section(known map[string]bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n", c.Version)
+	for _, tag := range c.Tags {
+		var tagSections strings.Builder
+		for _, section := range changelogSections {
+			entries := []string{}
+			for _, entry := range tag.Entries[section] {
+				if !known["- "+entry] {
+					entries = append(entries, entry)
+				}
+			}
+			if len(entries) == 0 {
+				continue
+			}
+			fmt.Fprintf(&tagSections, "\n#### %s\n\n", section)
+			for _, entry := range entries {
+				fmt.Fprintf(&tagSections, "- %s\n", entry)
+			}
+		}
+		if tagSections.Len() > 0 {
+			fmt.Fprintf(&b, "\n### %s\n%s", tag.Name, tagSections.String())
+		}
+	}
+	return b.String()
+}
+
+// AppendTo adds the changelog to a markdown document, e.g. a CHANGELOG.md file.
+//
+// The changes are appended to the section of the version, unless the section already lists them.
+// A missing section is inserted before the sections of the former versions.
+func (c *Changelog) AppendTo(document string) string {
+	if strings.TrimSpace(document) == "" {
+		return "# Changelog\n\n" + c.section(nil)
+	}
+	lines := strings.SplitAfter(document, "\n")
+	heading := regexp.MustCompile(`^##\s+\[?` + regexp.QuoteMeta(c.Version) + `\]?(\s|$)`)
+
+	start, end := -1, len(lines)
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "## ") {
+			continue
+		}
+		if start >= 0 {
+			end = i
+			break
+		}
+		if heading.MatchString(line) {
+			start = i
+		}
+	}
+
+	if start < 0 {
+		// a new section, before the first version
+		for i, line := range lines {
+			if strings.HasPrefix(line, "## ") {
+				end = i
+				break
+			}
+		}
+		before := strings.Join(lines[:end], "")
+		if before != "" && !strings.HasSuffix(before, "\n") {
+			before += "\n"
+		}
+		if before != "" && !strings.HasSuffix(before, "\n\n") {
+			before += "\n"
+		}
+		after := strings.Join(lines[end:], "")
+		if after != "" {
+			after = "\n" + after
+		}
+		return before + c.section(nil) + after
+	}
+
+	known := make(map[string]bool)
+	for _, line := range lines[start+1 : end] {
+		known[strings.TrimSpace(line)] = true
+	}
+	section := c.section(known)
+	added := section[strings.Index(section, "\n")+1:]
+	if added == "" {
+		return document
+	}
+	body := strings.TrimRight(strings.Join(lines[start:end], ""), "\n") + "\n"
+	after := strings.Join(lines[end:], "")
+	if after != "" {
+		added += "\n"
+	}
+	return strings.Join(lines[:start], "") + body + added + after
+}
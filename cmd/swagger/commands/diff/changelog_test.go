@@ -0,0 +1,65 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+	"github.com/go-openapi/loads"
+)
+
+func changelogFixture(t *testing.T) *Changelog {
+	specDoc1, err := loads.Spec(basePath + "/changelog.v1.json")
+	dieOn(err, t)
+	specDoc2, err := loads.Spec(basePath + "/changelog.v2.json")
+	dieOn(err, t)
+	diffs, err := Compare(specDoc1.Spec(), specDoc2.Spec())
+	dieOn(err, t)
+	return NewChangelog(diffs, specDoc1.Spec(), specDoc2.Spec())
+}
+
+func TestNewChangelog(t *testing.T) {
+	changelog := changelogFixture(t)
+	assertThat(t, changelog.Version, is.EqualTo("1.1.0"))
+	assertThat(t, len(changelog.Tags), is.EqualTo(3))
+
+	general, pets, stores := changelog.Tags[0], changelog.Tags[1], changelog.Tags[2]
+	assertThat(t, general.Name, is.EqualTo(GeneralTag))
+	assertThat(t, general.Entries[ChangelogRemoved], is.EqualTo([]string{"**Breaking:** Spec.schemes: Deleted schemes `http`"}))
+	assertThat(t, pets.Name, is.EqualTo("pets"))
+	assertThat(t, pets.Entries[ChangelogAdded], is.EqualTo([]string{"`GET /pets` Query.tag: Added optional param"}))
+	assertThat(t, pets.Entries[ChangelogChanged], is.EqualTo([]string{"`GET /pets`: Changed a description"}))
+	assertThat(t, pets.Entries[ChangelogDeprecated], is.EqualTo([]string{"`DELETE /pets/{id}`: Deprecated endpoint"}))
+	assertThat(t, stores.Name, is.EqualTo("stores"))
+	assertThat(t, stores.Entries[ChangelogAdded], is.EqualTo([]string{"`GET /stores`: Added endpoint"}))
+	assertThat(t, stores.Entries[ChangelogRemoved], is.EqualTo([]string{"**Breaking:** `DELETE /stores/{id}`: Deleted endpoint"}))
+
+	var b bytes.Buffer
+	dieOn(changelog.WriteMarkdown(&b), t)
+	assertThat(t, b.String(), is.ValueContaining("## 1.1.0\n\n### General\n\n#### Removed\n\n"))
+	assertThat(t, strings.Index(b.String(), "### pets") < strings.Index(b.String(), "### stores"), is.EqualTo(true))
+}
+
+func TestChangelogAppendTo(t *testing.T) {
+	changelog := changelogFixture(t)
+
+	created := changelog.AppendTo("")
+	assertThat(t, strings.HasPrefix(created, "# Changelog\n\n## 1.1.0\n"), is.EqualTo(true))
+
+	former := "# Changelog\n\nAll the changes of the API.\n\n## [1.0.0] - 2020-01-01\n\n- first release\n"
+	inserted := changelog.AppendTo(former)
+	assertThat(t, strings.HasPrefix(inserted, "# Changelog\n\nAll the changes of the API.\n\n## 1.1.0\n"), is.EqualTo(true))
+	assertThat(t, strings.HasSuffix(inserted, "\n\n## [1.0.0] - 2020-01-01\n\n- first release\n"), is.EqualTo(true))
+
+	// appending again does not repeat the changes
+	assertThat(t, changelog.AppendTo(inserted), is.EqualTo(inserted))
+
+	// the section of the version is completed
+	partial := "# Changelog\n\n## [1.1.0]\n\n- `GET /stores`: Added endpoint\n\n## 1.0.0\n"
+	completed := changelog.AppendTo(partial)
+	assertThat(t, strings.Count(completed, "`GET /stores`: Added endpoint"), is.EqualTo(1))
+	assertThat(t, completed, is.ValueContaining("Deprecated endpoint"))
+	assertThat(t, strings.HasSuffix(completed, "\n\n## 1.0.0\n"), is.EqualTo(true))
+	assertThat(t, strings.Index(completed, "Deprecated endpoint") < strings.Index(completed, "## 1.0.0"), is.EqualTo(true))
+}
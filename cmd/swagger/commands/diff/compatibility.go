@@ -32,6 +32,35 @@ func init() {
 			ChangedTag:              NonBreaking,
 			AddedTag:                NonBreaking,
 			DeletedTag:              NonBreaking,
+			// a client may rely on the constraints of a response, not on their loosening
+			TightenedMaximum:          NonBreaking,
+			LoosenedMaximum:           Breaking,
+			TightenedExclusiveMaximum: NonBreaking,
+			LoosenedExclusiveMaximum:  Breaking,
+			TightenedMinimum:          NonBreaking,
+			LoosenedMinimum:           Breaking,
+			TightenedExclusiveMinimum: NonBreaking,
+			LoosenedExclusiveMinimum:  Breaking,
+			TightenedMaxLength:        NonBreaking,
+			LoosenedMaxLength:         Breaking,
+			TightenedMinLength:        NonBreaking,
+			LoosenedMinLength:         Breaking,
+			AddedPattern:              NonBreaking,
+			DeletedPattern:            Breaking,
+			ChangedPattern:            Breaking,
+			TightenedMaxItems:         NonBreaking,
+			LoosenedMaxItems:          Breaking,
+			TightenedMinItems:         NonBreaking,
+			LoosenedMinItems:          Breaking,
+			TightenedUniqueItems:      NonBreaking,
+			LoosenedUniqueItems:       Breaking,
+			TightenedMultipleOf:       NonBreaking,
+			LoosenedMultipleOf:        Breaking,
+			ChangedMultipleOf:         Breaking,
+			TightenedMaxProperties:    NonBreaking,
+			LoosenedMaxProperties:     Breaking,
+			TightenedMinProperties:    NonBreaking,
+			LoosenedMinProperties:     Breaking,
 		},
 		ForRequest: map[SpecChangeCode]Compatibility{
 			AddedRequiredProperty:          Breaking,
@@ -55,6 +84,35 @@ func init() {
 			ChangedTag:                     NonBreaking,
 			AddedTag:                       NonBreaking,
 			DeletedTag:                     NonBreaking,
+			// a client may send values which no longer satisfy tightened constraints
+			TightenedMaximum:          Breaking,
+			LoosenedMaximum:           NonBreaking,
+			TightenedExclusiveMaximum: Breaking,
+			LoosenedExclusiveMaximum:  NonBreaking,
+			TightenedMinimum:          Breaking,
+			LoosenedMinimum:           NonBreaking,
+			TightenedExclusiveMinimum: Breaking,
+			LoosenedExclusiveMinimum:  NonBreaking,
+			TightenedMaxLength:        Breaking,
+			LoosenedMaxLength:         NonBreaking,
+			TightenedMinLength:        Breaking,
+			LoosenedMinLength:         NonBreaking,
+			AddedPattern:              Breaking,
+			DeletedPattern:            NonBreaking,
+			ChangedPattern:            Breaking,
+			TightenedMaxItems:         Breaking,
+			LoosenedMaxItems:          NonBreaking,
+			TightenedMinItems:         Breaking,
+			LoosenedMinItems:          NonBreaking,
+			TightenedUniqueItems:      Breaking,
+			LoosenedUniqueItems:       NonBreaking,
+			TightenedMultipleOf:       Breaking,
+			LoosenedMultipleOf:        NonBreaking,
+			ChangedMultipleOf:         Breaking,
+			TightenedMaxProperties:    Breaking,
+			LoosenedMaxProperties:     NonBreaking,
+			TightenedMinProperties:    Breaking,
+			LoosenedMinProperties:     NonBreaking,
 		},
 		ForChange: map[SpecChangeCode]Compatibility{
 			NoChangeDetected:          NonBreaking,
@@ -75,6 +133,26 @@ func init() {
 			ChangedTag:                NonBreaking,
 			AddedTag:                  NonBreaking,
 			DeletedTag:                NonBreaking,
+			// security applies to the requests of the operations
+			AddedSecurityRequirement:   NonBreaking,
+			DeletedSecurityRequirement: Breaking,
+			AddedAuthentication:        Breaking,
+			DeletedAuthentication:      NonBreaking,
+			AddedRequiredScope:         Breaking,
+			DeletedRequiredScope:       NonBreaking,
+			AddedSecurityDefinition:    NonBreaking,
+			DeletedSecurityDefinition:  Breaking,
+			ChangedSecuritySchemeType:  Breaking,
+			ChangedSecurityURL:         Breaking,
+			AddedSecurityScope:         NonBreaking,
+			DeletedSecurityScope:       Breaking,
+			// the name of a definition, of a path or of a body param is not part of the messages
+			RenamedDefinition:  NonBreaking,
+			MovedEndpoint:      Breaking,
+			RenamedParam:       Breaking,
+			RenamedPathParam:   NonBreaking,
+			RenamedBodyParam:   NonBreaking,
+			DeprecatedEndpoint: NonBreaking,
 		},
 	}
 }
@@ -88,6 +88,98 @@ const (
 	ChangedResponseHeader
 	// DeletedResponseHeader Added a header Item
 	DeletedResponseHeader
+	// TightenedMaximum - A maximum has been added or lowered in the new spec
+	TightenedMaximum
+	// LoosenedMaximum - A maximum has been removed or raised in the new spec
+	LoosenedMaximum
+	// TightenedExclusiveMaximum - A maximum has been made exclusive in the new spec
+	TightenedExclusiveMaximum
+	// LoosenedExclusiveMaximum - A maximum has been made inclusive in the new spec
+	LoosenedExclusiveMaximum
+	// TightenedMinimum - A minimum has been added or raised in the new spec
+	TightenedMinimum
+	// LoosenedMinimum - A minimum has been removed or lowered in the new spec
+	LoosenedMinimum
+	// TightenedExclusiveMinimum - A minimum has been made exclusive in the new spec
+	TightenedExclusiveMinimum
+	// LoosenedExclusiveMinimum - A minimum has been made inclusive in the new spec
+	LoosenedExclusiveMinimum
+	// TightenedMaxLength - A maxLength has been added or lowered in the new spec
+	TightenedMaxLength
+	// LoosenedMaxLength - A maxLength has been removed or raised in the new spec
+	LoosenedMaxLength
+	// TightenedMinLength - A minLength has been added or raised in the new spec
+	TightenedMinLength
+	// LoosenedMinLength - A minLength has been removed or lowered in the new spec
+	LoosenedMinLength
+	// AddedPattern - A pattern has been added in the new spec
+	AddedPattern
+	// DeletedPattern - A pattern has been removed from the new spec
+	DeletedPattern
+	// ChangedPattern - A pattern has been changed in the new spec
+	ChangedPattern
+	// TightenedMaxItems - A maxItems has been added or lowered in the new spec
+	TightenedMaxItems
+	// LoosenedMaxItems - A maxItems has been removed or raised in the new spec
+	LoosenedMaxItems
+	// TightenedMinItems - A minItems has been added or raised in the new spec
+	TightenedMinItems
+	// LoosenedMinItems - A minItems has been removed or lowered in the new spec
+	LoosenedMinItems
+	// TightenedUniqueItems - The items of an array must be unique in the new spec
+	TightenedUniqueItems
+	// LoosenedUniqueItems - The items of an array may be duplicated in the new spec
+	LoosenedUniqueItems
+	// TightenedMultipleOf - A multipleOf has been added, or changed to a multiple of the previous one in the new spec
+	TightenedMultipleOf
+	// LoosenedMultipleOf - A multipleOf has been removed, or changed to a divisor of the previous one in the new spec
+	LoosenedMultipleOf
+	// ChangedMultipleOf - A multipleOf has been changed to a value which is neither a multiple nor a divisor of the previous one in the new spec
+	ChangedMultipleOf
+	// TightenedMaxProperties - A maxProperties has been added or lowered in the new spec
+	TightenedMaxProperties
+	// LoosenedMaxProperties - A maxProperties has been removed or raised in the new spec
+	LoosenedMaxProperties
+	// TightenedMinProperties - A minProperties has been added or raised in the new spec
+	TightenedMinProperties
+	// LoosenedMinProperties - A minProperties has been removed or lowered in the new spec
+	LoosenedMinProperties
+	// AddedSecurityRequirement - An alternative security requirement has been added in the new spec
+	AddedSecurityRequirement
+	// DeletedSecurityRequirement - An alternative security requirement has been removed from the new spec
+	DeletedSecurityRequirement
+	// AddedAuthentication - Authentication is required in the new spec, where it was optional
+	AddedAuthentication
+	// DeletedAuthentication - Authentication is optional in the new spec, where it was required
+	DeletedAuthentication
+	// AddedRequiredScope - A security requirement requires a new scope in the new spec
+	AddedRequiredScope
+	// DeletedRequiredScope - A security requirement no longer requires a scope in the new spec
+	DeletedRequiredScope
+	// AddedSecurityDefinition - A security definition has been added in the new spec
+	AddedSecurityDefinition
+	// DeletedSecurityDefinition - A security definition has been removed from the new spec
+	DeletedSecurityDefinition
+	// ChangedSecuritySchemeType - The type, flow or location of a security scheme has changed in the new spec
+	ChangedSecuritySchemeType
+	// ChangedSecurityURL - The authorization or token URL of a security scheme has changed in the new spec
+	ChangedSecurityURL
+	// AddedSecurityScope - A scope has been added to a security definition in the new spec
+	AddedSecurityScope
+	// DeletedSecurityScope - A scope has been removed from a security definition in the new spec
+	DeletedSecurityScope
+	// RenamedDefinition - A definition has been renamed in the new spec
+	RenamedDefinition
+	// MovedEndpoint - An operation has been moved to another path in the new spec
+	MovedEndpoint
+	// RenamedParam - A query, header or form param has been renamed in the new spec
+	RenamedParam
+	// RenamedPathParam - A path param has been renamed in the new spec
+	RenamedPathParam
+	// RenamedBodyParam - A body param has been renamed in the new spec
+	RenamedBodyParam
+	// DeprecatedEndpoint - An endpoint has been deprecated in the new spec
+	DeprecatedEndpoint
 )
 
 var toLongStringSpecChangeCode = map[SpecChangeCode]string{
@@ -129,6 +221,52 @@ var toLongStringSpecChangeCode = map[SpecChangeCode]string{
 	AddedResponseHeader:            "Added response header",
 	ChangedResponseHeader:          "Changed response header",
 	DeletedResponseHeader:          "Deleted response header",
+	TightenedMaximum:               "Tightened maximum",
+	LoosenedMaximum:                "Loosened maximum",
+	TightenedExclusiveMaximum:      "Tightened exclusive maximum",
+	LoosenedExclusiveMaximum:       "Loosened exclusive maximum",
+	TightenedMinimum:               "Tightened minimum",
+	LoosenedMinimum:                "Loosened minimum",
+	TightenedExclusiveMinimum:      "Tightened exclusive minimum",
+	LoosenedExclusiveMinimum:       "Loosened exclusive minimum",
+	TightenedMaxLength:             "Tightened max length",
+	LoosenedMaxLength:              "Loosened max length",
+	TightenedMinLength:             "Tightened min length",
+	LoosenedMinLength:              "Loosened min length",
+	AddedPattern:                   "Added pattern",
+	DeletedPattern:                 "Deleted pattern",
+	ChangedPattern:                 "Changed pattern",
+	TightenedMaxItems:              "Tightened max items",
+	LoosenedMaxItems:               "Loosened max items",
+	TightenedMinItems:              "Tightened min items",
+	LoosenedMinItems:               "Loosened min items",
+	TightenedUniqueItems:           "Tightened unique items",
+	LoosenedUniqueItems:            "Loosened unique items",
+	TightenedMultipleOf:            "Tightened multiple of",
+	LoosenedMultipleOf:             "Loosened multiple of",
+	ChangedMultipleOf:              "Changed multiple of",
+	TightenedMaxProperties:         "Tightened max properties",
+	LoosenedMaxProperties:          "Loosened max properties",
+	TightenedMinProperties:         "Tightened min properties",
+	LoosenedMinProperties:          "Loosened min properties",
+	AddedSecurityRequirement:       "Added security requirement",
+	DeletedSecurityRequirement:     "Deleted security requirement",
+	AddedAuthentication:            "Added required authentication",
+	DeletedAuthentication:          "Deleted required authentication",
+	AddedRequiredScope:             "Added required scope",
+	DeletedRequiredScope:           "Deleted required scope",
+	AddedSecurityDefinition:        "Added security definition",
+	DeletedSecurityDefinition:      "Deleted security definition",
+	ChangedSecuritySchemeType:      "Changed security scheme",
+	ChangedSecurityURL:             "Changed security URL",
+	AddedSecurityScope:             "Added security scope",
+	DeletedSecurityScope:           "Deleted security scope",
+	RenamedDefinition:              "Renamed definition",
+	MovedEndpoint:                  "Moved endpoint",
+	RenamedParam:                   "Renamed param",
+	RenamedPathParam:               "Renamed path param",
+	RenamedBodyParam:               "Renamed body param",
+	DeprecatedEndpoint:             "Deprecated endpoint",
 }
 
 var toStringSpecChangeCode = map[SpecChangeCode]string{
@@ -170,6 +308,52 @@ var toStringSpecChangeCode = map[SpecChangeCode]string{
 	AddedResponseHeader:            "AddedResponseHeader",
 	ChangedResponseHeader:          "ChangedResponseHeader",
 	DeletedResponseHeader:          "DeletedResponseHeader",
+	TightenedMaximum:               "TightenedMaximum",
+	LoosenedMaximum:                "LoosenedMaximum",
+	TightenedExclusiveMaximum:      "TightenedExclusiveMaximum",
+	LoosenedExclusiveMaximum:       "LoosenedExclusiveMaximum",
+	TightenedMinimum:               "TightenedMinimum",
+	LoosenedMinimum:                "LoosenedMinimum",
+	TightenedExclusiveMinimum:      "TightenedExclusiveMinimum",
+	LoosenedExclusiveMinimum:       "LoosenedExclusiveMinimum",
+	TightenedMaxLength:             "TightenedMaxLength",
+	LoosenedMaxLength:              "LoosenedMaxLength",
+	TightenedMinLength:             "TightenedMinLength",
+	LoosenedMinLength:              "LoosenedMinLength",
+	AddedPattern:                   "AddedPattern",
+	DeletedPattern:                 "DeletedPattern",
+	ChangedPattern:                 "ChangedPattern",
+	TightenedMaxItems:              "TightenedMaxItems",
+	LoosenedMaxItems:               "LoosenedMaxItems",
+	TightenedMinItems:              "TightenedMinItems",
+	LoosenedMinItems:               "LoosenedMinItems",
+	TightenedUniqueItems:           "TightenedUniqueItems",
+	LoosenedUniqueItems:            "LoosenedUniqueItems",
+	TightenedMultipleOf:            "TightenedMultipleOf",
+	LoosenedMultipleOf:             "LoosenedMultipleOf",
+	ChangedMultipleOf:              "ChangedMultipleOf",
+	TightenedMaxProperties:         "TightenedMaxProperties",
+	LoosenedMaxProperties:          "LoosenedMaxProperties",
+	TightenedMinProperties:         "TightenedMinProperties",
+	LoosenedMinProperties:          "LoosenedMinProperties",
+	AddedSecurityRequirement:       "AddedSecurityRequirement",
+	DeletedSecurityRequirement:     "DeletedSecurityRequirement",
+	AddedAuthentication:            "AddedAuthentication",
+	DeletedAuthentication:          "DeletedAuthentication",
+	AddedRequiredScope:             "AddedRequiredScope",
+	DeletedRequiredScope:           "DeletedRequiredScope",
+	AddedSecurityDefinition:        "AddedSecurityDefinition",
+	DeletedSecurityDefinition:      "DeletedSecurityDefinition",
+	ChangedSecuritySchemeType:      "ChangedSecuritySchemeType",
+	ChangedSecurityURL:             "ChangedSecurityURL",
+	AddedSecurityScope:             "AddedSecurityScope",
+	DeletedSecurityScope:           "DeletedSecurityScope",
+	RenamedDefinition:              "RenamedDefinition",
+	MovedEndpoint:                  "MovedEndpoint",
+	RenamedParam:                   "RenamedParam",
+	RenamedPathParam:               "RenamedPathParam",
+	RenamedBodyParam:               "RenamedBodyParam",
+	DeprecatedEndpoint:             "DeprecatedEndpoint",
 }
 
 var toIDSpecChangeCode = map[string]SpecChangeCode{}
@@ -214,6 +398,8 @@ const (
 	Breaking Compatibility = iota
 	// NonBreaking This is a backwards-compatible API change
 	NonBreaking
+	// Warning This is a backwards-compatible API change which deserves attention
+	Warning
 )
 
 func (s Compatibility) String() string {
@@ -223,6 +409,7 @@ func (s Compatibility) String() string {
 var toStringCompatibility = map[Compatibility]string{
 	Breaking:    "Breaking",
 	NonBreaking: "NonBreaking",
+	Warning:     "Warning",
 }
 
 var toIDCompatibility = map[string]Compatibility{}
@@ -41,7 +41,7 @@ func (n Node) Copy() *Node {
 	newNode := n
 
 	if newNode.ChildNode != nil {
-		n.ChildNode = newNode.ChildNode.Copy()
+		newNode.ChildNode = newNode.ChildNode.Copy()
 	}
 	return &newNode
 }
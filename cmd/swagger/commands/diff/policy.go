@@ -0,0 +1,148 @@
+package diff
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Policy overrides the compatibility assigned to changes by the default policy
+type Policy struct {
+	Rules []PolicyRule `yaml:"rules"`
+}
+
+// PolicyRule sets the compatibility of a change, optionally for some operations only.
+//
+// The rules scoped by path, tag or direction only apply to the changes of operations.
+type PolicyRule struct {
+	// Code of the change, e.g. AddedEnumValue
+	Code string `yaml:"code"`
+	// Compatibility of the change: breaking, non-breaking or warning
+	Compatibility string `yaml:"compatibility"`
+	// Path is a pattern of the paths of the operations: * matches a segment of a path, ** any number of segments,
+	// including none
+	Path string `yaml:"path,omitempty"`
+	// Tag of the operations
+	Tag string `yaml:"tag,omitempty"`
+	// Direction of the change: request or response
+	Direction string `yaml:"direction,omitempty"`
+
+	code          SpecChangeCode
+	compatibility Compatibility
+	direction     DataDirection
+	path          *regexp.Regexp
+}
+
+// ReadPolicy reads a policy from a YAML or JSON file
+func ReadPolicy(policyFile string) (*Policy, error) {
+	b, err := ioutil.ReadFile(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	var policy Policy
+	if err := yaml.UnmarshalStrict(b, &policy); err != nil {
+		return nil, fmt.Errorf("cannot read policy %s: %v", policyFile, err)
+	}
+	for i := range policy.Rules {
+		if err := policy.Rules[i].compile(); err != nil {
+			return nil, fmt.Errorf("invalid rule #%d of policy %s: %v", i+1, policyFile, err)
+		}
+	}
+	return &policy, nil
+}
+
+func (r *PolicyRule) compile() error {
+	code, ok := toIDSpecChangeCode[r.Code]
+	if !ok {
+		return fmt.Errorf("unknown change code %q", r.Code)
+	}
+	r.code = code
+
+	switch strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(r.Compatibility)) {
+	case "breaking":
+		r.compatibility = Breaking
+	case "nonbreaking":
+		r.compatibility = NonBreaking
+	case "warning":
+		r.compatibility = Warning
+	default:
+		return fmt.Errorf("unknown compatibility %q: use breaking, non-breaking or warning", r.Compatibility)
+	}
+
+	switch strings.ToLower(r.Direction) {
+	case "":
+	case "request":
+		r.direction = Request
+	case "response":
+		r.direction = Response
+	default:
+		return fmt.Errorf("unknown direction %q: use request or response", r.Direction)
+	}
+
+	if r.Path != "" {
+		pattern := regexp.QuoteMeta(r.Path)
+		// /** matches no segment too, e.g. /b/** matches /b
+		pattern = strings.Replace(pattern, `/\*\*`, "(/.*)?", -1)
+		pattern = strings.Replace(pattern, `\*\*`, ".*", -1)
+		pattern = strings.Replace(pattern, `\*`, "[^/]*", -1)
+		r.path = regexp.MustCompile("^" + pattern + "$")
+	}
+	return nil
+}
+
+// matches tells if a rule applies to a change, given the tags of its operation
+func (r *PolicyRule) matches(diff SpecDifference, tags map[string]bool) bool {
+	if diff.Code != r.code {
+		return false
+	}
+	if r.Path == "" && r.Tag == "" && r.Direction == "" {
+		return true
+	}
+	location := diff.DifferenceLocation
+	if location.URL == "" || location.Method == "" {
+		return false
+	}
+	direction := Request
+	if location.Response > 0 {
+		direction = Response
+	}
+	return (r.Direction == "" || r.direction == direction) &&
+		(r.path == nil || r.path.MatchString(location.URL)) &&
+		(r.Tag == "" || tags[r.Tag])
+}
+
+// Apply returns a copy of the changes between two specs, with the compatibility set by the first rule
+// which matches each change. The changes no rule matches keep their compatibility.
+func (p *Policy) Apply(diffs SpecDifferences, spec1, spec2 *spec.Swagger) SpecDifferences {
+	if p == nil {
+		return diffs
+	}
+	tags := make(map[URLMethod]map[string]bool)
+	for _, urlMethods := range []URLMethods{getURLMethodsFor(spec1), getURLMethodsFor(spec2)} {
+		for urlMethod, op := range urlMethods {
+			if tags[urlMethod] == nil {
+				tags[urlMethod] = make(map[string]bool)
+			}
+			for _, tag := range op.Operation.Tags {
+				tags[urlMethod][tag] = true
+			}
+		}
+	}
+
+	applied := make(SpecDifferences, 0, len(diffs))
+	for _, diff := range diffs {
+		location := diff.DifferenceLocation
+		for i := range p.Rules {
+			if p.Rules[i].matches(diff, tags[URLMethod{Path: location.URL, Method: location.Method}]) {
+				diff.Compatibility = p.Rules[i].compatibility
+				break
+			}
+		}
+		applied = append(applied, diff)
+	}
+	return applied
+}
@@ -0,0 +1,95 @@
+package diff
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+	"github.com/go-openapi/loads"
+)
+
+func TestReadPolicy(t *testing.T) {
+	policy, err := ReadPolicy(basePath + "/policy.yaml")
+	assertThat(t, err, is.Nil())
+	assertThat(t, len(policy.Rules), is.EqualTo(3))
+
+	dir, err := ioutil.TempDir("", "policy")
+	assertThat(t, err, is.Nil())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	for _, invalid := range []string{
+		"rules:\n  - code: NoSuchChange\n    compatibility: breaking\n",
+		"rules:\n  - code: AddedEnumValue\n    compatibility: sometimes\n",
+		"rules:\n  - code: AddedEnumValue\n    compatibility: breaking\n    direction: sideways\n",
+		"rules:\n  - code: AddedEnumValue\n    compatibility: breaking\n    method: get\n",
+	} {
+		file := filepath.Join(dir, "policy.yaml")
+		assertThat(t, ioutil.WriteFile(file, []byte(invalid), 0644), is.Nil())
+		_, err = ReadPolicy(file)
+		assertThat(t, err, is.Not(is.Nil()))
+	}
+	_, err = ReadPolicy(filepath.Join(dir, "missing.yaml"))
+	assertThat(t, err, is.Not(is.Nil()))
+}
+
+func TestPolicyApply(t *testing.T) {
+	doc1, err := loads.Spec(basePath + "/enum.v1.json")
+	assertThat(t, err, is.Nil())
+	doc2, err := loads.Spec(basePath + "/enum.v2.json")
+	assertThat(t, err, is.Nil())
+	diffs, err := Compare(doc1.Spec(), doc2.Spec())
+	assertThat(t, err, is.Nil())
+
+	policy, err := ReadPolicy(basePath + "/policy.yaml")
+	assertThat(t, err, is.Nil())
+	applied := policy.Apply(diffs, doc1.Spec(), doc2.Spec())
+	assertThat(t, len(applied), is.EqualTo(len(diffs)))
+
+	compatibilities := map[string]Compatibility{}
+	for _, diff := range applied {
+		compatibilities[diff.String()] = diff.Compatibility
+	}
+	// only the responses of /b/ accept new values
+	assertThat(t, compatibilities["/a/:get -> 200 Response - array[A1].personality : string - Added possible enumeration(s) <sane>"], is.EqualTo(Breaking))
+	assertThat(t, compatibilities["/b/:get -> 200 Response - array[A1].personality : string - Added possible enumeration(s) <sane>"], is.EqualTo(NonBreaking))
+	// deleted values break the responses
+	assertThat(t, compatibilities["/b/:get -> 200 Response - array[A1].personality : string - Deleted possible enumeration(s) <crazy>"], is.EqualTo(Breaking))
+	// no operation is tagged public
+	assertThat(t, compatibilities["/a/:get Request - Query.personality - Added possible enumeration(s) <extrovert>"], is.EqualTo(NonBreaking))
+	assertThat(t, diffs.BreakingChangeCount(), is.EqualTo(4))
+	assertThat(t, applied.BreakingChangeCount(), is.EqualTo(6))
+
+	var none *Policy
+	assertThat(t, len(none.Apply(diffs, doc1.Spec(), doc2.Spec())), is.EqualTo(len(diffs)))
+}
+
+func TestPolicyRuleMatches(t *testing.T) {
+	rule := PolicyRule{Code: "AddedEnumValue", Compatibility: "Breaking", Path: "/pets/*", Tag: "public"}
+	assertThat(t, rule.compile(), is.Nil())
+
+	diff := SpecDifference{Code: AddedEnumValue, DifferenceLocation: DifferenceLocation{URL: "/pets/{id}", Method: "get"}}
+	assertThat(t, rule.matches(diff, map[string]bool{"public": true}), is.EqualTo(true))
+	assertThat(t, rule.matches(diff, map[string]bool{"internal": true}), is.EqualTo(false))
+
+	diff.DifferenceLocation.URL = "/pets/{id}/owner"
+	assertThat(t, rule.matches(diff, map[string]bool{"public": true}), is.EqualTo(false))
+
+	diff.DifferenceLocation = DifferenceLocation{}
+	assertThat(t, rule.matches(diff, nil), is.EqualTo(false))
+
+	// a trailing /** matches the parent path too
+	rule = PolicyRule{Code: "AddedEnumValue", Compatibility: "Breaking", Path: "/b/**"}
+	assertThat(t, rule.compile(), is.Nil())
+	for url, expected := range map[string]bool{"/b": true, "/b/": true, "/b/{id}/owner": true, "/bc": false, "/a/b": false} {
+		diff.DifferenceLocation = DifferenceLocation{URL: url, Method: "get"}
+		assertThat(t, rule.matches(diff, nil), is.EqualTo(expected))
+	}
+
+	diff.Code = DeletedEnumValue
+	rule = PolicyRule{Code: "DeletedEnumValue", Compatibility: "non-breaking"}
+	assertThat(t, rule.compile(), is.Nil())
+	assertThat(t, rule.matches(diff, nil), is.EqualTo(true))
+}
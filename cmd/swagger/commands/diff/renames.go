@@ -0,0 +1,262 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// DefaultSimilarityThreshold is the similarity above which a deleted and an added definition are a renamed definition
+const DefaultSimilarityThreshold = 0.9
+
+var pathParamPattern = regexp.MustCompile(`{[^}]*}`)
+
+// findMovedEndpoints pairs the endpoints of the new spec with the endpoints they were moved from:
+// the operations with the same operationId under another path, and the paths which only renamed their parameters
+func (sd *SpecAnalyser) findMovedEndpoints() {
+	sd.movedFrom = map[URLMethod]URLMethod{}
+	sd.movedTo = map[URLMethod]URLMethod{}
+
+	deleted := []URLMethod{}
+	for urlMethod := range sd.urlMethods1 {
+		if _, ok := sd.urlMethods2[urlMethod]; !ok {
+			deleted = append(deleted, urlMethod)
+		}
+	}
+	added := []URLMethod{}
+	for urlMethod := range sd.urlMethods2 {
+		if _, ok := sd.urlMethods1[urlMethod]; !ok {
+			added = append(added, urlMethod)
+		}
+	}
+	sortURLMethods(deleted)
+	sortURLMethods(added)
+
+	move := func(from, to URLMethod) {
+		sd.movedFrom[to] = from
+		sd.movedTo[from] = to
+	}
+	for _, to := range added {
+		for _, from := range deleted {
+			if _, paired := sd.movedTo[from]; paired || from.Method != to.Method {
+				continue
+			}
+			if pathParamPattern.ReplaceAllString(from.Path, "{}") == pathParamPattern.ReplaceAllString(to.Path, "{}") {
+				move(from, to)
+				break
+			}
+		}
+	}
+	for _, to := range added {
+		operationID := sd.urlMethods2[to].Operation.ID
+		if _, paired := sd.movedFrom[to]; paired || operationID == "" {
+			continue
+		}
+		for _, from := range deleted {
+			if _, paired := sd.movedTo[from]; !paired && sd.urlMethods1[from].Operation.ID == operationID {
+				move(from, to)
+				sd.Diffs = sd.Diffs.addDiff(SpecDifference{
+					DifferenceLocation: DifferenceLocation{URL: to.Path, Method: to.Method},
+					Code:               MovedEndpoint,
+					DiffInfo:           fmt.Sprintf("%s %s -> %s %s", strings.ToUpper(from.Method), from.Path, strings.ToUpper(to.Method), to.Path)})
+				break
+			}
+		}
+	}
+}
+
+func sortURLMethods(urlMethods []URLMethod) {
+	sort.Slice(urlMethods, func(i, j int) bool {
+		if urlMethods[i].Path != urlMethods[j].Path {
+			return urlMethods[i].Path < urlMethods[j].Path
+		}
+		return urlMethods[i].Method < urlMethods[j].Method
+	})
+}
+
+// counterpart returns the endpoint of the old spec an endpoint of the new spec is compared with
+func (sd *SpecAnalyser) counterpart(urlMethod URLMethod) (*PathItemOp, bool) {
+	if from, moved := sd.movedFrom[urlMethod]; moved {
+		urlMethod = from
+	}
+	op, ok := sd.urlMethods1[urlMethod]
+	return op, ok
+}
+
+// findRenamedDefinitions pairs the deleted definitions with the most similar added definitions
+func (sd *SpecAnalyser) findRenamedDefinitions() {
+	sd.renamedDefinitions = map[string]string{}
+
+	type candidate struct {
+		from, to   string
+		similarity float64
+	}
+	candidates := []candidate{}
+	for from, schema1 := range sd.Definitions1 {
+		if _, ok := sd.Definitions2[from]; ok {
+			continue
+		}
+		for to, schema2 := range sd.Definitions2 {
+			if _, ok := sd.Definitions1[to]; ok {
+				continue
+			}
+			if similarity := schemaSimilarity(schema1, schema2); similarity >= sd.SimilarityThreshold {
+				candidates = append(candidates, candidate{from: from, to: to, similarity: similarity})
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].similarity != candidates[j].similarity {
+			return candidates[i].similarity > candidates[j].similarity
+		}
+		if candidates[i].from != candidates[j].from {
+			return candidates[i].from < candidates[j].from
+		}
+		return candidates[i].to < candidates[j].to
+	})
+
+	renamedTo := map[string]bool{}
+	location := DifferenceLocation{Node: getNameOnlyDiffNode("Spec")}.AddNode(getNameOnlyDiffNode("definitions"))
+	for _, eachCandidate := range candidates {
+		if _, renamed := sd.renamedDefinitions[eachCandidate.from]; renamed || renamedTo[eachCandidate.to] {
+			continue
+		}
+		sd.renamedDefinitions[eachCandidate.from] = eachCandidate.to
+		renamedTo[eachCandidate.to] = true
+		sd.Diffs = sd.Diffs.addDiff(SpecDifference{
+			DifferenceLocation: location,
+			Code:               RenamedDefinition,
+			DiffInfo:           fmt.Sprintf("%s -> %s", eachCandidate.from, eachCandidate.to)})
+	}
+}
+
+// schemaSimilarity is the share of the validations, types and properties two schemas have in common, from 0 to 1.
+// Descriptions, titles and examples are ignored.
+func schemaSimilarity(schema1, schema2 spec.Schema) float64 {
+	leaves1, leaves2 := schemaLeaves(schema1), schemaLeaves(schema2)
+	if len(leaves1) == 0 && len(leaves2) == 0 {
+		return 1
+	}
+	common := 0
+	for leaf := range leaves1 {
+		if leaves2[leaf] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(leaves1)+len(leaves2)-common)
+}
+
+// schemaLeaves returns the values of a schema by JSON pointer, e.g. /properties/name/type=string
+func schemaLeaves(schema spec.Schema) map[string]bool {
+	leaves := map[string]bool{}
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return leaves
+	}
+	var document interface{}
+	if err := json.Unmarshal(b, &document); err != nil {
+		return leaves
+	}
+
+	var walk func(pointer string, node interface{}, names bool)
+	walk = func(pointer string, node interface{}, names bool) {
+		switch value := node.(type) {
+		case map[string]interface{}:
+			for key, child := range value {
+				if !names && (key == "description" || key == "title" || key == "example" || strings.HasPrefix(key, "x-")) {
+					continue
+				}
+				walk(pointer+"/"+key, child, !names && (key == "properties" || key == "patternProperties" || key == "definitions"))
+			}
+			if len(value) == 0 {
+				leaves[pointer+"={}"] = true
+			}
+		case []interface{}:
+			for i, child := range value {
+				walk(fmt.Sprintf("%s/%d", pointer, i), child, false)
+			}
+		default:
+			leaves[fmt.Sprintf("%s=%v", pointer, value)] = true
+		}
+	}
+	walk("", document, false)
+	return leaves
+}
+
+// findRenamedParams pairs the deleted params of an operation with identical added params.
+//
+// Optional query, header and form params are not paired: their deletion and addition are reported as such.
+func (sd *SpecAnalyser) findRenamedParams(location DifferenceLocation, params1, params2 map[string]spec.Parameter) map[string]string {
+	deleted := []string{}
+	for name := range params1 {
+		if _, ok := params2[name]; !ok {
+			deleted = append(deleted, name)
+		}
+	}
+	added := []string{}
+	for name := range params2 {
+		if _, ok := params1[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(deleted)
+	sort.Strings(added)
+
+	renamed := map[string]string{}
+	renamedTo := map[string]bool{}
+	for _, from := range deleted {
+		for _, to := range added {
+			if renamedTo[to] || !renameable(params2[to]) || !sd.sameParam(params1[from], params2[to]) {
+				continue
+			}
+			renamed[from] = to
+			renamedTo[to] = true
+
+			code := RenamedParam
+			switch params2[to].In {
+			case "path":
+				code = RenamedPathParam
+			case "body":
+				code = RenamedBodyParam
+			}
+			sd.Diffs = sd.Diffs.addDiff(SpecDifference{
+				DifferenceLocation: location.AddNode(getSchemaDiffNode(to, params2[to].Schema)),
+				Code:               code,
+				DiffInfo:           fmt.Sprintf("%s -> %s", from, to)})
+			break
+		}
+	}
+	return renamed
+}
+
+func isRenamedTo(renamed map[string]string, name string) bool {
+	for _, to := range renamed {
+		if to == name {
+			return true
+		}
+	}
+	return false
+}
+
+func renameable(param spec.Parameter) bool {
+	return param.In == "path" || param.In == "body" || param.Required
+}
+
+// sameParam tells if two params only differ by their names, their descriptions and the names of their definitions
+func (sd *SpecAnalyser) sameParam(param1, param2 spec.Parameter) bool {
+	param1.Name, param2.Name = "", ""
+	param1.Description, param2.Description = "", ""
+	if param1.Schema != nil && param2.Schema != nil {
+		_, definition1 := sd.schemaFromRef(param1.Schema, &sd.Definitions1)
+		_, definition2 := sd.schemaFromRef(param2.Schema, &sd.Definitions2)
+		if definition1 != "" && sd.renamedDefinitions[definition1] == definition2 {
+			param1.Schema, param2.Schema = nil, nil
+		}
+	}
+	return reflect.DeepEqual(param1, param2)
+}
@@ -0,0 +1,56 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+func TestSchemaSimilarity(t *testing.T) {
+	store := spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:        spec.StringOrArray{"object"},
+		Description: "a store",
+		Properties: map[string]spec.Schema{
+			"id":      *spec.Int64Property(),
+			"address": *spec.StringProperty(),
+		},
+	}}
+	shop := store
+	shop.Description = "a shop"
+	assertThat(t, schemaSimilarity(store, shop), is.EqualTo(1.0))
+
+	warehouse := store
+	warehouse.Properties = map[string]spec.Schema{
+		"id":       *spec.Int64Property(),
+		"location": *spec.StringProperty(),
+	}
+	// type, id type and id format in common, out of 5 leaves
+	assertThat(t, schemaSimilarity(store, warehouse), is.EqualTo(0.6))
+}
+
+func TestCompareWithSimilarity(t *testing.T) {
+	specDoc1, err := loads.Spec(basePath + "/renames.v1.json")
+	dieOn(err, t)
+	specDoc2, err := loads.Spec(basePath + "/renames.v2.json")
+	dieOn(err, t)
+
+	renamedDefinitions := func(diffs SpecDifferences) []string {
+		renamed := []string{}
+		for _, eachDiff := range diffs {
+			if eachDiff.Code == RenamedDefinition {
+				renamed = append(renamed, eachDiff.DiffInfo)
+			}
+		}
+		return renamed
+	}
+
+	diffs, err := CompareWithSimilarity(specDoc1.Spec(), specDoc2.Spec(), DefaultSimilarityThreshold)
+	assertThat(t, err, is.Nil())
+	assertThat(t, len(renamedDefinitions(diffs)), is.EqualTo(2))
+
+	diffs, err = CompareWithSimilarity(specDoc1.Spec(), specDoc2.Spec(), 0.5)
+	assertThat(t, err, is.Nil())
+	assertThat(t, renamedDefinitions(diffs), is.ValueContaining("Store -> Warehouse"))
+}
@@ -0,0 +1,372 @@
+package diff
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Formats of the diff reports
+const (
+	TextFormat     = "txt"
+	JSONFormat     = "json"
+	MarkdownFormat = "markdown"
+	HTMLFormat     = "html"
+	JUnitFormat    = "junit"
+)
+
+// Severities of the changes which fail a diff
+const (
+	FailOnBreaking = "breaking"
+	FailOnWarning  = "warning"
+	FailOnAny      = "any"
+	FailOnNone     = "none"
+)
+
+// severities orders the changes of the reports, the most severe first
+var severities = []Compatibility{Breaking, Warning, NonBreaking}
+
+var compatibilityTitles = map[Compatibility]string{
+	Breaking:    "breaking",
+	Warning:     "warning",
+	NonBreaking: "non-breaking",
+}
+
+// WarningCount Calculates the count of the changes which are warnings
+func (sd SpecDifferences) WarningCount() int {
+	count := 0
+	for _, eachDiff := range sd {
+		if eachDiff.Compatibility == Warning {
+			count++
+		}
+	}
+	return count
+}
+
+// Check returns an error when some changes are as severe as a severity:
+// breaking, warning (breaking changes and warnings), any change or none.
+func (sd SpecDifferences) Check(failOn string) error {
+	breaking, warnings := sd.BreakingChangeCount(), sd.WarningCount()
+	switch failOn {
+	case FailOnNone:
+	case FailOnBreaking, "":
+		if breaking > 0 {
+			return fmt.Errorf("compatibility Test FAILED: %d Breaking changes detected", breaking)
+		}
+	case FailOnWarning:
+		if breaking+warnings > 0 {
+			return fmt.Errorf("compatibility Test FAILED: %d Breaking changes and %d warnings detected", breaking, warnings)
+		}
+	case FailOnAny:
+		if len(sd) > 0 {
+			return fmt.Errorf("compatibility Test FAILED: %d changes detected", len(sd))
+		}
+	default:
+		return fmt.Errorf("unknown severity %q: use %s, %s, %s or %s", failOn, FailOnBreaking, FailOnWarning, FailOnAny, FailOnNone)
+	}
+	return nil
+}
+
+// WriteReport writes a report of the changes in a format: txt, json, markdown, html or junit.
+//
+// Only the breaking changes are reported when onlyBreaking is set.
+func (sd SpecDifferences) WriteReport(w io.Writer, format string, onlyBreaking bool) error {
+	diffs := sd
+	if onlyBreaking {
+		diffs = SpecDifferences{}
+		for _, eachDiff := range sd {
+			if eachDiff.Compatibility == Breaking {
+				diffs = append(diffs, eachDiff)
+			}
+		}
+	}
+
+	switch format {
+	case TextFormat, "":
+		diffs.writeText(w, onlyBreaking)
+		return nil
+	case JSONFormat:
+		b, err := JSONMarshal(diffs)
+		if err != nil {
+			return err
+		}
+		pretty, err := prettyprint(b)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(pretty))
+		return err
+	case MarkdownFormat:
+		return diffs.writeMarkdown(w)
+	case HTMLFormat:
+		return diffs.writeHTML(w)
+	case JUnitFormat:
+		return diffs.writeJUnit(w)
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+func (sd SpecDifferences) writeText(w io.Writer, onlyBreaking bool) {
+	if !onlyBreaking {
+		if len(sd) == 0 {
+			fmt.Fprintln(w, "No changes identified")
+			return
+		}
+		if len(sd) != sd.BreakingChangeCount()+sd.WarningCount() {
+			fmt.Fprintln(w, "NON-BREAKING CHANGES:\n=====================")
+			sd.reportChanges(w, NonBreaking)
+		}
+		if sd.WarningCount() > 0 {
+			fmt.Fprintf(w, "\nWARNINGS:\n=========\n")
+			sd.reportChanges(w, Warning)
+		}
+	}
+	if sd.BreakingChangeCount() > 0 {
+		fmt.Fprintf(w, "\nBREAKING CHANGES:\n=================\n")
+		sd.reportChanges(w, Breaking)
+	}
+}
+
+// Endpoint returns the operation of a change, e.g. GET /pets, or Spec for the changes of the spec metadata
+func (sd SpecDifference) Endpoint() string {
+	location := sd.DifferenceLocation
+	switch {
+	case location.URL == "":
+		return "Spec"
+	case location.Method == "":
+		return location.URL
+	default:
+		return strings.ToUpper(location.Method) + " " + location.URL
+	}
+}
+
+// Where returns the part of the endpoint a change is located in, e.g. 200 Response body.name : string
+func (sd SpecDifference) Where() string {
+	location := sd.DifferenceLocation
+	parts := []string{}
+	if location.Response > 0 {
+		parts = append(parts, fmt.Sprintf("%d Response", location.Response))
+	} else if location.URL != "" && location.Method != "" {
+		parts = append(parts, "Request")
+	}
+	if location.Node != nil {
+		parts = append(parts, location.Node.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// endpointChanges are the changes of an endpoint, the most severe first
+type endpointChanges struct {
+	Endpoint string
+	Breaking int
+	Warnings int
+	Changes  []SpecDifference
+}
+
+// byEndpoint groups the changes by endpoint: the endpoints with breaking changes, then with warnings, come first
+func (sd SpecDifferences) byEndpoint() []endpointChanges {
+	index := map[string]int{}
+	groups := []endpointChanges{}
+	for _, eachDiff := range sd {
+		endpoint := eachDiff.Endpoint()
+		i, ok := index[endpoint]
+		if !ok {
+			i = len(groups)
+			index[endpoint] = i
+			groups = append(groups, endpointChanges{Endpoint: endpoint})
+		}
+		switch eachDiff.Compatibility {
+		case Breaking:
+			groups[i].Breaking++
+		case Warning:
+			groups[i].Warnings++
+		}
+		groups[i].Changes = append(groups[i].Changes, eachDiff)
+	}
+
+	rank := map[Compatibility]int{}
+	for i, compat := range severities {
+		rank[compat] = i
+	}
+	for _, group := range groups {
+		changes := group.Changes
+		sort.SliceStable(changes, func(i, j int) bool {
+			if changes[i].Compatibility != changes[j].Compatibility {
+				return rank[changes[i].Compatibility] < rank[changes[j].Compatibility]
+			}
+			return changes[i].String() < changes[j].String()
+		})
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].Breaking > 0) != (groups[j].Breaking > 0) {
+			return groups[i].Breaking > 0
+		}
+		if (groups[i].Warnings > 0) != (groups[j].Warnings > 0) {
+			return groups[i].Warnings > 0
+		}
+		return groups[i].Endpoint < groups[j].Endpoint
+	})
+	return groups
+}
+
+// summary counts the changes by compatibility, e.g. 2 breaking, 1 warning, 3 non-breaking
+func (sd SpecDifferences) summary() string {
+	counts := map[Compatibility]int{}
+	for _, eachDiff := range sd {
+		counts[eachDiff.Compatibility]++
+	}
+	parts := []string{}
+	for _, compat := range severities {
+		switch {
+		case counts[compat] == 0:
+		case compat == Warning && counts[compat] > 1:
+			parts = append(parts, fmt.Sprintf("%d warnings", counts[compat]))
+		default:
+			parts = append(parts, fmt.Sprintf("%d %s", counts[compat], compatibilityTitles[compat]))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "<", "&lt;", ">", "&gt;", "`", "'", "\n", " ")
+
+func (sd SpecDifferences) writeMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# API changes\n\n")
+	if len(sd) == 0 {
+		b.WriteString("No changes identified\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	fmt.Fprintf(&b, "%s\n", sd.summary())
+	for _, group := range sd.byEndpoint() {
+		fmt.Fprintf(&b, "\n## `%s`\n\n", strings.Replace(group.Endpoint, "`", "'", -1))
+		b.WriteString("| Compatibility | Location | Change | Details |\n")
+		b.WriteString("|---|---|---|---|\n")
+		for _, eachDiff := range group.Changes {
+			title := compatibilityTitles[eachDiff.Compatibility]
+			if eachDiff.Compatibility == Breaking {
+				title = "**" + title + "**"
+			}
+			details := ""
+			if eachDiff.DiffInfo != "" {
+				details = "`" + strings.Replace(strings.Replace(eachDiff.DiffInfo, "`", "'", -1), "|", `\|`, -1) + "`"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+				title, markdownEscaper.Replace(eachDiff.Where()), markdownEscaper.Replace(eachDiff.Code.Description()), details)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"compatibility": func(compat Compatibility) string { return compatibilityTitles[compat] },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>API changes</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #333; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 2em; }
+th, td { text-align: left; padding: 0.4em 0.8em; border-bottom: 1px solid #ddd; }
+code { font-size: 0.9em; }
+.breaking { color: #b00020; font-weight: bold; }
+.warning { color: #b36b00; }
+.non-breaking { color: #2e7d32; }
+</style>
+</head>
+<body>
+<h1>API changes</h1>
+{{- if .Groups }}
+<p>{{ .Summary }}</p>
+{{- range .Groups }}
+<h2><code>{{ .Endpoint }}</code></h2>
+<table>
+<tr><th>Compatibility</th><th>Location</th><th>Change</th><th>Details</th></tr>
+{{- range .Changes }}
+{{- $compat := compatibility .Compatibility }}
+<tr class="{{ $compat }}"><td class="{{ $compat }}">{{ $compat }}</td><td>{{ .Where }}</td><td>{{ .Code.Description }}</td><td>{{ if .DiffInfo }}<code>{{ .DiffInfo }}</code>{{ end }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- else }}
+<p>No changes identified</p>
+{{- end }}
+</body>
+</html>
+`))
+
+func (sd SpecDifferences) writeHTML(w io.Writer) error {
+	return htmlReport.Execute(w, struct {
+		Summary string
+		Groups  []endpointChanges
+	}{
+		Summary: sd.summary(),
+		Groups:  sd.byEndpoint(),
+	})
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit writes a test suite by endpoint, with a test case by change: the breaking changes fail
+func (sd SpecDifferences) writeJUnit(w io.Writer) error {
+	report := junitTestSuites{Suites: []junitTestSuite{}}
+	for _, group := range sd.byEndpoint() {
+		suite := junitTestSuite{Name: group.Endpoint, Tests: len(group.Changes), Failures: group.Breaking}
+		for _, eachDiff := range group.Changes {
+			testCase := junitTestCase{
+				Name:      strings.TrimSpace(eachDiff.Where() + " " + eachDiff.Code.Description()),
+				ClassName: group.Endpoint,
+			}
+			switch eachDiff.Compatibility {
+			case Breaking:
+				testCase.Failure = &junitFailure{Message: eachDiff.Code.Description(), Type: toStringSpecChangeCode[eachDiff.Code], Text: eachDiff.String()}
+			case Warning:
+				testCase.SystemOut = "warning: " + eachDiff.String()
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		report.Suites = append(report.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
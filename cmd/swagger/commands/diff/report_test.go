@@ -0,0 +1,112 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+)
+
+func reportFixture(t *testing.T) SpecDifferences {
+	diffs, err := getDiffs(basePath+"/enum.v1.json", basePath+"/enum.v2.json")
+	assertThat(t, err, is.Nil())
+	// a warning on an endpoint without breaking changes
+	for i := range diffs {
+		if diffs[i].DifferenceLocation.URL == "/b/" && diffs[i].Code == AddedEnumValue {
+			diffs[i].Compatibility = Warning
+		}
+	}
+	return diffs
+}
+
+func TestCheck(t *testing.T) {
+	diffs := reportFixture(t)
+	assertThat(t, diffs.Check(FailOnNone), is.Nil())
+	assertThat(t, diffs.Check(FailOnBreaking).Error(), is.ValueContaining("3 Breaking changes"))
+	assertThat(t, diffs.Check(FailOnWarning).Error(), is.ValueContaining("3 Breaking changes and 1 warnings"))
+	assertThat(t, diffs.Check(FailOnAny).Error(), is.ValueContaining("8 changes"))
+	assertThat(t, diffs.Check("sometimes"), is.Not(is.Nil()))
+
+	var nonBreaking SpecDifferences
+	for _, diff := range diffs {
+		if diff.Compatibility == NonBreaking {
+			nonBreaking = append(nonBreaking, diff)
+		}
+	}
+	assertThat(t, nonBreaking.Check(FailOnWarning), is.Nil())
+	assertThat(t, nonBreaking.Check(FailOnAny), is.Not(is.Nil()))
+	assertThat(t, SpecDifferences{}.Check(FailOnAny), is.Nil())
+}
+
+func TestWriteReportText(t *testing.T) {
+	var b bytes.Buffer
+	assertThat(t, reportFixture(t).WriteReport(&b, TextFormat, false), is.Nil())
+	report := b.String()
+	assertThat(t, report, is.ValueContaining("NON-BREAKING CHANGES:"))
+	assertThat(t, report, is.ValueContaining("WARNINGS:\n=========\n/b/:get -> 200 Response - array[A1].personality : string - Added possible enumeration(s) <sane>\n"))
+	assertThat(t, strings.Index(report, "WARNINGS:") < strings.Index(report, "\nBREAKING CHANGES:"), is.EqualTo(true))
+
+	b.Reset()
+	assertThat(t, reportFixture(t).WriteReport(&b, TextFormat, true), is.Nil())
+	assertThat(t, b.String(), is.Not(is.ValueContaining("WARNINGS:")))
+	assertThat(t, strings.Count(b.String(), "\n/"), is.EqualTo(3))
+
+	b.Reset()
+	assertThat(t, SpecDifferences{}.WriteReport(&b, TextFormat, false), is.Nil())
+	assertThat(t, b.String(), is.EqualTo("No changes identified\n"))
+
+	assertThat(t, SpecDifferences{}.WriteReport(&b, "pdf", false), is.Not(is.Nil()))
+}
+
+func TestWriteReportMarkdown(t *testing.T) {
+	var b bytes.Buffer
+	assertThat(t, reportFixture(t).WriteReport(&b, MarkdownFormat, false), is.Nil())
+	report := b.String()
+	assertThat(t, report, is.ValueContaining("# API changes\n\n3 breaking, 1 warning, 4 non-breaking\n"))
+	assertThat(t, report, is.ValueContaining("| **breaking** | Request Query.personality | Deleted possible enumeration(s) | `saucy` |\n"))
+	assertThat(t, report, is.ValueContaining("| warning | 200 Response array[A1].personality : string | Added possible enumeration(s) | `sane` |\n"))
+
+	// endpoints with breaking changes first, then with warnings, and breaking changes first in each endpoint
+	a, id, bEndpoint := strings.Index(report, "## `GET /a/`"), strings.Index(report, "## `GET /a/{id}`"), strings.Index(report, "## `GET /b/`")
+	assertThat(t, a < id && id < bEndpoint, is.EqualTo(true))
+	section := report[a:id]
+	assertThat(t, strings.LastIndex(section, "**breaking**") < strings.Index(section, "non-breaking"), is.EqualTo(true))
+}
+
+func TestWriteReportHTML(t *testing.T) {
+	var b bytes.Buffer
+	assertThat(t, reportFixture(t).WriteReport(&b, HTMLFormat, true), is.Nil())
+	report := b.String()
+	assertThat(t, report, is.ValueContaining("<!DOCTYPE html>"))
+	assertThat(t, report, is.ValueContaining("<p>3 breaking</p>"))
+	assertThat(t, report, is.ValueContaining(`<td class="breaking">breaking</td><td>Request Query.personality</td><td>Deleted possible enumeration(s)</td><td><code>saucy</code></td>`))
+	assertThat(t, report, is.Not(is.ValueContaining("GET /b/")))
+
+	b.Reset()
+	assertThat(t, SpecDifferences{}.WriteReport(&b, HTMLFormat, false), is.Nil())
+	assertThat(t, b.String(), is.ValueContaining("No changes identified"))
+}
+
+func TestWriteReportJUnit(t *testing.T) {
+	var b bytes.Buffer
+	assertThat(t, reportFixture(t).WriteReport(&b, JUnitFormat, false), is.Nil())
+
+	var report junitTestSuites
+	assertThat(t, xml.Unmarshal(b.Bytes(), &report), is.Nil())
+	assertThat(t, len(report.Suites), is.EqualTo(3))
+	tests, failures := 0, 0
+	for _, suite := range report.Suites {
+		tests += suite.Tests
+		failures += suite.Failures
+		for _, testCase := range suite.Cases {
+			if testCase.Failure != nil {
+				assertThat(t, testCase.Failure.Type == "AddedEnumValue" || testCase.Failure.Type == "DeletedEnumValue", is.EqualTo(true))
+			}
+		}
+	}
+	assertThat(t, tests, is.EqualTo(8))
+	assertThat(t, failures, is.EqualTo(3))
+	assertThat(t, b.String(), is.ValueContaining("<system-out>warning: /b/:get -&gt; 200 Response"))
+}
@@ -16,7 +16,14 @@ var ArrayType = "array"
 // Compare returns the result of analysing breaking and non breaking changes
 // between to Swagger specs
 func Compare(spec1, spec2 *spec.Swagger) (diffs SpecDifferences, err error) {
+	return CompareWithSimilarity(spec1, spec2, DefaultSimilarityThreshold)
+}
+
+// CompareWithSimilarity returns the result of analysing breaking and non breaking changes
+// between two Swagger specs, with the similarity above which a definition is reported as renamed
+func CompareWithSimilarity(spec1, spec2 *spec.Swagger, similarity float64) (diffs SpecDifferences, err error) {
 	analyser := NewSpecAnalyser()
+	analyser.SimilarityThreshold = similarity
 	err = analyser.Analyse(spec1, spec2)
 	if err != nil {
 		return nil, err
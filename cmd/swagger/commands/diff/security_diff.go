@@ -0,0 +1,181 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// securityAlternatives indexes security requirements by the names of their schemes.
+//
+// Each requirement is an alternative a client may satisfy: no requirement, or an empty one,
+// makes authentication optional and is indexed by an empty name.
+type securityAlternatives map[string]map[string][]string
+
+func getSecurityAlternatives(requirements []map[string][]string) securityAlternatives {
+	alternatives := securityAlternatives{}
+	if len(requirements) == 0 {
+		alternatives[""] = map[string][]string{}
+	}
+	for _, requirement := range requirements {
+		names := make([]string, 0, len(requirement))
+		for name := range requirement {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		alternatives[strings.Join(names, " + ")] = requirement
+	}
+	return alternatives
+}
+
+// names returns the sorted names of the alternatives which require authentication
+func (a securityAlternatives) names() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (sd *SpecAnalyser) analyseSecurity(spec1, spec2 *spec.Swagger) {
+	location := DifferenceLocation{Node: getNameOnlyDiffNode("Spec")}
+	sd.compareSecurityDefinitions(location.AddNode(getNameOnlyDiffNode("securityDefinitions")), spec1.SecurityDefinitions, spec2.SecurityDefinitions)
+	sd.compareSecurityRequirements(location.AddNode(getNameOnlyDiffNode("security")), spec1.Security, spec2.Security)
+
+	// operations which inherit the security of the spec in both versions are covered by the spec
+	for URLMethod, op2 := range sd.urlMethods2 {
+		op1, ok := sd.urlMethods1[URLMethod]
+		if !ok || (op1.Operation.Security == nil && op2.Operation.Security == nil) {
+			continue
+		}
+		security1, security2 := op1.Operation.Security, op2.Operation.Security
+		if security1 == nil {
+			security1 = spec1.Security
+		}
+		if security2 == nil {
+			security2 = spec2.Security
+		}
+		opLocation := DifferenceLocation{URL: URLMethod.Path, Method: URLMethod.Method, Node: getNameOnlyDiffNode("Security")}
+		sd.compareSecurityRequirements(opLocation, security1, security2)
+	}
+}
+
+func (sd *SpecAnalyser) compareSecurityRequirements(location DifferenceLocation, security1, security2 []map[string][]string) {
+	alternatives1 := getSecurityAlternatives(security1)
+	alternatives2 := getSecurityAlternatives(security2)
+	_, optional1 := alternatives1[""]
+	_, optional2 := alternatives2[""]
+
+	// when authentication becomes required or optional, the alternatives are reported with it
+	addedAuthentication := optional1 && !optional2
+	deletedAuthentication := !optional1 && optional2
+	if addedAuthentication {
+		sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: AddedAuthentication, DiffInfo: strings.Join(alternatives2.names(), " | ")})
+	}
+	if deletedAuthentication {
+		sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: DeletedAuthentication, DiffInfo: strings.Join(alternatives1.names(), " | ")})
+	}
+
+	for _, name := range alternatives2.names() {
+		if _, ok := alternatives1[name]; !ok && !addedAuthentication {
+			sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: AddedSecurityRequirement, DiffInfo: name})
+		}
+	}
+	for _, name := range alternatives1.names() {
+		requirement2, ok := alternatives2[name]
+		if !ok {
+			if !deletedAuthentication {
+				sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: DeletedSecurityRequirement, DiffInfo: name})
+			}
+			continue
+		}
+		requirement1 := alternatives1[name]
+		schemes := make([]string, 0, len(requirement1))
+		for scheme := range requirement1 {
+			schemes = append(schemes, scheme)
+		}
+		sort.Strings(schemes)
+		for _, scheme := range schemes {
+			added, deleted, _ := FromStringArray(requirement1[scheme]).DiffsTo(requirement2[scheme])
+			sort.Strings(added)
+			sort.Strings(deleted)
+			for _, scope := range added {
+				sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: AddedRequiredScope, DiffInfo: fmt.Sprintf("%s: %s", scheme, scope)})
+			}
+			for _, scope := range deleted {
+				sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: DeletedRequiredScope, DiffInfo: fmt.Sprintf("%s: %s", scheme, scope)})
+			}
+		}
+	}
+}
+
+func (sd *SpecAnalyser) compareSecurityDefinitions(location DifferenceLocation, definitions1, definitions2 spec.SecurityDefinitions) {
+	names := make([]string, 0, len(definitions1)+len(definitions2))
+	for name := range definitions1 {
+		names = append(names, name)
+	}
+	for name := range definitions2 {
+		if _, ok := definitions1[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		scheme1, ok1 := definitions1[name]
+		scheme2, ok2 := definitions2[name]
+		childLocation := location.AddNode(getNameOnlyDiffNode(name))
+		switch {
+		case !ok1:
+			sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: childLocation, Code: AddedSecurityDefinition, DiffInfo: scheme2.Type})
+		case !ok2:
+			sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: childLocation, Code: DeletedSecurityDefinition, DiffInfo: scheme1.Type})
+		case scheme1 != nil && scheme2 != nil:
+			sd.compareSecurityScheme(childLocation, scheme1, scheme2)
+		}
+	}
+}
+
+func (sd *SpecAnalyser) compareSecurityScheme(location DifferenceLocation, scheme1, scheme2 *spec.SecurityScheme) {
+	changes := []struct {
+		field  string
+		value1 string
+		value2 string
+		code   SpecChangeCode
+	}{
+		{"type", scheme1.Type, scheme2.Type, ChangedSecuritySchemeType},
+		{"in", scheme1.In, scheme2.In, ChangedSecuritySchemeType},
+		{"name", scheme1.Name, scheme2.Name, ChangedSecuritySchemeType},
+		{"flow", scheme1.Flow, scheme2.Flow, ChangedSecuritySchemeType},
+		{"authorizationUrl", scheme1.AuthorizationURL, scheme2.AuthorizationURL, ChangedSecurityURL},
+		{"tokenUrl", scheme1.TokenURL, scheme2.TokenURL, ChangedSecurityURL},
+	}
+	for _, change := range changes {
+		if change.value1 != change.value2 {
+			sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: change.code, DiffInfo: fmt.Sprintf("%s: %s -> %s", change.field, change.value1, change.value2)})
+		}
+	}
+
+	scopes1 := make([]string, 0, len(scheme1.Scopes))
+	for scope := range scheme1.Scopes {
+		scopes1 = append(scopes1, scope)
+	}
+	scopes2 := make([]string, 0, len(scheme2.Scopes))
+	for scope := range scheme2.Scopes {
+		scopes2 = append(scopes2, scope)
+	}
+	added, deleted, _ := FromStringArray(scopes1).DiffsTo(scopes2)
+	sort.Strings(added)
+	sort.Strings(deleted)
+	for _, scope := range added {
+		sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: AddedSecurityScope, DiffInfo: scope})
+	}
+	for _, scope := range deleted {
+		sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: DeletedSecurityScope, DiffInfo: scope})
+	}
+}
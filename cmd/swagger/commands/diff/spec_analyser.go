@@ -9,6 +9,9 @@ import (
 
 const StringType = "string"
 
+// ObjectType const for object
+const ObjectType = "object"
+
 // URLMethodResponse encapsulates these three elements to act as a map key
 type URLMethodResponse struct {
 	Path     string `json:"path"`
@@ -32,12 +35,18 @@ type SpecAnalyser struct {
 	Definitions1               spec.Definitions
 	Definitions2               spec.Definitions
 	AlreadyComparedDefinitions map[string]bool
+	// SimilarityThreshold is the similarity above which a deleted and an added definition are a renamed definition
+	SimilarityThreshold float64
+	movedFrom           map[URLMethod]URLMethod
+	movedTo             map[URLMethod]URLMethod
+	renamedDefinitions  map[string]string
 }
 
 // NewSpecAnalyser returns an empty SpecDiffs
 func NewSpecAnalyser() *SpecAnalyser {
 	return &SpecAnalyser{
-		Diffs: SpecDifferences{},
+		Diffs:               SpecDifferences{},
+		SimilarityThreshold: DefaultSimilarityThreshold,
 	}
 }
 
@@ -49,10 +58,13 @@ func (sd *SpecAnalyser) Analyse(spec1, spec2 *spec.Swagger) error {
 	sd.urlMethods2 = getURLMethodsFor(spec2)
 
 	sd.analyseSpecMetadata(spec1, spec2)
+	sd.findRenamedDefinitions()
+	sd.findMovedEndpoints()
 	sd.analyseEndpoints()
 	sd.analyseParams()
 	sd.analyseEndpointData()
 	sd.analyseResponseParams()
+	sd.analyseSecurity(spec1, spec2)
 
 	return nil
 }
@@ -104,9 +116,6 @@ func (sd *SpecAnalyser) analyseSpecMetadata(spec1, spec2 *spec.Swagger) {
 	// // Base Path change will break non generated clients
 	sd.analyseMetaDataProperty(spec1.BasePath, spec2.BasePath, ChangedBasePath, Breaking)
 
-	// TODO: what to do about security?
-	// Missing security scheme will break a client
-	// Security            []map[string][]string  `json:"security,omitempty"`
 	// Tags                []Tag                  `json:"tags,omitempty"`
 	// ExternalDocs        *ExternalDocumentation `json:"externalDocs,omitempty"`
 }
@@ -119,7 +128,7 @@ func (sd *SpecAnalyser) analyseEndpoints() {
 func (sd *SpecAnalyser) analyseEndpointData() {
 
 	for URLMethod, op2 := range sd.urlMethods2 {
-		if op1, ok := sd.urlMethods1[URLMethod]; ok {
+		if op1, ok := sd.counterpart(URLMethod); ok {
 			addedTags, deletedTags, _ := FromStringArray(op1.Operation.Tags).DiffsTo(op2.Operation.Tags)
 			location := DifferenceLocation{URL: URLMethod.Path, Method: URLMethod.Method}
 
@@ -132,6 +141,10 @@ func (sd *SpecAnalyser) analyseEndpointData() {
 
 			sd.compareDescripton(location, op1.Operation.Description, op2.Operation.Description)
 
+			if op2.Operation.Deprecated && !op1.Operation.Deprecated {
+				sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: DeprecatedEndpoint})
+			}
+
 		}
 	}
 
@@ -143,15 +156,20 @@ func (sd *SpecAnalyser) analyseParams() {
 	for _, paramLocation := range locations {
 		rootNode := getNameOnlyDiffNode(strings.Title(paramLocation))
 		for URLMethod, op2 := range sd.urlMethods2 {
-			if op1, ok := sd.urlMethods1[URLMethod]; ok {
+			if op1, ok := sd.counterpart(URLMethod); ok {
 
 				params1 := getParams(op1.ParentPathItem.Parameters, op1.Operation.Parameters, paramLocation)
 				params2 := getParams(op2.ParentPathItem.Parameters, op2.Operation.Parameters, paramLocation)
 
 				location := DifferenceLocation{URL: URLMethod.Path, Method: URLMethod.Method, Node: rootNode}
+				renamed := sd.findRenamedParams(location, params1, params2)
 
 				// detect deleted params
 				for paramName1, param1 := range params1 {
+					if newName, ok := renamed[paramName1]; ok {
+						sd.compareParams(URLMethod, paramLocation, newName, param1, params2[newName])
+						continue
+					}
 					if _, ok := params2[paramName1]; !ok {
 						childLocation := location.AddNode(getSchemaDiffNode(paramName1, param1.Schema))
 						code := DeletedOptionalParam
@@ -166,7 +184,7 @@ func (sd *SpecAnalyser) analyseParams() {
 					//changed?
 					if param1, ok := params1[paramName2]; ok {
 						sd.compareParams(URLMethod, paramLocation, paramName2, param1, param2)
-					} else {
+					} else if !isRenamedTo(renamed, paramName2) {
 						// Added
 						childLocation := location.AddNode(getSchemaDiffNode(paramName2, param2.Schema))
 						code := AddedOptionalParam
@@ -184,7 +202,7 @@ func (sd *SpecAnalyser) analyseParams() {
 func (sd *SpecAnalyser) analyseResponseParams() {
 	// Loop through url+methods in spec 2 - check deleted and changed
 	for URLMethod2, op2 := range sd.urlMethods2 {
-		if op1, ok := sd.urlMethods1[URLMethod2]; ok {
+		if op1, ok := sd.counterpart(URLMethod2); ok {
 			// compare responses for url and method
 			op1Responses := op1.Operation.Responses.StatusCodeResponses
 			op2Responses := op2.Operation.Responses.StatusCodeResponses
@@ -283,24 +301,37 @@ func (sd *SpecAnalyser) CheckToFromArrayType(diffs []TypeDiff, type1, type2 spec
 
 	if type1Array && type2Array {
 		// array
-		// TODO: Items??
-		diffs = addTypeDiff(diffs, compareIntValues("MaxItems", type1.MaxItems, type2.MaxItems, WidenedType, NarrowedType))
-		diffs = addTypeDiff(diffs, compareIntValues("MinItems", type1.MinItems, type2.MinItems, NarrowedType, WidenedType))
-
+		diffs = addTypeDiff(diffs, compareIntLimits("MaxItems", type1.MaxItems, type2.MaxItems, true, TightenedMaxItems, LoosenedMaxItems))
+		diffs = addTypeDiff(diffs, compareIntLimits("MinItems", type1.MinItems, type2.MinItems, false, TightenedMinItems, LoosenedMinItems))
+		diffs = addTypeDiff(diffs, compareFlags("UniqueItems", type1.UniqueItems, type2.UniqueItems, TightenedUniqueItems, LoosenedUniqueItems))
+
+		// constraints of inline items
+		items1, items2 := itemsSchema(type1), itemsSchema(type2)
+		if items1 != nil && items2 != nil && len(items1.Type) > 0 && len(items2.Type) > 0 {
+			for _, eachDiff := range sd.CompareTypes(items1.SchemaProps, items2.SchemaProps) {
+				eachDiff.Description = strings.TrimSpace("Items " + eachDiff.Description)
+				diffs = addTypeDiff(diffs, eachDiff)
+			}
+		}
 	}
 	return diffs
 }
 
+func itemsSchema(props spec.SchemaProps) *spec.Schema {
+	if props.Items == nil {
+		return nil
+	}
+	return props.Items.Schema
+}
+
 // CheckStringTypeChanges checks for changes to or from a string type
 func (sd *SpecAnalyser) CheckStringTypeChanges(diffs []TypeDiff, type1, type2 spec.SchemaProps) []TypeDiff {
 	// string changes
 	if type1.Type[0] == StringType &&
 		type2.Type[0] == StringType {
-		diffs = addTypeDiff(diffs, compareIntValues("MinLength", type1.MinLength, type2.MinLength, NarrowedType, WidenedType))
-		diffs = addTypeDiff(diffs, compareIntValues("MaxLength", type1.MinLength, type2.MinLength, WidenedType, NarrowedType))
-		if type1.Pattern != type2.Pattern {
-			diffs = addTypeDiff(diffs, TypeDiff{Change: ChangedType, Description: fmt.Sprintf("Pattern Changed:%s->%s", type1.Pattern, type2.Pattern)})
-		}
+		diffs = addTypeDiff(diffs, compareIntLimits("MinLength", type1.MinLength, type2.MinLength, false, TightenedMinLength, LoosenedMinLength))
+		diffs = addTypeDiff(diffs, compareIntLimits("MaxLength", type1.MaxLength, type2.MaxLength, true, TightenedMaxLength, LoosenedMaxLength))
+		diffs = addTypeDiff(diffs, comparePatterns(type1.Pattern, type2.Pattern))
 		if type1.Type[0] == StringType {
 			if len(type1.Enum) > 0 {
 				enumDiffs := sd.compareEnums(type1.Enum, type2.Enum)
@@ -318,20 +349,20 @@ func (sd *SpecAnalyser) CheckNumericTypeChanges(diffs []TypeDiff, type1, type2 s
 	_, type2IsNumeric := numberWideness[type2.Type[0]]
 
 	if type1IsNumeric && type2IsNumeric {
-		diffs = addTypeDiff(diffs, compareFloatValues("Maximum", type1.Maximum, type2.Maximum, WidenedType, NarrowedType))
-		diffs = addTypeDiff(diffs, compareFloatValues("Minimum", type1.Minimum, type2.Minimum, NarrowedType, WidenedType))
-		if type1.ExclusiveMaximum && !type2.ExclusiveMaximum {
-			diffs = addTypeDiff(diffs, TypeDiff{Change: WidenedType, Description: fmt.Sprintf("Exclusive Maximum Removed:%v->%v", type1.ExclusiveMaximum, type2.ExclusiveMaximum)})
-		}
-		if !type1.ExclusiveMaximum && type2.ExclusiveMaximum {
-			diffs = addTypeDiff(diffs, TypeDiff{Change: NarrowedType, Description: fmt.Sprintf("Exclusive Maximum Added:%v->%v", type1.ExclusiveMaximum, type2.ExclusiveMaximum)})
-		}
-		if type1.ExclusiveMinimum && !type2.ExclusiveMinimum {
-			diffs = addTypeDiff(diffs, TypeDiff{Change: WidenedType, Description: fmt.Sprintf("Exclusive Minimum Removed:%v->%v", type1.ExclusiveMaximum, type2.ExclusiveMaximum)})
-		}
-		if !type1.ExclusiveMinimum && type2.ExclusiveMinimum {
-			diffs = addTypeDiff(diffs, TypeDiff{Change: NarrowedType, Description: fmt.Sprintf("Exclusive Minimum Added:%v->%v", type1.ExclusiveMinimum, type2.ExclusiveMinimum)})
-		}
+		diffs = addTypeDiff(diffs, compareFloatLimits("Maximum", type1.Maximum, type2.Maximum, true, TightenedMaximum, LoosenedMaximum))
+		diffs = addTypeDiff(diffs, compareFloatLimits("Minimum", type1.Minimum, type2.Minimum, false, TightenedMinimum, LoosenedMinimum))
+		diffs = addTypeDiff(diffs, compareFlags("ExclusiveMaximum", type1.ExclusiveMaximum, type2.ExclusiveMaximum, TightenedExclusiveMaximum, LoosenedExclusiveMaximum))
+		diffs = addTypeDiff(diffs, compareFlags("ExclusiveMinimum", type1.ExclusiveMinimum, type2.ExclusiveMinimum, TightenedExclusiveMinimum, LoosenedExclusiveMinimum))
+		diffs = addTypeDiff(diffs, compareMultipleOf(type1.MultipleOf, type2.MultipleOf))
+	}
+	return diffs
+}
+
+// CheckObjectTypeChanges checks for changes to the constraints of an object type
+func (sd *SpecAnalyser) CheckObjectTypeChanges(diffs []TypeDiff, type1, type2 spec.SchemaProps) []TypeDiff {
+	if type1.Type[0] == ObjectType && type2.Type[0] == ObjectType {
+		diffs = addTypeDiff(diffs, compareIntLimits("MaxProperties", type1.MaxProperties, type2.MaxProperties, true, TightenedMaxProperties, LoosenedMaxProperties))
+		diffs = addTypeDiff(diffs, compareIntLimits("MinProperties", type1.MinProperties, type2.MinProperties, false, TightenedMinProperties, LoosenedMinProperties))
 	}
 	return diffs
 }
@@ -374,7 +405,7 @@ func (sd *SpecAnalyser) CompareTypes(type1, type2 spec.SchemaProps) []TypeDiff {
 		return diffs
 	}
 
-	return diffs
+	return sd.CheckObjectTypeChanges(diffs, type1, type2)
 }
 
 func (sd *SpecAnalyser) compareParams(urlMethod URLMethod, location string, name string, param1, param2 spec.Parameter) {
@@ -433,9 +464,9 @@ func (sd *SpecAnalyser) compareSimpleSchema(location DifferenceLocation, schema1
 func (sd *SpecAnalyser) compareDescripton(location DifferenceLocation, desc1, desc2 string) {
 	if desc1 != desc2 {
 		code := ChangedDescripton
-		if len(desc1) > 0 {
+		if len(desc2) == 0 {
 			code = DeletedDescripton
-		} else if len(desc2) > 0 {
+		} else if len(desc1) == 0 {
 			code = AddedDescripton
 		}
 		sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location, Code: code})
@@ -458,7 +489,7 @@ func (sd *SpecAnalyser) compareSchema(location DifferenceLocation, schema1, sche
 		if len(definition1) > 0 {
 			info := fmt.Sprintf("[%s -> %s]", definition1, definition2)
 
-			if definition1 != definition2 {
+			if definition1 != definition2 && sd.renamedDefinitions[definition1] != definition2 {
 				sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: location,
 					Code:     ChangedType,
 					DiffInfo: info,
@@ -581,7 +612,7 @@ func (sd *SpecAnalyser) compareEnums(left, right []interface{}) []TypeDiff {
 
 func (sd *SpecAnalyser) findAddedEndpoints() {
 	for URLMethod := range sd.urlMethods2 {
-		if _, ok := sd.urlMethods1[URLMethod]; !ok {
+		if _, ok := sd.counterpart(URLMethod); !ok {
 			sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: DifferenceLocation{URL: URLMethod.Path, Method: URLMethod.Method}, Code: AddedEndpoint})
 		}
 	}
@@ -594,7 +625,8 @@ func (sd *SpecAnalyser) findDeletedEndpoints() {
 			(operation1.Operation.Deprecated) {
 			code = DeletedDeprecatedEndpoint
 		}
-		if _, ok := sd.urlMethods2[eachURLMethod]; !ok {
+		_, moved := sd.movedTo[eachURLMethod]
+		if _, ok := sd.urlMethods2[eachURLMethod]; !ok && !moved {
 			sd.Diffs = sd.Diffs.addDiff(SpecDifference{DifferenceLocation: DifferenceLocation{URL: eachURLMethod.Path, Method: eachURLMethod.Method}, Code: code})
 		}
 	}
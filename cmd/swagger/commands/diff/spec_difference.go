@@ -2,7 +2,9 @@ package diff
 
 import (
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"sort"
 )
 
@@ -62,7 +64,7 @@ func (sd SpecDifferences) FilterIgnores(ignores SpecDifferences) SpecDifferences
 	newDiffs := SpecDifferences{}
 	for _, eachDiff := range sd {
 		if !ignores.Contains(eachDiff) {
-			newDiffs = newDiffs.addDiff(eachDiff)
+			newDiffs = append(newDiffs, eachDiff)
 		}
 	}
 	return newDiffs
@@ -132,17 +134,15 @@ func (sd SpecDifferences) addDiff(diff SpecDifference) SpecDifferences {
 
 // ReportCompatibility lists and spec
 func (sd *SpecDifferences) ReportCompatibility() error {
-	breakingCount := sd.BreakingChangeCount()
-	if breakingCount > 0 {
-		fmt.Printf("\nBREAKING CHANGES:\n=================\n")
-		sd.reportChanges(Breaking)
-		return fmt.Errorf("compatibility Test FAILED: %d Breaking changes detected", breakingCount)
+	sd.writeText(os.Stdout, true)
+	if err := sd.Check(FailOnBreaking); err != nil {
+		return err
 	}
 	log.Printf("Compatibility test OK. No breaking changes identified.")
 	return nil
 }
 
-func (sd SpecDifferences) reportChanges(compat Compatibility) {
+func (sd SpecDifferences) reportChanges(w io.Writer, compat Compatibility) {
 	toReportList := []string{}
 
 	for _, diff := range sd {
@@ -156,35 +156,25 @@ func (sd SpecDifferences) reportChanges(compat Compatibility) {
 	})
 
 	for _, eachDiff := range toReportList {
-		fmt.Println(eachDiff)
+		fmt.Fprintln(w, eachDiff)
 	}
 }
 
 // ReportAllDiffs lists all the diffs between two specs
 func (sd SpecDifferences) ReportAllDiffs(fmtJSON bool) error {
 	if fmtJSON {
-
-		b, err := JSONMarshal(sd)
-		if err != nil {
-			log.Fatalf("Couldn't print results: %v", err)
-		}
-		pretty, err := prettyprint(b)
-		if err != nil {
+		if err := sd.WriteReport(os.Stdout, JSONFormat, false); err != nil {
 			log.Fatalf("Couldn't print results: %v", err)
 		}
-		fmt.Println(string(pretty))
 		return nil
 	}
-	numDiffs := len(sd)
-	if numDiffs == 0 {
-		fmt.Println("No changes identified")
+	sd.writeText(os.Stdout, false)
+	if len(sd) == 0 {
 		return nil
 	}
-
-	if numDiffs != sd.BreakingChangeCount() {
-		fmt.Println("NON-BREAKING CHANGES:\n=====================")
-		sd.reportChanges(NonBreaking)
+	if err := sd.Check(FailOnBreaking); err != nil {
+		return err
 	}
-
-	return sd.ReportCompatibility()
+	log.Printf("Compatibility test OK. No breaking changes identified.")
+	return nil
 }
@@ -2,6 +2,7 @@ package diff
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/go-openapi/spec"
 )
@@ -168,3 +169,93 @@ func compareIntValues(fieldName string, val1 *int64, val2 *int64, ifGreaterCode
 	}
 	return TypeDiff{Change: NoChangeDetected, Description: ""}
 }
+
+// compareIntLimits compares a bound of a validation: adding, lowering a maximum or raising a minimum tightens it
+func compareIntLimits(fieldName string, val1 *int64, val2 *int64, isMaximum bool, tightened, loosened SpecChangeCode) TypeDiff {
+	switch {
+	case val1 == nil && val2 == nil:
+		return TypeDiff{Change: NoChangeDetected}
+	case val1 == nil:
+		return TypeDiff{Change: tightened, Description: fmt.Sprintf("%s added:%d", fieldName, *val2)}
+	case val2 == nil:
+		return TypeDiff{Change: loosened, Description: fmt.Sprintf("%s removed:%d", fieldName, *val1)}
+	case isMaximum:
+		return compareIntValues(fieldName, val1, val2, loosened, tightened)
+	default:
+		return compareIntValues(fieldName, val1, val2, tightened, loosened)
+	}
+}
+
+// compareFloatLimits compares a bound of a validation: adding, lowering a maximum or raising a minimum tightens it
+func compareFloatLimits(fieldName string, val1 *float64, val2 *float64, isMaximum bool, tightened, loosened SpecChangeCode) TypeDiff {
+	switch {
+	case val1 == nil && val2 == nil:
+		return TypeDiff{Change: NoChangeDetected}
+	case val1 == nil:
+		return TypeDiff{Change: tightened, Description: fmt.Sprintf("%s added:%v", fieldName, *val2)}
+	case val2 == nil:
+		return TypeDiff{Change: loosened, Description: fmt.Sprintf("%s removed:%v", fieldName, *val1)}
+	case *val1 == *val2:
+		return TypeDiff{Change: NoChangeDetected}
+	case isMaximum == (*val2 < *val1):
+		return TypeDiff{Change: tightened, Description: fmt.Sprintf("%s %v->%v", fieldName, *val1, *val2)}
+	default:
+		return TypeDiff{Change: loosened, Description: fmt.Sprintf("%s %v->%v", fieldName, *val1, *val2)}
+	}
+}
+
+// compareFlags compares a boolean validation, which is tightened when set
+func compareFlags(fieldName string, val1, val2 bool, tightened, loosened SpecChangeCode) TypeDiff {
+	switch {
+	case !val1 && val2:
+		return TypeDiff{Change: tightened, Description: fmt.Sprintf("%s added", fieldName)}
+	case val1 && !val2:
+		return TypeDiff{Change: loosened, Description: fmt.Sprintf("%s removed", fieldName)}
+	default:
+		return TypeDiff{Change: NoChangeDetected}
+	}
+}
+
+func comparePatterns(pattern1, pattern2 string) TypeDiff {
+	switch {
+	case pattern1 == pattern2:
+		return TypeDiff{Change: NoChangeDetected}
+	case pattern1 == "":
+		return TypeDiff{Change: AddedPattern, Description: pattern2}
+	case pattern2 == "":
+		return TypeDiff{Change: DeletedPattern, Description: pattern1}
+	default:
+		return TypeDiff{Change: ChangedPattern, Description: fmt.Sprintf("%s->%s", pattern1, pattern2)}
+	}
+}
+
+// compareMultipleOf compares multipleOf validations: a multiple of the previous value tightens the validation
+func compareMultipleOf(val1, val2 *float64) TypeDiff {
+	switch {
+	case val1 == nil && val2 == nil:
+		return TypeDiff{Change: NoChangeDetected}
+	case val1 == nil:
+		return TypeDiff{Change: TightenedMultipleOf, Description: fmt.Sprintf("MultipleOf added:%v", *val2)}
+	case val2 == nil:
+		return TypeDiff{Change: LoosenedMultipleOf, Description: fmt.Sprintf("MultipleOf removed:%v", *val1)}
+	case *val1 == *val2:
+		return TypeDiff{Change: NoChangeDetected}
+	}
+	description := fmt.Sprintf("MultipleOf %v->%v", *val1, *val2)
+	switch {
+	case isMultiple(*val2, *val1):
+		return TypeDiff{Change: TightenedMultipleOf, Description: description}
+	case isMultiple(*val1, *val2):
+		return TypeDiff{Change: LoosenedMultipleOf, Description: description}
+	default:
+		return TypeDiff{Change: ChangedMultipleOf, Description: description}
+	}
+}
+
+func isMultiple(value, of float64) bool {
+	if of == 0 {
+		return false
+	}
+	ratio := value / of
+	return math.Abs(ratio-math.Round(ratio)) < 1e-9
+}
@@ -0,0 +1,153 @@
+package diff
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version bumps required by the changes of a spec, after https://semver.org
+const (
+	MajorBump = "major"
+	MinorBump = "minor"
+	PatchBump = "patch"
+	NoBump    = "none"
+)
+
+var bumps = map[string]bool{NoBump: true, PatchBump: true, MinorBump: true, MajorBump: true}
+
+// Bump returns the part of the version of a spec its changes require to bump:
+// major for breaking changes, minor for added or deprecated features, patch for the other changes.
+func (sd SpecDifferences) Bump() string {
+	bump := NoBump
+	for _, eachDiff := range sd {
+		switch {
+		case eachDiff.Compatibility == Breaking:
+			return MajorBump
+		case changelogSectionFor[eachDiff.Code] == ChangelogAdded || changelogSectionFor[eachDiff.Code] == ChangelogDeprecated:
+			bump = MinorBump
+		case bump == NoBump:
+			bump = PatchBump
+		}
+	}
+	return bump
+}
+
+var versionPattern = regexp.MustCompile(`^(v?)(\d+)(?:\.(\d+))?(?:\.(\d+))?([-+].*)?$`)
+
+// semVer is a version such as 1.2.3, v1.2 or 1.2.3-rc.1
+type semVer struct {
+	prefix  string
+	numbers []int
+	suffix  string
+}
+
+func parseVersion(version string) (semVer, error) {
+	matches := versionPattern.FindStringSubmatch(strings.TrimSpace(version))
+	if matches == nil {
+		return semVer{}, fmt.Errorf("%q is not a semantic version", version)
+	}
+	v := semVer{prefix: matches[1], suffix: matches[5]}
+	for _, number := range matches[2:5] {
+		if number == "" {
+			break
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return semVer{}, fmt.Errorf("%q is not a semantic version: %v", version, err)
+		}
+		v.numbers = append(v.numbers, n)
+	}
+	return v, nil
+}
+
+func (v semVer) String() string {
+	numbers := make([]string, 0, len(v.numbers))
+	for _, n := range v.numbers {
+		numbers = append(numbers, strconv.Itoa(n))
+	}
+	return v.prefix + strings.Join(numbers, ".") + v.suffix
+}
+
+func (v semVer) number(i int) int {
+	if i < len(v.numbers) {
+		return v.numbers[i]
+	}
+	return 0
+}
+
+// compare compares the numbers of two versions: pre-releases and builds are ignored
+func (v semVer) compare(other semVer) int {
+	for i := 0; i < 3; i++ {
+		if v.number(i) != other.number(i) {
+			if v.number(i) < other.number(i) {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// bumped returns the next version for a bump, keeping as many numbers as the version when possible
+func (v semVer) bumped(bump string) semVer {
+	if bump == NoBump {
+		return v
+	}
+	index := map[string]int{MajorBump: 0, MinorBump: 1, PatchBump: 2}[bump]
+	next := semVer{prefix: v.prefix}
+	size := len(v.numbers)
+	if size < index+1 {
+		size = index + 1
+	}
+	for i := 0; i < size; i++ {
+		switch {
+		case i < index:
+			next.numbers = append(next.numbers, v.number(i))
+		case i == index:
+			next.numbers = append(next.numbers, v.number(i)+1)
+		default:
+			next.numbers = append(next.numbers, 0)
+		}
+	}
+	return next
+}
+
+// SuggestVersion returns the version which follows a base version for a bump, e.g. 1.3.0 for a minor bump of 1.2.4
+func SuggestVersion(base, bump string) (string, error) {
+	if !bumps[bump] {
+		return "", fmt.Errorf("unknown version bump %q", bump)
+	}
+	v, err := parseVersion(base)
+	if err != nil {
+		return "", err
+	}
+	return v.bumped(bump).String(), nil
+}
+
+// CheckVersion tells if a version is bumped from a base version. It returns an error when the version is lower
+// than the base version, or bumped less than the bump requires.
+func CheckVersion(base, version, bump string) (bool, error) {
+	suggested, err := SuggestVersion(base, bump)
+	if err != nil {
+		return false, err
+	}
+	baseVersion, _ := parseVersion(base)
+	suggestedVersion, _ := parseVersion(suggested)
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case v.compare(baseVersion) < 0:
+		return false, fmt.Errorf("version %s is lower than the base version %s", version, base)
+	case v.compare(baseVersion) == 0:
+		return false, nil
+	case v.compare(suggestedVersion) < 0:
+		return true, fmt.Errorf("version %s is bumped too little from %s: the changes require a %s version bump to %s", version, base, bump, suggested)
+	default:
+		return true, nil
+	}
+}
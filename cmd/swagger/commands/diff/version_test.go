@@ -0,0 +1,65 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+)
+
+func TestBump(t *testing.T) {
+	assertThat(t, SpecDifferences{}.Bump(), is.EqualTo(NoBump))
+	assertThat(t, SpecDifferences{{Code: ChangedDescripton, Compatibility: NonBreaking}}.Bump(), is.EqualTo(PatchBump))
+	assertThat(t, SpecDifferences{
+		{Code: ChangedDescripton, Compatibility: NonBreaking},
+		{Code: AddedEndpoint, Compatibility: NonBreaking},
+	}.Bump(), is.EqualTo(MinorBump))
+	assertThat(t, SpecDifferences{{Code: DeprecatedEndpoint, Compatibility: NonBreaking}}.Bump(), is.EqualTo(MinorBump))
+	assertThat(t, SpecDifferences{
+		{Code: AddedEndpoint, Compatibility: NonBreaking},
+		{Code: DeletedEndpoint, Compatibility: Breaking},
+	}.Bump(), is.EqualTo(MajorBump))
+}
+
+func TestSuggestVersion(t *testing.T) {
+	for _, tc := range []struct {
+		base, bump, expected string
+	}{
+		{"1.2.3", MajorBump, "2.0.0"},
+		{"1.2.3", MinorBump, "1.3.0"},
+		{"1.2.3", PatchBump, "1.2.4"},
+		{"1.2.3", NoBump, "1.2.3"},
+		{"1.0", MinorBump, "1.1"},
+		{"1.0", PatchBump, "1.0.1"},
+		{"v2", MinorBump, "v2.1"},
+		{"1.2.3-rc.1+build.5", PatchBump, "1.2.4"},
+	} {
+		suggested, err := SuggestVersion(tc.base, tc.bump)
+		assertThat(t, err, is.Nil())
+		assertThat(t, suggested, is.EqualTo(tc.expected))
+	}
+
+	_, err := SuggestVersion("latest", PatchBump)
+	assertThat(t, err, is.Not(is.Nil()))
+	_, err = SuggestVersion("1.0.0", "huge")
+	assertThat(t, err, is.Not(is.Nil()))
+}
+
+func TestCheckVersion(t *testing.T) {
+	bumped, err := CheckVersion("1.2.3", "1.2.3", MajorBump)
+	assertThat(t, err, is.Nil())
+	assertThat(t, bumped, is.EqualTo(false))
+
+	bumped, err = CheckVersion("1.2.3", "1.3.0", MinorBump)
+	assertThat(t, err, is.Nil())
+	assertThat(t, bumped, is.EqualTo(true))
+
+	bumped, err = CheckVersion("1.2.3", "2.0.0", PatchBump)
+	assertThat(t, err, is.Nil())
+	assertThat(t, bumped, is.EqualTo(true))
+
+	_, err = CheckVersion("1.2.3", "1.2.4", MinorBump)
+	assertThat(t, err.Error(), is.ValueContaining("bumped too little"))
+
+	_, err = CheckVersion("1.2.3", "1.2.2", PatchBump)
+	assertThat(t, err.Error(), is.ValueContaining("lower than the base version"))
+}
@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// gitRevision reads the documents of a spec at a revision of the git repository which contains it
+type gitRevision struct {
+	revision string
+	root     string
+	target   string
+	read     map[string]bool
+}
+
+// specAtGitRevision writes a spec, as it is at a git revision, and the documents it references in a temporary directory.
+//
+// The working tree is left untouched. It returns the directory, to remove once done, and the path of the spec in it.
+func specAtGitRevision(revision, specPath string) (string, string, error) {
+	abs, err := filepath.Abs(specPath)
+	if err != nil {
+		return "", "", err
+	}
+	if abs, err = filepath.EvalSymlinks(abs); err != nil {
+		return "", "", err
+	}
+
+	root, err := git(filepath.Dir(abs), "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", "", fmt.Errorf("%s is not in a git repository: %v", specPath, err)
+	}
+	root = strings.TrimSpace(root)
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return "", "", err
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := git(root, "rev-parse", "--verify", "--quiet", revision+"^{commit}"); err != nil {
+		return "", "", fmt.Errorf("unknown git revision %q", revision)
+	}
+
+	target, err := ioutil.TempDir("", "swagger-diff")
+	if err != nil {
+		return "", "", err
+	}
+	g := &gitRevision{revision: revision, root: root, target: target, read: make(map[string]bool)}
+	if err := g.readDocument(filepath.ToSlash(rel)); err != nil {
+		_ = os.RemoveAll(target)
+		return "", "", err
+	}
+	return target, filepath.Join(target, rel), nil
+}
+
+// readDocument writes a document of the revision to the temporary directory, then the documents it references
+func (g *gitRevision) readDocument(name string) error {
+	if g.read[name] {
+		return nil
+	}
+	g.read[name] = true
+
+	content, err := git(g.root, "show", g.revision+":"+name)
+	if err != nil {
+		return fmt.Errorf("cannot read %s at %s: %v", name, g.revision, err)
+	}
+	file := filepath.Join(g.target, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		return err
+	}
+
+	document, err := swag.BytesToYAMLDoc([]byte(content))
+	if err != nil {
+		return fmt.Errorf("cannot parse %s at %s: %v", name, g.revision, err)
+	}
+	for _, ref := range documentRefs(document) {
+		u, err := url.Parse(ref)
+		if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" || path.IsAbs(u.Path) {
+			// local and remote references are resolved by the loader
+			continue
+		}
+		referenced := path.Join(path.Dir(name), u.Path)
+		if referenced == ".." || strings.HasPrefix(referenced, "../") {
+			return fmt.Errorf("$ref %q in %s is outside of the git repository", ref, name)
+		}
+		if err := g.readDocument(referenced); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nameMaps are the keys of the objects which map names to specs: their keys are not keywords of the spec
+var nameMaps = map[string]bool{
+	"definitions":       true,
+	"parameters":        true,
+	"responses":         true,
+	"paths":             true,
+	"properties":        true,
+	"patternProperties": true,
+	"headers":           true,
+}
+
+// documentRefs returns the $ref's of a document, except in examples and extensions
+func documentRefs(node interface{}) []string {
+	var refs []string
+	switch value := node.(type) {
+	case yaml.MapSlice:
+		for _, item := range value {
+			key := fmt.Sprint(item.Key)
+			switch {
+			case key == "$ref":
+				if ref, ok := item.Value.(string); ok {
+					refs = append(refs, ref)
+				}
+			case key == "example" || key == "examples" || strings.HasPrefix(key, "x-"):
+			case nameMaps[key]:
+				names, ok := item.Value.(yaml.MapSlice)
+				if !ok {
+					// e.g. the parameters of an operation
+					refs = append(refs, documentRefs(item.Value)...)
+					continue
+				}
+				for _, named := range names {
+					refs = append(refs, documentRefs(named.Value)...)
+				}
+			default:
+				refs = append(refs, documentRefs(item.Value)...)
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			refs = append(refs, documentRefs(item)...)
+		}
+	}
+	return refs
+}
+
+// git runs a git command in a directory
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%v: %s", err, msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gitBaseSpec = `swagger: '2.0'
+info:
+  title: git base
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - $ref: 'parameters.yaml#/limit'
+      responses:
+        200:
+          description: pets
+          schema:
+            $ref: 'models/pet.yaml'
+          examples:
+            application/json:
+              $ref: 'missing.yaml'
+`
+
+func gitRepo(t *testing.T) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	dir, err := ioutil.TempDir("", "diff-git")
+	require.NoError(t, err)
+	write := func(name, content string) {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+		require.NoError(t, ioutil.WriteFile(file, []byte(content), 0644))
+	}
+	write("api/swagger.yaml", gitBaseSpec)
+	write("api/parameters.yaml", "limit:\n  name: limit\n  in: query\n  type: integer\n")
+	write("api/models/pet.yaml", "type: object\nproperties:\n  name:\n    type: string\n")
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "base"},
+	} {
+		_, err := git(dir, args...)
+		require.NoError(t, err)
+	}
+
+	// changes in the working tree
+	write("api/parameters.yaml", "limit:\n  name: limit\n  in: query\n  type: integer\n  maximum: 100\n")
+	write("api/models/pet.yaml", "type: object\nproperties:\n  name:\n    type: string\n  tag:\n    type: string\n")
+	return dir
+}
+
+func TestSpecAtGitRevision(t *testing.T) {
+	repo := gitRepo(t)
+	defer func() {
+		_ = os.RemoveAll(repo)
+	}()
+
+	dir, base, err := specAtGitRevision("HEAD", filepath.Join(repo, "api", "swagger.yaml"))
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	assert.Equal(t, filepath.Join(dir, "api", "swagger.yaml"), base)
+	assert.Equal(t, gitBaseSpec, LinesInFile(base))
+	assert.NotContains(t, LinesInFile(filepath.Join(dir, "api", "parameters.yaml")), "maximum")
+	assert.NotContains(t, LinesInFile(filepath.Join(dir, "api", "models", "pet.yaml")), "tag")
+	assert.Contains(t, LinesInFile(filepath.Join(repo, "api", "models", "pet.yaml")), "tag", "the working tree is untouched")
+
+	_, _, err = specAtGitRevision("no-such-revision", filepath.Join(repo, "api", "swagger.yaml"))
+	assert.Error(t, err)
+	_, _, err = specAtGitRevision("HEAD", filepath.Join(repo, "api", "new.yaml"))
+	assert.Error(t, err)
+
+	outside, err := ioutil.TempDir("", "not-git")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(outside)
+	}()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(outside, "swagger.yaml"), []byte(gitBaseSpec), 0644))
+	_, _, err = specAtGitRevision("HEAD", filepath.Join(outside, "swagger.yaml"))
+	assert.Error(t, err)
+}
+
+func TestDiffGitBase(t *testing.T) {
+	repo := gitRepo(t)
+	defer func() {
+		_ = os.RemoveAll(repo)
+	}()
+	spec := filepath.Join(repo, "api", "swagger.yaml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(gitBaseSpec+"  /stores:\n    get:\n      responses:\n        200:\n          description: stores\n"), 0644))
+
+	cmd := DiffCommand{Format: "json", IgnoreFile: "none specified", GitBase: "HEAD", FailOn: "any"}
+	out := catchStdOut(t, func() {
+		assert.Error(t, cmd.Execute([]string{spec}))
+	})
+	assert.Contains(t, out, `"code": "AddedEndpoint"`)
+	// changes in the documents the spec references
+	assert.Contains(t, out, `"code": "AddedProperty"`)
+	assert.Contains(t, out, `"code": "TightenedMaximum"`)
+
+	assert.Error(t, cmd.Execute([]string{spec, spec}))
+}
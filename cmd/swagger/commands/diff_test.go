@@ -70,7 +70,7 @@ func TestDiffForVariousCombinations(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 
-			diffs, err := getDiffs(tc.oldSpec, tc.newSpec)
+			diffs, err := getDiffs(tc.oldSpec, tc.newSpec, nil, diff.DefaultSimilarityThreshold)
 
 			assertThat(t, err, is.Nil())
 
@@ -137,6 +137,56 @@ func TestProcessIgnores(t *testing.T) {
 	assertThat(t, diffsStr, is.EqualToIgnoringWhitespace(tc.expectedLines))
 }
 
+func TestDiffPolicy(t *testing.T) {
+	diffRootPath := basePath + "/"
+	cmd := DiffCommand{
+		OnlyBreakingChanges: true,
+		IgnoreFile:          "none specified",
+		PolicyFile:          diffRootPath + "policy.yaml",
+	}
+
+	diffsStr := catchStdOut(t, func() {
+		err := cmd.Execute([]string{diffRootPath + "enum.v1.json", diffRootPath + "enum.v2.json"})
+		assertThat(t, err, is.Not(is.Nil()))
+		assertThat(t, err.Error(), is.ValueContaining("6 Breaking changes"))
+	})
+	assertThat(t, diffsStr, is.ValueContaining("/b/:get -> 200 Response - array[A1].personality : string - Deleted possible enumeration(s) <crazy>"))
+	assertThat(t, diffsStr, is.Not(is.ValueContaining("/b/:get -> 200 Response - array[A1].personality : string - Added possible enumeration(s) <sane>")))
+
+	cmd.PolicyFile = diffRootPath + "enum.diff.txt"
+	assertThat(t, cmd.Execute([]string{diffRootPath + "enum.v1.json", diffRootPath + "enum.v2.json"}), is.Not(is.Nil()))
+}
+
+func TestDiffFailOn(t *testing.T) {
+	diffRootPath := basePath + "/"
+	dir, err := ioutil.TempDir("", "diff")
+	dieOn(err, t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	dest := filepath.Join(dir, "report.xml")
+	args := []string{diffRootPath + "enum.v1.json", diffRootPath + "enum.v2.json"}
+
+	cmd := DiffCommand{Format: "junit", IgnoreFile: "none specified", Destination: dest}
+	err = cmd.Execute(args)
+	assertThat(t, err, is.Not(is.Nil()))
+	assertThat(t, LinesInFile(dest), is.ValueContaining(`failures="2"`))
+
+	cmd.FailOn = "none"
+	assertThat(t, cmd.Execute(args), is.Nil())
+
+	// json reports do not fail unless asked to
+	cmd = DiffCommand{Format: "json", IgnoreFile: "none specified", Destination: dest}
+	assertThat(t, cmd.Execute(args), is.Nil())
+	cmd.FailOn = "breaking"
+	assertThat(t, cmd.Execute(args), is.Not(is.Nil()))
+
+	cmd = DiffCommand{Format: "markdown", IgnoreFile: "none specified", Destination: dest, FailOn: "any"}
+	err = cmd.Execute([]string{diffRootPath + "enum.v1.json", diffRootPath + "enum.v1.json"})
+	assertThat(t, err, is.Nil())
+	assertThat(t, LinesInFile(dest), is.ValueContaining("No changes identified"))
+}
+
 func TestNoArgs(t *testing.T) {
 
 	cmd := DiffCommand{
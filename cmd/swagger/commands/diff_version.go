@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/diff"
+)
+
+var (
+	jsonInfoPattern    = regexp.MustCompile(`"info"\s*:\s*{`)
+	jsonVersionPattern = regexp.MustCompile(`"version"\s*:\s*"((?:[^"\\]|\\.)*)"`)
+	yamlInfoPattern    = regexp.MustCompile(`^["']?info["']?\s*:\s*(#.*)?$`)
+	yamlVersionPattern = regexp.MustCompile(`^(\s+["']?version["']?\s*:\s*)(.*)$`)
+)
+
+// suggestVersion writes the version the changes between two specs require, then checks or sets the version of the new spec
+func (c *DiffCommand) suggestVersion(output io.Writer, diffs diff.SpecDifferences, oldSpecPath, newSpecPath string) error {
+	base, err := specVersion(oldSpecPath)
+	if err != nil {
+		return err
+	}
+	version, err := specVersion(newSpecPath)
+	if err != nil {
+		return err
+	}
+	bump := diffs.Bump()
+	suggested, err := diff.SuggestVersion(base, bump)
+	if err != nil {
+		return err
+	}
+	log.Printf("The changes require a %s version bump: %s -> %s", bump, base, suggested)
+
+	bumped, err := diff.CheckVersion(base, version, bump)
+	if err != nil {
+		return err
+	}
+	switch {
+	case bumped:
+		log.Printf("The version of %s is already bumped to %s", newSpecPath, version)
+	case c.WriteVersion && suggested != version:
+		if err := writeSpecVersion(newSpecPath, version, suggested); err != nil {
+			return err
+		}
+		log.Printf("Set the version of %s to %s", newSpecPath, suggested)
+	}
+	_, err = fmt.Fprintln(output, suggested)
+	return err
+}
+
+func specVersion(specPath string) (string, error) {
+	specDoc, err := loads.Spec(specPath)
+	if err != nil {
+		return "", err
+	}
+	if specDoc.Spec().Info == nil || specDoc.Spec().Info.Version == "" {
+		return "", fmt.Errorf("%s has no info.version", specPath)
+	}
+	return specDoc.Spec().Info.Version, nil
+}
+
+// writeSpecVersion replaces the info.version of a spec file, leaving the rest of the file untouched
+func writeSpecVersion(specPath, version, newVersion string) error {
+	info, err := os.Stat(specPath)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+
+	var updated []byte
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '{' {
+		updated, err = replaceJSONVersion(content, version, newVersion)
+	} else {
+		updated, err = replaceYAMLVersion(content, version, newVersion)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot set the version of %s: %v", specPath, err)
+	}
+	return ioutil.WriteFile(specPath, updated, info.Mode())
+}
+
+func replaceJSONVersion(content []byte, version, newVersion string) ([]byte, error) {
+	infoLoc := jsonInfoPattern.FindIndex(content)
+	if infoLoc == nil {
+		return nil, fmt.Errorf("no info object")
+	}
+	loc := jsonVersionPattern.FindSubmatchIndex(content[infoLoc[1]:])
+	if loc == nil || string(content[infoLoc[1]+loc[2]:infoLoc[1]+loc[3]]) != version {
+		return nil, fmt.Errorf("no info.version %q", version)
+	}
+	start, end := infoLoc[1]+loc[2], infoLoc[1]+loc[3]
+	updated := append([]byte{}, content[:start]...)
+	updated = append(updated, newVersion...)
+	return append(updated, content[end:]...), nil
+}
+
+// replaceYAMLVersion replaces the version in the block of the top level info key, keeping its quotes and comments
+func replaceYAMLVersion(content []byte, version, newVersion string) ([]byte, error) {
+	lines := strings.SplitAfter(string(content), "\n")
+	inInfo, indent := false, ""
+	for i, line := range lines {
+		trimmed := strings.TrimRight(line, "\r\n")
+		if trimmed == "" || strings.HasPrefix(strings.TrimSpace(trimmed), "#") {
+			continue
+		}
+		if !strings.HasPrefix(trimmed, " ") && !strings.HasPrefix(trimmed, "\t") {
+			// a top level key
+			if inInfo {
+				break
+			}
+			inInfo = yamlInfoPattern.MatchString(trimmed)
+			continue
+		}
+		if !inInfo {
+			continue
+		}
+		lineIndent := trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, " \t"))]
+		if indent == "" {
+			indent = lineIndent
+		}
+		if lineIndent != indent {
+			// not a key of info
+			continue
+		}
+		matches := yamlVersionPattern.FindStringSubmatch(trimmed)
+		if matches == nil || !strings.Contains(matches[2], version) {
+			continue
+		}
+		lines[i] = matches[1] + strings.Replace(matches[2], version, newVersion, 1) + line[len(trimmed):]
+		return []byte(strings.Join(lines, "")), nil
+	}
+	return nil, fmt.Errorf("no info.version %q", version)
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/corbym/gocrest/is"
+)
+
+const versionedSpec = `# the pet store
+swagger: "2.0"
+info:
+  title: Pet store
+  contact:
+    name: the team
+    version: '1.0.0'
+  version: '1.0.0' # bumped on each change
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: the pets
+`
+
+func TestReplaceYAMLVersion(t *testing.T) {
+	updated, err := replaceYAMLVersion([]byte(versionedSpec), "1.0.0", "1.1.0")
+	assertThat(t, err, is.Nil())
+	assertThat(t, string(updated), is.EqualTo(strings.Replace(versionedSpec,
+		"  version: '1.0.0' # bumped", "  version: '1.1.0' # bumped", 1)))
+
+	_, err = replaceYAMLVersion([]byte(versionedSpec), "2.0.0", "2.1.0")
+	assertThat(t, err, is.Not(is.Nil()))
+}
+
+func TestReplaceJSONVersion(t *testing.T) {
+	spec := "{\n  \"swagger\": \"2.0\",\n  \"info\": {\n    \"title\": \"Pet store\",\n    \"version\" : \"1.0\"\n  }\n}\n"
+	updated, err := replaceJSONVersion([]byte(spec), "1.0", "2.0")
+	assertThat(t, err, is.Nil())
+	assertThat(t, string(updated), is.EqualTo(strings.Replace(spec, `"version" : "1.0"`, `"version" : "2.0"`, 1)))
+}
+
+func TestDiffSuggestVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diff-version")
+	dieOn(err, t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	oldSpec, newSpec := filepath.Join(dir, "v1.yml"), filepath.Join(dir, "v2.yml")
+	dieOn(ioutil.WriteFile(oldSpec, []byte(versionedSpec), 0644), t)
+	added := versionedSpec + "  /stores:\n    get:\n      responses:\n        200:\n          description: the stores\n"
+	dieOn(ioutil.WriteFile(newSpec, []byte(added), 0644), t)
+
+	cmd := DiffCommand{IgnoreFile: "none specified", SuggestVersion: true}
+	suggested := catchStdOut(t, func() {
+		assertThat(t, cmd.Execute([]string{oldSpec, newSpec}), is.Nil())
+	})
+	assertThat(t, suggested, is.EqualTo("1.1.0\n"))
+	assertThat(t, LinesInFile(newSpec), is.EqualTo(added))
+
+	cmd.WriteVersion = true
+	_ = catchStdOut(t, func() {
+		assertThat(t, cmd.Execute([]string{oldSpec, newSpec}), is.Nil())
+	})
+	assertThat(t, LinesInFile(newSpec), is.ValueContaining("  version: '1.1.0' # bumped on each change\n"))
+
+	// a breaking change requires a major bump
+	deleted := strings.Replace(strings.Replace(versionedSpec, "/pets", "/animals", 1), "version: '1.0.0' #", "version: '1.1.0' #", 1)
+	dieOn(ioutil.WriteFile(newSpec, []byte(deleted), 0644), t)
+	err = cmd.Execute([]string{oldSpec, newSpec})
+	assertThat(t, err.Error(), is.ValueContaining("require a major version bump to 2.0.0"))
+	assertThat(t, LinesInFile(newSpec), is.EqualTo(deleted))
+}
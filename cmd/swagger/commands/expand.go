@@ -7,7 +7,6 @@ import (
 	"io/ioutil"
 
 	"github.com/go-openapi/loads"
-	"github.com/go-openapi/spec"
 	"github.com/go-openapi/swag"
 	flags "github.com/jessevdk/go-flags"
 	yaml "gopkg.in/yaml.v2"
@@ -42,7 +41,7 @@ func (c *ExpandSpec) Execute(args []string) error {
 	return writeToFile(exp.Spec(), !c.Compact, c.Format, string(c.Output))
 }
 
-func writeToFile(swspec *spec.Swagger, pretty bool, format string, output string) error {
+func writeToFile(swspec interface{}, pretty bool, format string, output string) error {
 	var b []byte
 	var err error
 	asJSON := format == "json"
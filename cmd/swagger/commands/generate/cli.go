@@ -0,0 +1,72 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"log"
+
+	"github.com/go-swagger/go-swagger/generator"
+)
+
+type cliOptions struct {
+	CLIAppName string `long:"cli-app-name" description:"the name of the command line client, defaults to a mangled value of info.title followed by -cli"`
+	CLIPackage string `long:"cli-package" description:"the package to save the command line client code" default:"cli"`
+}
+
+func (co cliOptions) apply(opts *generator.GenOpts) {
+	opts.CLIAppName = co.CLIAppName
+	opts.CLIPackage = co.CLIPackage
+}
+
+// CLI the command to generate a command line client, built on top of the swagger client
+type CLI struct {
+	Client
+
+	cliOptions
+}
+
+func (c CLI) apply(opts *generator.GenOpts) {
+	c.Client.apply(opts)
+	c.cliOptions.apply(opts)
+
+	opts.IncludeCLI = true
+}
+
+func (c *CLI) generate(opts *generator.GenOpts) error {
+	return c.Client.generate(opts)
+}
+
+func (c *CLI) log(rp string) {
+	log.Printf(`Generation completed!
+
+For this generation to compile you need to have some packages in your GOPATH:
+
+	* github.com/go-openapi/errors
+	* github.com/go-openapi/runtime
+	* github.com/go-openapi/runtime/client
+	* github.com/go-openapi/strfmt
+	* github.com/spf13/cobra
+	* gopkg.in/yaml.v2
+
+You can get these now with: go get -u -f %s/...
+
+The command line client is built with: go build %s/cmd/...
+`, rp, rp)
+}
+
+// Execute runs this command
+func (c *CLI) Execute(args []string) error {
+	return createSwagger(c)
+}
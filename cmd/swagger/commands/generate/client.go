@@ -40,6 +40,7 @@ type Client struct {
 
 	SkipModels     bool `long:"skip-models" description:"no models will be generated when this flag is specified"`
 	SkipOperations bool `long:"skip-operations" description:"no operations will be generated when this flag is specified"`
+	WithMocks      bool `long:"with-mocks" description:"generate a mock implementation of the client service of each tag, to unit test the code using the client"`
 
 	Name string `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
 }
@@ -57,6 +58,7 @@ func (c Client) apply(opts *generator.GenOpts) {
 	opts.IncludeHandler = !c.SkipOperations
 	opts.IncludeParameters = !c.SkipOperations
 	opts.IncludeResponses = !c.SkipOperations
+	opts.IncludeMocks = c.WithMocks
 	opts.Name = c.Name
 
 	opts.IsClient = true
@@ -39,6 +39,14 @@ func TestGenerateClient(t *testing.T) {
 				c.Shared.CopyrightFile = flags.Filename(filepath.Join(base, "LICENSE"))
 			},
 		},
+		{
+			name:      "tasklist_with_mocks",
+			spec:      "tasklist.basic.yml",
+			wantError: false,
+			prepare: func(c *generate.Client) {
+				c.WithMocks = true
+			},
+		},
 		{
 			name:      "generate_client_with_invalid_template",
 			spec:      "todolist.simplequery.yml",
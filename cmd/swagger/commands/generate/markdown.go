@@ -0,0 +1,58 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"log"
+
+	"github.com/go-swagger/go-swagger/generator"
+)
+
+// Markdown generates a markdown API reference documentation
+type Markdown struct {
+	WithShared
+	WithModels
+	WithOperations
+
+	Name string `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
+}
+
+func (m *Markdown) apply(opts *generator.GenOpts) {
+	m.Shared.apply(opts)
+	m.Models.apply(opts)
+	m.Operations.apply(opts)
+
+	opts.Name = m.Name
+	opts.IncludeModel = true
+	opts.IncludeHandler = true
+	opts.IncludeSupport = true
+	opts.IsMarkdown = true
+}
+
+func (m *Markdown) generate(opts *generator.GenOpts) error {
+	return generator.GenerateMarkdown(m.Name, m.Models.Models, m.Operations.Operations, opts)
+}
+
+func (m Markdown) log(rp string) {
+	log.Printf(`Generation completed!
+
+The API reference documentation starts at %s/index.md
+`, rp)
+}
+
+// Execute generates the markdown documentation
+func (m *Markdown) Execute(args []string) error {
+	return createSwagger(m)
+}
@@ -26,6 +26,9 @@ type modelOptions struct {
 	Models                     []string `long:"model" short:"M" description:"specify a model to include in generation, repeat for multiple (defaults to all)"`
 	ExistingModels             string   `long:"existing-models" description:"use pre-generated models e.g. github.com/foobar/model"`
 	StrictAdditionalProperties bool     `long:"strict-additional-properties" description:"disallow extra properties when additionalProperties is set to false"`
+	UnmarshalDefaults          bool     `long:"unmarshal-defaults" description:"when unmarshalling models, fill absent properties with their default values"`
+	WithDeepCopy               bool     `long:"with-deepcopy" description:"generate DeepCopy, DeepCopyInto and Equal methods for models"`
+	WithEasyJSON               bool     `long:"with-easyjson" description:"generate JSON marshallers for models which do not use reflection, compatible with easyjson"`
 	KeepSpecOrder              bool     `long:"keep-spec-order" description:"keep schema properties order identical to spec file"`
 	AllDefinitions             bool     `long:"all-definitions" description:"generate all model definitions regardless of usage in operations"`
 	StructTags                 []string `long:"struct-tags" description:"the struct tags to generate, repeat for multiple (defaults to json)"`
@@ -36,6 +39,9 @@ func (mo modelOptions) apply(opts *generator.GenOpts) {
 	opts.Models = mo.Models
 	opts.ExistingModels = mo.ExistingModels
 	opts.StrictAdditionalProperties = mo.StrictAdditionalProperties
+	opts.UnmarshalDefaults = mo.UnmarshalDefaults
+	opts.IncludeDeepCopy = mo.WithDeepCopy
+	opts.IncludeEasyJSON = mo.WithEasyJSON
 	opts.PropertiesSpecOrder = mo.KeepSpecOrder
 	opts.IgnoreOperations = mo.AllDefinitions
 }
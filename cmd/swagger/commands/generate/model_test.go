@@ -17,6 +17,7 @@ func TestGenerateModel(t *testing.T) {
 		"billforward.discriminators.yml",
 		"existing-model.yml",
 		"instagram.yml",
+		"model-defaults.yml",
 		"shipyard.yml",
 		"sodabooth.json",
 		"tasklist.basic.yml",
@@ -43,6 +44,9 @@ func TestGenerateModel(t *testing.T) {
 			if i == 0 {
 				m.Models.ExistingModels = "nonExisting"
 			}
+			if spec == "model-defaults.yml" {
+				m.Models.UnmarshalDefaults = true
+			}
 			m.Shared.Spec = flags.Filename(path)
 			m.Shared.Target = flags.Filename(generated)
 
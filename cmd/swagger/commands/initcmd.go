@@ -4,7 +4,8 @@ import "github.com/go-swagger/go-swagger/cmd/swagger/commands/initcmd"
 
 // InitCmd is a command namespace for initializing things like a swagger spec.
 type InitCmd struct {
-	Model *initcmd.Spec `command:"spec"`
+	Model  *initcmd.Spec   `command:"spec"`
+	Schema *initcmd.Schema `command:"schema"`
 }
 
 // Execute provides default empty implementation
@@ -0,0 +1,168 @@
+package initcmd
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+)
+
+// detectedFormats are the string formats of the strfmt registry which are inferred from samples, the most specific first.
+//
+// The formats which match most strings, e.g. password, hostname or byte, are not detected.
+var detectedFormats = []string{"date-time", "date", "uuid", "email", "ipv4", "ipv6", "mac"}
+
+// maxDistinctStrings bounds the string values remembered for enums
+const maxDistinctStrings = 100
+
+// sample accumulates the values found at a location of the sample documents
+type sample struct {
+	// kinds of the values by JSON type: object, array, string, integer, number, boolean or null
+	kinds map[string]int
+	// properties of the objects, and the number of objects
+	properties map[string]*sample
+	objects    int
+	// items of the arrays
+	items *sample
+	// strings counts the distinct strings, up to maxDistinctStrings
+	strings        map[string]int
+	stringCount    int
+	tooManyStrings bool
+	// formats all the strings but the empty ones match
+	formats   []string
+	formatted bool
+}
+
+func newSample() *sample {
+	return &sample{kinds: make(map[string]int), properties: make(map[string]*sample), strings: make(map[string]int)}
+}
+
+// add accounts for a value decoded with json.Decoder.UseNumber
+func (s *sample) add(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		s.kinds["null"]++
+	case bool:
+		s.kinds["boolean"]++
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			s.kinds["number"]++
+		} else {
+			s.kinds["integer"]++
+		}
+	case string:
+		s.kinds["string"]++
+		s.stringCount++
+		if _, ok := s.strings[v]; ok || len(s.strings) < maxDistinctStrings {
+			s.strings[v]++
+		} else {
+			s.tooManyStrings = true
+		}
+		if v != "" {
+			s.addFormats(v)
+		}
+	case []interface{}:
+		s.kinds["array"]++
+		if s.items == nil {
+			s.items = newSample()
+		}
+		for _, item := range v {
+			s.items.add(item)
+		}
+	case map[string]interface{}:
+		s.kinds["object"]++
+		s.objects++
+		for name, property := range v {
+			if s.properties[name] == nil {
+				s.properties[name] = newSample()
+			}
+			s.properties[name].add(property)
+		}
+	}
+}
+
+// addFormats keeps the formats a string matches
+func (s *sample) addFormats(value string) {
+	candidates := s.formats
+	if !s.formatted {
+		candidates = detectedFormats
+		s.formatted = true
+	}
+	s.formats = []string{}
+	for _, format := range candidates {
+		if strfmt.Default.ContainsName(format) && strfmt.Default.Validates(format, value) {
+			s.formats = append(s.formats, format)
+		}
+	}
+}
+
+// inferrer turns samples into schemas
+type inferrer struct {
+	// maxEnum is the largest number of distinct strings turned into an enum, 0 to disable enums
+	maxEnum int
+}
+
+func (in *inferrer) schema(s *sample) *spec.Schema {
+	schema := new(spec.Schema)
+	kinds := make([]string, 0, len(s.kinds))
+	for kind := range s.kinds {
+		if kind != "null" {
+			kinds = append(kinds, kind)
+		}
+	}
+	if s.kinds["null"] > 0 && len(kinds) > 0 {
+		schema.AddExtension("x-nullable", true)
+	}
+	if len(kinds) == 2 && s.kinds["integer"] > 0 && s.kinds["number"] > 0 {
+		kinds = []string{"number"}
+	}
+	if len(kinds) != 1 {
+		// no value or values of several types: any value
+		return schema
+	}
+
+	schema.Typed(kinds[0], "")
+	switch kinds[0] {
+	case "object":
+		names := make([]string, 0, len(s.properties))
+		for name := range s.properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property := s.properties[name]
+			schema.SetProperty(name, *in.schema(property))
+			if property.occurrences() == s.objects {
+				schema.AddRequired(name)
+			}
+		}
+	case "array":
+		schema.Items = &spec.SchemaOrArray{Schema: in.schema(s.items)}
+	case "string":
+		if len(s.formats) > 0 {
+			schema.Format = s.formats[0]
+		}
+		if schema.Format == "" && !s.tooManyStrings && len(s.strings) <= in.maxEnum && s.stringCount > len(s.strings) {
+			values := make([]string, 0, len(s.strings))
+			for value := range s.strings {
+				values = append(values, value)
+			}
+			sort.Strings(values)
+			for _, value := range values {
+				schema.Enum = append(schema.Enum, value)
+			}
+		}
+	}
+	return schema
+}
+
+// occurrences is the number of values of a location, e.g. the number of objects which have a property
+func (s *sample) occurrences() int {
+	count := 0
+	for _, n := range s.kinds {
+		count += n
+	}
+	return count
+}
@@ -0,0 +1,316 @@
+package initcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+// Schema a command struct for inferring a schema from sample documents.
+type Schema struct {
+	FromJSON []string `long:"from-json" description:"a sample JSON document or a pattern of sample documents, can repeat: the arguments are samples too"`
+	Name     string   `long:"name" short:"n" description:"the name of the definition (default: after the name of the first sample)"`
+	Spec     string   `long:"spec" short:"f" description:"the spec document to add the definition to (default: writes the definition to stdout)"`
+	Format   string   `long:"format" description:"the format of the definition written to stdout" default:"yaml" choice:"yaml" choice:"json"`
+	MaxEnum  int      `long:"max-enum" description:"the largest number of distinct values of a string property turned into an enum, 0 to disable enums" default:"5"`
+	Force    bool     `long:"force" description:"replaces the definition when the spec already has it"`
+}
+
+// Execute this command
+func (s *Schema) Execute(args []string) error {
+	samples, err := s.samples(args)
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 {
+		return errors.New("no sample document: use --from-json samples/*.json")
+	}
+
+	root := newSample()
+	for _, file := range samples {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		decoder.UseNumber()
+		var document interface{}
+		if err := decoder.Decode(&document); err != nil {
+			return fmt.Errorf("%s is not a JSON document: %v", file, err)
+		}
+		root.add(document)
+	}
+	log.Printf("inferred a schema from %d sample documents", len(samples))
+
+	name := s.Name
+	if name == "" {
+		base := filepath.Base(samples[0])
+		name = swag.ToGoName(strings.TrimSuffix(base, filepath.Ext(base)))
+	}
+	schema := (&inferrer{maxEnum: s.MaxEnum}).schema(root)
+
+	if s.Spec == "" {
+		return s.writeDefinition(name, schema)
+	}
+	return s.addDefinition(name, schema)
+}
+
+// samples returns the sample files, in the order of the options then of the arguments
+func (s *Schema) samples(args []string) ([]string, error) {
+	var samples []string
+	for _, pattern := range append(append([]string{}, s.FromJSON...), args...) {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no sample document matches %s", pattern)
+		}
+		samples = append(samples, matches...)
+	}
+	return samples, nil
+}
+
+func (s *Schema) writeDefinition(name string, schema *spec.Schema) error {
+	node, err := toYAMLNode(schema)
+	if err != nil {
+		return err
+	}
+	b, err := marshalNode(yaml.MapSlice{{Key: name, Value: node}}, s.Format == "json")
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
+
+// addDefinition adds the definition to the spec document.
+//
+// The definition is inserted in YAML documents, which are otherwise kept as they are, with their comments.
+// JSON documents are rewritten, keeping the order of their keys.
+func (s *Schema) addDefinition(name string, schema *spec.Schema) error {
+	info, err := os.Stat(s.Spec)
+	if err != nil {
+		return err
+	}
+	b, err := ioutil.ReadFile(s.Spec)
+	if err != nil {
+		return err
+	}
+	document, err := swag.BytesToYAMLDoc(b)
+	if err != nil {
+		return fmt.Errorf("cannot read %s: %v", s.Spec, err)
+	}
+	doc, ok := document.(yaml.MapSlice)
+	if !ok {
+		return fmt.Errorf("%s is not a spec document", s.Spec)
+	}
+	node, err := toYAMLNode(schema)
+	if err != nil {
+		return err
+	}
+
+	definitions, _ := get(doc, "definitions").(yaml.MapSlice)
+	if get(definitions, name) != nil && !s.Force {
+		return fmt.Errorf("%s already defines %s: use --force to replace it", s.Spec, name)
+	}
+
+	asJSON := bytes.HasPrefix(bytes.TrimSpace(b), []byte("{"))
+	var updated []byte
+	inserted := false
+	if !asJSON {
+		updated, inserted = insertYAMLDefinition(b, name, node)
+	}
+	if !inserted {
+		if updated, err = marshalNode(setDefinition(doc, name, node), asJSON); err != nil {
+			return err
+		}
+	}
+	log.Println("adding the definition", name, "to", s.Spec)
+	return ioutil.WriteFile(s.Spec, updated, info.Mode())
+}
+
+func get(node yaml.MapSlice, key string) interface{} {
+	for _, item := range node {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// setDefinition adds or replaces a definition in a spec document
+func setDefinition(doc yaml.MapSlice, name string, node interface{}) yaml.MapSlice {
+	definitionsIndex := -1
+	for i, item := range doc {
+		if item.Key == "definitions" {
+			definitionsIndex = i
+		}
+	}
+	if definitionsIndex < 0 {
+		doc = append(doc, yaml.MapItem{Key: "definitions", Value: yaml.MapSlice{}})
+		definitionsIndex = len(doc) - 1
+	}
+	definitions, _ := doc[definitionsIndex].Value.(yaml.MapSlice)
+	replaced := false
+	for i, item := range definitions {
+		if item.Key == name {
+			definitions[i].Value = node
+			replaced = true
+		}
+	}
+	if !replaced {
+		definitions = append(definitions, yaml.MapItem{Key: name, Value: node})
+	}
+	doc[definitionsIndex].Value = definitions
+	return doc
+}
+
+var (
+	yamlDefinitionsPattern = regexp.MustCompile(`^["']?definitions["']?\s*:\s*(#.*)?$`)
+	yamlKeyPattern         = regexp.MustCompile(`^(?:"([^"]*)"|'([^']*)'|([^\s"'#][^:]*?))\s*:(?:\s|$)`)
+)
+
+// insertYAMLDefinition adds or replaces a definition in the text of a YAML spec document, so the rest of the
+// document is kept as it is. It returns false when the definitions are not laid out as a block.
+func insertYAMLDefinition(content []byte, name string, node interface{}) ([]byte, bool) {
+	lines := strings.SplitAfter(string(content), "\n")
+	isContent := func(line string) bool {
+		trimmed := strings.TrimSpace(line)
+		return trimmed != "" && !strings.HasPrefix(trimmed, "#")
+	}
+	indentOf := func(line string) string {
+		return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	}
+
+	// locates the definitions and the entry to replace
+	start, end, indent := -1, len(lines), ""
+	entry, entryEnd := -1, -1
+	for i, line := range lines {
+		trimmed := strings.TrimRight(line, "\r\n")
+		if !isContent(trimmed) {
+			continue
+		}
+		lineIndent := indentOf(trimmed)
+		if lineIndent == "" {
+			if start >= 0 {
+				end = i
+				break
+			}
+			if strings.HasPrefix(trimmed, "definitions") || strings.HasPrefix(trimmed, `"definitions"`) || strings.HasPrefix(trimmed, "'definitions'") {
+				if !yamlDefinitionsPattern.MatchString(trimmed) {
+					// e.g. definitions: {}
+					return nil, false
+				}
+				start = i
+			}
+			continue
+		}
+		if start < 0 {
+			continue
+		}
+		if indent == "" {
+			indent = lineIndent
+		}
+		if len(lineIndent) <= len(indent) && entry >= 0 && entryEnd < 0 {
+			entryEnd = i
+		}
+		if lineIndent == indent {
+			if matches := yamlKeyPattern.FindStringSubmatch(strings.TrimLeft(trimmed, " \t")); matches != nil &&
+				matches[1]+matches[2]+matches[3] == name {
+				entry = i
+			}
+		}
+	}
+	if indent == "" {
+		indent = "  "
+	}
+
+	b, err := yaml.Marshal(yaml.MapSlice{{Key: name, Value: node}})
+	if err != nil {
+		return nil, false
+	}
+	var definition strings.Builder
+	for _, line := range strings.SplitAfter(string(b), "\n") {
+		if line != "" {
+			definition.WriteString(indent + line)
+		}
+	}
+
+	if start < 0 {
+		// no definitions yet
+		prefix := strings.Join(lines, "")
+		if prefix != "" && !strings.HasSuffix(prefix, "\n") {
+			prefix += "\n"
+		}
+		return []byte(prefix + "definitions:\n" + definition.String()), true
+	}
+
+	// the definition replaces an entry, or comes after the last definition
+	at, skip := end, end
+	if entry >= 0 {
+		if entryEnd < 0 {
+			entryEnd = end
+		}
+		at, skip = entry, entryEnd
+	}
+	for skip > at+1 && !isContent(lines[skip-1]) {
+		// keeps the blank lines and comments which follow the replaced entry
+		skip--
+	}
+	if entry < 0 {
+		for at > start+1 && !isContent(lines[at-1]) {
+			at--
+		}
+		skip = at
+	}
+
+	var result strings.Builder
+	for _, line := range lines[:at] {
+		result.WriteString(line)
+	}
+	if at > 0 && !strings.HasSuffix(lines[at-1], "\n") {
+		result.WriteString("\n")
+	}
+	result.WriteString(definition.String())
+	for _, line := range lines[skip:] {
+		result.WriteString(line)
+	}
+	return []byte(result.String()), true
+}
+
+func toYAMLNode(schema *spec.Schema) (interface{}, error) {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	return swag.BytesToYAMLDoc(b)
+}
+
+func marshalNode(node interface{}, asJSON bool) ([]byte, error) {
+	if !asJSON {
+		return yaml.Marshal(node)
+	}
+	b, err := swag.YAMLToJSON(node)
+	if err != nil {
+		return nil, err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, b, "", "  "); err != nil {
+		return nil, err
+	}
+	indented.WriteString("\n")
+	return indented.Bytes(), nil
+}
@@ -0,0 +1,177 @@
+package initcmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSamples(t *testing.T, dir string, samples map[string]string) {
+	for name, sample := range samples {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(sample), 0644))
+	}
+}
+
+func TestSchema_Infer(t *testing.T) {
+	root := newSample()
+	for _, document := range []string{
+		`{"id": "7d444840-9dc0-11d1-b245-5ffdce74fad2", "created": "2020-01-02T10:00:00Z", "email": "jane@example.com", "ip": "10.0.0.1",
+		  "status": "active", "count": 1, "ratio": 1, "nickname": null, "any": 1, "tags": ["a"]}`,
+		`{"id": "8d444840-9dc0-11d1-b245-5ffdce74fad2", "created": "2020-01-03T10:00:00Z", "email": "", "ip": "10.0.0.2",
+		  "status": "active", "count": 2, "ratio": 0.5, "nickname": "jj", "any": "one", "tags": [], "zip": "12345"}`,
+		`{"id": "9d444840-9dc0-11d1-b245-5ffdce74fad2", "created": "2020-01-04T10:00:00Z", "email": "joe@example.com", "ip": "10.0.0.3",
+		  "status": "inactive", "count": 3, "ratio": 2, "any": true, "tags": ["b"]}`,
+	} {
+		var value interface{}
+		decoder := json.NewDecoder(strings.NewReader(document))
+		decoder.UseNumber()
+		require.NoError(t, decoder.Decode(&value))
+		root.add(value)
+	}
+	schema := (&inferrer{maxEnum: 5}).schema(root)
+
+	assert.Equal(t, spec.StringOrArray{"object"}, schema.Type)
+	assert.Equal(t, []string{"any", "count", "created", "email", "id", "ip", "ratio", "status", "tags"}, schema.Required)
+	assert.Equal(t, "uuid", schema.Properties["id"].Format)
+	assert.Equal(t, "date-time", schema.Properties["created"].Format)
+	assert.Equal(t, "email", schema.Properties["email"].Format)
+	assert.Equal(t, "ipv4", schema.Properties["ip"].Format)
+	assert.Equal(t, []interface{}{"active", "inactive"}, schema.Properties["status"].Enum)
+	assert.Empty(t, schema.Properties["zip"].Enum)
+	assert.Equal(t, spec.StringOrArray{"integer"}, schema.Properties["count"].Type)
+	assert.Equal(t, spec.StringOrArray{"number"}, schema.Properties["ratio"].Type)
+	assert.Equal(t, true, schema.Properties["nickname"].Extensions["x-nullable"])
+	assert.Empty(t, schema.Properties["any"].Type)
+	assert.Equal(t, spec.StringOrArray{"string"}, schema.Properties["tags"].Items.Schema.Type)
+
+	schema = (&inferrer{}).schema(root)
+	assert.Empty(t, schema.Properties["status"].Enum)
+}
+
+func TestSchema_AddDefinition(t *testing.T) {
+	dir, err := ioutil.TempDir("", "init-schema")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	writeSamples(t, dir, map[string]string{
+		"pet-1.json": `{"name": "rex", "kind": "dog"}`,
+		"pet-2.json": `{"name": "tom", "kind": "cat", "age": 3}`,
+	})
+	specPath := filepath.Join(dir, "swagger.json")
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(`{"swagger": "2.0", "info": {"title": "pets", "version": "1.0"}, "paths": {}}`), 0644))
+
+	cmd := &Schema{FromJSON: []string{filepath.Join(dir, "pet-*.json")}, Spec: specPath}
+	require.NoError(t, cmd.Execute(nil))
+
+	doc, err := loads.Spec(specPath)
+	require.NoError(t, err)
+	pet, ok := doc.Spec().Definitions["Pet1"]
+	require.True(t, ok)
+	assert.Equal(t, []string{"kind", "name"}, pet.Required)
+	assert.Contains(t, pet.Properties, "age")
+
+	// the definition is not replaced unless forced
+	assert.Error(t, cmd.Execute(nil))
+	cmd.Force = true
+	assert.NoError(t, cmd.Execute(nil))
+
+	cmd = &Schema{Name: "Pet", Spec: specPath}
+	assert.Error(t, cmd.Execute(nil))
+	assert.NoError(t, cmd.Execute([]string{filepath.Join(dir, "pet-1.json")}))
+	doc, err = loads.Spec(specPath)
+	require.NoError(t, err)
+	assert.Contains(t, doc.Spec().Definitions, "Pet")
+	assert.Equal(t, "pets", doc.Spec().Info.Title)
+}
+
+func TestSchema_AddDefinitionYAML(t *testing.T) {
+	dir, err := ioutil.TempDir("", "init-schema")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	writeSamples(t, dir, map[string]string{"pet.json": `{"name": "rex"}`})
+	specPath := filepath.Join(dir, "swagger.yml")
+	const original = `# the pet store
+swagger: '2.0'
+info:
+    title: pets
+    version: '1.0'
+paths: {}
+definitions:
+    # pet owners
+    Owner:
+        type: object
+        required: [name]
+    Pet:
+        type: string
+
+    Store:
+        type: object
+
+# shared parameters
+parameters: {}
+`
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(original), 0644))
+
+	// the definition is added after the others, the document is otherwise kept as it is.
+	// Its indentation is the one of the definitions, then the one of yaml.Marshal.
+	cmd := &Schema{FromJSON: []string{filepath.Join(dir, "pet.json")}, Name: "Cat", Spec: specPath}
+	require.NoError(t, cmd.Execute(nil))
+	b, err := ioutil.ReadFile(specPath)
+	require.NoError(t, err)
+	added := strings.Replace(original, "    Store:\n        type: object\n", `    Store:
+        type: object
+    Cat:
+      type: object
+      required:
+      - name
+      properties:
+        name:
+          type: string
+`, 1)
+	assert.Equal(t, added, string(b))
+
+	// a replaced definition keeps its place
+	cmd = &Schema{FromJSON: []string{filepath.Join(dir, "pet.json")}, Name: "Pet", Spec: specPath, Force: true}
+	require.NoError(t, cmd.Execute(nil))
+	b, err = ioutil.ReadFile(specPath)
+	require.NoError(t, err)
+	replaced := strings.Replace(added, "    Pet:\n        type: string\n", `    Pet:
+      type: object
+      required:
+      - name
+      properties:
+        name:
+          type: string
+`, 1)
+	assert.Equal(t, replaced, string(b))
+
+	doc, err := loads.Spec(specPath)
+	require.NoError(t, err)
+	assert.Len(t, doc.Spec().Definitions, 4)
+	assert.Equal(t, "2.0", doc.Spec().Swagger)
+
+	// a spec without definitions
+	require.NoError(t, ioutil.WriteFile(specPath, []byte("swagger: '2.0'\ninfo:\n  title: pets\n  version: '1.0'\npaths: {}"), 0644))
+	require.NoError(t, cmd.Execute(nil))
+	b, err = ioutil.ReadFile(specPath)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(b), "swagger: '2.0'\ninfo:\n  title: pets\n  version: '1.0'\npaths: {}\ndefinitions:\n  Pet:\n    type: object\n"))
+
+	// definitions in flow style are rewritten
+	require.NoError(t, ioutil.WriteFile(specPath, []byte("swagger: '2.0'\ninfo: {title: pets, version: '1.0'}\npaths: {}\ndefinitions: {}\n"), 0644))
+	require.NoError(t, cmd.Execute(nil))
+	doc, err = loads.Spec(specPath)
+	require.NoError(t, err)
+	assert.Contains(t, doc.Spec().Definitions, "Pet")
+}
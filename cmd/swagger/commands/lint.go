@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/lint"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LintSpec is a command that checks a swagger document against style rules.
+//
+// Rules are configured with a YAML ruleset. Each rule may be disabled,
+// given a severity (error, warning, info) or tuned with options.
+type LintSpec struct {
+	Ruleset flags.Filename `long:"ruleset" short:"r" description:"the YAML ruleset configuring the lint rules (default: all rules with their default severity)"`
+	Format  string         `long:"format" short:"f" description:"the format of the lint report" default:"txt" choice:"txt" choice:"json" choice:"sarif"`
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write the lint report to (default: stdout)"`
+}
+
+// Execute lints the spec
+func (c *LintSpec) Execute(args []string) error {
+	if len(args) != 1 {
+		return errors.New("lint command requires the single swagger document url to be specified")
+	}
+
+	swaggerDoc := args[0]
+	specDoc, err := loads.Spec(swaggerDoc)
+	if err != nil {
+		return err
+	}
+
+	ruleset := lint.DefaultRuleset()
+	if c.Ruleset != "" {
+		if ruleset, err = lint.LoadRuleset(string(c.Ruleset)); err != nil {
+			return err
+		}
+	}
+
+	findings, err := lint.Lint(specDoc, ruleset)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if c.Output != "" {
+		f, erc := os.Create(string(c.Output))
+		if erc != nil {
+			return erc
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch c.Format {
+	case JSONFormat:
+		err = lint.WriteJSON(w, findings)
+	case "sarif":
+		err = lint.WriteSARIF(w, swaggerDoc, Version, findings)
+	default:
+		err = lint.WriteText(w, findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	if errCount := findings.Count(lint.SeverityError); errCount > 0 {
+		return fmt.Errorf("the swagger spec at %q has %d lint error(s)", swaggerDoc, errCount)
+	}
+	log.Printf("the swagger spec at %q passed linting with %d warning(s)", swaggerDoc, findings.Count(lint.SeverityWarning))
+	return nil
+}
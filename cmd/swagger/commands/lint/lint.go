@@ -0,0 +1,153 @@
+// Package lint checks a swagger spec against a set of style rules.
+//
+// Unlike validation, which tells whether a spec is legal, linting reports constructs
+// which are legal but do not follow an API style guide (e.g. operations without tags).
+//
+// Rules are built in, and configured with a Ruleset: each rule may be disabled, given
+// another severity, or tuned with options.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+)
+
+// Severity of a finding
+type Severity string
+
+// Severities, from the most to the least severe
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+	// SeverityOff disables a rule
+	SeverityOff Severity = "off"
+)
+
+var severityRanks = map[Severity]int{
+	SeverityError:   3,
+	SeverityWarning: 2,
+	SeverityInfo:    1,
+	SeverityOff:     0,
+}
+
+// Valid returns true when the severity is known
+func (s Severity) Valid() bool {
+	_, ok := severityRanks[s]
+	return ok
+}
+
+// AtLeast returns true when the severity is at least as severe as other
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s] >= severityRanks[other]
+}
+
+// Finding is a violation of a rule
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Pointer is the JSON pointer to the offending construct in the spec, e.g. #/paths/~1pets/get
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s (%s)", f.Pointer, f.Message, f.Rule)
+}
+
+// Findings is a sortable list of findings
+type Findings []Finding
+
+func (f Findings) Len() int      { return len(f) }
+func (f Findings) Swap(i, j int) { f[i], f[j] = f[j], f[i] }
+func (f Findings) Less(i, j int) bool {
+	if f[i].Pointer != f[j].Pointer {
+		return f[i].Pointer < f[j].Pointer
+	}
+	return f[i].Rule < f[j].Rule
+}
+
+// Count returns the number of findings with a given severity
+func (f Findings) Count(severity Severity) int {
+	count := 0
+	for _, finding := range f {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// Rule is a built-in linting rule
+type Rule struct {
+	Name        string
+	Description string
+	// Severity is the default severity of the rule
+	Severity Severity
+	// Options are the default options of the rule
+	Options map[string]string
+
+	check func(*context)
+}
+
+// context holds what a rule needs to check a spec and report findings
+type context struct {
+	doc      *loads.Document
+	rule     *Rule
+	severity Severity
+	options  map[string]string
+	findings Findings
+}
+
+func (c *context) option(name string) string {
+	if value, ok := c.options[name]; ok {
+		return value
+	}
+	return c.rule.Options[name]
+}
+
+func (c *context) report(ptr string, format string, args ...interface{}) {
+	c.findings = append(c.findings, Finding{
+		Rule:     c.rule.Name,
+		Severity: c.severity,
+		Pointer:  ptr,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Lint checks a spec against the rules enabled by a ruleset
+func Lint(doc *loads.Document, ruleset *Ruleset) (Findings, error) {
+	if ruleset == nil {
+		ruleset = DefaultRuleset()
+	}
+	if err := ruleset.Validate(); err != nil {
+		return nil, err
+	}
+
+	var findings Findings
+	for _, rule := range Rules() {
+		severity, options := ruleset.configFor(rule)
+		if severity == SeverityOff {
+			continue
+		}
+		ctx := &context{doc: doc, rule: rule, severity: severity, options: options}
+		rule.check(ctx)
+		findings = append(findings, ctx.findings...)
+	}
+	sort.Stable(findings)
+	return findings, nil
+}
+
+// pointer builds an escaped JSON pointer from reference tokens
+func pointer(tokens ...string) string {
+	escaped := make([]string, 0, len(tokens)+1)
+	escaped = append(escaped, "#")
+	for _, token := range tokens {
+		escaped = append(escaped, jsonpointer.Escape(token))
+	}
+	return strings.Join(escaped, "/")
+}
@@ -0,0 +1,117 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sarif"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fixtureBase = filepath.FromSlash("../../../../fixtures/lint")
+
+func loadFixture(t *testing.T) *loads.Document {
+	specDoc, err := loads.Spec(filepath.Join(fixtureBase, "style.yaml"))
+	require.NoError(t, err)
+	return specDoc
+}
+
+func rulesAt(findings Findings) map[string][]string {
+	found := make(map[string][]string)
+	for _, finding := range findings {
+		found[finding.Rule] = append(found[finding.Rule], finding.Pointer)
+	}
+	return found
+}
+
+func TestLint_Defaults(t *testing.T) {
+	findings, err := Lint(loadFixture(t), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"operation-id-style":     {"#/paths/~1pets/post/operationId"},
+		"operation-description":  {"#/paths/~1pets/post"},
+		"parameter-description":  {"#/parameters/ownerId", "#/paths/~1pets/post/parameters/0"},
+		"definition-description": {"#/definitions/Unused"},
+		"response-example":       {"#/paths/~1pets/post/responses/201"},
+		"operation-4xx-response": {"#/paths/~1pets/post/responses"},
+		"operation-tags":         {"#/paths/~1pets/post"},
+		"path-casing":            {"#/paths/~1petOwners~1{ownerId}"},
+		"typed-schema":           {"#/definitions/Pet/properties/extra"},
+		"unused-definition":      {"#/definitions/Unused"},
+	}, rulesAt(findings))
+
+	assert.Equal(t, 0, findings.Count(SeverityError))
+	for _, finding := range findings {
+		assert.Equal(t, RuleByName(finding.Rule).Severity, finding.Severity)
+	}
+}
+
+func TestLint_Ruleset(t *testing.T) {
+	ruleset, err := LoadRuleset(filepath.Join(fixtureBase, "ruleset.yaml"))
+	require.NoError(t, err)
+
+	findings, err := Lint(loadFixture(t), ruleset)
+	require.NoError(t, err)
+
+	found := rulesAt(findings)
+	assert.NotContains(t, found, "parameter-description")
+	assert.NotContains(t, found, "definition-description")
+	assert.ElementsMatch(t, []string{
+		"#/paths/~1pets/get/operationId",
+		"#/paths/~1petOwners~1{ownerId}/get/operationId",
+	}, found["operation-id-style"])
+
+	require.Equal(t, 1, findings.Count(SeverityError))
+	for _, finding := range findings {
+		if finding.Severity == SeverityError {
+			assert.Equal(t, "operation-tags", finding.Rule)
+		}
+	}
+}
+
+func TestLoadRuleset_Invalid(t *testing.T) {
+	_, err := LoadRuleset(filepath.Join(fixtureBase, "invalid-ruleset.yaml"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown rule "no-such-rule"`)
+	assert.Contains(t, err.Error(), `invalid severity "fatal"`)
+	assert.Contains(t, err.Error(), `unknown style "UPPER"`)
+
+	_, err = LoadRuleset(filepath.Join(fixtureBase, "nowhere.yaml"))
+	assert.Error(t, err)
+}
+
+func TestWriteReports(t *testing.T) {
+	findings, err := Lint(loadFixture(t), nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteText(&buf, findings))
+	assert.Contains(t, buf.String(), "warning #/paths/~1pets/post: operation has no tags (operation-tags)")
+	assert.Contains(t, buf.String(), "11 problem(s): 0 error(s), 7 warning(s), 4 info(s)")
+
+	buf.Reset()
+	require.NoError(t, WriteJSON(&buf, findings))
+	var decoded Findings
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, findings, decoded)
+
+	buf.Reset()
+	require.NoError(t, WriteSARIF(&buf, filepath.Join(fixtureBase, "style.yaml"), "dev", findings))
+	var log sarif.Log
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	assert.Equal(t, sarif.Version, log.Version)
+	require.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, len(Rules()))
+	require.Len(t, log.Runs[0].Results, len(findings))
+	result := log.Runs[0].Results[0]
+	assert.Equal(t, findings[0].Rule, result.RuleID)
+	assert.Equal(t, filepath.ToSlash(filepath.Join(fixtureBase, "style.yaml")), result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.NotNil(t, result.Locations[0].PhysicalLocation.Region)
+	assert.NotZero(t, result.Locations[0].PhysicalLocation.Region.StartLine)
+	assert.Equal(t, findings[0].Pointer, result.Locations[0].LogicalLocations[0].FullyQualifiedName)
+}
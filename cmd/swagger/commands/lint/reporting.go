@@ -0,0 +1,66 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sarif"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sourcemap"
+)
+
+// sarifLevels maps severities to SARIF levels
+var sarifLevels = map[Severity]string{
+	SeverityError:   sarif.LevelError,
+	SeverityWarning: sarif.LevelWarning,
+	SeverityInfo:    sarif.LevelNote,
+}
+
+// WriteText writes findings as text, one line per finding, followed by a summary
+func WriteText(w io.Writer, findings Findings) error {
+	for _, finding := range findings {
+		if _, err := fmt.Fprintf(w, "%-7s %s\n", finding.Severity, finding); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d problem(s): %d error(s), %d warning(s), %d info(s)\n",
+		len(findings), findings.Count(SeverityError), findings.Count(SeverityWarning), findings.Count(SeverityInfo))
+	return err
+}
+
+// WriteJSON writes findings as a JSON array
+func WriteJSON(w io.Writer, findings Findings) error {
+	if findings == nil {
+		findings = Findings{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(findings)
+}
+
+// WriteSARIF writes findings as a SARIF log, locating findings in the source of the spec document at path
+func WriteSARIF(w io.Writer, path, version string, findings Findings) error {
+	rules := make([]sarif.ReportingDescriptor, 0, len(builtinRules))
+	for _, rule := range builtinRules {
+		rules = append(rules, sarif.ReportingDescriptor{
+			ID:                   rule.Name,
+			ShortDescription:     &sarif.Message{Text: rule.Description},
+			DefaultConfiguration: &sarif.Configuration{Level: sarifLevels[rule.Severity]},
+		})
+	}
+
+	locator := sourcemap.New(path)
+	results := make([]sarif.Result, 0, len(findings))
+	for _, finding := range findings {
+		pos := locator.Locate(finding.Pointer)
+		results = append(results, sarif.Result{
+			RuleID:    finding.Rule,
+			Level:     sarifLevels[finding.Severity],
+			Message:   sarif.Message{Text: finding.Message},
+			Locations: []sarif.Location{sarif.NewLocation(filepath.ToSlash(pos.File), finding.Pointer, pos.Line, pos.Column)},
+		})
+	}
+
+	return sarif.New(version, rules, results).Write(w)
+}
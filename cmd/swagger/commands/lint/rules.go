@@ -0,0 +1,380 @@
+package lint
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+const (
+	styleOption       = "style"
+	definitionsPrefix = "#/definitions/"
+)
+
+// casings are the naming styles supported by the style options
+var casings = map[string]*regexp.Regexp{
+	"camelCase":  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	"PascalCase": regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	"snake_case": regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	"kebab-case": regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+}
+
+func casingNames() []string {
+	names := make([]string, 0, len(casings))
+	for name := range casings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// methods in the order they are declared in a path item
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+var builtinRules = []*Rule{
+	{
+		Name:        "operation-id-style",
+		Description: "operations must have an operationId following a naming style",
+		Severity:    SeverityWarning,
+		Options:     map[string]string{styleOption: "camelCase"},
+		check:       checkOperationIDStyle,
+	},
+	{
+		Name:        "operation-description",
+		Description: "operations must have a summary or a description",
+		Severity:    SeverityWarning,
+		check:       checkOperationDescription,
+	},
+	{
+		Name:        "parameter-description",
+		Description: "parameters must have a description",
+		Severity:    SeverityInfo,
+		check:       checkParameterDescription,
+	},
+	{
+		Name:        "definition-description",
+		Description: "definitions must have a description",
+		Severity:    SeverityInfo,
+		check:       checkDefinitionDescription,
+	},
+	{
+		Name:        "response-example",
+		Description: "successful responses with a schema must have an example",
+		Severity:    SeverityInfo,
+		check:       checkResponseExample,
+	},
+	{
+		Name:        "operation-4xx-response",
+		Description: "operations must declare at least one 4xx response",
+		Severity:    SeverityWarning,
+		check:       checkOperation4xxResponse,
+	},
+	{
+		Name:        "operation-tags",
+		Description: "operations must have at least one tag",
+		Severity:    SeverityWarning,
+		check:       checkOperationTags,
+	},
+	{
+		Name:        "path-casing",
+		Description: "path segments must follow a naming style",
+		Severity:    SeverityWarning,
+		Options:     map[string]string{styleOption: "kebab-case"},
+		check:       checkPathCasing,
+	},
+	{
+		Name:        "typed-schema",
+		Description: "schemas must have a type, a $ref, properties or an allOf composition",
+		Severity:    SeverityWarning,
+		check:       checkTypedSchema,
+	},
+	{
+		Name:        "unused-definition",
+		Description: "definitions must be used in the spec",
+		Severity:    SeverityWarning,
+		check:       checkUnusedDefinition,
+	},
+}
+
+// Rules returns all built-in rules
+func Rules() []*Rule {
+	return builtinRules
+}
+
+// RuleByName returns the built-in rule with this name, or nil
+func RuleByName(name string) *Rule {
+	for _, rule := range builtinRules {
+		if rule.Name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// forEachOperation calls fn on each operation, in a stable order
+func forEachOperation(ctx *context, fn func(ptr string, op *spec.Operation)) {
+	sw := ctx.doc.Spec()
+	if sw.Paths == nil {
+		return
+	}
+	for _, path := range sortedPaths(sw.Paths.Paths) {
+		item := sw.Paths.Paths[path]
+		for _, method := range methods {
+			if op := operationFor(&item, method); op != nil {
+				fn(pointer("paths", path, method), op)
+			}
+		}
+	}
+}
+
+func operationFor(item *spec.PathItem, method string) *spec.Operation {
+	switch method {
+	case "get":
+		return item.Get
+	case "put":
+		return item.Put
+	case "post":
+		return item.Post
+	case "delete":
+		return item.Delete
+	case "options":
+		return item.Options
+	case "head":
+		return item.Head
+	case "patch":
+		return item.Patch
+	default:
+		return nil
+	}
+}
+
+func sortedPaths(paths map[string]spec.PathItem) []string {
+	keys := make([]string, 0, len(paths))
+	for k := range paths {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedDefinitions(definitions spec.Definitions) []string {
+	keys := make([]string, 0, len(definitions))
+	for k := range definitions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func checkOperationIDStyle(ctx *context) {
+	style := ctx.option(styleOption)
+	forEachOperation(ctx, func(ptr string, op *spec.Operation) {
+		if op.ID == "" {
+			ctx.report(ptr, "operation has no operationId")
+			return
+		}
+		if !casings[style].MatchString(op.ID) {
+			ctx.report(ptr+"/operationId", "operationId %q is not %s", op.ID, style)
+		}
+	})
+}
+
+func checkOperationDescription(ctx *context) {
+	forEachOperation(ctx, func(ptr string, op *spec.Operation) {
+		if strings.TrimSpace(op.Summary) == "" && strings.TrimSpace(op.Description) == "" {
+			ctx.report(ptr, "operation has no summary nor description")
+		}
+	})
+}
+
+func checkParameterDescription(ctx *context) {
+	check := func(ptr string, params []spec.Parameter) {
+		for i, param := range params {
+			if param.Ref.String() != "" {
+				// checked where it is defined
+				continue
+			}
+			if strings.TrimSpace(param.Description) == "" {
+				ctx.report(ptr+"/"+strconv.Itoa(i), "%s parameter %q has no description", param.In, param.Name)
+			}
+		}
+	}
+
+	sw := ctx.doc.Spec()
+	for _, name := range sortedParameters(sw.Parameters) {
+		param := sw.Parameters[name]
+		if strings.TrimSpace(param.Description) == "" {
+			ctx.report(pointer("parameters", name), "%s parameter %q has no description", param.In, param.Name)
+		}
+	}
+	if sw.Paths == nil {
+		return
+	}
+	for _, path := range sortedPaths(sw.Paths.Paths) {
+		item := sw.Paths.Paths[path]
+		check(pointer("paths", path, "parameters"), item.Parameters)
+		for _, method := range methods {
+			if op := operationFor(&item, method); op != nil {
+				check(pointer("paths", path, method, "parameters"), op.Parameters)
+			}
+		}
+	}
+}
+
+func sortedParameters(params map[string]spec.Parameter) []string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func checkDefinitionDescription(ctx *context) {
+	definitions := ctx.doc.Spec().Definitions
+	for _, name := range sortedDefinitions(definitions) {
+		if strings.TrimSpace(definitions[name].Description) == "" {
+			ctx.report(pointer("definitions", name), "definition %q has no description", name)
+		}
+	}
+}
+
+func checkResponseExample(ctx *context) {
+	definitions := ctx.doc.Spec().Definitions
+	hasExample := func(schema *spec.Schema) bool {
+		if schema.Example != nil {
+			return true
+		}
+		definition, ok := definitions[definitionName(schema.Ref.String())]
+		return ok && definition.Example != nil
+	}
+
+	forEachOperation(ctx, func(ptr string, op *spec.Operation) {
+		if op.Responses == nil {
+			return
+		}
+		for _, code := range sortedCodes(op.Responses.StatusCodeResponses) {
+			if code < 200 || code > 299 {
+				continue
+			}
+			response := op.Responses.StatusCodeResponses[code]
+			if response.Schema == nil || len(response.Examples) > 0 || hasExample(response.Schema) {
+				continue
+			}
+			ctx.report(ptr+"/responses/"+strconv.Itoa(code), "%d response has no example", code)
+		}
+	})
+}
+
+func sortedCodes(responses map[int]spec.Response) []int {
+	codes := make([]int, 0, len(responses))
+	for code := range responses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+func checkOperation4xxResponse(ctx *context) {
+	forEachOperation(ctx, func(ptr string, op *spec.Operation) {
+		if op.Responses != nil {
+			for code := range op.Responses.StatusCodeResponses {
+				if code >= http.StatusBadRequest && code < http.StatusInternalServerError {
+					return
+				}
+			}
+		}
+		ctx.report(ptr+"/responses", "operation has no 4xx response")
+	})
+}
+
+func checkOperationTags(ctx *context) {
+	forEachOperation(ctx, func(ptr string, op *spec.Operation) {
+		if len(op.Tags) == 0 {
+			ctx.report(ptr, "operation has no tags")
+		}
+	})
+}
+
+func checkPathCasing(ctx *context) {
+	style := ctx.option(styleOption)
+	sw := ctx.doc.Spec()
+	if sw.Paths == nil {
+		return
+	}
+	for _, path := range sortedPaths(sw.Paths.Paths) {
+		for _, segment := range strings.Split(path, "/") {
+			if segment == "" || strings.Contains(segment, "{") {
+				// path parameters are named after parameters, not resources
+				continue
+			}
+			if !casings[style].MatchString(segment) {
+				ctx.report(pointer("paths", path), "path segment %q is not %s", segment, style)
+				break
+			}
+		}
+	}
+}
+
+func checkTypedSchema(ctx *context) {
+	schemas := ctx.doc.Analyzer.AllDefinitions()
+	sort.Slice(schemas, func(i, j int) bool {
+		return schemas[i].Ref.String() < schemas[j].Ref.String()
+	})
+	for _, sch := range schemas {
+		schema := sch.Schema
+		if len(schema.Type) > 0 || schema.Ref.String() != "" || len(schema.AllOf) > 0 ||
+			len(schema.Properties) > 0 || (schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil) {
+			continue
+		}
+		ctx.report(sch.Ref.String(), "schema has no type")
+	}
+}
+
+func checkUnusedDefinition(ctx *context) {
+	sw := ctx.doc.Spec()
+	used := make(map[string]bool, len(sw.Definitions))
+	for _, ref := range ctx.doc.Analyzer.AllDefinitionReferences() {
+		if name := definitionName(ref); name != "" {
+			used[name] = true
+		}
+	}
+
+	for _, name := range sortedDefinitions(sw.Definitions) {
+		if used[name] || isSubtype(sw, sw.Definitions[name]) {
+			continue
+		}
+		ctx.report(pointer("definitions", name), "definition %q is not used", name)
+	}
+}
+
+// definitionName returns the name of the definition targeted by a local $ref
+func definitionName(ref string) string {
+	if !strings.HasPrefix(ref, definitionsPrefix) {
+		return ""
+	}
+	ptr, err := jsonpointer.New(strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return ""
+	}
+	tokens := ptr.DecodedTokens()
+	if len(tokens) < 2 {
+		return ""
+	}
+	return tokens[1]
+}
+
+// isSubtype tells if a definition extends a polymorphic type, and is thus used without being referenced
+func isSubtype(sw *spec.Swagger, schema spec.Schema) bool {
+	for _, member := range schema.AllOf {
+		if parent, ok := sw.Definitions[definitionName(member.Ref.String())]; ok && parent.Discriminator != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Ruleset configures the rules to check
+//
+// Rules which are not configured run with their default severity and options.
+//
+// Example:
+//
+//	rules:
+//	  operation-tags: error
+//	  parameter-description: off
+//	  operation-id-style:
+//	    severity: warning
+//	    options:
+//	      style: snake_case
+type Ruleset struct {
+	Rules map[string]RuleConfig `yaml:"rules" json:"rules"`
+}
+
+// RuleConfig configures a rule.
+//
+// A rule may be configured with a severity only, or with an object.
+type RuleConfig struct {
+	Enabled  *bool             `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+	Severity Severity          `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Options  map[string]string `yaml:"options,omitempty" json:"options,omitempty"`
+}
+
+// UnmarshalYAML accepts a severity, a boolean or a rule config object
+func (r *RuleConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var scalar interface{}
+	if err := unmarshal(&scalar); err != nil {
+		return err
+	}
+	switch value := scalar.(type) {
+	case bool:
+		// YAML reads the unquoted off and on as booleans
+		r.Enabled = &value
+		return nil
+	case string:
+		r.Severity = Severity(value)
+		return nil
+	}
+
+	type ruleConfigAlias RuleConfig
+	var config ruleConfigAlias
+	if err := unmarshal(&config); err != nil {
+		return err
+	}
+	*r = RuleConfig(config)
+	return nil
+}
+
+// DefaultRuleset runs all rules with their default severity and options
+func DefaultRuleset() *Ruleset {
+	return &Ruleset{}
+}
+
+// LoadRuleset reads a YAML ruleset
+func LoadRuleset(path string) (*Ruleset, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ruleset Ruleset
+	if err := yaml.UnmarshalStrict(b, &ruleset); err != nil {
+		return nil, fmt.Errorf("could not read ruleset %s: %v", path, err)
+	}
+	if err := ruleset.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid ruleset %s: %v", path, err)
+	}
+	return &ruleset, nil
+}
+
+// Validate checks that the ruleset only configures known rules, severities and options
+func (r *Ruleset) Validate() error {
+	names := make([]string, 0, len(r.Rules))
+	for name := range r.Rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	for _, name := range names {
+		config := r.Rules[name]
+		rule := RuleByName(name)
+		if rule == nil {
+			errs = append(errs, fmt.Sprintf("unknown rule %q", name))
+			continue
+		}
+		if config.Severity != "" && !config.Severity.Valid() {
+			errs = append(errs, fmt.Sprintf("rule %q: invalid severity %q (expected one of error, warning, info, off)", name, config.Severity))
+		}
+		for option, value := range config.Options {
+			if _, ok := rule.Options[option]; !ok {
+				errs = append(errs, fmt.Sprintf("rule %q: unknown option %q", name, option))
+				continue
+			}
+			if option == styleOption {
+				if _, ok := casings[value]; !ok {
+					errs = append(errs, fmt.Sprintf("rule %q: unknown style %q (expected one of %s)", name, value, strings.Join(casingNames(), ", ")))
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// configFor resolves the severity and options of a rule
+func (r *Ruleset) configFor(rule *Rule) (Severity, map[string]string) {
+	config, ok := r.Rules[rule.Name]
+	if !ok {
+		return rule.Severity, nil
+	}
+	if config.Enabled != nil && !*config.Enabled {
+		return SeverityOff, nil
+	}
+	if config.Severity == "" {
+		return rule.Severity, config.Options
+	}
+	return config.Severity, config.Options
+}
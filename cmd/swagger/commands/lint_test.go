@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sarif"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Commands requires at least one arg
+func TestCmd_Lint(t *testing.T) {
+	v := &LintSpec{}
+	testRequireParam(t, v)
+}
+
+func TestCmd_Lint_SARIF(t *testing.T) {
+	specDoc := filepath.Join(fixtureBase, "lint", "style.yaml")
+	outDir, output := getOutput(t, specDoc, "lint", "lint.sarif")
+	defer os.RemoveAll(outDir)
+	v := &LintSpec{
+		Format: "sarif",
+		Output: flags.Filename(output),
+	}
+	testProduceOutput(t, v, specDoc, output)
+
+	b, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	var report sarif.Log
+	require.NoError(t, json.Unmarshal(b, &report))
+	require.Len(t, report.Runs, 1)
+	assert.NotEmpty(t, report.Runs[0].Results)
+}
+
+func TestCmd_Lint_Errors(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	specDoc := filepath.Join(fixtureBase, "lint", "style.yaml")
+	outDir, output := getOutput(t, specDoc, "lint", "lint.json")
+	defer os.RemoveAll(outDir)
+	v := &LintSpec{
+		Ruleset: flags.Filename(filepath.Join(fixtureBase, "lint", "ruleset.yaml")),
+		Format:  "json",
+		Output:  flags.Filename(output),
+	}
+	err := v.Execute([]string{specDoc})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 lint error(s)")
+
+	v.Ruleset = flags.Filename(filepath.Join(fixtureBase, "lint", "invalid-ruleset.yaml"))
+	assert.Error(t, v.Execute([]string{specDoc}))
+}
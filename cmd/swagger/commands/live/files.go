@@ -0,0 +1,79 @@
+package live
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// Files returns the absolute paths of the spec document at root and of the local documents
+// it references through $ref, recursively.
+//
+// Documents which cannot be read are part of the result, so that they are watched until fixed.
+func Files(root string) []string {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		abs = filepath.Clean(root)
+	}
+
+	seen := map[string]bool{abs: true}
+	pending := []string{abs}
+	for len(pending) > 0 {
+		file := pending[0]
+		pending = pending[1:]
+		for _, ref := range references(file) {
+			target := filepath.Join(filepath.Dir(file), filepath.FromSlash(ref))
+			if !seen[target] {
+				seen[target] = true
+				pending = append(pending, target)
+			}
+		}
+	}
+
+	files := make([]string, 0, len(seen))
+	for file := range seen {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// references returns the documents referenced by the local $ref of a document
+func references(file string) []string {
+	raw, err := swag.YAMLDoc(file)
+	if err != nil {
+		return nil
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil
+	}
+
+	var refs []string
+	var walk func(interface{})
+	walk = func(node interface{}) {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			if ref, ok := n["$ref"].(string); ok {
+				if idx := strings.Index(ref, "#"); idx >= 0 {
+					ref = ref[:idx]
+				}
+				if ref != "" && !strings.Contains(ref, "://") {
+					refs = append(refs, ref)
+				}
+			}
+			for _, value := range n {
+				walk(value)
+			}
+		case []interface{}:
+			for _, item := range n {
+				walk(item)
+			}
+		}
+	}
+	walk(doc)
+	return refs
+}
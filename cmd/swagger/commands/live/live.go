@@ -0,0 +1,227 @@
+// Package live reloads a spec served by swagger serve when the spec, or a document it references, changes.
+//
+// Open documentation pages are notified through server-sent events, either to refresh or to show
+// the problems which prevent the spec from loading or validating.
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Names of the events sent to documentation pages
+const (
+	// EventReload tells pages to refresh, since a new version of the spec is served
+	EventReload = "reload"
+	// EventProblems tells pages about the problems of the spec, as a JSON array of messages
+	EventProblems = "problems"
+)
+
+// debounce is how long to wait for more changes before reloading, since editors often write files in several steps
+const debounce = 100 * time.Millisecond
+
+// LoadFunc loads the spec to serve, as JSON.
+//
+// Problems are reported along a spec which could be loaded, e.g. validation errors.
+// When an error is returned, the previous version of the spec keeps being served.
+type LoadFunc func() (spec []byte, problems []string, err error)
+
+type event struct {
+	name string
+	data string
+}
+
+// Reloader serves the latest version of a spec
+type Reloader struct {
+	root string
+	load LoadFunc
+
+	mu          sync.RWMutex
+	spec        []byte
+	problems    []string
+	files       []string
+	subscribers map[chan event]struct{}
+}
+
+// New loads the spec document at root for the first time
+func New(root string, load LoadFunc) (*Reloader, error) {
+	spec, problems, err := load()
+	if err != nil {
+		return nil, err
+	}
+	return &Reloader{
+		root:        root,
+		load:        load,
+		spec:        spec,
+		problems:    problems,
+		files:       Files(root),
+		subscribers: make(map[chan event]struct{}),
+	}, nil
+}
+
+// Spec returns the latest version of the spec which could be loaded
+func (r *Reloader) Spec() []byte {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.spec
+}
+
+// Problems returns the problems found when loading the spec for the last time
+func (r *Reloader) Problems() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.problems
+}
+
+// Files returns the files of the spec, which are watched for changes
+func (r *Reloader) Files() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.files
+}
+
+// Reload loads the spec again and notifies the documentation pages
+func (r *Reloader) Reload() {
+	spec, problems, err := r.load()
+	files := Files(r.root)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.files = files
+	if err != nil {
+		log.Printf("cannot reload the spec at %q: %v", r.root, err)
+		r.problems = []string{err.Error()}
+		r.broadcast(problemsEvent(r.problems))
+		return
+	}
+
+	log.Printf("reloaded the spec at %q with %d problem(s)", r.root, len(problems))
+	r.spec, r.problems = spec, problems
+	r.broadcast(event{name: EventReload})
+}
+
+func problemsEvent(problems []string) event {
+	if problems == nil {
+		problems = []string{}
+	}
+	data, _ := json.Marshal(problems)
+	return event{name: EventProblems, data: string(data)}
+}
+
+// broadcast sends an event to all subscribers, without waiting for slow ones
+func (r *Reloader) broadcast(e event) {
+	for ch := range r.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+func (r *Reloader) subscribe() chan event {
+	ch := make(chan event, 8)
+	r.mu.Lock()
+	r.subscribers[ch] = struct{}{}
+	r.mu.Unlock()
+	return ch
+}
+
+func (r *Reloader) unsubscribe(ch chan event) {
+	r.mu.Lock()
+	delete(r.subscribers, ch)
+	r.mu.Unlock()
+}
+
+// ServeHTTP streams events to a documentation page, starting with the current problems of the spec
+func (r *Reloader) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+
+	ch := r.subscribe()
+	defer r.unsubscribe(ch)
+
+	e := problemsEvent(r.Problems())
+	for {
+		if _, err := fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", e.name, e.data); err != nil {
+			return
+		}
+		flusher.Flush()
+
+		select {
+		case <-req.Context().Done():
+			return
+		case e = <-ch:
+		}
+	}
+}
+
+// Watch reloads the spec when one of its files changes, until stop is closed.
+//
+// The directories of the files are watched rather than the files, so that editors which
+// replace files on save are supported.
+func (r *Reloader) Watch(stop <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	watched := make(map[string]bool)
+	watchDirs := func() {
+		for _, file := range r.Files() {
+			dir := filepath.Dir(file)
+			if watched[dir] {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				log.Printf("cannot watch %q: %v", dir, err)
+				continue
+			}
+			watched[dir] = true
+		}
+	}
+	watchDirs()
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return nil
+		case e := <-watcher.Events:
+			if e.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) != 0 && r.watches(e.Name) {
+				reload = time.After(debounce)
+			}
+		case err := <-watcher.Errors:
+			log.Printf("error watching the spec at %q: %v", r.root, err)
+		case <-reload:
+			reload = nil
+			r.Reload()
+			watchDirs()
+		}
+	}
+}
+
+func (r *Reloader) watches(name string) bool {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return false
+	}
+	for _, file := range r.Files() {
+		if file == abs {
+			return true
+		}
+	}
+	return false
+}
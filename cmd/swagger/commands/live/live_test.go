@@ -0,0 +1,191 @@
+package live
+
+import (
+	"bufio"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiles(t *testing.T) {
+	base, err := filepath.Abs(filepath.FromSlash("../../../../fixtures/sourcemap"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		filepath.Join(base, "remote.yaml"),
+		filepath.Join(base, "spec.yaml"),
+	}, Files(filepath.Join(base, "spec.yaml")))
+
+	missing := filepath.Join(base, "nowhere.yaml")
+	assert.Equal(t, []string{missing}, Files(missing))
+}
+
+// writeSpec writes a spec in a temporary directory, with a reference to another document
+func writeSpec(t *testing.T) (string, string) {
+	dir, err := ioutil.TempDir("", "live")
+	require.NoError(t, err)
+
+	root := filepath.Join(dir, "swagger.yaml")
+	require.NoError(t, ioutil.WriteFile(root, []byte(`swagger: '2.0'
+info:
+  title: live
+  version: 1.0.0
+paths: {}
+definitions:
+  Pet:
+    $ref: './models/pet.yaml'
+`), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "models"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "models", "pet.yaml"), []byte("type: object\n"), 0644))
+	return dir, root
+}
+
+// loadFile loads a spec by reading its root document, failing when it contains some text
+func loadFile(root string) LoadFunc {
+	return func() ([]byte, []string, error) {
+		b, err := ioutil.ReadFile(root)
+		if err != nil {
+			return nil, nil, err
+		}
+		if strings.Contains(string(b), "broken") {
+			return nil, nil, errors.New("broken spec")
+		}
+		return b, []string{"a validation error"}, nil
+	}
+}
+
+func TestReloader(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	dir, root := writeSpec(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	r, err := New(root, loadFile(root))
+	require.NoError(t, err)
+	assert.Len(t, r.Files(), 2)
+	assert.Equal(t, []string{"a validation error"}, r.Problems())
+	assert.Contains(t, string(r.Spec()), "title: live")
+
+	ch := r.subscribe()
+	defer r.unsubscribe(ch)
+
+	require.NoError(t, ioutil.WriteFile(root, []byte("broken"), 0644))
+	r.Reload()
+	assert.Equal(t, event{name: EventProblems, data: `["broken spec"]`}, <-ch)
+	assert.Contains(t, string(r.Spec()), "title: live", "the previous version of the spec is served")
+
+	require.NoError(t, ioutil.WriteFile(root, []byte("swagger: '2.0'\n"), 0644))
+	r.Reload()
+	assert.Equal(t, event{name: EventReload}, <-ch)
+	assert.Equal(t, "swagger: '2.0'\n", string(r.Spec()))
+	assert.Len(t, r.Files(), 1)
+
+	_, err = New(filepath.Join(dir, "nowhere.yaml"), loadFile(filepath.Join(dir, "nowhere.yaml")))
+	assert.Error(t, err)
+}
+
+func TestReloader_Watch(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	dir, root := writeSpec(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	r, err := New(root, loadFile(root))
+	require.NoError(t, err)
+	ch := r.subscribe()
+	defer r.unsubscribe(ch)
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- r.Watch(stop)
+	}()
+	// let the watcher start
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "models", "pet.yaml"), []byte("type: string\n"), 0644))
+	select {
+	case e := <-ch:
+		assert.Equal(t, EventReload, e.name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("a change to a referenced document should reload the spec")
+	}
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "unrelated.yaml"), []byte("type: string\n"), 0644))
+	select {
+	case e := <-ch:
+		t.Fatalf("a change to another file should not reload the spec: %v", e)
+	case <-time.After(3 * debounce):
+	}
+
+	close(stop)
+	assert.NoError(t, <-done)
+}
+
+func TestReloader_ServeHTTP(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	dir, root := writeSpec(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	r, err := New(root, loadFile(root))
+	require.NoError(t, err)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := bufio.NewReader(resp.Body)
+	readEvent := func() string {
+		var e []string
+		for {
+			line, err := lines.ReadString('\n')
+			require.NoError(t, err)
+			if line == "\n" {
+				return strings.Join(e, "")
+			}
+			e = append(e, line)
+		}
+	}
+	assert.Equal(t, "event: problems\ndata: [\"a validation error\"]\n", readEvent())
+
+	r.Reload()
+	assert.Equal(t, "event: reload\ndata: \n", readEvent())
+}
+
+func TestSnapshot(t *testing.T) {
+	dir, root := writeSpec(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	snapshot, err := NewSnapshot(root)
+	require.NoError(t, err)
+	assert.NotEqual(t, root, snapshot.Root)
+	content, err := ioutil.ReadFile(filepath.Join(filepath.Dir(snapshot.Root), "models", "pet.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "type: object\n", string(content))
+	assert.Equal(t, "cannot load "+root, snapshot.Original("cannot load "+snapshot.Root))
+
+	snapshot.Remove()
+	_, err = os.Stat(snapshot.Root)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,81 @@
+package live
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Snapshot is a copy of the files of a spec in a temporary directory.
+//
+// The documents referenced through $ref are cached by path for the lifetime of the process when a spec
+// is expanded or validated: loading a new snapshot of the spec ensures that their latest version is used.
+type Snapshot struct {
+	// Root is the path of the copy of the root document
+	Root string
+	dir  string
+	base string
+}
+
+// NewSnapshot copies the spec document at root and the local documents it references
+func NewSnapshot(root string) (*Snapshot, error) {
+	files := Files(root)
+	base := filepath.Dir(files[0])
+	for _, file := range files[1:] {
+		for !strings.HasPrefix(file, base+string(filepath.Separator)) && filepath.Dir(base) != base {
+			base = filepath.Dir(base)
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "swagger-serve")
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{dir: dir, base: base}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if os.IsNotExist(err) {
+			// reported when loading the snapshot
+			continue
+		}
+		if err != nil {
+			snapshot.Remove()
+			return nil, err
+		}
+		target := snapshot.path(file)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			snapshot.Remove()
+			return nil, err
+		}
+		if err := ioutil.WriteFile(target, content, 0644); err != nil {
+			snapshot.Remove()
+			return nil, err
+		}
+	}
+
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		abs = root
+	}
+	snapshot.Root = snapshot.path(abs)
+	return snapshot, nil
+}
+
+func (s *Snapshot) path(file string) string {
+	rel, err := filepath.Rel(s.base, file)
+	if err != nil {
+		rel = filepath.Base(file)
+	}
+	return filepath.Join(s.dir, rel)
+}
+
+// Original replaces the paths of the snapshot with the paths of the original files in a message
+func (s *Snapshot) Original(message string) string {
+	return strings.Replace(message, s.dir, s.base, -1)
+}
+
+// Remove deletes the snapshot
+func (s *Snapshot) Remove() {
+	_ = os.RemoveAll(s.dir)
+}
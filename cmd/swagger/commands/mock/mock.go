@@ -0,0 +1,244 @@
+// Package mock serves a stand-in implementation of the API described by a spec.
+//
+// Every operation of the spec is routed and its request is validated. Valid requests are answered
+// with the example of a declared response, or with a payload generated from the response schema.
+package mock
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	// StatusHeader is the request header to choose the declared status code of the response
+	StatusHeader = "X-Mock-Status"
+	// StatusQueryParam is the query parameter to choose the declared status code of the response
+	StatusQueryParam = "_mock_status"
+)
+
+// Opts configures the mock server
+type Opts struct {
+	// Seed makes the generated payloads deterministic: the same request is always answered with the same data.
+	// When 0, payloads are random.
+	Seed int64
+}
+
+// New creates a handler which answers the operations of a spec
+func New(doc *loads.Document, opts Opts) (http.Handler, error) {
+	expanded, err := doc.Expanded(&spec.ExpandOptions{
+		RelativeBase:    doc.SpecFilePath(),
+		ContinueOnError: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	api := &mockAPI{opts: opts, spec: expanded.Spec(), handlers: make(map[string]map[string]http.Handler)}
+	ctx := middleware.NewRoutableContext(expanded, api, nil)
+	for method, operations := range expanded.Analyzer.Operations() {
+		method = strings.ToUpper(method)
+		api.handlers[method] = make(map[string]http.Handler, len(operations))
+		for path, op := range operations {
+			api.handlers[method][path] = api.operationHandler(ctx, method, path, op)
+		}
+	}
+	return ctx.RoutesHandler(nil), nil
+}
+
+// mockAPI provides the routes of the spec to the middleware context
+type mockAPI struct {
+	opts     Opts
+	spec     *spec.Swagger
+	handlers map[string]map[string]http.Handler
+}
+
+func (a *mockAPI) HandlerFor(method, path string) (http.Handler, bool) {
+	handler, ok := a.handlers[strings.ToUpper(method)][path]
+	return handler, ok
+}
+
+func (a *mockAPI) ServeErrorFor(operationID string) func(http.ResponseWriter, *http.Request, error) {
+	return errors.ServeError
+}
+
+func (a *mockAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consumer {
+	consumers := make(map[string]runtime.Consumer, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		switch {
+		case isJSON(mediaType):
+			consumers[mediaType] = runtime.JSONConsumer()
+		case isXML(mediaType):
+			consumers[mediaType] = runtime.XMLConsumer()
+		case mediaType == runtime.MultipartFormMime || mediaType == runtime.URLencodedFormMime:
+			// form parameters are bound from the request
+			consumers[mediaType] = runtime.DiscardConsumer
+		case strings.HasPrefix(mediaType, "text/"):
+			consumers[mediaType] = runtime.TextConsumer()
+		default:
+			consumers[mediaType] = runtime.ByteStreamConsumer()
+		}
+	}
+	return consumers
+}
+
+func (a *mockAPI) ProducersFor(mediaTypes []string) map[string]runtime.Producer {
+	producers := make(map[string]runtime.Producer, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		switch {
+		case isJSON(mediaType):
+			producers[mediaType] = runtime.JSONProducer()
+		case isXML(mediaType):
+			producers[mediaType] = runtime.XMLProducer()
+		case strings.HasPrefix(mediaType, "text/"):
+			producers[mediaType] = runtime.TextProducer()
+		default:
+			producers[mediaType] = runtime.ByteStreamProducer()
+		}
+	}
+	return producers
+}
+
+// AuthenticatorsFor returns no authenticator: the mock server does not enforce security requirements
+func (a *mockAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
+	return nil
+}
+
+func (a *mockAPI) Authorizer() runtime.Authorizer {
+	return security.Authorized()
+}
+
+func (a *mockAPI) Formats() strfmt.Registry {
+	return strfmt.Default
+}
+
+func (a *mockAPI) DefaultProduces() string {
+	return runtime.JSONMime
+}
+
+func (a *mockAPI) DefaultConsumes() string {
+	return runtime.JSONMime
+}
+
+func isJSON(mediaType string) bool {
+	return strings.Contains(mediaType, "json")
+}
+
+func isXML(mediaType string) bool {
+	return strings.HasSuffix(mediaType, "/xml") || strings.HasSuffix(mediaType, "+xml")
+}
+
+// operationHandler validates the requests of an operation and answers them with a mock response
+func (a *mockAPI) operationHandler(ctx *middleware.Context, method, path string, op *spec.Operation) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		route, rCtx, _ := ctx.RouteInfo(r)
+		if rCtx != nil {
+			r = rCtx
+		}
+
+		var err error
+		if _, r, err = ctx.BindAndValidate(r, route); err != nil {
+			ctx.Respond(rw, r, route.Produces, route, err)
+			return
+		}
+
+		requested := r.Header.Get(StatusHeader)
+		if requested == "" {
+			requested = r.URL.Query().Get(StatusQueryParam)
+		}
+		code, response, err := selectResponse(op, requested)
+		if err != nil {
+			ctx.Respond(rw, r, route.Produces, route, err)
+			return
+		}
+
+		var format string
+		format, r = ctx.ResponseFormat(r, route.Produces)
+		g := &generator{rnd: a.random(method, path, code), root: a.spec}
+		payload, hasPayload := g.payload(response, format)
+		headers := g.headers(response)
+
+		ctx.Respond(rw, r, route.Produces, route, middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+			for name, value := range headers {
+				rw.Header().Set(name, value)
+			}
+			rw.WriteHeader(code)
+			if !hasPayload || code == http.StatusNoContent || r.Method == http.MethodHead {
+				return
+			}
+			if err := producer.Produce(rw, payload); err != nil {
+				log.Printf("cannot produce the mock response of %s %s: %v", method, path, err)
+			}
+		}))
+	})
+}
+
+// random returns the source of the generated data of a response
+func (a *mockAPI) random(method, path string, code int) *rand.Rand {
+	if a.opts.Seed == 0 {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d %s %s %d", a.opts.Seed, method, path, code)
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// selectResponse returns the response of an operation with the requested status code.
+//
+// By default, the first success response is selected, or else the default response.
+// A status code which is not declared is answered with the default response, when there is one.
+func selectResponse(op *spec.Operation, requested string) (int, *spec.Response, error) {
+	if op.Responses == nil {
+		return http.StatusOK, nil, nil
+	}
+
+	if requested == "" {
+		if response, code, ok := op.SuccessResponse(); ok {
+			return code, response, nil
+		}
+		if op.Responses.Default != nil {
+			return http.StatusOK, op.Responses.Default, nil
+		}
+		codes := declaredCodes(op)
+		if len(codes) == 0 {
+			return http.StatusOK, nil, nil
+		}
+		response := op.Responses.StatusCodeResponses[codes[0]]
+		return codes[0], &response, nil
+	}
+
+	code, err := strconv.Atoi(requested)
+	if err != nil || code < 100 || code > 599 {
+		return 0, nil, errors.New(http.StatusBadRequest, "invalid mock status %q: a status code is expected", requested)
+	}
+	if response, ok := op.Responses.StatusCodeResponses[code]; ok {
+		return code, &response, nil
+	}
+	if op.Responses.Default != nil {
+		return code, op.Responses.Default, nil
+	}
+	return 0, nil, errors.New(http.StatusBadRequest, "mock status %d is not declared by operation %q: use one of %v", code, op.ID, declaredCodes(op))
+}
+
+func declaredCodes(op *spec.Operation) []int {
+	codes := make([]int, 0, len(op.Responses.StatusCodeResponses))
+	for code := range op.Responses.StatusCodeResponses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
@@ -0,0 +1,124 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockServer(t *testing.T, opts Opts) http.Handler {
+	doc, err := loads.Spec(filepath.FromSlash("../../../../fixtures/mock/petstore.yaml"))
+	require.NoError(t, err)
+	handler, err := New(doc, opts)
+	require.NoError(t, err)
+	return handler
+}
+
+func request(t *testing.T, handler http.Handler, method, target, body string, headers ...string) (*httptest.ResponseRecorder, interface{}) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var payload interface{}
+	if rec.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &payload), rec.Body.String())
+	}
+	return rec, payload
+}
+
+func TestMock_Schema(t *testing.T) {
+	handler := mockServer(t, Opts{Seed: 1})
+
+	rec, payload := request(t, handler, http.MethodGet, "/api/pets/12", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	pet := payload.(map[string]interface{})
+	assert.GreaterOrEqual(t, pet["id"].(float64), 1.0)
+	assert.GreaterOrEqual(t, len(pet["name"].(string)), 3)
+	assert.Contains(t, []interface{}{"cat", "dog"}, pet["tag"])
+	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}$`, pet["birthday"])
+	assert.Regexp(t, `@example\.com$`, pet["owner"].(map[string]interface{})["email"])
+
+	rec, payload = request(t, handler, http.MethodGet, "/api/pets", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, payload, 2)
+	total, err := strconv.Atoi(rec.Header().Get("X-Total"))
+	require.NoError(t, err)
+	assert.True(t, total >= 0 && total <= 10)
+
+	rec, _ = request(t, handler, http.MethodDelete, "/api/pets/12", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Zero(t, rec.Body.Len())
+}
+
+func TestMock_Example(t *testing.T) {
+	handler := mockServer(t, Opts{})
+
+	rec, payload := request(t, handler, http.MethodPost, "/api/pets", `{"id": 1, "name": "Felix"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, map[string]interface{}{"id": 42.0, "name": "Rex"}, payload)
+}
+
+func TestMock_Status(t *testing.T) {
+	handler := mockServer(t, Opts{})
+
+	rec, payload := request(t, handler, http.MethodGet, "/api/pets/12", "", StatusHeader, "404")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "something went wrong", payload.(map[string]interface{})["message"])
+
+	rec, _ = request(t, handler, http.MethodGet, "/api/pets/12?"+StatusQueryParam+"=404", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, payload = request(t, handler, http.MethodGet, "/api/pets", "", StatusHeader, "503")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "undeclared status codes use the default response")
+	assert.Contains(t, payload, "code")
+
+	rec, payload = request(t, handler, http.MethodGet, "/api/pets/12", "", StatusHeader, "503")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, payload.(map[string]interface{})["message"], "use one of [200 404]")
+
+	rec, _ = request(t, handler, http.MethodGet, "/api/pets/12", "", StatusHeader, "teapot")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestMock_Validation(t *testing.T) {
+	handler := mockServer(t, Opts{})
+
+	rec, _ := request(t, handler, http.MethodGet, "/api/pets/abc", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec, _ = request(t, handler, http.MethodGet, "/api/pets?limit=1000", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec, _ = request(t, handler, http.MethodPost, "/api/pets", `{"id": 1}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec, _ = request(t, handler, http.MethodGet, "/api/owners", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, _ = request(t, handler, http.MethodPut, "/api/pets/12", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestMock_Seed(t *testing.T) {
+	_, first := request(t, mockServer(t, Opts{Seed: 7}), http.MethodGet, "/api/pets", "")
+	_, again := request(t, mockServer(t, Opts{Seed: 7}), http.MethodGet, "/api/pets", "")
+	assert.Equal(t, first, again)
+
+	_, other := request(t, mockServer(t, Opts{Seed: 8}), http.MethodGet, "/api/pets", "")
+	assert.NotEqual(t, first, other)
+}
@@ -0,0 +1,304 @@
+package mock
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/spec"
+)
+
+// maxDepth limits the nesting of generated objects, e.g. for recursive schemas
+const maxDepth = 8
+
+var words = []string{"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do", "eiusmod", "tempor"}
+
+// generator builds mock payloads from the examples and schemas of responses
+type generator struct {
+	rnd  *rand.Rand
+	root *spec.Swagger
+}
+
+// payload returns the body of a response, preferring the example for the response format
+func (g *generator) payload(response *spec.Response, format string) (interface{}, bool) {
+	if response == nil {
+		return nil, false
+	}
+	if example, ok := response.Examples[format]; ok {
+		return example, true
+	}
+	if len(response.Examples) > 0 {
+		mediaTypes := make([]string, 0, len(response.Examples))
+		for mediaType := range response.Examples {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+		sort.Strings(mediaTypes)
+		return response.Examples[mediaTypes[0]], true
+	}
+	if response.Schema == nil {
+		return nil, false
+	}
+	return g.schema(response.Schema, 0), true
+}
+
+// headers returns the values of the headers of a response
+func (g *generator) headers(response *spec.Response) map[string]string {
+	if response == nil {
+		return nil
+	}
+	headers := make(map[string]string, len(response.Headers))
+	for name, header := range response.Headers {
+		value := g.schema(simpleSchema(header.SimpleSchema, header.CommonValidations), 0)
+		if values, ok := value.([]interface{}); ok {
+			items := make([]string, 0, len(values))
+			for _, item := range values {
+				items = append(items, fmt.Sprint(item))
+			}
+			headers[name] = strings.Join(items, ",")
+			continue
+		}
+		headers[name] = fmt.Sprint(value)
+	}
+	return headers
+}
+
+// simpleSchema converts the schema of a header or of items to a JSON schema
+func simpleSchema(simple spec.SimpleSchema, validations spec.CommonValidations) *spec.Schema {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Type:             spec.StringOrArray{simple.Type},
+			Format:           simple.Format,
+			Default:          simple.Default,
+			Maximum:          validations.Maximum,
+			ExclusiveMaximum: validations.ExclusiveMaximum,
+			Minimum:          validations.Minimum,
+			ExclusiveMinimum: validations.ExclusiveMinimum,
+			MaxLength:        validations.MaxLength,
+			MinLength:        validations.MinLength,
+			MaxItems:         validations.MaxItems,
+			MinItems:         validations.MinItems,
+			MultipleOf:       validations.MultipleOf,
+			Enum:             validations.Enum,
+		},
+		SwaggerSchemaProps: spec.SwaggerSchemaProps{Example: simple.Example},
+	}
+	if simple.Items != nil {
+		schema.Items = &spec.SchemaOrArray{Schema: simpleSchema(simple.Items.SimpleSchema, simple.Items.CommonValidations)}
+	}
+	return schema
+}
+
+// schema generates a value which validates against a schema
+func (g *generator) schema(schema *spec.Schema, depth int) interface{} {
+	if schema == nil || depth > maxDepth {
+		return nil
+	}
+	if schema.Ref.String() != "" {
+		resolved, err := spec.ResolveRef(g.root, &schema.Ref)
+		if err != nil {
+			return nil
+		}
+		return g.schema(resolved, depth+1)
+	}
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[g.rnd.Intn(len(schema.Enum))]
+	case len(schema.AllOf) > 0:
+		return g.allOf(schema, depth)
+	}
+
+	switch schemaType(schema) {
+	case "object":
+		return g.object(schema, depth)
+	case "array":
+		return g.array(schema, depth)
+	case "string":
+		return g.string(schema)
+	case "integer":
+		return int64(g.number(schema, true))
+	case "number":
+		return g.number(schema, false)
+	case "boolean":
+		return g.rnd.Intn(2) == 0
+	case "file":
+		return "mock file content"
+	default:
+		return nil
+	}
+}
+
+func schemaType(schema *spec.Schema) string {
+	switch {
+	case len(schema.Type) > 0 && schema.Type[0] != "":
+		return schema.Type[0]
+	case len(schema.Properties) > 0 || schema.AdditionalProperties != nil:
+		return "object"
+	case schema.Items != nil:
+		return "array"
+	default:
+		return ""
+	}
+}
+
+// allOf merges the objects generated for all the schemas of an allOf
+func (g *generator) allOf(schema *spec.Schema, depth int) interface{} {
+	merged := make(map[string]interface{})
+	var last interface{}
+	for i := range schema.AllOf {
+		last = g.schema(&schema.AllOf[i], depth+1)
+		if object, ok := last.(map[string]interface{}); ok {
+			for key, value := range object {
+				merged[key] = value
+			}
+		}
+	}
+	if len(schema.Properties) > 0 {
+		for key, value := range g.object(schema, depth).(map[string]interface{}) {
+			merged[key] = value
+		}
+	}
+	if len(merged) == 0 {
+		return last
+	}
+	return merged
+}
+
+func (g *generator) object(schema *spec.Schema, depth int) interface{} {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	object := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		if !required[name] && depth >= maxDepth/2 {
+			// keeps recursive schemas small
+			continue
+		}
+		property := schema.Properties[name]
+		if value := g.schema(&property, depth+1); value != nil || required[name] {
+			object[name] = value
+		}
+	}
+	return object
+}
+
+func (g *generator) array(schema *spec.Schema, depth int) interface{} {
+	size := 1
+	if schema.MinItems != nil && int(*schema.MinItems) > size {
+		size = int(*schema.MinItems)
+	}
+	if schema.MaxItems != nil && int(*schema.MaxItems) < size {
+		size = int(*schema.MaxItems)
+	}
+
+	items := make([]interface{}, 0, size)
+	for i := 0; i < size; i++ {
+		var item *spec.Schema
+		if schema.Items != nil {
+			item = schema.Items.Schema
+			if item == nil && len(schema.Items.Schemas) > 0 {
+				item = &schema.Items.Schemas[i%len(schema.Items.Schemas)]
+			}
+		}
+		items = append(items, g.schema(item, depth+1))
+	}
+	return items
+}
+
+func (g *generator) string(schema *spec.Schema) interface{} {
+	epoch := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	switch schema.Format {
+	case "date-time":
+		return epoch.Add(time.Duration(g.rnd.Int63n(int64(365 * 24 * time.Hour)))).Truncate(time.Second).Format(time.RFC3339)
+	case "date":
+		return epoch.AddDate(0, 0, g.rnd.Intn(365)).Format("2006-01-02")
+	case "uuid", "uuid4":
+		b := make([]byte, 16)
+		_, _ = g.rnd.Read(b)
+		b[6] = (b[6] & 0x0f) | 0x40
+		b[8] = (b[8] & 0x3f) | 0x80
+		return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+	case "email":
+		return fmt.Sprintf("%s%d@example.com", g.word(), g.rnd.Intn(100))
+	case "uri", "url":
+		return fmt.Sprintf("https://example.com/%s/%d", g.word(), g.rnd.Intn(1000))
+	case "hostname":
+		return g.word() + ".example.com"
+	case "ipv4":
+		return fmt.Sprintf("192.0.2.%d", g.rnd.Intn(254)+1)
+	case "ipv6":
+		return fmt.Sprintf("2001:db8::%x", g.rnd.Intn(0xffff)+1)
+	case "byte":
+		return base64.StdEncoding.EncodeToString([]byte(g.word()))
+	case "password":
+		return "********"
+	case "duration":
+		return fmt.Sprintf("%ds", g.rnd.Intn(3600))
+	}
+
+	value := g.word()
+	for schema.MinLength != nil && int64(len(value)) < *schema.MinLength {
+		value += " " + g.word()
+	}
+	if schema.MaxLength != nil && int64(len(value)) > *schema.MaxLength {
+		value = value[:*schema.MaxLength]
+	}
+	return value
+}
+
+func (g *generator) word() string {
+	return words[g.rnd.Intn(len(words))]
+}
+
+// number generates a number within the bounds of a schema
+func (g *generator) number(schema *spec.Schema, integer bool) float64 {
+	low, high := 1.0, 1000.0
+	if schema.Minimum != nil {
+		low = *schema.Minimum
+		if schema.Maximum == nil {
+			high = low + 1000
+		}
+	}
+	if schema.Maximum != nil {
+		high = *schema.Maximum
+		if schema.Minimum == nil && high < low {
+			low = high - 1000
+		}
+	}
+	if integer {
+		low, high = math.Ceil(low), math.Floor(high)
+		if schema.ExclusiveMinimum && schema.Minimum != nil && low == *schema.Minimum {
+			low++
+		}
+		if schema.ExclusiveMaximum && schema.Maximum != nil && high == *schema.Maximum {
+			high--
+		}
+	}
+	if high < low {
+		return low
+	}
+
+	value := low + g.rnd.Float64()*(high-low)
+	if schema.MultipleOf != nil && *schema.MultipleOf > 0 {
+		value = math.Ceil(low / *schema.MultipleOf) * *schema.MultipleOf
+	}
+	if integer {
+		return math.Floor(value)
+	}
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,156 @@
+// Package sarif provides a minimal model of the Static Analysis Results Interchange Format (SARIF) 2.1.0,
+// used by the swagger commands reporting issues found in a spec.
+//
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const (
+	// Version of the SARIF format
+	Version = "2.1.0"
+	// Schema of the SARIF format
+	Schema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	toolName           = "go-swagger"
+	toolInformationURI = "https://goswagger.io"
+)
+
+// Levels of a result
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+	LevelNone    = "none"
+)
+
+// Log is the root object of a SARIF document
+type Log struct {
+	Version string `json:"version"`
+	Schema  string `json:"$schema"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run describes a single run of an analysis tool
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+// Tool describes the analysis tool
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver describes the component of the tool which ran the analysis
+type Driver struct {
+	Name           string                `json:"name"`
+	Version        string                `json:"version,omitempty"`
+	InformationURI string                `json:"informationUri,omitempty"`
+	Rules          []ReportingDescriptor `json:"rules,omitempty"`
+}
+
+// ReportingDescriptor describes a rule
+type ReportingDescriptor struct {
+	ID                   string         `json:"id"`
+	ShortDescription     *Message       `json:"shortDescription,omitempty"`
+	DefaultConfiguration *Configuration `json:"defaultConfiguration,omitempty"`
+}
+
+// Configuration is the default configuration of a rule
+type Configuration struct {
+	Level string `json:"level,omitempty"`
+}
+
+// Result is an issue reported by the tool
+type Result struct {
+	RuleID    string     `json:"ruleId,omitempty"`
+	Level     string     `json:"level,omitempty"`
+	Message   Message    `json:"message"`
+	Locations []Location `json:"locations,omitempty"`
+}
+
+// Message is a plain text message
+type Message struct {
+	Text string `json:"text"`
+}
+
+// Location of a result
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []LogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// PhysicalLocation locates a result in a file
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           *Region          `json:"region,omitempty"`
+}
+
+// ArtifactLocation is the uri of a file
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// Region is a position in a file
+type Region struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// LogicalLocation locates a result in the spec document, with a JSON pointer
+type LogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// New builds a SARIF log for a single run of go-swagger
+func New(version string, rules []ReportingDescriptor, results []Result) *Log {
+	if results == nil {
+		// a run without results must report an empty array
+		results = []Result{}
+	}
+	return &Log{
+		Version: Version,
+		Schema:  Schema,
+		Runs: []Run{
+			{
+				Tool: Tool{
+					Driver: Driver{
+						Name:           toolName,
+						Version:        version,
+						InformationURI: toolInformationURI,
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// NewLocation builds the location of a result in a spec document
+func NewLocation(uri, pointer string, line, column int) Location {
+	loc := Location{
+		PhysicalLocation: &PhysicalLocation{
+			ArtifactLocation: ArtifactLocation{URI: uri},
+		},
+	}
+	if line > 0 {
+		loc.PhysicalLocation.Region = &Region{StartLine: line, StartColumn: column}
+	}
+	if pointer != "" {
+		loc.LogicalLocations = []LogicalLocation{{FullyQualifiedName: pointer, Kind: "member"}}
+	}
+	return loc
+}
+
+// Write writes a SARIF log as indented JSON
+func (l *Log) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(l)
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,25 +11,40 @@ import (
 	"net/url"
 	"path"
 	"strconv"
+	"sync"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/live"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/mock"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/ui"
 	"github.com/gorilla/handlers"
+	flags "github.com/jessevdk/go-flags"
 	"github.com/toqueteos/webbrowser"
 )
 
+// liveReloadPath is the path of the live reload events, relative to the base path
+const liveReloadPath = "swagger.events"
+
 // ServeCmd to serve a swagger spec with docs ui
 type ServeCmd struct {
-	BasePath string `long:"base-path" description:"the base path to serve the spec and UI at"`
-	Flavor   string `short:"F" long:"flavor" description:"the flavor of docs, can be swagger or redoc" default:"redoc" choice:"redoc" choice:"swagger"`
-	DocURL   string `long:"doc-url" description:"override the url which takes a url query param to render the doc ui"`
-	NoOpen   bool   `long:"no-open" description:"when present won't open the the browser to show the url"`
-	NoUI     bool   `long:"no-ui" description:"when present, only the swagger spec will be served"`
-	Flatten  bool   `long:"flatten" description:"when present, flatten the swagger spec before serving it"`
-	Port     int    `long:"port" short:"p" description:"the port to serve this site" env:"PORT"`
-	Host     string `long:"host" description:"the interface to serve this site, defaults to 0.0.0.0" env:"HOST"`
+	BasePath string         `long:"base-path" description:"the base path to serve the spec and UI at"`
+	Flavor   string         `short:"F" long:"flavor" description:"the flavor of docs, can be swagger or redoc" default:"redoc" choice:"redoc" choice:"swagger"`
+	DocURL   string         `long:"doc-url" description:"override the url which takes a url query param to render the doc ui"`
+	UIDir    flags.Filename `long:"ui-dir" description:"serve the doc ui from this directory instead of the ui embedded in the binary. Its index.html is rendered as a template with {{ .SpecURL }} and {{ .Title }}"`
+	NoOpen   bool           `long:"no-open" description:"when present won't open the the browser to show the url"`
+	NoUI     bool           `long:"no-ui" description:"when present, only the swagger spec will be served"`
+	Flatten  bool           `long:"flatten" description:"when present, flatten the swagger spec before serving it"`
+	Watch    bool           `long:"watch" description:"when present, reload the swagger spec when it or a document it references through $ref changes, and refresh the doc ui"`
+	Validate bool           `long:"validate" description:"when present, validate the swagger spec each time it is loaded. Validation errors are shown in the doc ui when watching"`
+	Mock     bool           `long:"mock" description:"when present, serve a mock of the API which validates requests and answers with examples or payloads generated from the response schemas"`
+	MockSeed int64          `long:"mock-seed" description:"when not 0, the seed of the mock payloads, which are then the same for the same requests"`
+	Port     int            `long:"port" short:"p" description:"the port to serve this site" env:"PORT"`
+	Host     string         `long:"host" description:"the interface to serve this site, defaults to 0.0.0.0" env:"HOST"`
 }
 
 // Execute the serve command
@@ -37,27 +53,36 @@ func (s *ServeCmd) Execute(args []string) error {
 		return errors.New("specify the spec to serve as argument to the serve command")
 	}
 
-	specDoc, err := loads.Spec(args[0])
+	specDoc, b, problems, err := s.loadSpec(args[0])
 	if err != nil {
 		return err
 	}
 
-	if s.Flatten {
-		var err error
-		specDoc, err = specDoc.Expanded(&spec.ExpandOptions{
-			SkipSchemas:         false,
-			ContinueOnError:     true,
-			AbsoluteCircularRef: true,
-		})
+	var reloader *live.Reloader
+	if s.Watch {
+		reloader, err = live.New(args[0], func() ([]byte, []string, error) {
+			snapshot, err := live.NewSnapshot(args[0])
+			if err != nil {
+				return nil, nil, err
+			}
+			defer snapshot.Remove()
 
+			_, b, problems, err := s.loadSpec(snapshot.Root)
+			if err != nil {
+				return nil, nil, errors.New(snapshot.Original(err.Error()))
+			}
+			for i, problem := range problems {
+				problems[i] = snapshot.Original(problem)
+			}
+			return b, problems, nil
+		})
 		if err != nil {
 			return err
 		}
+		problems = reloader.Problems()
 	}
-
-	b, err := json.MarshalIndent(specDoc.Spec(), "", "  ")
-	if err != nil {
-		return err
+	for _, problem := range problems {
+		log.Printf("- %s", problem)
 	}
 
 	basePath := s.BasePath
@@ -79,18 +104,14 @@ func (s *ServeCmd) Execute(args []string) error {
 
 	visit := s.DocURL
 	handler := http.NotFoundHandler()
+	if s.Mock {
+		if handler, err = s.mockHandler(specDoc, reloader); err != nil {
+			return err
+		}
+		log.Println("serving a mock of the API at", fmt.Sprintf("http://%s:%d%s", sh, sp, specDoc.BasePath()))
+	}
 	if !s.NoUI {
-		if s.Flavor == "redoc" {
-			handler = middleware.Redoc(middleware.RedocOpts{
-				BasePath: basePath,
-				SpecURL:  path.Join(basePath, "swagger.json"),
-				Path:     "docs",
-			}, handler)
-			visit = fmt.Sprintf("http://%s:%d%s", sh, sp, path.Join(basePath, "docs"))
-		} else if visit != "" || s.Flavor == "swagger" {
-			if visit == "" {
-				visit = "http://petstore.swagger.io/"
-			}
+		if visit != "" {
 			u, err := url.Parse(visit)
 			if err != nil {
 				return err
@@ -99,10 +120,27 @@ func (s *ServeCmd) Execute(args []string) error {
 			q.Add("url", fmt.Sprintf("http://%s:%d%s", sh, sp, path.Join(basePath, "swagger.json")))
 			u.RawQuery = q.Encode()
 			visit = u.String()
+		} else {
+			var docsPath string
+			handler, docsPath, err = s.docsHandler(basePath, specDoc, reloader != nil, handler)
+			if err != nil {
+				return err
+			}
+			visit = fmt.Sprintf("http://%s:%d%s", sh, sp, docsPath)
 		}
 	}
 
-	handler = handlers.CORS()(middleware.Spec(basePath, b, handler))
+	if reloader != nil {
+		handler = liveHandler(basePath, reloader, handler)
+		go func() {
+			if err := reloader.Watch(nil); err != nil {
+				log.Printf("cannot watch the spec: %v", err)
+			}
+		}()
+	} else {
+		handler = middleware.Spec(basePath, b, handler)
+	}
+	handler = handlers.CORS()(handler)
 	errFuture := make(chan error)
 	go func() {
 		docServer := new(http.Server)
@@ -121,3 +159,118 @@ func (s *ServeCmd) Execute(args []string) error {
 	log.Println("serving docs at", visit)
 	return <-errFuture
 }
+
+// loadSpec loads the spec to serve as JSON, optionally flattened, with the errors found when validating it
+func (s *ServeCmd) loadSpec(specPath string) (*loads.Document, []byte, []string, error) {
+	specDoc, err := loads.Spec(specPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var problems []string
+	if s.Validate {
+		validate.SetContinueOnErrors(true)
+		result, _ := validate.NewSpecValidator(specDoc.Schema(), strfmt.Default).Validate(specDoc)
+		for _, desc := range result.Errors {
+			problems = append(problems, desc.Error())
+		}
+	}
+
+	if s.Flatten {
+		specDoc, err = specDoc.Expanded(&spec.ExpandOptions{
+			RelativeBase:        specDoc.SpecFilePath(),
+			SkipSchemas:         false,
+			ContinueOnError:     true,
+			AbsoluteCircularRef: true,
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	b, err := json.MarshalIndent(specDoc.Spec(), "", "  ")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return specDoc, b, problems, nil
+}
+
+// mockHandler serves a mock of the API, which is rebuilt when the spec is reloaded
+func (s *ServeCmd) mockHandler(specDoc *loads.Document, reloader *live.Reloader) (http.Handler, error) {
+	opts := mock.Opts{Seed: s.MockSeed}
+	handler, err := mock.New(specDoc, opts)
+	if err != nil || reloader == nil {
+		return handler, err
+	}
+
+	var (
+		mu    sync.Mutex
+		built = reloader.Spec()
+	)
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if latest := reloader.Spec(); !bytes.Equal(latest, built) {
+			built = latest
+			doc, err := loads.Analyzed(latest, "")
+			if err == nil {
+				var reloaded http.Handler
+				if reloaded, err = mock.New(doc, opts); err == nil {
+					handler = reloaded
+				}
+			}
+			if err != nil {
+				log.Printf("cannot reload the mock of the API: %v", err)
+			}
+		}
+		current := handler
+		mu.Unlock()
+
+		current.ServeHTTP(rw, r)
+	}), nil
+}
+
+// liveHandler serves the latest version of the spec and the live reload events
+func liveHandler(basePath string, reloader *live.Reloader, next http.Handler) http.Handler {
+	specPath := path.Join(basePath, "swagger.json")
+	eventsPath := path.Join(basePath, liveReloadPath)
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case specPath:
+			rw.Header().Set("Content-Type", "application/json")
+			rw.WriteHeader(http.StatusOK)
+			_, _ = rw.Write(reloader.Spec())
+		case eventsPath:
+			reloader.ServeHTTP(rw, r)
+		default:
+			next.ServeHTTP(rw, r)
+		}
+	})
+}
+
+// docsHandler serves the doc ui from the embedded assets or from the ui directory, and returns the path it is served at
+func (s *ServeCmd) docsHandler(basePath string, specDoc *loads.Document, liveReload bool, next http.Handler) (http.Handler, string, error) {
+	opts := ui.Opts{
+		Flavor:   s.Flavor,
+		BasePath: basePath,
+		SpecURL:  path.Join(basePath, "swagger.json"),
+		Dir:      string(s.UIDir),
+	}
+	if liveReload {
+		opts.ReloadURL = path.Join(basePath, liveReloadPath)
+	}
+	if info := specDoc.Spec().Info; info != nil {
+		opts.Title = info.Title
+	}
+
+	if opts.Dir == "" {
+		if missing := ui.Missing(opts.Flavor); len(missing) > 0 {
+			return nil, "", fmt.Errorf("%v not embedded for the %s doc ui: run hack/vendor-ui.sh to vendor them, or use --ui-dir", missing, opts.Flavor)
+		}
+	}
+
+	handler, err := ui.Handler(opts, next)
+	if err != nil {
+		return nil, "", err
+	}
+	return handler, opts.URLPath(), nil
+}
@@ -0,0 +1,544 @@
+package sourcemap
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+type kind int
+
+const (
+	scalarNode kind = iota
+	mappingNode
+	sequenceNode
+)
+
+// node is a value in a YAML or JSON document, with its position in the source.
+//
+// The position of a value in a mapping is the position of its key.
+type node struct {
+	kind     kind
+	line     int
+	column   int
+	keys     []string
+	children []*node
+	value    string
+}
+
+func (n *node) child(token string) *node {
+	switch n.kind {
+	case mappingNode:
+		// like JSON decoders, the last duplicate key wins
+		for i := len(n.keys) - 1; i >= 0; i-- {
+			if n.keys[i] == token {
+				return n.children[i]
+			}
+		}
+	case sequenceNode:
+		if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(n.children) {
+			return n.children[i]
+		}
+	}
+	return nil
+}
+
+// parse builds a tree of the values in a YAML or JSON document.
+//
+// Parsing is lenient: it only retains the structure of the document and
+// the position of values, so that JSON pointers may be located in the source.
+func parse(data []byte) *node {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		p := newFlowParser(data, 0)
+		p.skipSpace()
+		return p.parseValue()
+	}
+
+	p := &blockParser{data: data, lines: splitLines(data), anchors: make(map[string]*node)}
+	if len(p.lines) == 0 {
+		return &node{kind: scalarNode, line: 1, column: 1}
+	}
+	return p.parseBlock(-1)
+}
+
+// flowParser parses JSON documents and YAML flow collections
+type flowParser struct {
+	data   []byte
+	pos    int
+	line   int
+	column int
+}
+
+func newFlowParser(data []byte, pos int) *flowParser {
+	line := bytes.Count(data[:pos], []byte("\n")) + 1
+	column := pos - bytes.LastIndexByte(data[:pos], '\n')
+	return &flowParser{data: data, pos: pos, line: line, column: column}
+}
+
+func (p *flowParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *flowParser) peek() byte {
+	return p.data[p.pos]
+}
+
+func (p *flowParser) advance() {
+	if p.data[p.pos] == '\n' {
+		p.line++
+		p.column = 1
+	} else {
+		p.column++
+	}
+	p.pos++
+}
+
+func (p *flowParser) skipSpace() {
+	for !p.eof() {
+		switch c := p.peek(); {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			p.advance()
+		case c == '#':
+			for !p.eof() && p.peek() != '\n' {
+				p.advance()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *flowParser) parseValue() *node {
+	if p.eof() {
+		return &node{kind: scalarNode, line: p.line, column: p.column}
+	}
+	switch p.peek() {
+	case '{':
+		return p.parseMapping()
+	case '[':
+		return p.parseSequence()
+	default:
+		n := &node{kind: scalarNode, line: p.line, column: p.column}
+		n.value = p.parseScalar(false)
+		return n
+	}
+}
+
+func (p *flowParser) parseMapping() *node {
+	n := &node{kind: mappingNode, line: p.line, column: p.column}
+	p.advance()
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return n
+		}
+		if p.peek() == '}' {
+			p.advance()
+			return n
+		}
+		if p.peek() == ',' {
+			p.advance()
+			continue
+		}
+
+		line, column := p.line, p.column
+		key := p.parseScalar(true)
+		p.skipSpace()
+		var value *node
+		if !p.eof() && p.peek() == ':' {
+			p.advance()
+			p.skipSpace()
+			value = p.parseValue()
+		} else {
+			value = &node{kind: scalarNode}
+		}
+		value.line, value.column = line, column
+		n.keys = append(n.keys, key)
+		n.children = append(n.children, value)
+	}
+}
+
+func (p *flowParser) parseSequence() *node {
+	n := &node{kind: sequenceNode, line: p.line, column: p.column}
+	p.advance()
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return n
+		}
+		if p.peek() == ']' {
+			p.advance()
+			return n
+		}
+		if p.peek() == ',' {
+			p.advance()
+			continue
+		}
+		n.children = append(n.children, p.parseValue())
+	}
+}
+
+// parseScalar reads a quoted or plain scalar. A plain key stops at the first colon.
+func (p *flowParser) parseScalar(isKey bool) string {
+	start := p.pos
+	switch p.peek() {
+	case '"':
+		p.advance()
+		for !p.eof() && p.peek() != '"' {
+			if p.peek() == '\\' {
+				p.advance()
+				if p.eof() {
+					break
+				}
+			}
+			p.advance()
+		}
+		if !p.eof() {
+			p.advance()
+		}
+		return unquote(string(p.data[start:p.pos]))
+	case '\'':
+		p.advance()
+		for !p.eof() {
+			if p.peek() == '\'' {
+				p.advance()
+				if p.eof() || p.peek() != '\'' {
+					break
+				}
+			}
+			p.advance()
+		}
+		return unquote(string(p.data[start:p.pos]))
+	}
+
+	for !p.eof() {
+		c := p.peek()
+		if c == ',' || c == ']' || c == '}' || c == '\n' || (isKey && c == ':') {
+			break
+		}
+		p.advance()
+	}
+	if p.pos == start {
+		// unexpected character: skip it to make progress
+		p.advance()
+	}
+	return strings.TrimSpace(string(p.data[start:p.pos]))
+}
+
+func unquote(s string) string {
+	switch {
+	case len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"':
+		var unquoted string
+		if err := json.Unmarshal([]byte(s), &unquoted); err == nil {
+			return unquoted
+		}
+		return s[1 : len(s)-1]
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return strings.Replace(s[1:len(s)-1], "''", "'", -1)
+	default:
+		return s
+	}
+}
+
+// sourceLine is a significant line of a YAML document, stripped from comments
+type sourceLine struct {
+	num    int
+	indent int
+	text   string
+	// offset of text in the document
+	offset int
+}
+
+func splitLines(data []byte) []sourceLine {
+	var lines []sourceLine
+	offset := 0
+	for num, raw := range strings.Split(string(data), "\n") {
+		start := offset
+		offset += len(raw) + 1
+
+		raw = strings.TrimRight(raw, "\r")
+		text := strings.TrimLeft(raw, " ")
+		indent := len(raw) - len(text)
+		text = strings.TrimRight(stripComment(text), " \t")
+		if text == "" || text == "---" || text == "..." || strings.HasPrefix(text, "%") {
+			continue
+		}
+		lines = append(lines, sourceLine{num: num + 1, indent: indent, text: text, offset: start + indent})
+	}
+	return lines
+}
+
+// stripComment removes a trailing comment, outside of quotes
+func stripComment(text string) string {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || text[i-1] == ' ' || text[i-1] == ':' || text[i-1] == '[' || text[i-1] == '{' || text[i-1] == ',' || text[i-1] == '-' {
+				quote = c
+			}
+		case c == '#':
+			if i == 0 || text[i-1] == ' ' || text[i-1] == '\t' {
+				return text[:i]
+			}
+		}
+	}
+	return text
+}
+
+// blockParser parses YAML documents in block style
+type blockParser struct {
+	data    []byte
+	lines   []sourceLine
+	i       int
+	anchors map[string]*node
+}
+
+func (p *blockParser) done() bool {
+	return p.i >= len(p.lines)
+}
+
+func (p *blockParser) current() sourceLine {
+	return p.lines[p.i]
+}
+
+// parseBlock parses the block starting at the current line, nested under the parent indentation
+func (p *blockParser) parseBlock(parent int) *node {
+	l := p.current()
+	if isSequenceItem(l.text) {
+		return p.parseSequence(l.indent)
+	}
+	if _, _, ok := splitKey(l.text); ok {
+		return p.parseMapping(l.indent)
+	}
+	p.i++
+	return p.parseValue(l.text, l.offset, parent, false)
+}
+
+func (p *blockParser) parseMapping(indent int) *node {
+	first := p.current()
+	n := &node{kind: mappingNode, line: first.num, column: first.indent + 1}
+	for !p.done() {
+		l := p.current()
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			// continuation of a multi-line scalar
+			p.i++
+			continue
+		}
+		key, rest, ok := splitKey(l.text)
+		if !ok {
+			break
+		}
+		p.i++
+		value := p.parseValue(l.text[rest:], l.offset+rest, indent, true)
+		value.line, value.column = l.num, l.indent+1
+		n.keys = append(n.keys, key)
+		n.children = append(n.children, value)
+	}
+	return n
+}
+
+func (p *blockParser) parseSequence(indent int) *node {
+	first := p.current()
+	n := &node{kind: sequenceNode, line: first.num, column: first.indent + 1}
+	for !p.done() {
+		l := p.current()
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			p.i++
+			continue
+		}
+		if !isSequenceItem(l.text) {
+			break
+		}
+
+		rest := strings.TrimLeft(l.text[1:], " ")
+		skipped := len(l.text) - len(rest)
+		var item *node
+		switch {
+		case rest == "":
+			p.i++
+			item = p.parseValue("", l.offset+skipped, indent, false)
+		case isSequenceItem(rest):
+			// compact nested sequence: read the rest of the line as a block at its own indentation
+			p.lines[p.i] = sourceLine{num: l.num, indent: l.indent + skipped, text: rest, offset: l.offset + skipped}
+			item = p.parseSequence(l.indent + skipped)
+		default:
+			if _, _, ok := splitKey(rest); ok {
+				// compact mapping: the following keys of this item are aligned with the first one
+				p.lines[p.i] = sourceLine{num: l.num, indent: l.indent + skipped, text: rest, offset: l.offset + skipped}
+				item = p.parseMapping(l.indent + skipped)
+			} else {
+				p.i++
+				item = p.parseValue(rest, l.offset+skipped, indent, false)
+			}
+		}
+		item.line, item.column = l.num, l.indent+skipped+1
+		n.children = append(n.children, item)
+	}
+	return n
+}
+
+// parseValue parses the value following a key or a sequence indicator, once the current line has been consumed
+func (p *blockParser) parseValue(text string, offset, indent int, inMapping bool) *node {
+	anchor, text := stripProperties(text)
+	n := p.parseUnanchored(text, offset, indent, inMapping)
+	if anchor != "" {
+		p.anchors[anchor] = n
+	}
+	return n
+}
+
+func (p *blockParser) parseUnanchored(text string, offset, indent int, inMapping bool) *node {
+	switch {
+	case text == "":
+		if !p.done() {
+			next := p.current()
+			if next.indent > indent {
+				return p.parseBlock(indent)
+			}
+			if next.indent == indent && inMapping && isSequenceItem(next.text) {
+				// YAML allows a sequence under a key at the same indentation
+				return p.parseSequence(indent)
+			}
+		}
+		return &node{kind: scalarNode}
+
+	case text[0] == '*':
+		if anchored, ok := p.anchors[text[1:]]; ok {
+			// the alias has its own position
+			alias := *anchored
+			return &alias
+		}
+		return &node{kind: scalarNode}
+
+	case text[0] == '{' || text[0] == '[':
+		fp := newFlowParser(p.data, offset+bytes.IndexByte(p.data[offset:], text[0]))
+		n := fp.parseValue()
+		for !p.done() && p.current().offset < fp.pos {
+			p.i++
+		}
+		return n
+
+	case text[0] == '|' || text[0] == '>':
+		p.skipNested(indent)
+		return &node{kind: scalarNode}
+
+	case (text[0] == '"' || text[0] == '\'') && closingQuote(text[1:], text[0]) < 0:
+		// multi-line quoted scalar: continuation lines may have any indentation
+		for !p.done() {
+			l := p.current()
+			p.i++
+			if closingQuote(l.text, text[0]) >= 0 {
+				break
+			}
+		}
+		return &node{kind: scalarNode, value: text[1:]}
+
+	default:
+		p.skipNested(indent)
+		return &node{kind: scalarNode, value: unquote(text)}
+	}
+}
+
+// closingQuote returns the index of the closing quote in text, or -1
+func closingQuote(text string, quote byte) int {
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && quote == '"':
+			i++
+		case text[i] == quote:
+			if quote == '\'' && i+1 < len(text) && text[i+1] == '\'' {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// skipNested skips the lines of a multi-line scalar
+func (p *blockParser) skipNested(indent int) {
+	for !p.done() && p.current().indent > indent {
+		p.i++
+	}
+}
+
+// stripProperties removes the anchor and the tag in front of a value, returning the anchor
+func stripProperties(text string) (string, string) {
+	var anchor string
+	text = strings.TrimSpace(text)
+	for strings.HasPrefix(text, "&") || strings.HasPrefix(text, "!") {
+		idx := strings.IndexAny(text, " \t")
+		if idx < 0 {
+			idx = len(text)
+		}
+		if text[0] == '&' {
+			anchor = text[1:idx]
+		}
+		text = strings.TrimSpace(text[idx:])
+	}
+	return anchor, text
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitKey splits a "key: value" line, returning the key and the index of the value
+func splitKey(text string) (string, int, bool) {
+	if text == "" || text[0] == '[' || text[0] == '{' || isSequenceItem(text) {
+		return "", 0, false
+	}
+
+	end := 0
+	if text[0] == '"' || text[0] == '\'' {
+		quote := text[0]
+		end = 1
+		for end < len(text) {
+			if text[end] == '\\' && quote == '"' {
+				end += 2
+				continue
+			}
+			if text[end] == quote {
+				if quote == '\'' && end+1 < len(text) && text[end+1] == '\'' {
+					end += 2
+					continue
+				}
+				break
+			}
+			end++
+		}
+		if end >= len(text) {
+			return "", 0, false
+		}
+		end++
+		rest := strings.TrimLeft(text[end:], " ")
+		if !strings.HasPrefix(rest, ":") || (len(rest) > 1 && rest[1] != ' ' && rest[1] != '\t') {
+			return "", 0, false
+		}
+		colon := len(text) - len(rest)
+		return unquote(text[:end]), colon + 1, true
+	}
+
+	for end < len(text) {
+		if text[end] == ':' && (end+1 == len(text) || text[end+1] == ' ' || text[end+1] == '\t') {
+			return strings.TrimSpace(text[:end]), end + 1, true
+		}
+		end++
+	}
+	return "", 0, false
+}
@@ -0,0 +1,114 @@
+// Package sourcemap locates JSON pointers in the YAML or JSON source of a spec.
+//
+// Pointers which traverse a $ref are followed into the referenced document, so that
+// the position of a construct is reported in the file where it is actually written.
+package sourcemap
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// maxRefHops limits how many $ref are followed when locating a pointer
+const maxRefHops = 32
+
+// Position of a construct in a source document. Line and column start at 1.
+type Position struct {
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// Locator locates JSON pointers in a spec document and the documents it references.
+type Locator struct {
+	root string
+	docs map[string]*node
+}
+
+// New creates a locator for the spec document at path
+func New(path string) *Locator {
+	return &Locator{root: path, docs: make(map[string]*node)}
+}
+
+// Locate returns the position of the construct at a JSON pointer (e.g. #/definitions/Pet) in the root document.
+//
+// When the pointer cannot be resolved entirely, the position of the deepest construct found is returned.
+func (l *Locator) Locate(pointer string) Position {
+	return l.walk(l.root, nil, tokens(pointer), 0)
+}
+
+func (l *Locator) load(file string) *node {
+	if doc, ok := l.docs[file]; ok {
+		return doc
+	}
+	var doc *node
+	if !isRemote(file) {
+		if data, err := ioutil.ReadFile(file); err == nil {
+			doc = parse(data)
+		}
+	}
+	l.docs[file] = doc
+	return doc
+}
+
+// walk resolves tokens from a node in a document (from the document root when n is nil)
+func (l *Locator) walk(file string, n *node, tokens []string, hops int) Position {
+	if n == nil {
+		if n = l.load(file); n == nil {
+			return Position{File: file}
+		}
+	}
+	pos := Position{File: file, Line: n.line, Column: n.column}
+
+	for len(tokens) > 0 {
+		if child := n.child(tokens[0]); child != nil {
+			n = child
+			pos.Line, pos.Column = n.line, n.column
+			tokens = tokens[1:]
+			continue
+		}
+
+		ref := n.child("$ref")
+		if ref == nil || ref.kind != scalarNode || hops >= maxRefHops {
+			break
+		}
+		refFile, fragment := splitRef(ref.value)
+		target := file
+		if refFile != "" {
+			if isRemote(refFile) {
+				break
+			}
+			target = filepath.Join(filepath.Dir(file), filepath.FromSlash(refFile))
+		}
+		return l.walk(target, nil, append(splitPointer(fragment), tokens...), hops+1)
+	}
+	return pos
+}
+
+func splitRef(ref string) (string, string) {
+	if idx := strings.Index(ref, "#"); idx >= 0 {
+		return ref[:idx], ref[idx+1:]
+	}
+	return ref, ""
+}
+
+func isRemote(path string) bool {
+	return strings.Contains(path, "://")
+}
+
+// tokens splits a JSON pointer, with or without a leading #, in unescaped reference tokens
+func tokens(pointer string) []string {
+	return splitPointer(strings.TrimPrefix(pointer, "#"))
+}
+
+func splitPointer(pointer string) []string {
+	if pointer == "" || pointer == "/" {
+		return nil
+	}
+	parts := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, part := range parts {
+		parts[i] = strings.Replace(strings.Replace(part, "~1", "/", -1), "~0", "~", -1)
+	}
+	return parts
+}
@@ -0,0 +1,58 @@
+package sourcemap
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fixtureBase = filepath.FromSlash("../../../../fixtures/sourcemap")
+
+func TestLocate_YAML(t *testing.T) {
+	spec := filepath.Join(fixtureBase, "spec.yaml")
+	remote := filepath.Join(fixtureBase, "remote.yaml")
+	l := New(spec)
+
+	for _, toPin := range []struct {
+		pointer string
+		pos     Position
+	}{
+		{"", Position{File: spec, Line: 2, Column: 1}},
+		{"#/swagger", Position{File: spec, Line: 2, Column: 1}},
+		{"#/info/title", Position{File: spec, Line: 4, Column: 3}},
+		{"#/info/version", Position{File: spec, Line: 8, Column: 3}},
+		{"#/paths/~1pets~1{id}/get", Position{File: spec, Line: 11, Column: 5}},
+		{"#/paths/~1pets~1{id}/get/tags/1", Position{File: spec, Line: 12, Column: 20}},
+		{"#/paths/~1pets~1{id}/get/parameters/0/in", Position{File: spec, Line: 15, Column: 9}},
+		{"#/paths/~1pets~1{id}/get/parameters/1", Position{File: spec, Line: 18, Column: 9}},
+		{"#/paths/~1pets~1{id}/get/parameters/1/type", Position{File: spec, Line: 30, Column: 5}},
+		{"#/paths/~1pets~1{id}/get/responses/200/schema", Position{File: spec, Line: 23, Column: 11}},
+		{"#/paths/~1pets~1{id}/get/responses/200/schema/properties/name/type", Position{File: remote, Line: 6, Column: 9}},
+		{"#/paths/~1pets~1{id}/get/responses/404/schema", Position{File: spec, Line: 25, Column: 41}},
+		{"#/paths/~1pets~1{id}/get/responses/404/schema/required/0", Position{File: spec, Line: 35, Column: 9}},
+		{"#/definitions/Error/properties/code/type", Position{File: spec, Line: 38, Column: 9}},
+		{"#/definitions/Error/properties/nested/items/0/0/type", Position{File: spec, Line: 42, Column: 15}},
+		// unresolved: deepest construct found
+		{"#/definitions/Error/properties/missing", Position{File: spec, Line: 36, Column: 5}},
+	} {
+		assert.Equal(t, toPin.pos, l.Locate(toPin.pointer), toPin.pointer)
+	}
+}
+
+func TestLocate_JSON(t *testing.T) {
+	spec := filepath.Join(fixtureBase, "spec.json")
+	l := New(spec)
+
+	assert.Equal(t, Position{File: spec, Line: 3, Column: 3}, l.Locate("#/info"))
+	assert.Equal(t, Position{File: spec, Line: 3, Column: 34}, l.Locate("#/info/version"))
+	assert.Equal(t, Position{File: spec, Line: 9, Column: 11}, l.Locate("#/paths/~1pets/get/parameters/1"))
+	assert.Equal(t, Position{File: spec, Line: 11, Column: 31}, l.Locate("/paths/~1pets/get/responses/200/description"))
+	assert.Equal(t, Position{File: filepath.Join(fixtureBase, "remote.yaml"), Line: 5, Column: 7},
+		l.Locate("#/paths/~1pets/get/responses/200/schema/properties/name"))
+}
+
+func TestLocate_Missing(t *testing.T) {
+	l := New(filepath.Join(fixtureBase, "nowhere.yaml"))
+	assert.Equal(t, Position{File: filepath.Join(fixtureBase, "nowhere.yaml")}, l.Locate("#/definitions"))
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/split"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// SplitSpec is a command that splits a swagger document into a layout of files,
+// where each definition, shared parameter, shared response and path item is written to its own file.
+type SplitSpec struct {
+	Output flags.Filename `long:"output" short:"o" description:"the directory to write the files to" required:"true"`
+	Layout string         `long:"layout" description:"the layout of the files in the directory" default:"by-name" choice:"by-name" choice:"by-tag"`
+	Format string         `long:"format" description:"the format of the files" default:"yaml" choice:"yaml" choice:"json"`
+	Root   string         `long:"root" description:"the name of the root document, without extension" default:"swagger"`
+}
+
+// Execute splits the spec
+func (c *SplitSpec) Execute(args []string) error {
+	if len(args) != 1 {
+		return errors.New("split command requires the single swagger document url to be specified")
+	}
+
+	swaggerDoc := args[0]
+	opts := split.Opts{Layout: c.Layout, Format: c.Format, Root: c.Root}
+	dir := string(c.Output)
+	root, err := filepath.Abs(filepath.Join(dir, opts.RootFile()))
+	if err != nil {
+		return err
+	}
+	if source, ers := filepath.Abs(swaggerDoc); ers == nil && source == root {
+		return fmt.Errorf("cannot split %s onto itself: choose another output directory or root document", swaggerDoc)
+	}
+
+	specDoc, err := loads.Spec(swaggerDoc)
+	if err != nil {
+		return err
+	}
+
+	files, err := split.Split(specDoc, dir, opts)
+	if err != nil {
+		return err
+	}
+	if err := split.Write(dir, files); err != nil {
+		return err
+	}
+	log.Printf("split %s into %d files, with the root document %s", swaggerDoc, len(files), root)
+	return nil
+}
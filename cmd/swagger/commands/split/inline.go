@@ -0,0 +1,244 @@
+package split
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// maxRefs limits the chains of $ref's to other $ref's
+const maxRefs = 32
+
+// Inline replaces the path items, parameters and responses which a spec references in other local files by
+// their content, like the files of a split spec. So are the definitions which reference a whole file.
+//
+// The $ref's of the inlined content are rebased on the root document: the schemas they reference are
+// resolved from the files they were written in when the spec is flattened, and the $ref's to the file of
+// a definition point to the definition, so that it keeps its name.
+func Inline(sp *spec.Swagger, specPath string) error {
+	if u, err := url.Parse(specPath); err == nil && u.Scheme != "" && len(u.Scheme) > 1 {
+		// remote specs are left to the expansion of the spec
+		return nil
+	}
+	rootFile, err := filepath.Abs(specPath)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(sp)
+	if err != nil {
+		return err
+	}
+	document, err := swag.BytesToYAMLDoc(b)
+	if err != nil {
+		return err
+	}
+	root, ok := document.(yaml.MapSlice)
+	if !ok {
+		return nil
+	}
+
+	in := &inliner{root: rootFile, docs: make(map[string]interface{}), definitions: make(map[string]string)}
+	definitions, _ := get(root, "definitions")
+	for _, item := range asObject(definitions) {
+		ref, _ := refOf(item.Value)
+		if target, fragment, remote := in.target(ref, rootFile); remote && fragment == "" {
+			in.definitions[target] = fmt.Sprint(item.Key)
+		}
+	}
+
+	for i := range root {
+		section := asObject(root[i].Value)
+		for j := range section {
+			var erp error
+			switch fmt.Sprint(root[i].Key) {
+			case "paths":
+				if strings.HasPrefix(fmt.Sprint(section[j].Key), "x-") {
+					continue
+				}
+				section[j].Value, erp = in.pathItem(section[j].Value)
+			case "parameters", "responses":
+				section[j].Value, erp = in.object(section[j].Value, rootFile)
+			case "definitions":
+				section[j].Value, erp = in.definition(section[j].Value)
+			}
+			if erp != nil {
+				return erp
+			}
+		}
+	}
+	if !in.inlined {
+		return nil
+	}
+
+	b, err = swag.YAMLToJSON(root)
+	if err != nil {
+		return err
+	}
+	var inlined spec.Swagger
+	if err := json.Unmarshal(b, &inlined); err != nil {
+		return err
+	}
+	*sp = inlined
+	return nil
+}
+
+func asObject(node interface{}) yaml.MapSlice {
+	object, _ := node.(yaml.MapSlice)
+	return object
+}
+
+type inliner struct {
+	root string
+	docs map[string]interface{}
+	// definitions are the names of the definitions of the root document by the file they reference
+	definitions map[string]string
+	inlined     bool
+}
+
+// target returns the file and fragment a $ref in a file points to, when it is in another local document
+func (in *inliner) target(ref, file string) (string, string, bool) {
+	if ref == "" {
+		return "", "", false
+	}
+	u, err := url.Parse(ref)
+	if err != nil || u.Scheme != "" || u.Host != "" || (u.Path == "" && file == in.root) {
+		return "", "", false
+	}
+	target := file
+	if u.Path != "" {
+		target = filepath.FromSlash(u.Path)
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(file), target)
+		}
+	}
+	return target, u.Fragment, true
+}
+
+// definition returns the schema of a definition in another file
+func (in *inliner) definition(node interface{}) (interface{}, error) {
+	ref, _ := refOf(node)
+	target, fragment, remote := in.target(ref, in.root)
+	if _, isFile := in.definitions[target]; !remote || fragment != "" || !isFile {
+		return rewriteRefs(node, in.rebase(in.root)), nil
+	}
+	schema, err := in.resolve(target, "")
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve $ref %q in %s: %v", ref, in.root, err)
+	}
+	in.inlined = true
+	return rewriteRefs(schema, in.rebase(target)), nil
+}
+
+// pathItem inlines a path item, and the parameters and responses of its operations
+func (in *inliner) pathItem(node interface{}) (interface{}, error) {
+	node, err := in.object(node, in.root)
+	if err != nil {
+		return nil, err
+	}
+
+	inlineAll := func(list interface{}) error {
+		items, _ := list.([]interface{})
+		for i := range items {
+			if items[i], err = in.object(items[i], in.root); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	parameters, _ := get(node, "parameters")
+	if err := inlineAll(parameters); err != nil {
+		return nil, err
+	}
+	for _, method := range methods {
+		operation, ok := get(node, method)
+		if !ok {
+			continue
+		}
+		parameters, _ := get(operation, "parameters")
+		if err := inlineAll(parameters); err != nil {
+			return nil, err
+		}
+		responses, _ := get(operation, "responses")
+		codes := asObject(responses)
+		for i := range codes {
+			if strings.HasPrefix(fmt.Sprint(codes[i].Key), "x-") {
+				continue
+			}
+			if codes[i].Value, err = in.object(codes[i].Value, in.root); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return node, nil
+}
+
+// object returns the content of an object when it references another file, with $ref's rebased on the root document
+func (in *inliner) object(node interface{}, file string) (interface{}, error) {
+	seen := make(map[string]bool)
+	for i := 0; i < maxRefs; i++ {
+		ref, _ := refOf(node)
+		target, fragment, remote := in.target(ref, file)
+		if !remote {
+			break
+		}
+		if seen[target+"#"+fragment] {
+			return nil, fmt.Errorf("circular $ref %q in %s", ref, file)
+		}
+		seen[target+"#"+fragment] = true
+
+		var err error
+		if node, err = in.resolve(target, fragment); err != nil {
+			return nil, fmt.Errorf("cannot resolve $ref %q in %s: %v", ref, file, err)
+		}
+		file = target
+		in.inlined = true
+	}
+	return rewriteRefs(node, in.rebase(file)), nil
+}
+
+func (in *inliner) resolve(file, fragment string) (interface{}, error) {
+	doc, ok := in.docs[file]
+	if !ok {
+		b, err := swag.LoadFromFileOrHTTP(file)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = swag.BytesToYAMLDoc(b); err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %v", file, err)
+		}
+		in.docs[file] = doc
+	}
+	return lookup(doc, fragment)
+}
+
+// rebase returns the function which rewrites the $ref's of a file into references from the root document
+func (in *inliner) rebase(file string) func(string) string {
+	return func(ref string) string {
+		target, fragment, remote := in.target(ref, file)
+		if !remote {
+			return ref
+		}
+		if name, ok := in.definitions[target]; ok {
+			return "#" + pointer([]string{"definitions", name}) + fragment
+		}
+		if target == in.root {
+			return "#" + fragment
+		}
+		rel, err := filepath.Rel(filepath.Dir(in.root), target)
+		if err != nil {
+			return ref
+		}
+		rebased := filepath.ToSlash(rel)
+		if fragment != "" {
+			rebased += "#" + fragment
+		}
+		return rebased
+	}
+}
@@ -0,0 +1,157 @@
+package split
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const refKey = "$ref"
+
+// nameMaps are the keys of the objects which map names to specs: their keys are not keywords of the spec
+var nameMaps = map[string]bool{
+	"definitions":         true,
+	"parameters":          true,
+	"responses":           true,
+	"paths":               true,
+	"properties":          true,
+	"patternProperties":   true,
+	"headers":             true,
+	"securityDefinitions": true,
+}
+
+// literals are the keys of the values which are not specs: a $ref in such a value is not a reference
+var literals = map[string]bool{
+	"example":  true,
+	"examples": true,
+	"default":  true,
+	"enum":     true,
+}
+
+// rewriteRefs returns a copy of a document, where each $ref is rewritten by a function
+func rewriteRefs(node interface{}, rewrite func(string) string) interface{} {
+	return rewriteNode(node, false, rewrite)
+}
+
+func rewriteNode(node interface{}, names bool, rewrite func(string) string) interface{} {
+	switch value := node.(type) {
+	case yaml.MapSlice:
+		rewritten := make(yaml.MapSlice, 0, len(value))
+		for _, item := range value {
+			key := fmt.Sprint(item.Key)
+			switch {
+			case names:
+				item.Value = rewriteNode(item.Value, false, rewrite)
+			case key == refKey:
+				if ref, ok := item.Value.(string); ok {
+					item.Value = rewrite(ref)
+				}
+			case literals[key] || strings.HasPrefix(key, "x-"):
+			default:
+				item.Value = rewriteNode(item.Value, nameMaps[key], rewrite)
+			}
+			rewritten = append(rewritten, item)
+		}
+		return rewritten
+	case []interface{}:
+		rewritten := make([]interface{}, len(value))
+		for i, item := range value {
+			rewritten[i] = rewriteNode(item, false, rewrite)
+		}
+		return rewritten
+	default:
+		return node
+	}
+}
+
+// refOf returns the $ref of an object which only is a reference
+func refOf(node interface{}) (string, bool) {
+	object, ok := node.(yaml.MapSlice)
+	if !ok {
+		return "", false
+	}
+	for _, item := range object {
+		if key, ok := item.Key.(string); ok && key == refKey {
+			ref, isString := item.Value.(string)
+			return ref, isString
+		}
+	}
+	return "", false
+}
+
+// get returns the value of a key in an object
+func get(node interface{}, key string) (interface{}, bool) {
+	object, ok := node.(yaml.MapSlice)
+	if !ok {
+		return nil, false
+	}
+	for _, item := range object {
+		if fmt.Sprint(item.Key) == key {
+			return item.Value, true
+		}
+	}
+	return nil, false
+}
+
+// set replaces the value of a key in an object
+func set(node interface{}, key string, value interface{}) {
+	object, ok := node.(yaml.MapSlice)
+	if !ok {
+		return
+	}
+	for i := range object {
+		if fmt.Sprint(object[i].Key) == key {
+			object[i].Value = value
+			return
+		}
+	}
+}
+
+// tokens splits the fragment of a $ref into unescaped JSON pointer tokens
+func tokens(fragment string) []string {
+	fragment = strings.TrimPrefix(fragment, "/")
+	if fragment == "" {
+		return nil
+	}
+	parts := strings.Split(fragment, "/")
+	for i, part := range parts {
+		parts[i] = jsonpointer.Unescape(part)
+	}
+	return parts
+}
+
+// pointer joins JSON pointer tokens into a fragment
+func pointer(parts []string) string {
+	var b strings.Builder
+	for _, part := range parts {
+		b.WriteString("/")
+		b.WriteString(jsonpointer.Escape(part))
+	}
+	return b.String()
+}
+
+// lookup returns the value at a JSON pointer in a document
+func lookup(node interface{}, fragment string) (interface{}, error) {
+	for _, token := range tokens(fragment) {
+		switch value := node.(type) {
+		case yaml.MapSlice:
+			found, ok := get(value, token)
+			if !ok {
+				return nil, fmt.Errorf("no %q in the document at %q", token, fragment)
+			}
+			node = found
+		case []interface{}:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(value) {
+				return nil, fmt.Errorf("no item %q in the document at %q", token, fragment)
+			}
+			node = value[idx]
+		default:
+			return nil, fmt.Errorf("no %q in the document at %q", token, fragment)
+		}
+	}
+	return node, nil
+}
@@ -0,0 +1,459 @@
+// Package split explodes a spec into a layout of files which reference each other with $ref.
+//
+// Each definition, shared parameter, shared response and path item of the spec is written to its own
+// file. The root document references the files of the definitions and path items, and the operations
+// reference the files of their parameters and responses: swagger 2.0 does not allow $ref's in the shared
+// parameters and responses of the root document.
+package split
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Layouts of the split files
+const (
+	// LayoutByName writes the files of each kind of component in a directory, named after the components:
+	// definitions/{name}, parameters/{name}, responses/{name} and paths/{path}
+	LayoutByName = "by-name"
+	// LayoutByTag writes the path items of the operations of a tag, and the components only they use, under tags/{tag}.
+	// The other files are laid out by name.
+	LayoutByTag = "by-tag"
+)
+
+// Formats of the split files
+const (
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+)
+
+// kinds are the sections of the spec which components are written to their own files
+var kinds = []string{"paths", "definitions", "parameters", "responses"}
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// Opts configures the layout of the split spec
+type Opts struct {
+	// Layout of the files, by-name or by-tag
+	Layout string
+	// Format of the files, yaml or json
+	Format string
+	// Root is the name of the root document, without extension. Defaults to swagger.
+	Root string
+}
+
+func (o *Opts) ensureDefaults() {
+	if o.Layout == "" {
+		o.Layout = LayoutByName
+	}
+	if o.Format == "" {
+		o.Format = FormatYAML
+	}
+	if o.Root == "" {
+		o.Root = "swagger"
+	}
+}
+
+// RootFile returns the name of the root document of the split spec
+func (o Opts) RootFile() string {
+	o.ensureDefaults()
+	return o.Root + "." + o.Format
+}
+
+type key struct {
+	kind, name string
+}
+
+// component is a part of the spec written to its own file
+type component struct {
+	key
+	content interface{}
+	file    string
+	// files are the copies of the component, by the directory of the tag they are laid out for
+	files map[string]string
+	uses  []key
+	tags  map[string]bool
+}
+
+type splitter struct {
+	opts       Opts
+	source     string
+	target     string
+	root       yaml.MapSlice
+	rootFile   string
+	components map[key]*component
+	ordered    []*component
+}
+
+// Split explodes a spec into files, to be written in a directory.
+//
+// The files are returned by slash-separated path, relative to the directory.
+// The relative $ref's to other documents are rebased on the directory.
+func Split(doc *loads.Document, dir string, opts Opts) (map[string][]byte, error) {
+	opts.ensureDefaults()
+	if opts.Layout != LayoutByName && opts.Layout != LayoutByTag {
+		return nil, fmt.Errorf("unsupported layout %q: use %s or %s", opts.Layout, LayoutByName, LayoutByTag)
+	}
+	if opts.Format != FormatYAML && opts.Format != FormatJSON {
+		return nil, fmt.Errorf("unsupported format %q: use %s or %s", opts.Format, FormatYAML, FormatJSON)
+	}
+
+	b, err := json.Marshal(doc.Spec())
+	if err != nil {
+		return nil, err
+	}
+	document, err := swag.BytesToYAMLDoc(b)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := document.(yaml.MapSlice)
+	if !ok {
+		return nil, errors.New("the spec is not an object")
+	}
+	target, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &splitter{
+		opts:       opts,
+		source:     doc.SpecFilePath(),
+		target:     target,
+		root:       root,
+		rootFile:   opts.RootFile(),
+		components: make(map[key]*component),
+	}
+	s.collect()
+	s.layout()
+	return s.render()
+}
+
+// collect finds the components of the spec, and the components they use
+func (s *splitter) collect() {
+	for _, kind := range kinds {
+		section, _ := get(s.root, kind)
+		items, _ := section.(yaml.MapSlice)
+		for _, item := range items {
+			name := fmt.Sprint(item.Key)
+			if kind == "paths" && strings.HasPrefix(name, "x-") {
+				continue
+			}
+			if _, isRef := refOf(item.Value); isRef {
+				// already a reference: kept in the root document
+				continue
+			}
+			c := &component{key: key{kind: kind, name: name}, content: item.Value}
+			s.components[c.key] = c
+			s.ordered = append(s.ordered, c)
+		}
+	}
+
+	for _, c := range s.ordered {
+		seen := make(map[key]bool)
+		rewriteRefs(c.content, func(ref string) string {
+			if used, ok := s.local(ref); ok && !seen[used.key] {
+				seen[used.key] = true
+				c.uses = append(c.uses, used.key)
+			}
+			return ref
+		})
+	}
+}
+
+// local returns the component a local $ref points into
+func (s *splitter) local(ref string) (*component, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+	parts := tokens(ref[1:])
+	if len(parts) < 2 {
+		return nil, false
+	}
+	c, ok := s.components[key{kind: parts[0], name: parts[1]}]
+	return c, ok
+}
+
+// layout chooses the file of each component
+func (s *splitter) layout() {
+	if s.opts.Layout == LayoutByTag {
+		s.tag()
+	}
+
+	used := map[string]bool{strings.ToLower(s.rootFile): true}
+	for _, c := range s.ordered {
+		var tags []string
+		switch {
+		case len(c.tags) == 1, len(c.tags) > 1 && !referencedFromRoot(c.kind):
+			// the parameters and responses are only referenced from the operations, so each of their tags gets a copy
+			for tag := range c.tags {
+				tags = append(tags, tag)
+			}
+			sort.Strings(tags)
+		default:
+			tags = []string{""}
+		}
+
+		name := sanitize(c.name, "unnamed")
+		if c.kind == "paths" {
+			name = sanitize(strings.Replace(strings.Replace(strings.Trim(c.name, "/"), "{", "", -1), "}", "", -1), "root")
+		}
+		c.files = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			home := tagDir(tag)
+			dir := path.Join(home, c.kind)
+			file := path.Join(dir, name+"."+s.opts.Format)
+			for i := 2; used[strings.ToLower(file)]; i++ {
+				file = path.Join(dir, fmt.Sprintf("%s-%d.%s", name, i, s.opts.Format))
+			}
+			used[strings.ToLower(file)] = true
+			c.files[home] = file
+			if c.file == "" {
+				c.file = file
+			}
+		}
+	}
+}
+
+// tagDir returns the directory of the files laid out for a tag
+func tagDir(tag string) string {
+	if tag == "" {
+		return ""
+	}
+	return path.Join("tags", sanitize(tag, "default"))
+}
+
+// home returns the directory of the tag a file is laid out for
+func home(file string) string {
+	if parts := strings.SplitN(file, "/", 3); len(parts) == 3 && parts[0] == "tags" {
+		return path.Join(parts[0], parts[1])
+	}
+	return ""
+}
+
+// tag finds the tags of the operations which use each component.
+// The path items of operations without tag use the components with the empty tag.
+func (s *splitter) tag() {
+	for _, c := range s.ordered {
+		if c.kind != "paths" {
+			continue
+		}
+		tag := firstTag(c.content)
+		pending := []*component{c}
+		for len(pending) > 0 {
+			current := pending[0]
+			pending = pending[1:]
+			if current.tags[tag] {
+				continue
+			}
+			if current.tags == nil {
+				current.tags = make(map[string]bool)
+			}
+			current.tags[tag] = true
+			for _, used := range current.uses {
+				pending = append(pending, s.components[used])
+			}
+		}
+	}
+}
+
+// firstTag returns the first tag of the operations of a path item
+func firstTag(pathItem interface{}) string {
+	for _, method := range methods {
+		operation, _ := get(pathItem, method)
+		tags, _ := get(operation, "tags")
+		if list, ok := tags.([]interface{}); ok && len(list) > 0 {
+			return fmt.Sprint(list[0])
+		}
+	}
+	return ""
+}
+
+// sanitize makes a name safe to use as a file name and in a $ref
+func sanitize(name, fallback string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '.') {
+			return r
+		}
+		return '_'
+	}, name)
+	sanitized = strings.Trim(sanitized, "._")
+	if sanitized == "" {
+		return fallback
+	}
+	return sanitized
+}
+
+// isComponentKind tells if a section of the spec holds components written to their own files
+func isComponentKind(section string) bool {
+	for _, kind := range kinds {
+		if section == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedFromRoot tells if the root document references the files of a kind of component.
+//
+// Swagger 2.0 does not allow $ref's in the shared parameters and responses: they are left out of the root document,
+// and the operations reference their files instead.
+func referencedFromRoot(kind string) bool {
+	return kind == "paths" || kind == "definitions"
+}
+
+// render writes the documents of the components, and the root document which references them
+func (s *splitter) render() (map[string][]byte, error) {
+	files := make(map[string][]byte, len(s.ordered)+1)
+	for _, c := range s.ordered {
+		for _, file := range c.files {
+			b, err := s.marshal(rewriteRefs(c.content, s.rebase(file)))
+			if err != nil {
+				return nil, fmt.Errorf("cannot write %s %q: %v", strings.TrimSuffix(c.kind, "s"), c.name, err)
+			}
+			files[file] = b
+		}
+	}
+
+	rewritten := rewriteRefs(s.root, s.rebase(s.rootFile)).(yaml.MapSlice)
+	root := make(yaml.MapSlice, 0, len(rewritten))
+	for _, item := range rewritten {
+		section := fmt.Sprint(item.Key)
+		items, isKind := item.Value.(yaml.MapSlice)
+		if !isKind || !isComponentKind(section) {
+			root = append(root, item)
+			continue
+		}
+		kept := make(yaml.MapSlice, 0, len(items))
+		for _, entry := range items {
+			if c, ok := s.components[key{kind: section, name: fmt.Sprint(entry.Key)}]; ok {
+				if !referencedFromRoot(section) {
+					continue
+				}
+				entry.Value = yaml.MapSlice{{Key: refKey, Value: c.file}}
+			}
+			kept = append(kept, entry)
+		}
+		if len(kept) > 0 || len(items) == 0 {
+			root = append(root, yaml.MapItem{Key: item.Key, Value: kept})
+		}
+	}
+	b, err := s.marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	files[s.rootFile] = b
+	return files, nil
+}
+
+// rebase returns the function which rewrites the $ref's of the spec into references from a file
+func (s *splitter) rebase(from string) func(string) string {
+	return func(ref string) string {
+		if strings.HasPrefix(ref, "#") {
+			c, ok := s.local(ref)
+			if from == s.rootFile && (!ok || referencedFromRoot(c.kind)) {
+				// the root document references its definitions and path items, so local pointers to them remain valid, and
+				// $ref's between components of the root document, e.g. a definition which is only a $ref, are kept
+				return ref
+			}
+			target, fragment := s.rootFile, ref[1:]
+			if ok {
+				target, fragment = c.file, pointer(tokens(ref[1:])[2:])
+				if copied, isCopied := c.files[home(from)]; isCopied {
+					target = copied
+				}
+			}
+			if fragment != "" {
+				return relative(from, target) + "#" + fragment
+			}
+			return relative(from, target)
+		}
+		return s.remote(from, ref)
+	}
+}
+
+// remote rebases a reference to another document on the directory of the split files
+func (s *splitter) remote(from, ref string) string {
+	u, err := url.Parse(ref)
+	if err != nil || u.Scheme != "" || u.Host != "" || s.source == "" {
+		return ref
+	}
+	if source, erp := url.Parse(s.source); erp == nil && source.Scheme != "" && len(source.Scheme) > 1 {
+		return source.ResolveReference(u).String()
+	}
+	if filepath.IsAbs(filepath.FromSlash(u.Path)) {
+		return ref
+	}
+
+	abs, err := filepath.Abs(filepath.Join(filepath.Dir(s.source), filepath.FromSlash(u.Path)))
+	if err != nil {
+		return ref
+	}
+	rel, err := filepath.Rel(filepath.Join(s.target, filepath.FromSlash(path.Dir(from))), abs)
+	if err != nil {
+		return ref
+	}
+	rebased := filepath.ToSlash(rel)
+	if u.Fragment != "" || strings.HasSuffix(ref, "#") {
+		rebased += "#" + u.Fragment
+	}
+	return rebased
+}
+
+// relative returns the slash-separated path of a file relative to the directory of another one
+func relative(from, to string) string {
+	rel, err := filepath.Rel(filepath.FromSlash(path.Dir(from)), filepath.FromSlash(to))
+	if err != nil {
+		return to
+	}
+	return filepath.ToSlash(rel)
+}
+
+func (s *splitter) marshal(document interface{}) ([]byte, error) {
+	if s.opts.Format == FormatYAML {
+		return yaml.Marshal(document)
+	}
+	b, err := swag.YAMLToJSON(document)
+	if err != nil {
+		return nil, err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, b, "", "  "); err != nil {
+		return nil, err
+	}
+	indented.WriteString("\n")
+	return indented.Bytes(), nil
+}
+
+// Write writes split files in a directory
+func Write(dir string, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, files[name], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package split
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fixture = filepath.Join("..", "..", "..", "..", "fixtures", "split", "petstore.yaml")
+
+// flatten bundles a spec, and its split files, into a single document.
+//
+// The files of the path items, parameters and responses are inlined first, since the flattening resolves
+// the $ref's of their schemas from the root document. The shared parameters and responses are written in
+// the operations which use them, since a split spec references their files from the operations.
+func flatten(t *testing.T, path string, full bool) string {
+	doc, err := loads.Spec(path)
+	require.NoError(t, err)
+	require.NoError(t, Inline(doc.Spec(), doc.SpecFilePath()))
+	require.NoError(t, analysis.Flatten(analysis.FlattenOpts{
+		Spec:     analysis.New(doc.Spec()),
+		BasePath: doc.SpecFilePath(),
+		Minimal:  !full,
+	}))
+	require.NoError(t, spec.ExpandSpec(doc.Spec(), &spec.ExpandOptions{RelativeBase: doc.SpecFilePath(), SkipSchemas: true}))
+	doc.Spec().Parameters = nil
+	doc.Spec().Responses = nil
+	b, err := json.Marshal(doc.Spec())
+	require.NoError(t, err)
+	return string(b)
+}
+
+func splitTo(t *testing.T, path string, opts Opts) (string, map[string][]byte) {
+	doc, err := loads.Spec(path)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "split")
+	require.NoError(t, err)
+	files, err := Split(doc, dir, opts)
+	require.NoError(t, err)
+	require.NoError(t, Write(dir, files))
+	return dir, files
+}
+
+func names(files map[string][]byte) []string {
+	list := make([]string, 0, len(files))
+	for name := range files {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func TestSplit_RoundTrip(t *testing.T) {
+	// todolist.models.yml holds definitions which are only a $ref to another definition
+	for _, spec := range []string{fixture, filepath.Join("..", "..", "..", "..", "fixtures", "codegen", "todolist.models.yml")} {
+		for _, layout := range []string{LayoutByName, LayoutByTag} {
+			for _, format := range []string{FormatYAML, FormatJSON} {
+				opts := Opts{Layout: layout, Format: format}
+				dir, _ := splitTo(t, spec, opts)
+				root := filepath.Join(dir, opts.RootFile())
+				assert.JSONEq(t, flatten(t, spec, false), flatten(t, root, false), "%s %s %s", spec, layout, format)
+				assert.JSONEq(t, flatten(t, spec, true), flatten(t, root, true), "%s %s %s", spec, layout, format)
+				_ = os.RemoveAll(dir)
+			}
+		}
+	}
+}
+
+func TestSplit_Valid(t *testing.T) {
+	for _, layout := range []string{LayoutByName, LayoutByTag} {
+		for _, format := range []string{FormatYAML, FormatJSON} {
+			opts := Opts{Layout: layout, Format: format}
+			dir, _ := splitTo(t, fixture, opts)
+			doc, err := loads.Spec(filepath.Join(dir, opts.RootFile()))
+			require.NoError(t, err)
+			result, _ := validate.NewSpecValidator(doc.Schema(), strfmt.Default).Validate(doc)
+			assert.Empty(t, result.Errors, "%s %s", layout, format)
+			_ = os.RemoveAll(dir)
+		}
+	}
+}
+
+func TestSplit_ByName(t *testing.T) {
+	dir, files := splitTo(t, fixture, Opts{})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	assert.Equal(t, []string{
+		"definitions/Error.yaml",
+		"definitions/NewPet.yaml",
+		"definitions/Pet.yaml",
+		"definitions/pet-owner.yaml",
+		"definitions/store.Store.yaml",
+		"parameters/limit.yaml",
+		"parameters/petId.yaml",
+		"paths/pets.yaml",
+		"paths/pets_petId.yaml",
+		"paths/pets_petId_name.yaml",
+		"paths/root.yaml",
+		"paths/stores_storeId.yaml",
+		"responses/error.yaml",
+		"responses/notFound.yaml",
+		"swagger.yaml",
+	}, names(files))
+
+	root := string(files["swagger.yaml"])
+	assert.Contains(t, root, "  /pets/{petId}:\n    $ref: paths/pets_petId.yaml\n")
+	assert.Contains(t, root, "  pet-owner:\n    $ref: definitions/pet-owner.yaml\n")
+	assert.Contains(t, root, "x-generated: true")
+	assert.NotContains(t, root, "\nparameters:", "swagger 2.0 does not allow $ref's in the shared parameters")
+	assert.NotContains(t, root, "\nresponses:", "swagger 2.0 does not allow $ref's in the shared responses")
+
+	pets := string(files["paths/pets_petId.yaml"])
+	assert.Contains(t, pets, "$ref: ../parameters/petId.yaml")
+	assert.Contains(t, pets, "$ref: ../definitions/Pet.yaml")
+	assert.Contains(t, pets, "$ref: ../responses/notFound.yaml")
+	assert.Contains(t, pets, "$ref: not a reference", "examples are not rewritten")
+
+	assert.Contains(t, string(files["paths/pets_petId_name.yaml"]), "$ref: ../definitions/NewPet.yaml#/properties/name")
+	assert.Contains(t, string(files["definitions/Pet.yaml"]), "$ref: Pet.yaml\n")
+	assert.Contains(t, string(files["definitions/store.Store.yaml"]), "default:\n    $ref: Error.yaml\n", "a property named default is a schema")
+}
+
+func TestSplit_ByTag(t *testing.T) {
+	dir, files := splitTo(t, fixture, Opts{Layout: LayoutByTag, Format: FormatJSON, Root: "api"})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	assert.Equal(t, []string{
+		"api.json",
+		"definitions/Error.json",
+		"definitions/NewPet.json",
+		"definitions/Pet.json",
+		"definitions/pet-owner.json",
+		"paths/root.json",
+		"tags/pets/parameters/limit.json",
+		"tags/pets/parameters/petId.json",
+		"tags/pets/paths/pets.json",
+		"tags/pets/paths/pets_petId.json",
+		"tags/pets/paths/pets_petId_name.json",
+		"tags/pets/responses/error.json",
+		"tags/pets/responses/notFound.json",
+		"tags/stores/definitions/store.Store.json",
+		"tags/stores/parameters/limit.json",
+		"tags/stores/paths/stores_storeId.json",
+		"tags/stores/responses/error.json",
+	}, names(files))
+	assert.Contains(t, string(files["tags/stores/paths/stores_storeId.json"]), `"$ref": "../parameters/limit.json"`)
+	assert.Contains(t, string(files["tags/stores/responses/error.json"]), `"$ref": "../../../definitions/Error.json"`)
+	assert.Contains(t, string(files["tags/stores/paths/stores_storeId.json"]), `"$ref": "../definitions/store.Store.json"`)
+	assert.Contains(t, string(files["tags/stores/definitions/store.Store.json"]), `"$ref": "../../../definitions/Pet.json"`)
+
+	_, err := Split(&loads.Document{}, dir, Opts{Layout: "by-color"})
+	assert.Error(t, err)
+	_, err = Split(&loads.Document{}, dir, Opts{Format: "xml"})
+	assert.Error(t, err)
+}
+
+func TestSplit_RemoteRefs(t *testing.T) {
+	source, err := ioutil.TempDir("", "split-source")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(source)
+	}()
+	spec := filepath.Join(source, "spec.yaml")
+	require.NoError(t, ioutil.WriteFile(spec, []byte(`swagger: '2.0'
+info:
+  title: remote
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: ok
+          schema:
+            $ref: '#/definitions/Pets'
+definitions:
+  Pets:
+    type: array
+    items:
+      $ref: 'models/pet.yaml'
+`), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(source, "models"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "models", "pet.yaml"), []byte("type: object\n"), 0644))
+
+	dir, files := splitTo(t, spec, Opts{})
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	rel, err := filepath.Rel(filepath.Join(dir, "definitions"), filepath.Join(source, "models", "pet.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(files["definitions/Pets.yaml"]), "$ref: "+filepath.ToSlash(rel))
+	assert.JSONEq(t, flatten(t, spec, false), flatten(t, filepath.Join(dir, "swagger.yaml"), false))
+}
+
+func TestInline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inline")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	write := func(name, content string) string {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+		return filepath.Join(dir, name)
+	}
+
+	write("loop.yaml", "$ref: loop.yaml\n")
+	root := write("swagger.yaml", `swagger: '2.0'
+info:
+  title: inline
+  version: 1.0.0
+paths: {}
+parameters:
+  loop:
+    $ref: loop.yaml
+`)
+	doc, err := loads.Spec(root)
+	require.NoError(t, err)
+	assert.Error(t, Inline(doc.Spec(), root))
+
+	root = write("swagger.yaml", `swagger: '2.0'
+info:
+  title: inline
+  version: 1.0.0
+paths: {}
+responses:
+  missing:
+    $ref: missing.yaml
+`)
+	doc, err = loads.Spec(root)
+	require.NoError(t, err)
+	assert.Error(t, Inline(doc.Spec(), root))
+
+	doc, err = loads.Spec(fixture)
+	require.NoError(t, err)
+	before, err := json.Marshal(doc.Spec())
+	require.NoError(t, err)
+	require.NoError(t, Inline(doc.Spec(), fixture))
+	after, err := json.Marshal(doc.Spec())
+	require.NoError(t, err)
+	assert.JSONEq(t, string(before), string(after), "a spec without references to other files is left unchanged")
+}
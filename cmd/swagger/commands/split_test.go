@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+)
+
+// Commands requires at least one arg
+func TestCmd_Split(t *testing.T) {
+	v := &SplitSpec{}
+	testRequireParam(t, v)
+}
+
+func TestCmd_Split_Validate(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	specDoc := filepath.Join(fixtureBase, "split", "petstore.yaml")
+	outDir, err := ioutil.TempDir(filepath.Dir(specDoc), "split")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outDir)
+
+	for _, layout := range []string{"by-name", "by-tag"} {
+		dir := filepath.Join(outDir, layout)
+		v := &SplitSpec{
+			Output: flags.Filename(dir),
+			Layout: layout,
+			Format: "yaml",
+			Root:   "swagger",
+		}
+		assert.NoError(t, v.Execute([]string{specDoc}))
+		assert.NoError(t, (&ValidateSpec{}).Execute([]string{filepath.Join(dir, "swagger.yaml")}), layout)
+	}
+	_, err = os.Stat(filepath.Join(outDir, "by-tag", "tags", "pets", "paths", "pets.yaml"))
+	assert.NoError(t, err)
+
+	original := filepath.Join(outDir, "original.yaml")
+	content, err := ioutil.ReadFile(specDoc)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(original, content, 0644))
+	v := &SplitSpec{Output: flags.Filename(outDir), Layout: "by-name", Format: "yaml", Root: "original"}
+	assert.Error(t, v.Execute([]string{original}), "the spec cannot be overwritten")
+	_, err = os.Stat(filepath.Join(outDir, "definitions", "Pet.yaml"))
+	assert.True(t, os.IsNotExist(err))
+}
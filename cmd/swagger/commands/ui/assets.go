@@ -0,0 +1,9 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package ui
+
+// assets are the files of the assets directory, by slash-separated path
+var assets = map[string]string{
+	"redoc/index.html":   "<!DOCTYPE html>\n<html lang=\"en\">\n  <head>\n    <meta charset=\"UTF-8\">\n    <title>{{ .Title }}</title>\n    <meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n    <style>\n      body {\n        margin: 0;\n        padding: 0;\n      }\n    </style>\n  </head>\n  <body>\n    <redoc spec-url=\"{{ .SpecURL }}\"></redoc>\n    <script src=\"./redoc.standalone.js\"></script>\n  </body>\n</html>\n",
+	"swagger/index.html": "<!DOCTYPE html>\n<html lang=\"en\">\n  <head>\n    <meta charset=\"UTF-8\">\n    <title>{{ .Title }}</title>\n    <link rel=\"stylesheet\" type=\"text/css\" href=\"./swagger-ui.css\">\n    <style>\n      body {\n        margin: 0;\n      }\n    </style>\n  </head>\n  <body>\n    <div id=\"swagger-ui\"></div>\n    <script src=\"./swagger-ui-bundle.js\"></script>\n    <script src=\"./swagger-ui-standalone-preset.js\"></script>\n    <script>\n      window.onload = function() {\n        window.ui = SwaggerUIBundle({\n          url: {{ .SpecURL }},\n          dom_id: \"#swagger-ui\",\n          deepLinking: true,\n          presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],\n          plugins: [SwaggerUIBundle.plugins.DownloadUrl],\n          layout: \"StandaloneLayout\"\n        });\n      };\n    </script>\n  </body>\n</html>\n",
+}
@@ -0,0 +1,80 @@
+// +build ignore
+
+// gen embeds the files of the assets directory in assets.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// bundles are the files vendored by hack/vendor-ui.sh, as listed in ui.go
+var bundles = []string{
+	"swagger/swagger-ui-bundle.js",
+	"swagger/swagger-ui-standalone-preset.js",
+	"swagger/swagger-ui.css",
+	"redoc/redoc.standalone.js",
+}
+
+func main() {
+	files := make(map[string][]byte)
+	err := filepath.Walk("assets", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) == ".md" {
+			return err
+		}
+		rel, err := filepath.Rel("assets", path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var missing []string
+	for _, name := range bundles {
+		if _, ok := files[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		log.Fatalf("missing bundles in the assets directory: %s: run hack/vendor-ui.sh from the root of the repository", strings.Join(missing, ", "))
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go. DO NOT EDIT.\n\npackage ui\n\n")
+	buf.WriteString("// assets are the files of the assets directory, by slash-separated path\n")
+	buf.WriteString("var assets = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s: %s,\n", strconv.Quote(name), strconv.Quote(string(files[name])))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := ioutil.WriteFile("assets.go", src, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,204 @@
+// Package ui serves the Swagger UI and Redoc documentation sites of a spec.
+//
+// The assets of both UIs are embedded in the binary, so the documentation is available without
+// an internet connection. A customized bundle may be served from a local directory instead.
+package ui
+
+//go:generate go run gen.go
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Flavors of documentation UI
+const (
+	FlavorSwagger = "swagger"
+	FlavorRedoc   = "redoc"
+)
+
+const indexFile = "index.html"
+
+// bundles are the files of the embedded UIs which are vendored with hack/vendor-ui.sh
+var bundles = map[string][]string{
+	FlavorSwagger: {"swagger-ui-bundle.js", "swagger-ui-standalone-preset.js", "swagger-ui.css"},
+	FlavorRedoc:   {"redoc.standalone.js"},
+}
+
+// Opts configures the documentation UI
+type Opts struct {
+	// Flavor of the UI, swagger or redoc
+	Flavor string
+	// BasePath of the site, defaults to /
+	BasePath string
+	// Path of the UI, relative to the base path, defaults to docs
+	Path string
+	// SpecURL is the URL the UI loads the spec from
+	SpecURL string
+	// Title of the documentation page
+	Title string
+	// Dir serves the UI from a directory instead of the embedded assets.
+	// The index.html of the directory is rendered as an html template, like the embedded ones.
+	Dir string
+	// ReloadURL is the URL of the live reload events of the spec. When set, a script is added to the
+	// index page to refresh it when the spec changes, and to show the problems of the spec.
+	ReloadURL string
+}
+
+func (o *Opts) ensureDefaults() {
+	if o.BasePath == "" {
+		o.BasePath = "/"
+	}
+	if o.Path == "" {
+		o.Path = "docs"
+	}
+	if o.Title == "" {
+		o.Title = "API documentation"
+	}
+}
+
+// URLPath returns the path the UI is served at, with a trailing slash
+func (o Opts) URLPath() string {
+	o.ensureDefaults()
+	return strings.TrimSuffix(path.Join(o.BasePath, o.Path), "/") + "/"
+}
+
+// source reads the assets of a UI by slash-separated name
+type source func(name string) ([]byte, error)
+
+func embedded(flavor string) source {
+	return func(name string) ([]byte, error) {
+		content, ok := assets[flavor+"/"+name]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return []byte(content), nil
+	}
+}
+
+func directory(dir string) source {
+	return func(name string) ([]byte, error) {
+		return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(path.Clean("/"+name))))
+	}
+}
+
+// Missing returns the files of the embedded UI of a flavor which were not vendored in the binary
+func Missing(flavor string) []string {
+	var missing []string
+	for _, name := range append([]string{indexFile}, bundles[flavor]...) {
+		if _, ok := assets[flavor+"/"+name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// Handler serves the documentation UI at its path, and delegates other requests to next
+func Handler(opts Opts, next http.Handler) (http.Handler, error) {
+	opts.ensureDefaults()
+	if _, known := bundles[opts.Flavor]; !known && opts.Dir == "" {
+		return nil, fmt.Errorf("unsupported documentation UI flavor: %q", opts.Flavor)
+	}
+
+	read := embedded(opts.Flavor)
+	if opts.Dir != "" {
+		read = directory(opts.Dir)
+	}
+
+	index, err := read(indexFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the %s of the documentation UI: %v", indexFile, err)
+	}
+	tpl, err := template.New(indexFile).Parse(string(index))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s in the documentation UI: %v", indexFile, err)
+	}
+	var page bytes.Buffer
+	if err := tpl.Execute(&page, opts); err != nil {
+		return nil, fmt.Errorf("cannot render the %s of the documentation UI: %v", indexFile, err)
+	}
+	if opts.ReloadURL != "" {
+		if err := injectReload(&page, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	prefix := opts.URLPath()
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == strings.TrimSuffix(prefix, "/") {
+			http.Redirect(rw, r, prefix, http.StatusMovedPermanently)
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, prefix)
+		content := page.Bytes()
+		if name != "" && name != indexFile {
+			var err error
+			if content, err = read(name); err != nil {
+				http.NotFound(rw, r)
+				return
+			}
+		}
+		if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+			rw.Header().Set("Content-Type", contentType)
+		}
+		http.ServeContent(rw, r, name, time.Time{}, bytes.NewReader(content))
+	}), nil
+}
+
+// reloadScript refreshes the page on reload events, and shows the problems of the spec in a banner
+var reloadScript = template.Must(template.New("reload").Parse(`<script>
+  (function() {
+    var banner = document.createElement("pre");
+    banner.id = "swagger-serve-problems";
+    banner.style.cssText = "display:none;position:fixed;top:0;left:0;right:0;z-index:10000;margin:0;padding:1em;" +
+      "max-height:50%;overflow:auto;background:#fdecea;color:#611a15;border-bottom:2px solid #f44336;font-size:13px;white-space:pre-wrap;";
+    document.body.appendChild(banner);
+
+    var events = new EventSource({{ .ReloadURL }});
+    events.addEventListener("reload", function() {
+      window.location.reload();
+    });
+    events.addEventListener("problems", function(e) {
+      var problems = JSON.parse(e.data);
+      banner.textContent = problems.length ? "The spec has " + problems.length + " problem(s):\n- " + problems.join("\n- ") : "";
+      banner.style.display = problems.length ? "block" : "none";
+    });
+  })();
+</script>
+`))
+
+// injectReload adds the live reload script at the end of the body of a page
+func injectReload(page *bytes.Buffer, opts Opts) error {
+	var script bytes.Buffer
+	if err := reloadScript.Execute(&script, opts); err != nil {
+		return fmt.Errorf("cannot render the live reload script: %v", err)
+	}
+
+	content := page.Bytes()
+	idx := bytes.LastIndex(bytes.ToLower(content), []byte("</body>"))
+	if idx < 0 {
+		idx = len(content)
+	}
+	injected := make([]byte, 0, len(content)+script.Len())
+	injected = append(injected, content[:idx]...)
+	injected = append(injected, script.Bytes()...)
+	injected = append(injected, content[idx:]...)
+
+	page.Reset()
+	page.Write(injected)
+	return nil
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, handler http.Handler, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestHandler_Embedded(t *testing.T) {
+	for _, flavor := range []string{FlavorRedoc, FlavorSwagger} {
+		handler, err := Handler(Opts{Flavor: flavor, BasePath: "/api", SpecURL: "/api/swagger.json", Title: "Petstore"}, http.NotFoundHandler())
+		require.NoError(t, err)
+
+		rec := get(t, handler, "/api/docs/")
+		assert.Equal(t, http.StatusOK, rec.Code, flavor)
+		assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"), flavor)
+		assert.Contains(t, rec.Body.String(), "<title>Petstore</title>", flavor)
+		assert.Contains(t, rec.Body.String(), "/api/swagger.json", flavor)
+		assert.NotContains(t, rec.Body.String(), "http", flavor)
+
+		rec = get(t, handler, "/api/docs")
+		assert.Equal(t, http.StatusMovedPermanently, rec.Code, flavor)
+		assert.Equal(t, "/api/docs/", rec.Header().Get("Location"), flavor)
+
+		assert.Equal(t, http.StatusNotFound, get(t, handler, "/api/docs/unknown.js").Code, flavor)
+		for _, name := range Missing(flavor) {
+			assert.Equal(t, http.StatusNotFound, get(t, handler, "/api/docs/"+name).Code, name)
+		}
+		assert.Equal(t, http.StatusNotFound, get(t, handler, "/api/other").Code, flavor)
+	}
+
+	_, err := Handler(Opts{Flavor: "unknown"}, http.NotFoundHandler())
+	assert.Error(t, err)
+}
+
+func TestHandler_Dir(t *testing.T) {
+	dir := filepath.FromSlash("../../../../fixtures/serve-ui/custom")
+	handler, err := Handler(Opts{Flavor: FlavorSwagger, SpecURL: "/swagger.json", Title: "Petstore", Dir: dir}, http.NotFoundHandler())
+	require.NoError(t, err)
+
+	rec := get(t, handler, "/docs/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<title>custom Petstore</title>")
+	assert.Contains(t, rec.Body.String(), `data-spec="/swagger.json"`)
+
+	rec = get(t, handler, "/docs/app.js")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "javascript")
+	assert.Contains(t, rec.Body.String(), "custom documentation ui")
+
+	assert.Equal(t, http.StatusNotFound, get(t, handler, "/docs/../../custom/index.html").Code)
+
+	_, err = Handler(Opts{Dir: filepath.Join(dir, "nowhere")}, http.NotFoundHandler())
+	assert.Error(t, err)
+}
+
+func TestOpts_URLPath(t *testing.T) {
+	assert.Equal(t, "/docs/", Opts{}.URLPath())
+	assert.Equal(t, "/api/docs/", Opts{BasePath: "/api/"}.URLPath())
+	assert.Equal(t, "/api/reference/", Opts{BasePath: "/api", Path: "reference"}.URLPath())
+}
+
+func TestMissing(t *testing.T) {
+	for flavor := range bundles {
+		assert.Empty(t, Missing(flavor), "the %s bundles are not vendored: run hack/vendor-ui.sh", flavor)
+	}
+
+	if content, ok := assets["redoc/redoc.standalone.js"]; ok {
+		defer func() { assets["redoc/redoc.standalone.js"] = content }()
+	}
+	delete(assets, "redoc/redoc.standalone.js")
+	assert.Equal(t, []string{"redoc.standalone.js"}, Missing(FlavorRedoc))
+}
+
+func TestHandler_Reload(t *testing.T) {
+	dir := filepath.FromSlash("../../../../fixtures/serve-ui/custom")
+	for _, opts := range []Opts{
+		{Flavor: FlavorRedoc, ReloadURL: "/swagger.events"},
+		{Dir: dir, ReloadURL: "/swagger.events"},
+	} {
+		handler, err := Handler(opts, http.NotFoundHandler())
+		require.NoError(t, err)
+
+		page := get(t, handler, "/docs/").Body.String()
+		assert.Contains(t, page, `new EventSource("/swagger.events")`)
+		assert.True(t, strings.Index(page, "EventSource") < strings.Index(page, "</body>"), "the script is added to the body")
+	}
+
+	handler, err := Handler(Opts{Flavor: FlavorRedoc}, http.NotFoundHandler())
+	require.NoError(t, err)
+	assert.NotContains(t, get(t, handler, "/docs/").Body.String(), "EventSource")
+}
@@ -17,11 +17,15 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/validation"
+	flags "github.com/jessevdk/go-flags"
 )
 
 const (
@@ -36,8 +40,10 @@ const (
 // against the swagger specification
 type ValidateSpec struct {
 	// SchemaURL string `long:"schema" description:"The schema url to use" default:"http://swagger.io/v2/schema.json"`
-	SkipWarnings bool `long:"skip-warnings" description:"when present will not show up warnings upon validation"`
-	StopOnError  bool `long:"stop-on-error" description:"when present will not continue validation after critical errors are found"`
+	SkipWarnings bool           `long:"skip-warnings" description:"when present will not show up warnings upon validation"`
+	StopOnError  bool           `long:"stop-on-error" description:"when present will not continue validation after critical errors are found"`
+	Format       string         `long:"format" short:"f" description:"the format of the validation report. Other formats than txt report each error and warning with a code, a JSON pointer and a position in the source" default:"txt" choice:"txt" choice:"json" choice:"junit" choice:"sarif"`
+	Output       flags.Filename `long:"output" short:"o" description:"the file to write the validation report to, when the format is not txt (default: stdout)"`
 }
 
 // Execute validates the spec
@@ -59,6 +65,10 @@ func (c *ValidateSpec) Execute(args []string) error {
 	v := validate.NewSpecValidator(specDoc.Schema(), strfmt.Default)
 	result, _ := v.Validate(specDoc) // returns fully detailed result with errors and warnings
 
+	if c.Format != "" && c.Format != "txt" {
+		return c.report(swaggerDoc, specDoc, result)
+	}
+
 	if result.IsValid() {
 		log.Printf(validSpecMsg, swaggerDoc, specDoc.Version())
 	}
@@ -81,3 +91,39 @@ func (c *ValidateSpec) Execute(args []string) error {
 
 	return nil
 }
+
+// report writes a machine-readable validation report
+func (c *ValidateSpec) report(swaggerDoc string, specDoc *loads.Document, result *validate.Result) error {
+	report := validation.NewReport(swaggerDoc, specDoc, result, !c.SkipWarnings)
+
+	var w io.Writer = os.Stdout
+	if c.Output != "" {
+		f, err := os.Create(string(c.Output))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	var err error
+	switch c.Format {
+	case JSONFormat:
+		err = report.WriteJSON(w)
+	case "junit":
+		err = report.WriteJUnit(w)
+	case "sarif":
+		err = report.WriteSARIF(w, Version)
+	default:
+		err = fmt.Errorf("unsupported report format: %s", c.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !report.Valid {
+		return fmt.Errorf("the swagger spec at %q is invalid against swagger specification %s: %d error(s)", swaggerDoc, specDoc.Version(), len(report.Errors))
+	}
+	log.Printf(validSpecMsg, swaggerDoc, specDoc.Version())
+	return nil
+}
@@ -7,7 +7,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	flags "github.com/jessevdk/go-flags"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Commands requires at least one arg
@@ -102,3 +104,36 @@ func TestCmd_Validate_Issue342_Correct(t *testing.T) {
 	result := v.Execute([]string{specDoc})
 	assert.NoError(t, result)
 }
+
+// Test machine-readable validation reports
+func TestCmd_Validate_Report(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	base := filepath.FromSlash("../../../")
+	specDoc := filepath.Join(base, "fixtures", "validation-report", "invalid.yaml")
+
+	output, err := ioutil.TempDir(".", "validate")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(output)
+	}()
+
+	for _, format := range []string{"json", "junit", "sarif"} {
+		target := filepath.Join(output, "report."+format)
+		v := ValidateSpec{Format: format, Output: flags.Filename(target)}
+		result := v.Execute([]string{specDoc})
+		if assert.Error(t, result, format) {
+			assert.Contains(t, result.Error(), "is invalid against swagger specification 2.0: 7 error(s)")
+		}
+
+		report, err := ioutil.ReadFile(target)
+		require.NoError(t, err)
+		assert.Contains(t, string(report), "no-parameter-in-path", format)
+	}
+
+	v := ValidateSpec{Format: "json", Output: flags.Filename(filepath.Join(output, "valid.json"))}
+	assert.NoError(t, v.Execute([]string{filepath.Join(base, "fixtures", "bugs", "342", "fixture-342-3.yaml")}))
+	report, err := ioutil.ReadFile(filepath.Join(output, "valid.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(report), `"valid": true`)
+}
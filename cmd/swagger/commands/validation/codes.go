@@ -0,0 +1,315 @@
+package validation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// Codes which do not derive from a validation message
+const (
+	// CodeUnclassified is the code of messages which are not recognized
+	CodeUnclassified = "unclassified"
+	// CodeInvalidNumericValue is the code of numeric values which do not fit their type and format
+	CodeInvalidNumericValue = "invalid-numeric-value"
+)
+
+// kind is a kind of validation failure, recognized by the message it produces
+type kind struct {
+	code    string
+	pattern *regexp.Regexp
+	quoted  []bool
+	// locate returns the JSON pointer to the failing construct, given the arguments of the message
+	locate func(r *resolver, args []string) string
+}
+
+// newKind builds a kind from the format of its message
+func newKind(code, format string, locate func(*resolver, []string) string) kind {
+	format = strings.TrimPrefix(format, "IMPORTANT!")
+	var (
+		expr   strings.Builder
+		quoted []bool
+	)
+	expr.WriteString("^")
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			expr.WriteString(regexp.QuoteMeta(format[i : i+1]))
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'q':
+			expr.WriteString(`"(.*?)"`)
+			quoted = append(quoted, true)
+		case 'd':
+			expr.WriteString(`(-?\d+)`)
+			quoted = append(quoted, false)
+		default:
+			expr.WriteString(`(.*?)`)
+			quoted = append(quoted, false)
+		}
+	}
+	expr.WriteString("$")
+	return kind{code: code, pattern: regexp.MustCompile(expr.String()), quoted: quoted, locate: locate}
+}
+
+// match returns the unquoted arguments of a message when it is of this kind
+func (k kind) match(message string) ([]string, bool) {
+	groups := k.pattern.FindStringSubmatch(message)
+	if groups == nil {
+		return nil, false
+	}
+	args := groups[1:]
+	for i, arg := range args {
+		if i < len(k.quoted) && k.quoted[i] {
+			if unquoted, err := strconv.Unquote(`"` + arg + `"`); err == nil {
+				args[i] = unquoted
+			}
+		}
+	}
+	return args, true
+}
+
+func firstOf(pointers ...string) string {
+	for _, ptr := range pointers {
+		if ptr != "" {
+			return ptr
+		}
+	}
+	return ""
+}
+
+// kinds of failures reported by the spec validator, with a stable code
+var kinds = []kind{
+	newKind("array-requires-items", validate.ArrayRequiresItemsError, func(r *resolver, args []string) string {
+		return firstOf(r.operation(args[1]), r.located(args[0]))
+	}),
+	newKind("array-in-param-requires-items", validate.ArrayInParamRequiresItemsError, func(r *resolver, args []string) string {
+		return r.parameter(args[1], args[0], "")
+	}),
+	newKind("array-in-header-requires-items", validate.ArrayInHeaderRequiresItemsError, func(r *resolver, args []string) string {
+		return r.operation(args[1])
+	}),
+	newKind("both-form-data-and-body", validate.BothFormDataAndBodyError, func(r *resolver, args []string) string {
+		return r.within(r.operation(args[0]), "parameters")
+	}),
+	newKind("cannot-resolve-reference", validate.CannotResolveReferenceError, func(r *resolver, args []string) string {
+		return firstOf(r.referenceSite(args[1]), r.located(args[0]))
+	}),
+	newKind("circular-ancestry", validate.CircularAncestryDefinitionError, func(r *resolver, args []string) string {
+		return r.definition(args[0])
+	}),
+	newKind("default-value-items-does-not-validate", validate.DefaultValueItemsDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.parameter("", args[0], args[1]), "items", "default")
+	}),
+	newKind("default-value-does-not-validate", validate.DefaultValueDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.parameter("", args[0], args[1]), "default")
+	}),
+	newKind("default-value-header-items-does-not-validate", validate.DefaultValueHeaderItemsDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[2]), "headers", args[1], "items", "default")
+	}),
+	newKind("default-value-header-does-not-validate", validate.DefaultValueHeaderDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[2]), "headers", args[1], "default")
+	}),
+	newKind("default-value-in-does-not-validate", validate.DefaultValueInDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.response(args[0], args[1])
+	}),
+	newKind("duplicate-param-name", validate.DuplicateParamNameError, func(r *resolver, args []string) string {
+		return r.parameter(args[2], args[0], args[1])
+	}),
+	newKind("duplicate-properties", validate.DuplicatePropertiesError, func(r *resolver, args []string) string {
+		return r.definition(args[0])
+	}),
+	newKind("example-value-items-does-not-validate", validate.ExampleValueItemsDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.parameter("", args[0], args[1]), "items")
+	}),
+	newKind("example-value-does-not-validate", validate.ExampleValueDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.parameter("", args[0], args[1])
+	}),
+	newKind("example-value-header-items-does-not-validate", validate.ExampleValueHeaderItemsDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[2]), "headers", args[1], "items")
+	}),
+	newKind("example-value-header-does-not-validate", validate.ExampleValueHeaderDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[2]), "headers", args[1])
+	}),
+	newKind("example-value-in-does-not-validate", validate.ExampleValueInDoesNotValidateError, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[1]), "examples")
+	}),
+	newKind("empty-path-parameter", validate.EmptyPathParameterError, func(r *resolver, args []string) string {
+		return r.path(args[0])
+	}),
+	newKind("invalid-document", validate.InvalidDocumentError, nil),
+	newKind("invalid-items-pattern", validate.InvalidItemsPatternError, func(r *resolver, args []string) string {
+		return r.operation(args[1])
+	}),
+	newKind("invalid-parameter-definition", validate.InvalidParameterDefinitionError, func(r *resolver, args []string) string {
+		return r.parameter(args[2], args[0], args[1])
+	}),
+	newKind("invalid-parameter-definition-as-schema", validate.InvalidParameterDefinitionAsSchemaError, func(r *resolver, args []string) string {
+		return r.parameter(args[2], args[0], args[1])
+	}),
+	newKind("invalid-pattern", validate.InvalidPatternError, func(r *resolver, args []string) string {
+		return firstOf(r.definition(args[1]), r.located(args[1]))
+	}),
+	newKind("invalid-pattern-in-header", validate.InvalidPatternInHeaderError, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[2]), "headers", args[1], "pattern")
+	}),
+	newKind("invalid-pattern-in-param", validate.InvalidPatternInParamError, func(r *resolver, args []string) string {
+		return r.within(r.parameter(args[0], args[1], ""), "pattern")
+	}),
+	newKind("invalid-pattern-in", validate.InvalidPatternInError, func(r *resolver, args []string) string {
+		return r.within(r.parameter("", args[0], args[1]), "pattern")
+	}),
+	newKind("invalid-reference", validate.InvalidReferenceError, func(r *resolver, args []string) string {
+		return r.referenceSite(args[0])
+	}),
+	newKind("invalid-response-definition-as-schema", validate.InvalidResponseDefinitionAsSchemaError, func(r *resolver, args []string) string {
+		return r.within(r.operation(args[1]), "responses", args[0])
+	}),
+	newKind("multiple-body-param", validate.MultipleBodyParamError, func(r *resolver, args []string) string {
+		return r.within(r.operation(args[0]), "parameters")
+	}),
+	newKind("non-unique-operation-id", validate.NonUniqueOperationIDError, func(r *resolver, args []string) string {
+		return r.operation(args[0])
+	}),
+	newKind("no-parameter-in-path", validate.NoParameterInPathError, func(r *resolver, args []string) string {
+		return r.pathWith(args[0])
+	}),
+	newKind("no-valid-path", validate.NoValidPathErrorOrWarning, func(r *resolver, _ []string) string {
+		return r.within("#", "paths")
+	}),
+	newKind("no-valid-response", validate.NoValidResponseError, func(r *resolver, args []string) string {
+		return r.within(r.operation(args[0]), "responses")
+	}),
+	newKind("path-overlap", validate.PathOverlapError, func(r *resolver, args []string) string {
+		return r.path(args[0])
+	}),
+	newKind("path-param-not-in-path", validate.PathParamNotInPathError, func(r *resolver, args []string) string {
+		return r.pathParameter(args[1], args[0])
+	}),
+	newKind("path-param-not-unique", validate.PathParamNotUniqueError, func(r *resolver, args []string) string {
+		return r.path(args[0])
+	}),
+	newKind("path-param-required", validate.PathParamRequiredError, func(r *resolver, args []string) string {
+		return r.parameter(args[0], args[1], "path")
+	}),
+	newKind("ref-not-allowed-in-header", validate.RefNotAllowedInHeaderError, func(r *resolver, args []string) string {
+		return r.within(r.located(args[0]), "headers", args[1])
+	}),
+	newKind("required-but-not-defined", validate.RequiredButNotDefinedError, func(r *resolver, args []string) string {
+		return r.within(firstOf(r.definition(args[1]), r.located(args[1])), "required")
+	}),
+	newKind("some-parameters-broken", validate.SomeParametersBrokenError, func(r *resolver, args []string) string {
+		return r.within(r.path(args[0]), args[1], "parameters")
+	}),
+	newKind("unresolved-references", validate.UnresolvedReferencesError, nil),
+	newKind("examples-without-schema", validate.ExamplesWithoutSchemaWarning, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[1]), "examples")
+	}),
+	newKind("examples-mime-not-supported", validate.ExamplesMimeNotSupportedWarning, func(r *resolver, args []string) string {
+		return r.within(r.response(args[0], args[1]), "examples")
+	}),
+	newKind("path-param-garbled", validate.PathParamGarbledWarning, func(r *resolver, args []string) string {
+		return r.path(args[0])
+	}),
+	newKind("param-validation-type-mismatch", validate.ParamValidationTypeMismatch, func(r *resolver, args []string) string {
+		return r.pathParameter(args[1], args[0])
+	}),
+	newKind("path-stripped-param-garbled", validate.PathStrippedParamGarbledWarning, nil),
+	newKind("read-only-and-required", validate.ReadOnlyAndRequiredWarning, func(r *resolver, args []string) string {
+		return r.within(firstOf(r.definition(args[1]), r.located(args[1])), "properties", args[0], "readOnly")
+	}),
+	newKind("ref-should-not-have-siblings", validate.RefShouldNotHaveSiblingsWarning, func(r *resolver, args []string) string {
+		return r.located(strings.TrimPrefix(args[0]+"."+args[1], "."))
+	}),
+	newKind("required-has-default", validate.RequiredHasDefaultWarning, func(r *resolver, args []string) string {
+		return r.within(r.parameter("", args[0], args[1]), "default")
+	}),
+	newKind("unused-definition", validate.UnusedDefinitionWarning, func(r *resolver, args []string) string {
+		return r.reference(args[0])
+	}),
+	newKind("unused-param", validate.UnusedParamWarning, func(r *resolver, args []string) string {
+		return r.reference(args[0])
+	}),
+	newKind("unused-response", validate.UnusedResponseWarning, func(r *resolver, args []string) string {
+		return r.reference(args[0])
+	}),
+	newKind("has-dependency", validate.HasDependencyError, func(r *resolver, args []string) string {
+		return r.located(args[0])
+	}),
+	newKind("invalid-type-conversion", validate.InvalidTypeConversionError, func(r *resolver, args []string) string {
+		return r.located(args[0])
+	}),
+	newKind("must-validate-at-least-one-schema", validate.MustValidateAtLeastOneSchemaError, func(r *resolver, args []string) string {
+		return r.located(args[0])
+	}),
+	newKind("must-validate-only-one-schema", validate.MustValidateOnlyOneSchemaError, func(r *resolver, args []string) string {
+		return r.located(args[0])
+	}),
+	newKind("must-validate-all-schemas", validate.MustValidateAllSchemasError, func(r *resolver, args []string) string {
+		return r.located(args[0])
+	}),
+	newKind("must-not-validate-schema", validate.MustNotValidateSchemaError, func(r *resolver, args []string) string {
+		return r.located(args[0])
+	}),
+	newKind("array-does-not-allow-additional-items", validate.ArrayDoesNotAllowAdditionalItemsError, nil),
+	// numeric values checked against their type and format
+	newKind(CodeInvalidNumericValue, "%s value must be of type %s with format %s in %s", func(r *resolver, args []string) string {
+		return firstOf(r.parameter("", args[3], ""), r.located(args[3]))
+	}),
+	newKind(CodeInvalidNumericValue, "%s value must be of type %s (default format) in %s", func(r *resolver, args []string) string {
+		return firstOf(r.parameter("", args[2], ""), r.located(args[2]))
+	}),
+}
+
+// schemaCodes are the codes of the failures of the JSON schema validation
+var schemaCodes = map[int32]string{
+	errors.InvalidTypeCode:              "schema-invalid-type",
+	errors.RequiredFailCode:             "schema-required",
+	errors.TooLongFailCode:              "schema-too-long",
+	errors.TooShortFailCode:             "schema-too-short",
+	errors.PatternFailCode:              "schema-pattern",
+	errors.EnumFailCode:                 "schema-enum",
+	errors.MultipleOfFailCode:           "schema-multiple-of",
+	errors.MaxFailCode:                  "schema-maximum",
+	errors.MinFailCode:                  "schema-minimum",
+	errors.UniqueFailCode:               "schema-unique-items",
+	errors.MaxItemsFailCode:             "schema-max-items",
+	errors.MinItemsFailCode:             "schema-min-items",
+	errors.NoAdditionalItemsCode:        "schema-no-additional-items",
+	errors.TooFewPropertiesCode:         "schema-too-few-properties",
+	errors.TooManyPropertiesCode:        "schema-too-many-properties",
+	errors.UnallowedPropertyCode:        "schema-unallowed-property",
+	errors.FailedAllPatternPropsCode:    "schema-failed-pattern-properties",
+	errors.MultipleOfMustBePositiveCode: "schema-multiple-of-must-be-positive",
+}
+
+// classify returns the code of a validation failure and the JSON pointer to the failing construct.
+//
+// Failures of the validation of values against a schema (e.g. defaults or examples) follow the failure
+// which reports them, and may be located relative to it.
+func classify(r *resolver, err error, context string) (string, string) {
+	if ve, ok := err.(*errors.Validation); ok {
+		code, known := schemaCodes[ve.Code()]
+		if !known {
+			code = "schema-" + strconv.Itoa(int(ve.Code()))
+		}
+		return code, firstOf(r.dotted(ve.Name), r.relative(context, ve.Name), r.located(ve.Name))
+	}
+
+	message := strings.TrimPrefix(err.Error(), "IMPORTANT!")
+	for _, k := range kinds {
+		args, ok := k.match(message)
+		if !ok {
+			continue
+		}
+		if k.locate == nil {
+			return k.code, ""
+		}
+		return k.code, k.locate(r, args)
+	}
+	return CodeUnclassified, ""
+}
@@ -0,0 +1,99 @@
+// Package validation builds machine-readable reports of the validation of a swagger spec.
+//
+// Every error and warning of the validator is reported with a stable code, the JSON pointer
+// to the failing construct and its position in the YAML or JSON source.
+package validation
+
+import (
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/validate"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sourcemap"
+)
+
+// Severities of an issue
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Issue is an error or a warning reported by the validator
+type Issue struct {
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// Pointer is the JSON pointer to the failing construct in the spec, e.g. #/paths/~1pets/get
+	Pointer string `json:"pointer,omitempty"`
+	// File is the document where the failing construct is written, which differs from the spec for remote $ref
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// Report is the result of the validation of a spec
+type Report struct {
+	Spec     string  `json:"spec"`
+	Version  string  `json:"version"`
+	Valid    bool    `json:"valid"`
+	Errors   []Issue `json:"errors"`
+	Warnings []Issue `json:"warnings"`
+}
+
+// NewReport builds the report of the validation of the spec document at path
+func NewReport(path string, doc *loads.Document, result *validate.Result, withWarnings bool) *Report {
+	r := newResolver(doc)
+	locator := sourcemap.New(path)
+
+	report := &Report{
+		Spec:     path,
+		Version:  doc.Version(),
+		Valid:    result.IsValid(),
+		Errors:   issues(r, locator, SeverityError, result.Errors),
+		Warnings: []Issue{},
+	}
+	if withWarnings {
+		report.Warnings = issues(r, locator, SeverityWarning, result.Warnings)
+	}
+	return report
+}
+
+func issues(r *resolver, locator *sourcemap.Locator, severity string, errs []error) []Issue {
+	result := make([]Issue, 0, len(errs))
+	var context string
+	for _, err := range flatten(errs) {
+		code, ptr := classify(r, err, context)
+		if _, isSchema := err.(*errors.Validation); !isSchema {
+			context = ptr
+		}
+		pos := locator.Locate(ptr)
+		if ptr == "" {
+			// not located in the spec
+			pos.Line, pos.Column = 0, 0
+		}
+		result = append(result, Issue{
+			Code:     code,
+			Severity: severity,
+			Message:  strings.TrimPrefix(err.Error(), "IMPORTANT!"),
+			Pointer:  ptr,
+			File:     pos.File,
+			Line:     pos.Line,
+			Column:   pos.Column,
+		})
+	}
+	return result
+}
+
+// flatten unwraps composite errors
+func flatten(errs []error) []error {
+	flat := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if composite, ok := err.(*errors.CompositeError); ok && len(composite.Errors) > 0 {
+			flat = append(flat, flatten(composite.Errors)...)
+			continue
+		}
+		flat = append(flat, err)
+	}
+	return flat
+}
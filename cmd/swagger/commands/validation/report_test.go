@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sarif"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fixtureBase = filepath.FromSlash("../../../../fixtures/validation-report")
+
+func invalidReport(t *testing.T) *Report {
+	path := filepath.Join(fixtureBase, "invalid.yaml")
+	doc, err := loads.Spec(path)
+	require.NoError(t, err)
+
+	validate.SetContinueOnErrors(true)
+	defer validate.SetContinueOnErrors(false)
+	result, _ := validate.NewSpecValidator(doc.Schema(), strfmt.Default).Validate(doc)
+	return NewReport(path, doc, result, true)
+}
+
+type located struct {
+	Pointer      string
+	Line, Column int
+}
+
+func issuesByCode(issues []Issue) map[string][]located {
+	found := make(map[string][]located)
+	for _, issue := range issues {
+		found[issue.Code] = append(found[issue.Code], located{Pointer: issue.Pointer, Line: issue.Line, Column: issue.Column})
+	}
+	return found
+}
+
+func TestNewReport(t *testing.T) {
+	report := invalidReport(t)
+
+	assert.False(t, report.Valid)
+	assert.Equal(t, "2.0", report.Version)
+	assert.Equal(t, map[string][]located{
+		"non-unique-operation-id":            {{"#/paths/~1pets~1{id}/get", 7, 5}},
+		"no-parameter-in-path":               {{"#/paths/~1pets~1{id}", 6, 3}},
+		"required-but-not-defined":           {{"#/definitions/Pet/required", 36, 5}},
+		"default-value-in-does-not-validate": {{"#/paths/~1owners/get/responses/200", 23, 9}},
+		"default-value-does-not-validate":    {{"#/paths/~1pets~1{id}/get/parameters/0/default", 13, 11}},
+		"schema-invalid-type": {
+			{"#/paths/~1owners/get/responses/200/schema", 25, 11},
+			{"#/paths/~1pets~1{id}/get/parameters/0", 10, 11},
+		},
+	}, sortedLocations(issuesByCode(report.Errors)))
+	assert.Equal(t, map[string][]located{
+		"unused-definition": {{"#/definitions/Unused", 41, 3}},
+	}, issuesByCode(report.Warnings))
+
+	for _, issue := range report.Errors {
+		assert.Equal(t, SeverityError, issue.Severity)
+		assert.Equal(t, filepath.Join(fixtureBase, "invalid.yaml"), issue.File)
+		assert.NotContains(t, issue.Message, "IMPORTANT!")
+	}
+}
+
+func sortedLocations(found map[string][]located) map[string][]located {
+	for _, locations := range found {
+		sort.Slice(locations, func(i, j int) bool { return locations[i].Pointer < locations[j].Pointer })
+	}
+	return found
+}
+
+func TestClassify(t *testing.T) {
+	doc, err := loads.Spec(filepath.Join(fixtureBase, "invalid.yaml"))
+	require.NoError(t, err)
+	r := newResolver(doc)
+
+	for _, toPin := range []struct {
+		message string
+		code    string
+		pointer string
+	}{
+		{`"getPet" is defined 2 times`, "non-unique-operation-id", "#/paths/~1pets~1{id}/get"},
+		{`definition "#/definitions/Pet" is not used anywhere`, "unused-definition", "#/definitions/Pet"},
+		{`path param "{id}" has no parameter definition`, "no-parameter-in-path", "#/paths/~1pets~1{id}"},
+		{`some message the validator does not produce`, CodeUnclassified, ""},
+	} {
+		code, ptr := classify(r, errorString(toPin.message), "")
+		assert.Equal(t, toPin.code, code, toPin.message)
+		assert.Equal(t, toPin.pointer, ptr, toPin.message)
+	}
+}
+
+type errorString string
+
+func (e errorString) Error() string { return string(e) }
+
+func TestWriteReports(t *testing.T) {
+	report := invalidReport(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteJSON(&buf))
+	var decoded Report
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, *report, decoded)
+
+	buf.Reset()
+	require.NoError(t, report.WriteJUnit(&buf))
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+	require.Len(t, suites.Suites, 1)
+	assert.Equal(t, len(report.Errors)+len(report.Warnings), suites.Suites[0].Tests)
+	assert.Equal(t, len(report.Errors), suites.Suites[0].Failures)
+	assert.Contains(t, buf.String(), `type="no-parameter-in-path"`)
+	assert.Contains(t, buf.String(), filepath.Join(fixtureBase, "invalid.yaml")+":6:3: path param")
+
+	buf.Reset()
+	require.NoError(t, report.WriteSARIF(&buf, "dev"))
+	var log sarif.Log
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Results, len(report.Errors)+len(report.Warnings))
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, 7)
+	for _, result := range log.Runs[0].Results {
+		require.Len(t, result.Locations, 1)
+		require.NotNil(t, result.Locations[0].PhysicalLocation.Region)
+		assert.NotZero(t, result.Locations[0].PhysicalLocation.Region.StartLine)
+	}
+}
+
+func TestWriteJUnit_Valid(t *testing.T) {
+	report := &Report{Spec: "valid.yaml", Version: "2.0", Valid: true}
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteJUnit(&buf))
+	assert.Contains(t, buf.String(), `<testcase name="valid" classname="valid.yaml"></testcase>`)
+}
@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/sarif"
+)
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as a JUnit XML test suite.
+//
+// Errors are reported as failed test cases and warnings as passed test cases with some output.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{Name: "swagger validate " + r.Spec}
+	for _, issue := range r.Errors {
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      testCaseName(issue),
+			ClassName: issue.File,
+			Failure: &junitFailure{
+				Message: issue.Message,
+				Type:    issue.Code,
+				Text:    issue.location() + ": " + issue.Message,
+			},
+		})
+	}
+	for _, issue := range r.Warnings {
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      testCaseName(issue),
+			ClassName: issue.File,
+			SystemOut: issue.location() + ": warning: " + issue.Message,
+		})
+	}
+	if len(suite.Cases) == 0 {
+		suite.Cases = append(suite.Cases, junitTestCase{Name: "valid", ClassName: r.Spec})
+	}
+	suite.Tests = len(suite.Cases)
+	suite.Failures = len(r.Errors)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func testCaseName(issue Issue) string {
+	if issue.Pointer == "" {
+		return issue.Code
+	}
+	return issue.Code + " " + issue.Pointer
+}
+
+// location formats the position of an issue as file:line:column
+func (i Issue) location() string {
+	if i.Line == 0 {
+		return i.File
+	}
+	return fmt.Sprintf("%s:%d:%d", i.File, i.Line, i.Column)
+}
+
+// WriteSARIF writes the report as a SARIF log
+func (r *Report) WriteSARIF(w io.Writer, version string) error {
+	all := make([]Issue, 0, len(r.Errors)+len(r.Warnings))
+	all = append(all, r.Errors...)
+	all = append(all, r.Warnings...)
+
+	codes := make(map[string]bool)
+	results := make([]sarif.Result, 0, len(all))
+	for _, issue := range all {
+		codes[issue.Code] = true
+		level := sarif.LevelError
+		if issue.Severity == SeverityWarning {
+			level = sarif.LevelWarning
+		}
+		results = append(results, sarif.Result{
+			RuleID:    issue.Code,
+			Level:     level,
+			Message:   sarif.Message{Text: issue.Message},
+			Locations: []sarif.Location{sarif.NewLocation(filepath.ToSlash(issue.File), issue.Pointer, issue.Line, issue.Column)},
+		})
+	}
+
+	rules := make([]sarif.ReportingDescriptor, 0, len(codes))
+	for _, code := range sortedKeys(codes) {
+		rules = append(rules, sarif.ReportingDescriptor{ID: code})
+	}
+
+	return sarif.New(version, rules, results).Write(w)
+}
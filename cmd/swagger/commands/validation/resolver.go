@@ -0,0 +1,371 @@
+package validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+// resolver finds the JSON pointer of the constructs mentioned in validation messages
+type resolver struct {
+	doc *loads.Document
+	raw interface{}
+}
+
+func newResolver(doc *loads.Document) *resolver {
+	r := &resolver{doc: doc}
+	_ = json.Unmarshal(doc.Raw(), &r.raw)
+	return r
+}
+
+// within appends the tokens which exist in the document to a pointer
+func (r *resolver) within(ptr string, tokens ...string) string {
+	if ptr == "" {
+		return ""
+	}
+	node, ok := r.get(ptr)
+	if !ok {
+		return ptr
+	}
+	for _, token := range tokens {
+		next, ok := child(node, token)
+		if !ok {
+			break
+		}
+		node = next
+		ptr += "/" + jsonpointer.Escape(token)
+	}
+	return ptr
+}
+
+// get returns the value at a pointer in the raw document
+func (r *resolver) get(ptr string) (interface{}, bool) {
+	node := r.raw
+	for _, token := range strings.Split(strings.TrimPrefix(ptr, "#"), "/")[1:] {
+		next, ok := child(node, unescape(token))
+		if !ok {
+			return nil, false
+		}
+		node = next
+	}
+	return node, true
+}
+
+func child(node interface{}, token string) (interface{}, bool) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		value, ok := n[token]
+		return value, ok
+	case []interface{}:
+		i, err := strconv.Atoi(token)
+		if err != nil || i < 0 || i >= len(n) {
+			return nil, false
+		}
+		return n[i], true
+	default:
+		return nil, false
+	}
+}
+
+func unescape(token string) string {
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
+
+// dotted resolves a dotted location (e.g. paths./pets.get.parameters) from the root of the document.
+//
+// Since keys may contain dots, the longest matching key is tried first.
+func (r *resolver) dotted(name string) string {
+	ptr := r.dottedFrom("#", r.raw, strings.Split(name, "."))
+	if ptr == "#" {
+		return ""
+	}
+	return ptr
+}
+
+// dottedFrom resolves as many dotted tokens as possible from a node.
+//
+// Like in validation messages, the schema of parameters and responses, and the properties
+// keyword of schemas may be omitted from the dotted location.
+func (r *resolver) dottedFrom(ptr string, node interface{}, tokens []string) string {
+	for len(tokens) > 0 {
+		matched := 0
+		for j := len(tokens); j > 0; j-- {
+			if next, ok := child(node, strings.Join(tokens[:j], ".")); ok {
+				ptr += "/" + jsonpointer.Escape(strings.Join(tokens[:j], "."))
+				node = next
+				matched = j
+				break
+			}
+		}
+		if matched > 0 {
+			tokens = tokens[matched:]
+			continue
+		}
+
+		if schema, ok := child(node, "schema"); ok {
+			ptr += "/schema"
+			node = schema
+			continue
+		}
+		if properties, ok := child(node, "properties"); ok {
+			if _, ok := child(properties, tokens[0]); ok {
+				ptr += "/properties"
+				node = properties
+				continue
+			}
+		}
+		break
+	}
+	return ptr
+}
+
+// relative resolves a dotted location relative to a pointer, or to its parent
+func (r *resolver) relative(base, name string) string {
+	if base == "" {
+		return ""
+	}
+	tokens := strings.Split(name, ".")
+	for _, ptr := range []string{base, base[:strings.LastIndex(base, "/")]} {
+		node, ok := r.get(ptr)
+		if !ok {
+			continue
+		}
+		if resolved := r.dottedFrom(ptr, node, tokens); resolved != ptr {
+			return resolved
+		}
+	}
+	return ""
+}
+
+// located resolves a dotted location, or a location relative to a parameter named after its first token
+func (r *resolver) located(name string) string {
+	if ptr := r.dotted(name); ptr != "" {
+		return ptr
+	}
+	tokens := strings.Split(name, ".")
+	ptr := r.parameter("", tokens[0], "")
+	if ptr == "" {
+		return ""
+	}
+	node, _ := r.get(ptr)
+	if schema, ok := child(node, "schema"); ok {
+		return r.dottedFrom(ptr+"/schema", schema, tokens[1:])
+	}
+	return r.dottedFrom(ptr, node, tokens[1:])
+}
+
+func pointer(tokens ...string) string {
+	escaped := make([]string, 0, len(tokens)+1)
+	escaped = append(escaped, "#")
+	for _, token := range tokens {
+		escaped = append(escaped, jsonpointer.Escape(token))
+	}
+	return strings.Join(escaped, "/")
+}
+
+// definition locates a definition, by name or by $ref
+func (r *resolver) definition(name string) string {
+	name = strings.TrimPrefix(name, "#/definitions/")
+	if _, ok := r.doc.Spec().Definitions[name]; !ok {
+		return ""
+	}
+	return pointer("definitions", name)
+}
+
+// reference locates a local $ref target, e.g. #/parameters/limit
+func (r *resolver) reference(ref string) string {
+	if !strings.HasPrefix(ref, "#/") {
+		return ""
+	}
+	if _, ok := r.get(ref); !ok {
+		return ""
+	}
+	return ref
+}
+
+// path locates a path item
+func (r *resolver) path(path string) string {
+	if _, ok := r.get(pointer("paths", path)); !ok {
+		return ""
+	}
+	return pointer("paths", path)
+}
+
+// pathWith locates the first path item which contains some path parameter
+func (r *resolver) pathWith(param string) string {
+	for _, path := range r.sortedPaths() {
+		if strings.Contains(path, param) {
+			return pointer("paths", path)
+		}
+	}
+	return ""
+}
+
+// operation locates an operation by ID. When the ID is not unique, the first operation is located.
+func (r *resolver) operation(operationID string) string {
+	if operationID == "" || r.doc.Spec().Paths == nil {
+		return ""
+	}
+	for _, path := range r.sortedPaths() {
+		item := r.doc.Spec().Paths.Paths[path]
+		for _, method := range methods {
+			if op := operationFor(&item, method); op != nil && op.ID == operationID {
+				return pointer("paths", path, method)
+			}
+		}
+	}
+	return ""
+}
+
+// response locates a response of an operation, named like "response 200" or "default response"
+func (r *resolver) response(operationID, response string) string {
+	ptr := r.operation(operationID)
+	if response == "default response" {
+		return r.within(ptr, "responses", "default")
+	}
+	return r.within(ptr, "responses", strings.TrimPrefix(response, "response "))
+}
+
+// parameter locates a parameter by name and location, in an operation or anywhere in the spec
+func (r *resolver) parameter(operationID, name, in string) string {
+	matches := func(param spec.Parameter) bool {
+		return param.Name == name && (in == "" || param.In == in)
+	}
+	find := func(base string, params []spec.Parameter) string {
+		for i, param := range params {
+			if matches(param) {
+				return base + "/" + strconv.Itoa(i)
+			}
+		}
+		return ""
+	}
+
+	sw := r.doc.Spec()
+	if sw.Paths != nil {
+		for _, path := range r.sortedPaths() {
+			item := sw.Paths.Paths[path]
+			for _, method := range methods {
+				op := operationFor(&item, method)
+				if op == nil || (operationID != "" && op.ID != operationID) {
+					continue
+				}
+				if ptr := find(pointer("paths", path, method, "parameters"), op.Parameters); ptr != "" {
+					return ptr
+				}
+				if ptr := find(pointer("paths", path, "parameters"), item.Parameters); ptr != "" {
+					return ptr
+				}
+			}
+		}
+	}
+	if operationID != "" {
+		return r.operation(operationID)
+	}
+	for _, key := range sortedKeys(sw.Parameters) {
+		if matches(sw.Parameters[key]) {
+			return pointer("parameters", key)
+		}
+	}
+	return ""
+}
+
+// pathParameter locates a parameter of the operations of a path
+func (r *resolver) pathParameter(path, name string) string {
+	sw := r.doc.Spec()
+	if sw.Paths == nil {
+		return ""
+	}
+	item, ok := sw.Paths.Paths[path]
+	if !ok {
+		return ""
+	}
+	for _, method := range methods {
+		op := operationFor(&item, method)
+		if op == nil {
+			continue
+		}
+		for i, param := range op.Parameters {
+			if param.Name == name {
+				return pointer("paths", path, method, "parameters", strconv.Itoa(i))
+			}
+		}
+	}
+	return pointer("paths", path)
+}
+
+// referenceSite locates the first $ref with some value
+func (r *resolver) referenceSite(ref string) string {
+	var found string
+	var walk func(string, interface{}) bool
+	walk = func(ptr string, node interface{}) bool {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			if value, ok := n["$ref"].(string); ok && value == ref {
+				found = ptr
+				return true
+			}
+			for _, key := range sortedKeys(n) {
+				if walk(ptr+"/"+jsonpointer.Escape(key), n[key]) {
+					return true
+				}
+			}
+		case []interface{}:
+			for i, item := range n {
+				if walk(ptr+"/"+strconv.Itoa(i), item) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	walk("#", r.raw)
+	return found
+}
+
+func (r *resolver) sortedPaths() []string {
+	if r.doc.Spec().Paths == nil {
+		return nil
+	}
+	return sortedKeys(r.doc.Spec().Paths.Paths)
+}
+
+// methods in the order they are declared in a path item
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+func operationFor(item *spec.PathItem, method string) *spec.Operation {
+	switch method {
+	case "get":
+		return item.Get
+	case "put":
+		return item.Put
+	case "post":
+		return item.Post
+	case "delete":
+		return item.Delete
+	case "options":
+		return item.Options
+	case "head":
+		return item.Head
+	case "patch":
+		return item.Patch
+	default:
+		return nil
+	}
+}
+
+// sortedKeys returns the sorted keys of a map with string keys
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	sorted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, key.String())
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -69,6 +69,11 @@ It aims to represent the contract of your API with a language agnostic descripti
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("lint", "lint the swagger document", "check the provided swagger document against style rules configured with a YAML ruleset", &commands.LintSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	_, err = parser.AddCommand("init", "initialize a spec document", "initialize a swagger spec document", &commands.InitCmd{})
 	if err != nil {
 		log.Fatal(err)
@@ -94,16 +99,31 @@ It aims to represent the contract of your API with a language agnostic descripti
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("split", "split a swagger document into files", "write each definition, shared parameter, shared response and path item of a spec to its own file, referenced with $ref", &commands.SplitSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	_, err = parser.AddCommand("mixin", "merge swagger documents", "merge additional specs into first/primary spec by copying their paths and definitions", &commands.MixinSpec{})
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("convert", "convert a swagger document to OpenAPI 3.0 and back", "convert a swagger 2.0 document to OpenAPI 3.0, or an OpenAPI 3.0 document to swagger 2.0, reporting lossy conversions", &commands.ConvertSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	_, err = parser.AddCommand("diff", "diff swagger documents", "diff specs showing which changes will break existing clients", &commands.DiffCommand{})
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("changelog", "write release notes of the changes between swagger documents", "write the changes between two versions of a spec as a markdown changelog, grouped by tag and by added, changed, deprecated and removed changes", &commands.ChangelogCommand{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	genpar, err := parser.AddCommand("generate", "generate go code", "generate go code for the swagger spec file", &commands.Generate{})
 	if err != nil {
 		log.Fatalln(err)
@@ -116,6 +136,9 @@ It aims to represent the contract of your API with a language agnostic descripti
 		case "client":
 			cmd.ShortDescription = "generate all the files for a client library"
 			cmd.LongDescription = cmd.ShortDescription
+		case "cli":
+			cmd.ShortDescription = "generate a command line client, with one command per operation, on top of a client library"
+			cmd.LongDescription = cmd.ShortDescription
 		case "server":
 			cmd.ShortDescription = "generate all the files for a server application"
 			cmd.LongDescription = cmd.ShortDescription
@@ -128,6 +151,9 @@ It aims to represent the contract of your API with a language agnostic descripti
 		case "operation":
 			cmd.ShortDescription = "generate one or more server operations from the swagger spec"
 			cmd.LongDescription = cmd.ShortDescription
+		case "markdown":
+			cmd.ShortDescription = "generate a markdown API reference documentation from the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
 		}
 	}
 
@@ -53,7 +53,7 @@ func (o *AddOrderParams) BindRequest(r *http.Request, route *middleware.MatchedR
 		var body models.Order
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			if err == io.EOF {
-				res = append(res, errors.Required("order", "body"))
+				res = append(res, errors.Required("order", "body", ""))
 			} else {
 				res = append(res, errors.NewParseError("order", "body", "", err))
 			}
@@ -68,7 +68,7 @@ func (o *AddOrderParams) BindRequest(r *http.Request, route *middleware.MatchedR
 			}
 		}
 	} else {
-		res = append(res, errors.Required("order", "body"))
+		res = append(res, errors.Required("order", "body", ""))
 	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
@@ -0,0 +1 @@
+console.log("custom documentation ui");
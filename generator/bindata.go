@@ -1,26 +1,41 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
+// templates/cli/cli.gotmpl (6.622kB)
+// templates/cli/completion.gotmpl (1.574kB)
+// templates/cli/main.gotmpl (523B)
+// templates/cli/models.gotmpl (5.757kB)
+// templates/cli/operation.gotmpl (8.548kB)
 // templates/client/client.gotmpl (5.125kB)
 // templates/client/facade.gotmpl (3.83kB)
-// templates/client/parameter.gotmpl (12.261kB)
-// templates/client/response.gotmpl (6.273kB)
+// templates/client/mock.gotmpl (5.067kB)
+// templates/client/parameter.gotmpl (13.457kB)
+// templates/client/response.gotmpl (6.843kB)
 // templates/contrib/stratoscale/client/client.gotmpl (3.591kB)
 // templates/contrib/stratoscale/client/facade.gotmpl (2.078kB)
 // templates/contrib/stratoscale/server/configureapi.gotmpl (5.947kB)
 // templates/contrib/stratoscale/server/server.gotmpl (236B)
 // templates/docstring.gotmpl (270B)
+// templates/easyjsonsupport.gotmpl (5.856kB)
+// templates/easyjsontest.gotmpl (2.124kB)
 // templates/header.gotmpl (432B)
+// templates/markdown/index.gotmpl (2.512kB)
+// templates/markdown/models.gotmpl (2.095kB)
+// templates/markdown/operations.gotmpl (2.273kB)
+// templates/markdown/shared.gotmpl (3.192kB)
 // templates/model.gotmpl (700B)
 // templates/modelvalidator.gotmpl (370B)
-// templates/schema.gotmpl (5.422kB)
+// templates/schema.gotmpl (7.523kB)
 // templates/schemabody.gotmpl (14.007kB)
-// templates/schemapolymorphic.gotmpl (2.061kB)
+// templates/schemapolymorphic.gotmpl (2.616kB)
+// templates/schemadeepcopy.gotmpl (19.388kB)
 // templates/schematype.gotmpl (965B)
-// templates/schemavalidator.gotmpl (31.954kB)
+// templates/schemavalidator.gotmpl (42.69kB)
 // templates/serializers/additionalpropertiesserializer.gotmpl (2.824kB)
 // templates/serializers/aliasedserializer.gotmpl (480B)
-// templates/serializers/allofserializer.gotmpl (7.467kB)
+// templates/serializers/allofserializer.gotmpl (7.907kB)
 // templates/serializers/basetypeserializer.gotmpl (2.894kB)
+// templates/serializers/defaultsserializer.gotmpl (586B)
+// templates/serializers/easyjsonserializer.gotmpl (24.997kB)
 // templates/serializers/marshalbinaryserializer.gotmpl (550B)
 // templates/serializers/schemaserializer.gotmpl (679B)
 // templates/serializers/subtypeserializer.gotmpl (6.461kB)
@@ -30,8 +45,8 @@
 // templates/server/doc.gotmpl (1.52kB)
 // templates/server/main.gotmpl (5.965kB)
 // templates/server/operation.gotmpl (3.64kB)
-// templates/server/parameter.gotmpl (28.55kB)
-// templates/server/responses.gotmpl (11.271kB)
+// templates/server/parameter.gotmpl (30.184kB)
+// templates/server/responses.gotmpl (11.825kB)
 // templates/server/server.gotmpl (23.049kB)
 // templates/server/urlbuilder.gotmpl (7.641kB)
 // templates/structfield.gotmpl (1.91kB)
@@ -107,6 +122,106 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _templatesCliCliGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5d\x6f\xdc\xb8\xd5\xbe\xd7\xaf\x38\xaf\xf0\x6e\x20\x2d\x64\xa9\xdd\xde\x39\x30\xb0\x8e\x9d\x64\xa7\xcd\xc6\x46\xc6\xd9\x5e\x2c\x16\x0b\x8e\x74\x34\x62\x2d\x91\x0a\x49\xd9\x9d\x1a\xfa\xef\xc5\x21\x29\x8d\x34\x5f\xb6\x73\xd3\x9b\x19\x50\x3c\x7c\xce\xf7\xc3\x8f\x2c\x83\x2b\x59\x20\xac\x51\xa0\x62\x06\x0b\x58\x6d\x60\x2d\xcf\xf4\x23\x5b\xaf\x51\xbd\x85\xeb\x1b\xf8\x7c\x73\x07\xef\xaf\x17\x77\x69\x10\x04\x4f\x4f\xc0\x4b\x48\xaf\x64\xbb\x51\x7c\x5d\x19\x38\xeb\xfb\x2c\x83\xa7\x27\xc8\x65\xd3\xa0\x30\x3b\x73\x4f\x4f\x80\xa2\x80\xbe\x0f\x82\xa0\x65\xf9\x3d\x5b\x23\x09\x1b\x79\xeb\x06\x9f\x59\x83\x90\x7e\x44\x71\xd3\x1a\x9d\x5e\x7d\x5a\xf8\xef\x76\x45\x96\xc1\x5d\xc5\x35\x94\xbc\x46\x78\x64\x7a\x6e\xa5\xa9\x10\xbc\x99\x60\xa4\xac\xd3\x20\xcb\xe0\x7d\xc1\x0d\x17\x6b\x30\xe3\xba\xc6\x9a\xd9\x2a\xf9\x80\x50\x76\xc6\x42\x55\x28\x60\x23\x3b\x50\x78\xa6\x3a\x31\x43\x1a\x54\x58\x7f\x98\x28\x82\x80\x37\xad\x54\x06\xa2\x00\x20\x44\x91\xcb\x82\x8b\x75\xf6\x2f\x2d\x45\x48\x5f\xca\xc6\xd8\x7f\x2e\x33\x2e\x09\xde\x8e\xb4\x51\x5c\xac\x75\x18\xd0\x60\xcd\x4d\xd5\xad\xd2\x5c\x36\xd9\x5a\x9e\xc9\x16\x05\x6b\x79\xa6\x3a\x61\x78\x83\x24\x5e\x19\xd3\x1a\xc5\x84\xb6\x8a\x4e\xcb\x67\x79\xcd\x51\x98\xf0\x38\xb0\x36\xaa\x6c\xf6\x04\x74\x5b\xfe\xf5\x6f\x59\x2e\x57\x8a\xb9\xb5\xb2\xbd\x5f\xa7\x5c\x64\x1b\xd6\xd4\xe9\xc3\x4f\xd6\x54\xca\xae\xf5\x56\x43\x7a\x8d\x25\xeb\x6a\xb3\xf0\xe3\xbe\xdf\x99\x9f\x4c\xc4\x01\x85\xfe\x57\x76\x8f\x5f\xa4\x34\x57\x4d\x01\xab\x8e\xd7\x85\xb6\x81\x55\x52\x9a\x21\x98\x20\x4b\xc2\x98\xe6\xfb\xb2\x6d\x6d\x0d\xf4\x7d\x02\x8f\xdc\x54\x20\xc5\x18\x7b\x68\x29\x21\x4a\x76\x2d\x2d\x94\x2d\xe5\x9e\x4b\xa1\x83\xb2\x13\xf9\x54\x5f\x14\xc3\x8f\xd6\xb5\xf4\xca\xaf\x7c\x0a\x00\x94\x37\xe6\xfc\x02\xde\xcc\x66\x69\x12\xe0\xab\xc6\x73\xb2\xa6\x55\x5c\x98\x12\xc2\x1f\xbe\x85\xc7\x2c\xb3\xf2\xcb\x4a\x2a\x63\x57\xf0\x12\xc8\xba\x74\x21\x4a\xe9\x7e\xd3\x3b\x6e\x6a\x84\xbe\xa7\x46\xa8\xf9\x57\x4d\x25\xbc\x37\x83\xb5\xde\x95\x89\xac\x76\x08\x07\xbb\x6b\x4e\xee\xdb\x14\x43\x29\x15\x84\x10\x55\x5d\xc3\x04\xff\x0f\x42\x4a\xe6\xc4\xb1\xc7\xb2\x5d\x95\xd8\xa4\x9c\x1d\xb2\xe8\x1a\x75\xae\x78\x6b\xb8\x14\xd4\x4c\xe4\xc1\x27\x29\xd6\xe7\x30\x55\x7f\x48\x74\xc0\xf4\x7d\x7b\x54\xc1\x6f\xa8\xf4\x04\xdc\x0f\xf7\x43\xba\x23\xbc\x07\x0f\xb0\xe4\x35\x8a\x1c\xad\x49\xe7\x60\x54\x87\x24\xd4\x53\x45\x66\x19\x94\x35\x5b\x6b\x30\x12\x14\xb2\xbc\xb2\x35\x75\x79\xbb\xd8\xe6\x37\xbd\x25\x6c\x6d\x50\x98\x0f\x24\x1a\xc5\xe9\xd2\xb6\x5f\x14\x56\x52\x9b\x30\x21\x8b\xd2\x2d\xaf\x0c\x95\xfd\x8b\xd4\x26\x81\x90\xf0\x48\x8e\x4a\xcc\x63\x87\xf1\x8b\xd0\x57\x4c\xe3\x59\xcb\x4c\x75\x54\xc5\x3b\xa6\xf1\x96\x99\xca\xab\xa1\x05\x40\x0b\x5e\xab\x6b\x59\xf3\x1c\xa3\x50\xe7\x15\x36\x78\x54\xdb\xd2\x4e\x6b\xaf\xcc\x09\x6b\xe8\x34\x16\x7b\xd1\x7b\x46\xeb\x3b\x29\xeb\x28\x2c\x70\xd5\xad\xc3\x04\x4a\x56\x6b\x4c\x20\x74\xa5\x4a\x08\x0a\xbf\x75\xa8\x8d\x06\x4d\x75\x6a\xe4\x00\x6b\x6b\xc4\x54\xc8\x15\x28\xd4\xad\x14\x1a\xf5\x8b\x1c\xbc\x8d\x42\xd9\x99\xb6\xa3\x6c\x85\x92\x7e\x2c\xc1\x7a\x57\x4a\xa9\x1a\x36\x26\x68\x44\x3e\x07\x12\x02\xa9\x80\x38\xcc\xe9\xc1\x35\xc5\x4f\x5d\x76\xa6\x72\x21\xf4\x9a\xe3\xc0\x97\x9d\x62\x82\x5a\xf3\x66\x20\x93\x8f\x44\x2f\x9e\xde\x06\x2b\x2f\x8b\xc2\xf7\x63\xd4\xb0\x7b\xb4\x22\x54\xd5\x4c\xe7\xac\x1e\x3b\x11\xfa\xde\x52\x4f\xbc\x5b\xd0\x47\x60\xae\x64\xd3\xd6\x48\x3a\xfd\x32\x12\x45\xd3\x29\x31\x84\x27\xe8\x69\x7f\x3d\x6e\x61\x96\xc1\xb3\xf6\x78\x48\xc7\xbd\x03\x8f\xae\xc9\x03\xb7\x2d\xda\xed\x77\x4e\x29\xd0\xf7\x7b\xe4\xfa\x02\xbf\x0f\x51\x6e\xfe\x3a\xba\x8d\x0a\xa6\x2b\x54\x23\x74\x7c\x80\x6c\xb7\x54\x29\x15\xa4\xcb\xae\x69\x98\xda\x8c\xbc\x39\x46\x49\x03\x5b\xc9\xce\x1c\x22\xcc\x78\x4e\x94\x2f\x64\xc6\x67\x48\xb1\x3f\x52\x4f\xbe\x94\xf2\xfd\xfc\x8f\x22\x2f\x2e\xa5\x6d\x81\xe4\x43\x71\xf8\x99\x2c\xdb\x2f\xf5\xf1\x8b\xcb\xbd\xa3\xcd\x4a\xd6\xc5\x90\xf8\x5c\x61\x81\xc2\x70\x56\xef\x35\xae\x4b\xfa\x1e\x64\x44\xf9\x9c\xa7\x39\x86\x27\x6f\xe4\xff\xaf\x98\xe6\x39\xa5\xdb\x12\x04\xf4\x3b\x21\x59\x62\xde\x29\x6e\x36\xd7\x58\x72\xc1\x27\xb1\xd9\x66\x62\xa1\xdf\x11\x06\xe9\x1b\xa6\xc6\x49\x21\xcd\xa0\x62\x9c\x9a\x29\xbe\xb0\x3b\xc5\x36\xdc\xc7\xb8\x25\x0a\x3b\x8d\x4a\x30\xcb\x9c\x61\x98\xec\x15\xa1\x2f\x25\x0a\x05\x49\x02\x89\xba\x00\xd9\x03\x89\xb3\x81\x75\xa6\xa2\xe0\xe5\x36\x85\x10\x85\x90\x2e\xae\x21\x8c\x43\xaa\xae\xf8\x59\x13\x5a\xa6\xf5\xa3\x54\xc5\xf3\x26\x0c\x92\xaf\xb7\x00\x60\x56\x3d\xe3\x90\x92\xe3\xa2\x7d\x79\xbb\xf8\x07\x6e\xb6\xe1\x3e\x69\xf3\x89\x5e\x5d\x5c\x93\xce\x67\x5d\xb9\xbc\x5d\xc0\x3d\x6e\x9c\x27\xdc\x9d\xb3\x43\x5f\xf1\x21\x50\x04\x05\x84\x07\x1d\xd9\xb1\xfb\x86\x4c\xfe\xe9\x3b\x6c\xb6\x83\x3d\xd3\xc3\x33\x23\xef\x51\x84\x2f\x72\xc2\xeb\x5e\x21\x53\xa8\xc0\x2e\x3c\x6e\xf2\x10\xf9\xc9\xc0\xdd\x63\x88\x00\x08\x88\x0e\x43\xff\x54\xdc\xa0\x9a\x9e\x91\x4f\x74\x26\x94\x4a\x36\x93\x86\x7e\xac\x78\x5e\x41\xc5\x1e\x10\x56\x88\x02\x34\x9a\x2d\x63\xcf\x35\x1c\xec\x5e\x7f\x8d\x48\xaf\xec\x19\x73\xc7\x24\x6a\xed\x07\xa6\x6c\xa9\x69\xf8\xfd\x8f\x53\xc2\xc1\x4e\x33\xfe\xef\x49\x80\x97\x30\x74\x3a\xb1\x12\x15\xca\x50\x1e\x9f\xa4\xbc\xef\xda\x09\x13\xc4\x6f\xb7\xb2\xff\x77\x01\x82\xd7\xf0\xe6\xcd\xf8\x29\xbd\xaa\xc8\x7c\xb7\xa7\xc1\xb6\x25\x8f\xa0\x0e\xf3\xf6\x08\x02\x3e\x7a\x17\xc0\xda\x16\x45\x11\xd9\x61\x32\xbf\xe4\xa5\xa3\xcb\xd1\xa8\xf3\x37\x56\x77\x38\x14\x72\x9c\x8c\x5a\x77\x26\xe2\xd8\xef\x3e\x00\xb3\x3a\x3b\xd0\x36\xbb\xed\xce\x4b\xdb\x8e\x87\xbd\x78\xb6\xdd\xe3\xb7\x76\xf5\x36\x5a\xf7\xb8\xd9\x09\xd4\x4b\x3c\xdf\x1a\xb5\xab\x72\xd8\x0a\xf7\xda\x91\x78\x82\x3e\x93\xc2\xa3\xc1\x38\x41\x19\xbc\xf4\x7d\xfb\x32\xcf\x9f\x27\x8d\xf8\xad\x07\xdc\x06\xc3\x8e\xbf\x23\x1c\xef\x2c\xaf\xdc\xd1\xea\xc8\x61\x9c\xf6\xf0\x00\xc3\xd8\xc4\xd6\x28\x5c\xbc\x63\xb8\xb8\x80\xbf\x78\x0b\xfc\xf1\x41\xf0\x7a\xb8\x50\xf9\x2f\xa7\x3a\xfb\x43\x27\xf2\x88\x38\x25\x52\xf8\x6d\x47\xf2\x8b\x3b\xf9\x27\x74\xd8\x00\xf7\xd2\x90\x7e\xb1\xc7\x06\xb5\x89\x01\x95\x92\xca\xab\xa6\xdb\xeb\x9f\x89\x8d\x01\x75\xa3\x3b\x14\xd0\x48\x7b\x01\x6b\x36\x2a\x45\xb3\xf4\x3d\xbd\xdc\x6e\x70\xe8\xf5\x90\x05\x56\x57\xfc\x96\xc0\x87\x56\x1d\x00\x46\x77\x50\x29\xff\xa9\x0f\xb6\xbf\x73\xef\xe3\x09\x15\x3b\x67\x06\x0a\x66\xc3\x8d\x5b\x96\xdf\x45\xbb\x0e\xed\x20\xdd\x46\x3f\xee\xdc\xd5\x0e\x1e\xfe\x12\x72\x4e\x2a\x77\xb6\xa2\xcb\xa8\xfd\xb0\x4b\x37\x1f\xd1\xf8\xaa\x70\x17\xdb\x38\x18\x43\x38\x8b\xcb\xd6\x6d\x0b\xe3\xeb\x67\x35\xde\x45\x4f\x43\x6f\x6f\xb5\xaf\xc3\xf7\xf7\xcd\xd3\xf0\xf3\x8b\xec\xeb\x14\xd8\xeb\xe8\x31\xf8\xe9\x8d\xf5\x35\xb0\x01\xc0\xd8\x8b\x14\xef\x79\x73\x7e\xc6\xc7\x88\x42\x9d\x4c\xa2\xe7\xfd\x8c\xa7\x2b\xd3\x25\x9a\x6b\x32\x2f\xb2\x16\xcc\xe7\xfc\xed\x7c\x52\xdd\x74\x7c\xbb\x38\xb2\x61\x4f\xaf\x85\x3b\xa5\x43\xd6\x8c\xb0\xc9\xd0\x7d\x1e\x3e\x4e\xc8\x31\x5f\xe2\x0a\x59\xf1\xf7\xe5\xcd\x67\xaa\x1c\x0f\xe6\x0e\x1a\xf4\x11\x0a\x99\x77\xf6\xa9\x56\xa3\x01\xe6\x26\x1e\x88\x74\xe8\x86\xcd\xec\x39\x23\xa1\x46\x91\x74\x8b\x67\x85\x6b\x05\xe6\x5f\x61\xe9\xe9\x74\xbb\x80\x6b\xf8\x99\xbe\x0f\xf7\x87\xad\xda\xc8\x09\xb8\x87\xd0\x18\xa2\xdf\xff\x58\x6d\x0c\xce\x0a\x9d\x97\x7e\x5a\xa7\xbf\x30\x7d\xab\xb0\xe4\xff\x76\xcb\x12\x08\x7f\x0e\xe3\x79\xda\xdc\xeb\x6a\xfa\x05\x59\xf1\x81\xd7\x18\x0d\x4b\xef\x14\x6f\xf6\xd7\x0e\xac\xe9\x17\x3b\xed\x4e\x60\x16\x29\xcb\xf5\x5f\x50\x77\xb5\x81\x47\x4a\x82\x0b\x47\xcb\x36\xb5\x64\x85\x0b\xc8\xf0\xe0\x90\x0c\x67\x58\xff\x1e\x41\xe1\xf3\xef\xd0\xee\xf1\xc2\x86\xce\x85\x62\x82\x7b\x80\x17\x92\x51\x01\x17\x06\x55\xc9\x72\x7c\xea\xa7\xf4\xe9\xf0\x4e\xf7\xd2\xf8\x64\xf2\x4c\xbd\x4f\x4a\x7d\xd5\x95\x23\x26\xbd\x9d\xa4\xbf\x32\xa5\x2b\x56\x2f\x04\x5d\x0c\x23\x6f\x94\x3b\xd7\x87\x00\xaf\x40\xd6\x8f\xdc\xe4\x95\xb7\xdb\x4a\xe5\xf4\xd8\x15\x92\x96\xf0\x7c\x1c\xda\x87\x1a\x1a\xba\x73\x66\x21\xf3\x69\x04\x82\xf9\xa6\x40\xc2\xe9\x57\xd1\x38\x1b\x23\x6b\xfc\x9b\x42\xe6\x87\xf7\x82\x99\x4d\xc3\x0e\xc0\xcb\xad\xcf\x1e\xd0\xbb\x1c\xbd\x06\xa8\x70\x3d\x76\x3e\x75\x9e\x7a\xef\x3d\xb1\x76\x19\x85\x9d\xd0\x5d\x4b\xdb\x39\x16\x43\x08\x7c\x8d\xfc\xf0\xed\x9c\x4e\x95\xf3\x97\xaa\xc4\x0b\xc5\x43\xf4\xfe\x1c\x2c\x24\xd0\x0f\xb6\x76\x6a\x41\x75\x93\xde\x74\xe6\x46\x2d\x4d\x21\x3b\x13\xc5\xc9\xd8\x2f\x54\xf4\xcb\x96\xe5\x43\x1b\x50\x70\xdc\x51\x61\x62\x7d\x1f\xfc\x77\x00\x5c\xe0\xde\xc2\xde\x19\x00\x00")
+
+func templatesCliCliGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliCliGotmpl,
+		"templates/cli/cli.gotmpl",
+	)
+}
+
+func templatesCliCliGotmpl() (*asset, error) {
+	bytes, err := templatesCliCliGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/cli.gotmpl", size: 6622, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaa, 0x40, 0x98, 0x6c, 0x51, 0x6b, 0x11, 0x23, 0xf9, 0xb6, 0x6f, 0xc9, 0xb1, 0x32, 0x28, 0xc9, 0xf9, 0x23, 0x5, 0x7, 0xe3, 0xf2, 0xe3, 0xa5, 0xb3, 0xe, 0xa4, 0xb6, 0x32, 0xf3, 0xf9, 0xa5}}
+	return a, nil
+}
+
+var _templatesCliCompletionGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x51\x6f\xdb\x36\x14\x85\xdf\xf9\x2b\x0e\x84\x62\xb0\x06\x5b\x5a\xb0\x37\x6d\x28\xd0\x39\x4e\x61\x20\xa8\x83\x3a\xdd\x43\x0d\x63\xa5\xa5\x2b\x89\x88\x44\x0a\x24\x55\x37\x96\xf5\xdf\x07\x4a\xb2\x1d\xb7\x48\xe2\x3c\x09\x26\xef\xf9\xee\xb9\x87\xd7\x61\x88\xa9\x4a\x08\x19\x49\xd2\xdc\x52\x82\xcd\x23\x32\x35\x31\x5b\x9e\x65\xa4\xff\xc2\xf5\x02\x9f\x16\xf7\x98\x5d\xcf\xef\x03\xc6\x58\xd3\x40\xa4\x08\xa6\xaa\x7a\xd4\x22\xcb\x2d\x26\x6d\x1b\x86\x68\x1a\xc4\xaa\x2c\x49\xda\x9f\xee\x9a\x06\x24\x13\xb4\x2d\x63\xac\xe2\xf1\x03\xcf\xc8\x15\x5b\x75\xd7\xff\xf8\xc4\x4b\x42\xf0\x91\xe4\xa2\xb2\x26\x98\xde\xce\x87\xf3\x4e\x11\x86\xb8\xcf\x85\x41\x2a\x0a\xc2\x96\x9b\x73\x97\x36\x27\x0c\x36\x61\x95\x2a\x02\x16\x86\x98\x25\xc2\x0a\x99\xc1\x1e\x75\x65\x67\xb3\xd2\xea\x3b\x21\xad\x6d\x87\xca\x49\xe2\x51\xd5\xd0\x34\xd1\xb5\x3c\x23\x1d\x5a\x74\xf3\x70\x99\x30\x26\xca\x4a\x69\x8b\x11\x03\xbc\x4c\xd8\xbc\xde\x04\xb1\x2a\x43\x53\xa5\x57\x7f\x86\xb1\xda\x68\xee\x31\x9f\xb9\xe6\x25\x7f\xa0\xa9\x2a\xab\x82\xac\x50\x72\x5a\x26\xd0\x64\x6b\x2d\x4d\xd7\x61\x00\x62\xab\x0f\x16\x09\x26\xa7\xa2\x40\x7c\xd4\xc0\xc4\x5a\x54\x16\x2a\x75\x29\x3d\xcd\xe5\x43\x55\x75\x59\xb5\x2d\x4b\x6b\x19\xff\xda\x6b\xe4\xe3\xf7\xce\x4d\x30\x1d\x1a\x35\x0c\x83\x01\xfc\x76\x76\xe3\x2e\x80\x2f\x86\x22\x78\x4f\x7a\xaf\x36\xdc\xe4\xfb\x9d\xc9\xf7\xa9\x30\xf9\xbe\x52\x5b\xd2\x9d\xc1\xb5\x37\xee\x14\xcb\x5c\x69\x1b\xc1\xfb\x78\xc8\xe8\x85\x11\x06\xc9\xad\x92\x59\x84\x6f\x97\x28\x5e\x1c\x3a\x60\xec\x1f\x6e\xf2\x88\x31\xe0\x1d\x8c\xaa\x75\x4c\xf8\x7b\xf4\x6c\xfd\x53\xbc\x1b\xcb\x67\xec\xeb\x51\x7e\x91\x6a\x67\x72\xbc\x87\xf7\xae\x49\x2b\x6e\xf3\xd5\xd5\xba\x0d\xff\x7b\x56\xe8\x31\x76\x23\xde\xc6\x77\x19\x63\x3f\x8c\xc2\xd8\x9d\x4b\x7b\xe9\x92\xe9\x20\x77\xcb\xf7\x97\x61\x4e\xaf\x84\x3d\x16\xb5\x9d\x2c\xad\x76\xdb\xb5\xc7\x5c\x7e\x57\x0f\x34\x99\xfd\xa8\x34\x19\x23\x94\x64\xdf\xfa\x37\xb9\x16\x86\x6f\x0a\xba\x29\x78\x66\xe6\xf2\x8b\xa1\x5b\x21\x29\x82\xd5\x35\xf5\x05\xff\xf2\x42\x24\x1f\x74\x66\x22\xac\xd6\xa6\xe3\x35\x9e\x4b\xd1\x1b\xc3\xdb\xf5\x1f\x67\xdf\x7d\x4f\xfd\xbd\xb6\x57\xf7\xc2\x7e\xe1\x66\x3f\x78\x6c\x8f\xb8\xd1\x95\xdf\x97\x7c\xae\xe5\x2c\x82\xdb\xe3\x51\x5c\x26\x3f\xed\xed\x18\x5c\x67\xe6\xd8\xd9\x07\x69\xad\x34\xfa\xa5\x05\xcc\x56\xd8\x38\xef\x6a\x56\x7f\xac\x8f\xc7\x31\x37\x84\xde\x64\x34\x1c\x1d\xb7\x3f\x2e\x93\xe0\xb3\x52\x76\xe4\xbb\x3c\xdd\x1e\x9d\xfe\x39\xce\x40\xb0\xa8\xed\x42\x2f\x6d\xa2\x6a\x3b\xf2\xfd\x33\xe2\xee\x75\xe0\xd7\xb7\xf0\x52\xf1\x3a\xf0\x46\xbc\x4c\x1c\x77\x6f\x75\xe0\x26\x94\xf2\xba\xb0\xaf\x30\x4f\xfb\x75\x89\xd7\xb6\x83\xb5\x63\x06\xb4\xac\x65\xff\x0f\x00\x83\xef\x42\xc1\x26\x06\x00\x00")
+
+func templatesCliCompletionGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliCompletionGotmpl,
+		"templates/cli/completion.gotmpl",
+	)
+}
+
+func templatesCliCompletionGotmpl() (*asset, error) {
+	bytes, err := templatesCliCompletionGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/completion.gotmpl", size: 1574, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa5, 0x48, 0x74, 0xcb, 0xc9, 0xff, 0x34, 0xda, 0x9, 0x65, 0xbc, 0x1e, 0xd8, 0xde, 0x51, 0x3d, 0x58, 0x13, 0x1d, 0x58, 0x6c, 0x20, 0xd6, 0xff, 0x7e, 0x74, 0x35, 0xf, 0x7d, 0x9a, 0x42, 0xbd}}
+	return a, nil
+}
+
+var _templatesCliMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x8b\x9c\x40\x10\x85\xef\xf5\x2b\x5e\x3c\xe9\x61\x5a\x72\xcd\xb2\x27\x57\xc2\x40\xb2\xb3\x04\xff\x40\x47\xcb\xb6\x59\xed\x96\xb6\xcc\xcc\x20\xfd\xdf\x83\x9d\xc9\xc0\x84\x1c\xf6\x5a\xef\xd5\xf7\xe0\x2b\x4b\x54\xbe\x63\x18\x76\x1c\xb4\x70\x87\x9f\x57\x18\x7f\x58\xce\xda\x18\x0e\x4f\x78\x39\xe1\xf5\xd4\xa0\x7e\x39\x36\x8a\x88\xb6\x0d\xb6\x87\xaa\xfc\x7c\x0d\xd6\x0c\x82\x43\x8c\x65\x89\x6d\x43\xeb\xa7\x89\x9d\xfc\x93\x6d\x1b\xd8\x75\x88\x91\x88\x66\xdd\xbe\x6b\xc3\x98\xb4\x75\x44\x76\x9a\x7d\x10\xe4\x04\x64\x7e\xc9\x88\xb0\x53\xc4\xbf\xfd\x69\xbd\xea\x89\xa1\xbe\xb2\x3b\xcd\xb2\xa8\xea\xdb\xf1\x76\x47\x8c\xc8\xf6\xb9\x91\xb5\x7b\xd3\x32\x20\x9f\x83\xdd\x77\x1b\x1d\x0c\xcb\x31\x61\x53\x90\x95\x19\xf2\x3b\xf0\x7f\xb0\xa2\x40\x8c\x19\x15\x44\x65\x89\x66\xb0\x0b\x7a\x3b\x32\xce\x7a\x79\x14\x22\x03\xe3\x66\x04\xe2\xfd\xa8\xf6\x7e\xdd\x59\xb1\xce\x40\xee\x7f\x53\x32\x32\x07\xff\x8b\xd1\xaf\x92\x50\x03\x3b\x5c\xfd\x8a\xc0\x87\xb0\xba\x07\xd2\xdf\x89\xa4\x4e\xbb\x8e\xa8\x5f\x5d\x9b\xf4\xe4\x05\x36\xc2\xae\x9a\x43\xc0\x97\xe7\x8f\xaa\x51\xdf\xf5\x3b\xff\xf0\x5e\xaa\xa9\xcb\x0b\x55\x5f\xb8\x5d\x85\xf3\xe2\x29\x71\x3e\x3d\xc3\xd9\x31\x91\x01\xbf\xa8\xfa\x62\x25\xff\x5c\x10\x10\x29\xd2\xef\x01\x00\xb7\x1e\xe5\x38\x0b\x02\x00\x00")
+
+func templatesCliMainGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliMainGotmpl,
+		"templates/cli/main.gotmpl",
+	)
+}
+
+func templatesCliMainGotmpl() (*asset, error) {
+	bytes, err := templatesCliMainGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/main.gotmpl", size: 523, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xec, 0xc4, 0xd6, 0xe1, 0xd, 0xa8, 0xaf, 0x9a, 0x58, 0xf6, 0x7c, 0xc3, 0x8c, 0xa3, 0x40, 0xae, 0x85, 0x31, 0x60, 0xeb, 0xe2, 0x6f, 0xc5, 0x93, 0x1a, 0xa3, 0x90, 0xcc, 0xd3, 0xe9, 0xf9, 0x69}}
+	return a, nil
+}
+
+var _templatesCliModelsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x6f\xdc\x38\x12\xbd\xeb\x57\xd4\x0a\x4e\x20\x25\xdd\xec\x5d\xec\xcd\x41\x1f\x02\xdb\x09\x8c\xdd\xc4\x41\xec\x9c\x06\x83\x01\x2d\x95\x24\xc2\x22\xa9\x90\x6c\xdb\x3d\x82\xfe\xfb\x80\x14\xa9\x96\xda\x6a\x8f\x0d\x67\x72\x18\xcc\xa5\xd1\xfc\x2a\x56\xbd\x7a\xf5\x8a\x6a\xdb\x25\xe4\x58\x30\x81\x10\x67\x35\xfb\x8a\x25\xd3\x06\xd5\x17\x25\x1b\x54\x66\xfb\xa1\xa6\xa5\x8e\xa1\xeb\x22\x00\xbb\x55\x51\x51\x22\x10\xbf\xcc\x50\xf7\x4b\xfd\xe2\xd1\x0d\x13\x39\x1c\xaf\x21\xf6\x27\xfa\x69\x56\x00\x15\x39\x24\x42\x1a\x20\x5f\x91\xe6\x17\xa2\xde\xa6\x7e\x7c\xae\xdf\xd7\x8c\x6a\xcc\x77\x13\x97\x46\x21\xe5\x69\x30\x31\x18\xc9\x6a\x66\xdd\xf9\x9f\xbd\x84\x7c\x94\x57\xdb\x06\xa1\xeb\xda\xd6\xdf\xbb\x3e\xb0\x61\x64\x04\x6b\x8d\xc1\x1d\x72\xae\xdf\x2b\x45\xb7\x40\xce\x0d\x72\x1d\x6e\xb7\xff\xc3\x52\xba\x1b\x9f\x6c\xb4\x91\xfc\x83\x54\x9c\x1a\x83\x6a\x7f\x77\x08\x61\xe2\x4e\x7c\x69\x14\x13\xe5\x65\xcd\x32\x8c\x0f\x3b\xe2\x4d\xed\xae\x7c\x70\xd9\x9c\xd5\x7d\x83\x22\x0f\x13\x33\x43\x56\xf8\xe3\x6e\x2e\xe3\x39\xb1\x40\xea\x24\x25\x83\xe1\xae\x4b\x1a\x85\x05\xbb\x7f\xdb\xb6\xd0\x28\x26\x4c\x01\xf1\xab\xef\x31\x24\x6e\x00\x31\x89\x21\xc9\xa9\xae\x50\xb1\xdf\x11\xc8\x67\xca\x31\xb5\x01\x2f\xa0\x6d\x03\xf2\xa7\x58\xd0\x4d\x6d\xbc\x49\x12\x86\xbb\x4d\xdf\x34\x2d\x11\x12\xa9\x80\x9c\xa2\xce\x14\x6b\x0c\x93\x02\xc8\x15\x33\x35\x42\x52\x6d\x38\x15\x53\xf3\xe9\xc3\x98\x46\x83\xd1\xdf\xe8\x01\x93\x8d\x62\x78\x8b\xff\x30\xf9\xef\xc4\xe4\xa3\x46\x32\x61\xdd\x3e\x5e\x87\xd8\x3f\x6f\xea\x9a\x5e\xd7\xb8\x7f\x7d\x18\x7e\xa2\xcd\x6e\x70\x6e\x0f\x17\x34\xc3\x74\x26\xaf\xbd\x63\x09\x7e\x0f\xff\x66\xd2\x90\xfa\x83\xac\x80\xa2\xa6\xa5\xe5\x48\x5f\x37\xf0\x16\x9e\x59\x39\xef\x26\x95\x78\x52\x59\x69\xcd\x13\x6b\x35\x85\xd6\xf9\x76\x4b\xeb\x0d\x2e\x00\x95\x8b\x77\xbc\xfb\x23\x9a\x01\xc9\xae\xeb\x0f\xb9\x23\xac\x70\xdb\xff\xb5\x06\xc1\x6a\x6f\x06\x40\xa1\xd9\x28\x01\x05\xad\x75\x6f\xcf\xcd\xdb\x38\x00\xb8\x15\x81\x86\xea\x8c\xd6\x83\x7f\xd0\x75\xb0\xb6\xf1\xb0\x62\x07\x79\xd7\xbd\x6e\x5b\x9f\x10\xe7\x99\x3b\x9e\xf5\x7e\xc3\x1a\x8c\xda\x60\x14\xac\x8e\xb9\x31\x00\x3a\xc3\xa3\x9f\x87\xa3\x3e\x04\xe4\xc8\xa9\x17\x01\xe9\x30\xb1\x89\xe2\xf4\x06\x93\xb6\x1d\x55\xee\x02\xfe\xbd\x80\x1a\x45\xe2\xf6\xe8\xb4\x57\xb5\x42\x2a\xf8\x6d\x01\x06\xef\x8d\x3d\xd6\x2b\x52\xbf\x63\xb8\xf0\x96\x2a\x60\x06\xb9\x4d\x86\xaf\xd2\x7d\x3d\xf0\x9e\x1e\xaf\xdd\x46\xf2\x4d\x70\xaa\x74\x45\xeb\x2b\xbc\x37\xc9\x2f\xbf\x5e\x6f\x0d\x26\xf6\x8e\x34\x7d\x37\x17\xd2\x7e\x50\x05\x37\xe4\x4c\x29\xa9\x8a\x24\x66\xe2\x96\xd6\x2c\xef\x01\x74\xfe\x5a\x80\x60\xb9\x7c\xa5\x8f\xe1\xd5\x6d\xbc\x70\xc9\x73\xc0\xa6\xde\x5c\xf0\xaa\x3f\xb2\x06\xda\x34\x28\xf2\xc4\x53\xd9\x7a\x98\x3e\x89\x7c\x2f\x62\xd8\xcf\x24\x97\x85\xf6\x71\x6a\xbd\x90\x55\xca\x63\x39\x61\xd4\xd8\xda\xb1\x47\xeb\xd9\xa9\xff\x81\x89\xff\xcb\xb5\x24\xf4\x81\xd1\x60\xef\xef\xa8\xf9\x7f\x92\x39\xd6\xfb\x1d\xff\xc8\x6c\x1b\xd4\x96\x0c\xc4\x82\xe8\x1b\xbe\x5d\xb9\x63\xa6\x02\x72\x99\x55\xc8\xa9\x9d\x8d\x56\x2b\x50\xfe\x25\x3c\x1b\xd0\xce\xfe\xb0\x4f\x83\xa9\xd0\x95\x83\x06\x8d\xc6\x30\x51\xba\x99\x66\xf7\xc0\x90\x05\x50\xcb\xbf\x01\x16\x6e\xcd\x2c\xec\x6d\x77\x15\xcb\x2a\xa0\x0a\x41\x50\x8e\x39\xd0\xc2\x2a\x2e\xf5\xb4\x5d\x00\x92\x92\xf8\x01\xf1\x16\xb7\x51\xb1\x11\xd9\x53\xfd\x4c\x32\x9e\xc3\x9b\x4c\x5e\x2b\x4a\x4e\x24\xe7\x54\xe4\x0b\x6f\x10\xb4\xab\x99\xc0\xe7\xd1\xe3\xe8\x7d\x5d\x5f\x14\x0f\x5e\x2f\xb6\xc5\x0a\x29\xb6\x5c\x6e\x86\x47\x53\x58\x36\xc8\x9b\x9a\x9a\x47\x3f\x26\xc8\xd4\x62\xa8\xe1\x4c\x0a\x43\x99\xd0\x21\x4f\x49\xae\x64\xf3\x85\x66\x37\xf6\xc9\xe8\x89\xef\x9b\xef\x6c\xcc\x87\xf6\x4f\x31\x08\x41\x07\xb9\x1a\x71\xe8\xc0\xf0\xc9\x21\x75\xd1\xe4\xbc\x4d\xab\xf2\x8f\xd0\x69\x72\x7a\xa2\x3d\xcc\x91\xe5\x8d\x3e\x48\x9a\xe9\xa9\x9e\x3b\x50\x28\xc9\x47\xc4\xeb\x69\x54\xd1\x5b\x84\x6b\x44\x61\x0d\x3a\x7a\xd9\xd7\x8d\xc1\xba\xd6\x16\x68\x53\xa1\x42\xb8\xb3\x3f\x54\x0c\x2c\x7a\x8e\xa3\x7f\x4e\xa6\x05\x70\x78\x63\xbd\x76\x87\x2c\x4d\xc8\x63\xa6\x53\x48\xae\xa5\xac\x9d\x9e\x48\xd5\x33\x31\x88\xc1\xf1\xba\x17\xa8\x03\xb5\xfa\xa3\x29\x3b\xfb\xd5\xf0\x72\xca\xb2\x02\xe4\xcd\xd0\x26\x66\xe1\x7e\x1e\x81\x17\xf0\xda\xa9\xed\xcc\x21\x0b\xe8\x8c\xde\xcf\x75\x99\x6e\x88\x45\xde\x40\xfb\x98\x08\x4f\xb8\xfd\xb4\x52\x79\x0c\xca\xd1\xe9\x68\x70\xcd\x5f\xbd\xb0\x4e\x47\x13\x7d\x5f\xad\xe0\x44\xe6\x08\x25\x0a\x54\xd4\x60\x0e\xd7\x5b\x28\xe5\x52\xdf\xd1\xb2\x44\xf5\x0e\x4e\x2f\xe0\xf3\xc5\x15\x9c\x9d\x9e\x5f\x91\x28\x8a\xfa\x76\x43\x4e\x64\xb3\x55\xac\xac\x0c\x2c\xbb\x6e\xb5\xb2\xe2\x9b\x49\xce\x51\x98\xbd\xb5\xa1\x19\x45\x51\xd4\x78\x34\xdb\x16\x8c\xf4\xd0\x3a\x9e\x92\x8f\x28\x2e\x1a\xa3\xc9\xc9\xff\xcf\x03\xe4\xbe\xd0\xaf\x2a\xa6\xa1\x60\x35\xc2\x1d\xd5\x53\x2f\x6d\x75\x7a\x37\xc1\x48\x59\x13\x5b\x90\x67\x39\xf3\x1d\x22\x9c\xe3\xce\xcd\x46\xc9\x5b\x84\x62\x63\xec\xd4\x5d\x85\x02\xb6\x72\x03\x0a\x97\x6a\x23\x26\x96\xc2\x15\x2e\x1e\x2a\xf2\x28\x62\xbc\x91\xca\x40\x12\x01\xc4\x05\x37\x71\x64\xff\x94\xcc\x54\x9b\x6b\x92\x49\xbe\x2a\xe5\x52\x36\x28\x68\xc3\x56\xda\x28\xb7\x63\xba\x41\x37\xc5\x7f\xfe\xbb\x72\x55\xed\x0e\x5b\x0c\x9d\x4d\x3d\x7c\xca\x9f\xfb\x71\xd7\x45\xa9\x4b\xcf\x91\x93\x21\xd7\x54\x0f\x60\xe5\xd8\x3b\x42\x2b\x54\x64\x58\x3f\xbb\x67\xda\x42\x31\x08\x45\xdb\x0e\x56\x0f\x1a\x9d\x3b\xe4\xf3\x37\xed\xf4\xe1\x2d\x60\x6b\x49\x8f\xd4\x68\xd2\xe2\x77\x05\xf6\xec\x6e\xee\x1c\x5d\xf4\x99\xb2\xe9\x71\x63\xd0\x9b\xa6\xc7\x89\x99\x69\x93\x7e\xa6\x84\xda\xdd\xc0\xc2\x27\x6b\xdb\xf5\xc2\xa8\xef\x98\xc9\xaa\xbe\x01\x90\xc4\x36\xcb\x7e\x7e\xa7\x82\x3e\x7e\x57\x99\x19\xd5\x08\x6f\x46\xa0\xf6\x52\x6c\x15\xed\xd8\xcb\xc2\xd0\x4c\xc9\x41\xb5\x49\xa7\x25\x6b\x45\x61\xda\xe1\x9e\xd2\xc5\x9e\xdc\xb2\x9e\xde\xaf\x5e\x0c\xe8\xc3\xbe\xe3\xe1\xf5\x0f\xd6\x80\x24\xb7\x6c\x1a\x58\xf6\xe3\xb0\x77\x92\x1c\xa2\x79\x34\x05\x0b\xe0\x33\x59\xd8\x97\xf5\x9d\x72\xfa\x86\xb8\xa3\xfc\xd0\x0d\xf7\xdb\xd6\xae\x6d\xcc\x2b\xf9\xce\x1f\xfb\x05\xce\x32\x03\x71\xff\x32\xb0\x0f\x9f\xd8\xad\xea\x78\x88\x31\x76\xb5\x16\x7b\xe3\xbe\xfd\x8d\xbc\x6e\xdb\x25\xa0\xc8\xa1\xeb\xa2\x3f\x06\x00\xda\xd8\x08\x5e\x7d\x16\x00\x00")
+
+func templatesCliModelsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliModelsGotmpl,
+		"templates/cli/models.gotmpl",
+	)
+}
+
+func templatesCliModelsGotmpl() (*asset, error) {
+	bytes, err := templatesCliModelsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/models.gotmpl", size: 5757, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0xb4, 0xef, 0xda, 0x3f, 0x63, 0x10, 0x2, 0x46, 0xcf, 0x3, 0xdc, 0xd2, 0x77, 0x37, 0xa4, 0xaf, 0xd8, 0x59, 0xc2, 0xf8, 0x6, 0xeb, 0x7a, 0xf2, 0xf, 0xd3, 0xa5, 0x35, 0x26, 0x17, 0xc4}}
+	return a, nil
+}
+
+var _templatesCliOperationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\x98\x13\xd2\x42\x2a\x1c\xe5\x0e\xf7\xe6\xc2\xc0\xf5\xdc\x6e\x37\x77\xdb\xa4\x68\xda\xa7\xdd\x45\xc1\x48\x23\x8b\x5b\x89\x54\x49\x2a\x69\x4e\xd0\x77\x3f\x0c\x49\xc9\xb2\x6c\xc7\x76\xb3\xbb\x0f\xfb\x64\x49\x24\xe7\xcf\x6f\x86\xbf\xe1\xd0\x6d\x7b\x0e\x19\xe6\x5c\x20\x84\x69\xc9\xdf\x33\xc5\xaa\x4f\x9a\xad\x30\x84\xae\x0b\x00\x68\xfc\xac\xa1\x0f\x30\x5f\x40\xf2\x1a\x75\xaa\x78\x6d\xb8\x14\xeb\x71\x9e\x83\x90\xa6\x9f\xd6\x75\x6d\xdb\x3f\x2f\xa0\x68\x2a\x26\xf8\xff\x10\x92\x2b\x56\xf9\x41\x14\xd9\xc6\xe2\xe4\x52\xff\xc0\x4b\xb4\xba\x27\xcb\x6b\xc5\xc5\x20\x39\x9c\x83\x29\x10\x6a\x66\x0a\x90\xb9\x7d\xce\x79\x89\x60\x24\x34\x75\x29\x59\x16\xee\x95\xff\x6f\x99\x3d\x1c\x23\x9f\xc1\x7f\x6e\xae\xaf\x20\x93\x69\x53\xa1\x30\x33\x90\x0a\xfe\xd5\x2b\x51\xc8\x32\xe0\x06\x72\x25\x2b\x60\x40\x9f\xf7\x69\x7c\x23\x9a\xc7\x55\x41\x24\x05\x82\xcc\xe7\xf4\xf8\x9b\x96\xc2\xad\x89\x21\x8c\x77\xc9\x4c\x4b\x6e\xa3\xd2\xaf\xef\xba\x80\x3e\xfb\x39\xc1\xc5\x05\x2c\x65\x86\xb0\x42\x81\x8a\x19\xcc\xe0\xf6\x01\x56\xf2\x5c\xdf\xb3\xd5\x0a\xd5\x4b\x78\x7d\x0d\x57\xd7\x1f\xe1\xcd\xeb\xcb\x8f\x49\x10\x04\x6d\x6b\xad\x5c\xca\xfa\x41\xf1\x55\x61\xe0\xbc\xeb\x2e\x2e\xa0\x6d\x21\x95\x15\x39\x3e\x19\x5b\x9b\x13\x04\x35\x4b\xbf\x90\x09\x6d\x0b\x46\xbe\x77\x2f\x36\xb6\xc9\x5b\x14\xd7\xb5\xd1\xc9\xf2\xa7\x4b\xff\xbd\x37\xee\x63\xc1\xb5\xc5\x0b\xee\x99\xde\xb4\x92\xa2\xe8\xcd\x04\x23\x65\x99\xd0\xfc\x37\x19\x37\x5c\xac\xc0\x0c\xeb\x2a\x6b\x66\xad\xe4\x1d\x42\xde\x18\x2b\xaa\x40\x01\x0f\xb2\x01\x85\xe7\xaa\x11\x1b\x92\x7a\x15\xd6\x1f\x26\xb2\x20\xe0\x55\x2d\x95\x81\x28\x00\x08\x6f\x1f\x0c\xea\x90\x9e\x50\xa4\x32\xe3\x62\x75\x41\x21\xb0\x5f\xf2\xca\xd8\x5f\xa9\xc3\x80\x7e\x57\xdc\x14\xcd\x6d\x92\xca\xea\x62\x25\xcf\x65\x8d\x82\xd5\xfc\x42\x35\xc2\xf0\x0a\xc3\xc9\x0c\x5d\xe7\xff\xf8\xe7\x45\x2a\x6f\x15\xb3\xab\x09\x67\xab\x57\xd3\xd6\xc9\x59\x53\x9a\x4b\xff\xde\x75\x93\xf1\xd1\x40\x6c\x83\x7b\x56\x7f\x59\xd9\x4d\xe7\xc1\x7c\x55\x72\xa6\x7b\x44\x2b\xf6\x05\xaf\x6b\x82\x91\x4b\xd1\xb6\x50\x33\x9d\xb2\x72\xbc\xd1\x96\x55\x06\x0a\x4d\xa3\x84\xb6\xd0\x78\x24\x20\x65\x65\xe9\xb0\xb5\x31\xec\xa7\x83\xec\xa5\x05\x79\x23\xd2\xe3\x14\x44\x31\xbc\xb0\xde\x26\x4b\x2f\xbd\x0d\x00\xd2\x2a\x23\xbb\x9f\x6f\x8c\xd0\x00\xc0\x27\x8d\x73\xd2\x6a\x37\x43\x0e\xe1\xb3\xaf\x21\x44\x19\xd3\x05\xaa\x41\x74\x0c\x5d\x37\xb3\xb3\x6f\x0a\xa9\x8c\x9d\x3f\xe4\x7f\x24\x15\x24\x37\x4d\x55\x31\xf5\x00\x91\x15\x03\x51\x53\xd7\xa8\x20\x79\x87\xa6\x90\x59\x0c\x21\x84\x90\xbc\x67\xa6\x88\xd7\xa2\x7e\x92\x62\x35\x91\x74\x60\xb1\x55\x15\x11\x62\x1b\xac\xe7\x75\x86\xbf\x88\x5f\x44\xb8\x31\x14\xc7\x10\x86\x23\x95\xaf\xd4\x4a\xcf\xc1\x81\x70\x25\xe9\xcd\x99\xf2\xa1\x11\x6f\xe6\xa0\x1a\xf1\x38\xbc\x34\x99\x72\x44\xe1\x8a\x6b\x83\xea\xf1\xd9\x96\xdc\x7e\x28\xd9\x4a\x47\x69\x95\xc5\x94\x7c\x2e\xf8\x14\x8d\xc0\xed\xc2\x53\x25\x0d\x0b\x5c\x02\xe5\xf6\x9b\x46\xe3\xb7\x26\x31\xb1\x62\x15\xda\x09\x32\x3f\x94\x50\xa7\x6a\x27\x3f\x26\xd9\x15\x43\xeb\xf9\x50\x31\xb1\x42\x0a\xb2\x62\x95\xdf\x4b\xfb\xe8\xde\x25\x64\xe2\xb0\x89\x93\x1b\xa3\xb8\x58\x45\xc7\xe4\x20\x84\xe1\x8c\x3c\x32\x58\xd5\x25\x33\xdb\x15\x32\x81\xae\x8b\xad\x6a\x5f\x28\x2b\x99\x61\xa9\x29\xf9\x43\x5f\x3f\x47\x76\xd9\x54\xba\x49\x0b\xac\x58\xff\x9b\x5c\xea\xa5\xac\xea\x12\xbf\x5d\xdf\xfe\x86\xa9\x81\x88\x0a\xe9\x7a\xf0\x95\x90\xe2\xa1\x92\x8d\x8e\x37\x47\x7e\x64\xfa\x35\xa7\xc4\xab\xb8\x60\x46\xaa\x78\xad\x6c\xd3\x92\x05\xe5\xfb\x3b\xfb\xec\xe8\x23\x79\x2b\x3f\x3e\xd4\x08\x67\x3b\xf9\xa8\x5f\xee\xc9\x7e\xfd\x81\xe7\x83\xc8\x6e\x9c\x94\x56\xf4\x10\xae\xd9\x51\x5b\x1b\x04\xde\x47\x6d\x3b\x98\xd2\x75\x71\xbc\x5b\xb7\x7d\x2d\x35\x6e\xda\x72\xf6\x85\x0b\x4b\x30\x7d\x34\x48\xff\x7f\xe9\x5b\xb2\x39\x91\x8c\xb6\x73\xb7\x92\xa0\x6d\x87\x91\xe3\x32\xa1\x6d\x77\x1c\x54\xc2\x90\xea\xa2\xb3\xcf\x31\x0b\x59\xe2\x71\xf5\x0a\x7a\x98\xc7\x35\xfd\xf8\xa4\x1a\xfc\x48\x3e\xe0\xd7\x86\x2b\xf4\xbe\x7c\x86\x85\xf5\xe7\x1d\x53\x5f\x48\x67\x3f\x7a\x8c\x33\x9b\xb2\xb7\x22\x3d\x85\xbf\x7f\x1d\xbd\xf4\x6c\x62\x14\xc7\x3b\x3c\x76\x3f\x83\x46\xa3\x4f\xa1\x0d\x77\xce\x5a\x33\xcf\x7d\xc1\xd3\x02\x0a\x76\x87\x70\x8b\x28\x48\x5e\xcf\x2c\xa7\x59\xb2\x83\x59\x66\x8e\xcb\x34\xbc\xa0\xd4\xa0\xc2\xdb\x75\xc9\x7e\x31\x3a\x06\x54\x4a\xaa\xc3\x7c\x74\x46\xb6\x53\xb2\x1e\x0a\xcb\x7a\x45\x2d\xb9\x30\xa8\x68\x91\xa5\x8c\x4b\x7d\xd5\x94\x25\xbb\x2d\xd1\x93\xc0\xa5\x7e\xa5\x14\x7b\xe8\x39\xe1\x52\xbf\x63\x75\xff\xb2\xcd\x0c\xfd\xa4\x1b\xa3\x90\x55\xf1\x41\xb2\x9c\x52\xc8\x5f\x8f\xcc\xce\xb2\xb5\x3a\xcc\x8e\x71\x70\x6a\xe2\x96\x85\x9b\x12\x9f\x6a\xe8\x1d\x53\x44\x11\x77\x4c\x09\xca\xdd\xe4\xf2\x35\x6d\x0c\xc7\x41\x43\x7a\x74\xdd\x8b\x81\x51\x1c\xfb\x78\x25\x83\x32\x97\xc6\x01\x6c\xcb\x5a\x16\x94\xaf\xd6\xf5\x31\x2d\xfa\xcf\xc4\x22\x2e\x6f\x1d\x5b\xf0\x7c\xbf\x04\xda\x00\x64\x70\xd9\xe0\x8c\xf6\xc4\x54\xe4\x5b\x34\xeb\x8a\x3b\x16\x6a\xc5\xd2\x82\xbf\x2d\x40\xf0\xd2\x0b\x1a\x4e\x2d\xa8\x94\xfd\x40\x70\x00\x64\xcc\xb0\x41\x3c\xb5\x61\xd4\xa5\x91\x8a\xc8\x6a\x3e\x59\xde\x10\xe5\x49\x2a\xf8\x70\x6c\x79\xeb\x74\x2f\x08\x86\xad\x15\xc9\x27\x51\x31\xa5\x0b\x56\x6e\xf0\x45\x94\x29\x59\xf7\x6d\x90\x0f\x09\x6d\xbe\xc8\x36\x1f\xc9\x15\xde\x7f\x40\x96\xa1\x8a\xc8\xb7\x78\x06\xbe\xa3\x48\xc8\xb3\xa5\x14\xba\xa9\x50\x45\xf1\x11\x9e\xe5\x95\x49\xde\x10\x19\xe5\x51\xc8\xc5\x1d\x2b\x79\xe6\xe2\x01\xb9\x54\x60\x11\x3f\x3f\x7f\xa6\xe7\xf0\xec\xce\x1d\x67\xfa\x28\x58\xa7\xe2\x2d\x54\x7c\x41\x1b\x2b\x9e\x2f\x80\xfa\xa3\xb5\xa7\xd6\xe8\x19\x3c\xdf\x02\x2a\x7e\xf9\x67\x5a\xda\xef\x97\xc7\x4e\x2a\x9b\x23\xeb\xcd\xb3\x27\xaf\x61\x31\x36\x7d\xc7\x70\x7f\x7a\xd9\xbb\xdf\xe2\x91\x41\x13\x3b\x79\x0e\xa9\xdb\x62\xa3\x64\x76\xb5\x6b\xc7\x41\x6a\xed\xbd\xdb\xf9\xc4\xe3\x23\x07\x9e\x8f\x77\xff\xe1\x28\xf8\x18\xb8\x7d\xd5\xb9\x28\xaf\xed\xd9\xe7\x6f\xbf\xcd\x17\x60\x54\x83\xc1\x1e\xfc\x0f\x13\x84\xab\xae\xc9\x0d\x9a\xcd\xa2\x6a\x41\x8d\xb6\x16\xaf\x31\x7c\xfa\x11\x70\x72\x68\xf3\x61\x38\xc0\x7a\x83\xd9\xa6\xf8\x03\x69\x8d\x6e\x36\x06\xf1\x52\x27\xd7\x35\x8a\x88\xae\xb7\x4e\x96\x74\x10\x5f\x9e\xef\xce\x1d\x32\x21\x0e\x76\x30\x80\x2f\x81\x0e\xe9\x08\xbf\x7a\xcc\x23\x7f\xca\x75\xe7\x6d\x4f\x6b\xf1\xa9\xb8\x3e\x5a\x2f\x26\x87\xf3\xef\x46\xf7\xbb\x31\x19\x6a\xca\x2e\x50\x06\x24\x42\x97\x00\x37\x25\x4f\x31\xfc\x2e\x00\xf4\x3e\x04\x46\x92\x9f\x84\x80\xd5\x42\x08\xd3\x65\xce\x66\xcb\x35\x83\xbf\xcf\xa0\x44\xe1\x0a\xa8\xf6\x85\x86\x2a\xc6\xe7\x19\x18\xfc\x66\x68\x99\xeb\xb2\xdd\x8c\x41\x13\x1d\x4e\xb8\xc1\x8a\xb6\x7c\xb2\x2c\x78\x99\xad\xa5\xfa\x29\xde\xc4\xf9\xc2\x4e\x5c\x97\x8d\x8f\xf8\xcd\x44\x3f\xff\x4a\x15\x30\x22\x1d\xf1\xee\x72\xf1\xbb\x16\x8c\x75\x14\xad\x1f\xb0\x00\x56\xd7\x28\xb2\xc8\xe7\x20\x59\x18\x1f\x9d\x35\x27\xa5\xc6\xc9\x59\x41\x98\x3c\x9e\x13\x4f\x4c\x07\xe5\x41\xd8\x48\x85\xb1\x98\xf9\xc2\xcd\x38\x39\x66\xbf\x63\xc4\xfe\x90\xdd\xdb\x97\xaa\xc9\xeb\xe8\x65\x7d\x63\x26\x78\xd9\xdf\x98\x1d\xba\xa4\xb3\xb7\xa9\xfa\x91\x1e\x96\xda\x08\xdb\xfa\x69\xe0\x46\x83\x42\x5d\x4b\xa1\xd1\x37\xae\x87\xc4\xef\xec\x54\x99\x5a\x69\xf8\xf9\x57\x6d\x73\x6c\xda\x88\x9e\x59\x65\xae\xab\xc9\xd9\x50\x38\x47\x3d\xea\x4d\x93\xa6\xa8\xf5\x07\x6f\x09\xf5\x20\x6d\x3b\xed\x78\x26\xad\xdb\xba\x77\x6c\xdb\xb5\x0a\x77\x28\x18\xdf\x6c\x0c\x0f\x01\xd0\x36\x5b\x96\x7c\x48\x67\xa2\xa0\x65\xc9\x51\x18\x72\x2a\x0e\x76\x67\xee\xe6\x29\x85\x6e\x31\x7d\x5f\x3e\x5f\xc0\xa8\x33\xbf\xc2\xfb\x9d\x78\xd9\xb3\x80\x8e\x46\xd2\x47\x47\xac\x53\xae\x07\xfa\xfb\x80\xf8\xe5\x31\x36\xb6\xad\x07\xf7\x8c\xcf\xe0\xac\x8f\x31\x85\xe0\x89\x68\xf7\xa2\xc8\x75\xee\xf1\x75\x31\xfd\x3c\x40\x6d\x8f\x87\x0e\x7e\x82\xba\xdf\x19\x3e\x46\x5d\xe7\x90\xf3\x67\xa8\x45\x3f\xf9\x7c\x14\xa3\xc9\x45\x47\xdf\xb4\xec\xbb\x01\x89\x1c\x34\xfe\x4a\xec\x55\x63\x0a\x49\x57\x19\x64\xc0\x8c\x38\x61\x30\xc7\xcf\xf8\x91\x79\x8f\xb8\x58\xf5\x38\xd8\xb9\xc4\x8a\xd7\x8d\xb9\x56\x37\x26\x93\x8d\x89\xe2\x61\xe5\xee\xf4\xa0\x24\x3e\xb3\xe9\xfe\x9e\x3d\xd0\xff\x7d\x7a\x7f\x96\xef\x06\x7c\xb8\x0b\x71\x51\x89\x0f\x47\xa0\x6d\xa7\x2a\x1f\xcf\x7a\x32\xe1\x9e\x9b\x62\xf8\xbf\x67\xe4\xf1\x09\x81\xff\x1e\xb5\x3c\x9f\xae\xf1\x8c\x77\x71\xe1\xff\x7c\x24\x9a\xaa\xdd\x20\xdd\xbc\xd9\xc9\x03\x25\x69\xb8\xc5\x5c\x2a\xf4\xf9\xdd\xdf\xf1\xdb\x49\x56\x8c\xbe\xe7\x26\x2d\x86\xf9\x84\x3d\x2a\x95\x44\xc6\x76\xb6\x7d\x03\xb1\x33\x02\x23\x26\xfe\xee\x48\x58\x19\x29\xd3\x78\xf8\x8e\x6e\xee\xf5\xf1\x1c\x6a\x4f\x02\x16\x80\x0f\xa8\x9b\xd2\xf2\xcf\x6c\x70\x23\xf1\x68\xc5\x2f\xa1\x9e\xe4\xdb\x46\x65\xab\xfb\x5a\x7a\x5c\x61\x79\x3c\x13\xb6\xf1\xf8\xcb\xb9\xdf\x05\x5b\x9f\x26\xc4\x39\xce\x96\xa3\x88\x33\x38\x19\x33\x47\x23\x53\x16\xdd\x74\xb2\x77\x70\x1f\x42\xfd\xaa\x01\xa9\xe1\x68\xb1\xe1\xde\x23\x27\x89\xff\x0f\x00\x50\xe1\xd6\xad\x64\x21\x00\x00")
+
+func templatesCliOperationGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliOperationGotmpl,
+		"templates/cli/operation.gotmpl",
+	)
+}
+
+func templatesCliOperationGotmpl() (*asset, error) {
+	bytes, err := templatesCliOperationGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/operation.gotmpl", size: 8548, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdc, 0xb2, 0x3c, 0xc9, 0xe4, 0xb3, 0x27, 0x87, 0x3b, 0xae, 0xbc, 0x8c, 0x3c, 0xad, 0x53, 0x9c, 0xeb, 0x43, 0xb2, 0x9d, 0xc9, 0x23, 0x6b, 0x9, 0x9b, 0x3f, 0x50, 0x4a, 0x7c, 0x51, 0xff, 0x4d}}
+	return a, nil
+}
+
 var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xdb\x38\x13\x3e\x57\xbf\x62\x5e\xbf\x69\x61\x1b\x8a\xb4\x7b\xf5\x22\x87\x20\xe9\xa2\x39\x34\x09\x62\x63\x7b\x5c\xd0\xd2\x48\x22\x22\x91\x2a\x49\xd9\x75\x05\xfd\xf7\x05\x3f\x44\x4b\xfe\x48\xb2\xc0\x6e\xb1\x87\x5e\x62\x49\xf3\xc1\x99\x67\xe6\x19\x92\x89\x63\xb8\xe1\x29\x42\x8e\x0c\x05\x51\x98\xc2\x7a\x07\x39\xbf\x94\x5b\x92\xe7\x28\x7e\x83\xdb\x07\xb8\x7f\x58\xc1\xc7\xdb\xbb\x55\x14\x04\x41\xdb\x02\xcd\x20\xba\xe1\xf5\x4e\xd0\xbc\x50\x70\xd9\x75\x71\x0c\x6d\x0b\x09\xaf\x2a\x64\xea\x40\xd6\xb6\x80\x2c\x85\xae\x0b\x82\xa0\x26\xc9\x33\xc9\x51\x2b\x47\xf7\xa4\x42\xf3\x35\x8e\x61\x55\x50\x09\x19\x2d\x11\xb6\x44\x8e\x23\x51\x05\x82\x0b\x05\x14\xe7\x65\xa4\xf5\x3f\xa6\x54\x51\x96\x83\xf2\x76\x95\x59\xae\x16\x7c\x83\x90\x35\xca\xb8\x2a\x90\xc1\x8e\x37\x20\xf0\x52\x34\x6c\xe4\xa9\x5f\xc2\xc4\x4c\x58\x1a\x04\xb4\xaa\xb9\x50\x30\x0d\x00\x26\x59\xa5\x26\xfa\x97\x72\xf3\xc3\x50\xc5\x85\x52\xf5\x24\xd0\x6f\x39\x55\x45\xb3\x8e\x12\x5e\xc5\x39\xbf\xe4\x35\x32\x52\xd3\x18\x85\xe0\x42\x4e\xce\x2b\x88\x86\x29\x5a\xe1\x0b\x1a\x52\x89\x7e\xe1\x33\x0a\x5b\x92\xbf\x20\xde\x90\x92\xa6\x44\xa1\x09\x53\x57\xc9\x64\x24\x21\xba\xc5\x8c\x34\xa5\xba\x73\xef\x5d\x77\x20\x1f\x08\x66\xa6\x1c\xf7\xb8\x85\x44\x20\x51\x28\x81\x00\xc3\xad\x56\x2f\x9a\x8a\x30\xfa\x1d\x7d\xe5\xe0\xfa\xf1\x0e\x92\x92\x22\x53\x51\x90\x35\x2c\xd1\x76\x53\x25\x08\x93\x06\x4a\x97\x71\x74\x63\x54\x56\xfd\xf7\x10\x32\x2e\x2a\xa2\x24\xd8\x84\xa3\x27\xcc\xa9\x54\x62\x37\x03\xab\xb9\x44\xb1\xa1\x09\x42\x1b\x00\x08\x54\x8d\x60\xf0\xc1\x4a\x5a\xef\x7c\x01\xea\xc8\xdf\xa2\x7f\xe8\x02\xdd\x55\xf3\xc0\x1a\x81\x6b\xd8\x65\x53\x55\x44\xec\xc0\x74\xe4\xf8\x4d\x8b\x6f\x51\x26\x82\xd6\x8a\x72\x66\xba\xb2\x6d\x61\x5d\xf2\xe4\xd9\x37\xf5\x58\xc1\x77\xb5\x7e\x28\x25\x1e\xfa\x30\x82\xd7\x1c\x68\xbb\xae\xcb\xb8\x38\x8b\xef\x9e\x3c\xf3\x38\x50\xbb\x1a\x1d\x46\x1a\xbb\x26\x51\x06\xa3\x57\x11\x0f\xe0\x1c\xe4\x81\xa5\xdf\x18\x77\x2a\x0d\x55\x28\x53\x28\x32\x92\xa0\x36\xee\x97\xad\x50\x15\x3c\x95\xc3\x50\xbc\x99\xd7\x6f\x83\x77\x6d\x0b\x82\xb0\x1c\x21\x7a\xa8\x35\xd1\x28\x67\xa6\xbf\xb4\xa0\x26\x32\x21\xe5\x30\xd3\x69\x4d\x04\xa9\x24\xcc\x4f\x4a\x1f\x8d\xd0\x95\xe9\xba\x51\x05\x17\xf4\x3b\x6a\x50\x42\x20\x8d\x2a\xee\x58\xc6\x0f\x52\xbf\x76\x9f\xbf\x08\xaa\x50\xb4\x2d\xb2\xd4\x17\xfa\x13\x91\x4b\x25\x90\x54\x94\xe5\x4f\x28\x6b\xce\x4c\x15\x42\xd8\x1a\x65\xa0\x3c\xea\xcd\x1c\xf6\xb3\x7d\x0b\x25\x09\x4a\x39\xb0\x9a\xee\x13\x3d\x10\xea\x74\x4f\xe7\x13\xc2\xa8\x79\xcc\x83\x19\x1f\x67\x57\x99\xed\xdb\xe0\xdd\x60\x9c\xbe\x5b\xe2\xbe\xc8\xaf\x13\x6f\x16\xd8\xb6\x3e\x59\x98\x78\x6e\x87\xc2\x71\xb8\x27\xf9\x53\x97\x8d\x30\x6a\xbf\x53\x21\xd5\x17\x2e\x52\x98\xee\x1b\xd8\xa9\xce\xce\xb3\xcb\xac\xf5\x83\xf8\xf5\x26\x6e\x99\xf9\x35\x25\x30\xb7\xa0\xcd\x4e\x63\xf1\xb3\x51\xdf\xda\xa8\x66\x32\xe9\x9d\xfd\xe1\xf6\x61\x01\x7f\xb8\xad\xc9\x0c\x16\x87\xe1\x1a\x33\x2e\x10\x24\xb2\x94\xb2\x3c\x00\xed\xd2\x89\xae\xae\x80\xd1\xd2\xb8\x00\xff\x4d\xef\x2e\x2f\xc0\x3e\x9d\x05\x00\x6e\x67\xbb\x28\x91\xe5\xaa\x80\xc5\x15\x94\xc8\x4e\x66\xec\xb6\xc0\x93\x59\x08\x94\x4d\xa9\xda\x56\xf7\x4f\xd7\xfd\xe9\x73\x0a\x01\x85\xd0\x4e\x49\xe4\xc9\x16\x2d\x9b\x75\x45\xd5\xf4\xc3\xb8\xae\x9e\x5c\x36\x87\xbb\xdb\x85\x69\x28\x41\x99\xca\x60\xf2\xfe\xeb\x64\x0f\xb2\x51\xf8\x6c\xe6\xea\xb1\x92\xfd\xee\xd5\x1e\x89\x2a\x1e\x89\x52\x28\xd8\xb1\xae\x16\xee\x35\x05\x4f\x9b\x04\xe5\x67\x4c\x29\x59\xed\x6a\x94\x63\x83\xff\x6f\xb4\xc5\x91\x92\xb7\xbf\xe1\x4c\x36\xd5\x2b\xf6\xc7\x4a\xde\x7e\x99\x14\x58\x9d\x34\x72\x92\x41\x4e\xba\x7c\x0b\x57\x67\xfb\xed\x09\x49\x8a\x62\x01\x1f\x4e\x16\xdc\x4a\x5b\xbf\xed\x93\xc8\x3d\xbe\x8d\x38\x0b\xf7\xeb\xeb\xda\x85\xa7\x38\x6b\x02\xe9\xf9\xb9\xf0\x04\x0e\xad\x99\x93\xdf\x70\xa6\xf0\x9b\xea\xa3\x8f\xdc\xbb\xc3\xd0\xb4\x82\x97\x7d\x5a\xad\x1e\xed\x27\x2d\xee\x66\xb6\xe5\x75\x4b\xfd\x6f\xd8\xef\xee\xc8\x73\xb6\x3b\x0d\x24\xe9\xb2\x11\x82\x37\x2c\x85\x09\xa3\xe5\xc4\xfd\xfd\xc5\x77\xfe\x88\xbc\x28\x84\xe7\xc6\xe5\x19\xaf\x66\x69\x27\xc6\xaf\xde\xcf\xaf\x56\x24\xad\x7e\x08\xfc\x59\xf7\xbf\x25\x48\x34\x3d\x18\x1b\x07\x5e\xfb\x72\xb9\x44\xf9\xf3\x38\xc1\xde\x27\xa3\xa5\x8b\x2e\x8e\xa1\x61\xf8\xad\xc6\x44\x9f\xfd\x9d\x5c\x2f\x66\xdc\x19\xdb\x7d\x0a\xee\x50\x3b\x02\x26\x9e\x6b\x11\x81\xd4\xca\xbc\xa9\x3e\xd2\xe8\xab\x01\x4d\x31\x0d\xf5\x7d\xa1\xb4\x37\x07\xc2\xd2\x3e\x1a\xc2\xc0\x8c\x36\x98\xc7\x26\xe3\x7d\x20\x2e\xab\x17\xf2\x3e\x08\x65\x98\xb7\xf3\xce\x68\x19\xfa\xc9\x7f\x8f\xdb\xeb\xc7\xbb\x8f\x7a\xb5\xe9\xe4\x85\x84\x17\x90\xe8\x6e\x62\x0a\xc8\x86\xd0\x92\xac\x4b\x04\x22\x4f\x24\xe7\x42\x9f\x84\xc7\x51\x9f\xf8\x14\xe9\xdb\xde\x74\x36\x1b\xe0\xe9\x36\x4a\x5b\x02\x49\x32\xcc\x1b\x22\xd2\x05\x30\x4d\xab\xb2\xdc\x85\x40\xd6\xd2\x04\x72\xb4\xba\x5e\xe0\x99\xf1\x2d\x3b\x0a\x5f\x1e\x41\x4b\xd6\x7c\x83\x0b\x90\xdc\xa2\xaf\x0b\x00\x09\x4f\x31\x47\x06\x54\xca\x46\x97\xb8\x92\xb9\x46\x5a\x1f\x52\x97\x76\x6e\xbc\x88\x11\xb8\xc3\x73\x8f\xf9\xc2\x5e\x4a\x38\x53\x82\x24\x0a\x18\x57\x80\x2c\xe3\x22\xb1\x97\x49\x89\x62\x83\x22\xea\x4f\xb3\xde\xad\xe2\x90\xa3\xf2\x91\x86\xb0\x6e\x14\xe4\x5c\x2d\xe0\xfd\x6a\x12\xba\xba\x6b\xc4\x6a\xc2\x68\x32\xad\x64\x3e\x82\x8f\xa5\x43\x06\xf9\x63\x47\x3c\x07\x89\x1b\x14\xa4\x84\x9a\x4b\x49\x75\x01\x8f\x51\x72\x0d\x27\xb7\x54\x25\x05\x6c\x48\xd9\xe0\xb0\xd7\xf4\x49\x7b\xe6\x98\x63\xfd\xdb\x6d\xfc\x82\x86\x70\xb1\xd1\x9a\x67\xb6\xb7\x84\x48\x3c\x38\xa8\x5c\x6c\x3c\x4e\x07\xa3\x66\x34\x51\x4c\x0c\xfd\x4c\xb9\xa0\xa3\xa1\x62\xd9\x7a\x94\x79\xf7\x63\xd9\xf9\xea\xa4\xf8\x67\xe9\xfb\xe6\x91\xfb\x93\xe2\xff\x1e\xc5\x2f\xfe\x53\x1c\xef\x5f\xc7\xd8\xee\xc7\x7d\x30\xd2\xeb\x82\xc1\x8b\xbe\x71\x0f\x6f\x6e\x90\x14\x9a\xd1\xf6\xd6\xbd\xbf\xc5\x71\xfb\x1f\x2b\xfb\x0f\x96\xe3\xfb\xc9\xdf\xbc\xfb\x99\x09\x32\x38\xb7\xc2\xd5\x7e\xa9\xa0\x0b\xfe\x0a\x00\x00\xff\xff\xf0\x51\xfe\x20\x05\x14\x00\x00")
 
 func templatesClientClientGotmplBytes() ([]byte, error) {
@@ -147,7 +262,27 @@ func templatesClientFacadeGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x73\xdb\xb8\x11\x7f\xe7\xa7\xd8\xaa\xe9\x55\xf4\x38\xd4\x3d\xfb\x46\x9d\xc9\xd9\xb9\xc6\x9d\x69\x2e\x4d\x3c\xd7\x87\x4c\xa6\x03\x93\x2b\x09\x77\x24\x40\x03\xa0\x14\x95\xc3\xef\xde\xc1\x1f\x92\x20\x45\x4a\x54\x12\xc7\x77\xd3\x3c\x59\x04\x81\xc5\xee\x6f\x7f\xfb\x07\xa0\x17\x0b\xb8\xe6\x09\xc2\x1a\x19\x0a\xa2\x30\x81\xfb\x3d\xac\xf9\x73\xb9\x23\xeb\x35\x8a\x1f\xe0\xe6\x67\x78\xfd\xf3\x1d\xbc\xbc\xb9\xbd\x8b\x82\x20\x28\x4b\xa0\x2b\x88\xae\x79\xbe\x17\x74\xbd\x51\xf0\xbc\xaa\x16\x0b\x28\x4b\x88\x79\x96\x21\x53\xbd\x77\x65\x09\xc8\x12\xa8\xaa\x20\x08\x72\x12\xff\x46\xd6\xa8\x27\x47\x6f\xdc\x6f\xfd\x62\xb1\x80\xbb\x0d\x95\xb0\xa2\x29\xc2\x8e\xc8\xae\x32\x6a\x83\xe0\xb4\x01\xc5\x79\x1a\xe9\xf9\x2f\x13\xaa\x28\x5b\x83\x6a\xd6\x65\x66\xc7\x5c\xf0\x2d\xc2\xaa\x50\x46\xd4\x06\x19\xec\x79\x01\x02\x9f\x8b\x82\x75\x24\xd5\x5b\x18\xb5\x09\x4b\x82\x80\x66\x39\x17\x0a\xe6\x01\xc0\x2c\xe6\x4c\xe1\x47\x35\xd3\xbf\x19\xaa\xc5\x46\xa9\xdc\x3c\x28\x9a\xe1\x2c\xd0\xbf\xd6\x54\x6d\x8a\xfb\x28\xe6\xd9\x62\xcd\x9f\xf3\x1c\x19\xc9\xe9\x02\x85\xe0\x42\xce\xc6\x27\x88\x82\x59\x19\x00\xb1\x38\x31\x69\x11\xa7\x14\x99\x3a\x22\x4d\x2a\xb1\xca\x8e\x4e\xd8\x91\xf5\x91\xd7\x5b\x92\xd2\x84\x28\x6b\x92\x76\xad\xc1\x40\x42\x74\x83\x2b\x52\xa4\xea\xd6\x3d\x57\x55\xef\xbd\xf7\x22\x34\x0e\x7c\x8d\xbb\xb2\x84\x9c\xc8\x98\xa4\xf4\xbf\x08\xd1\x6b\x92\x69\xef\xbe\x21\x82\x64\x12\x62\x81\x44\xa1\x04\x02\x0c\x77\x70\x6c\x26\xbf\xff\x15\x63\xa5\x45\xee\xa8\xda\x18\x9f\x25\x56\x19\xd8\x92\xb4\x40\x09\x94\x51\x45\xcd\xda\x24\x0a\x56\x05\x8b\x4f\x6c\x3e\x0f\xe1\xe2\xd8\x8e\xa5\xb3\x6d\xa5\x59\x69\x46\xaa\x6a\x4b\x84\x61\x42\x59\x82\x20\x6c\x8d\xde\x2b\x37\xf5\x15\x91\x0e\xa4\x66\x8c\x71\x05\xd1\xad\xfc\x89\xa6\x68\x66\xdb\x17\x5b\x22\x98\xde\x2f\xba\xbd\xa9\xaa\x7a\xc9\xb2\xde\xf1\x56\xbe\x11\x34\xa3\x8a\x6e\x51\xcf\x8e\xfe\xce\xef\xf6\x39\x56\xd5\xdc\x06\x8e\x91\x90\x0b\xca\xd4\x0a\x66\x7f\xf9\xf3\x76\xd6\xb8\xa6\xd5\xc4\x13\x01\x55\x15\xb6\x11\x67\xd4\xb7\xbf\xbd\x1f\x46\x6a\x00\xd0\x99\x28\x50\x15\x82\xc1\x77\x87\x38\xd5\x30\x95\x67\xa1\x71\x20\xe4\xca\x19\x4c\x58\x02\x73\x07\xd4\x0b\x21\xc8\x3e\x6c\x1e\xff\x49\xf2\xfa\x41\x8b\xa3\x32\xd6\x66\x31\xa2\xb8\x08\x61\xce\x85\x9e\xf3\xba\x48\x53\x72\x9f\x22\x40\x08\x55\xf5\x9d\x6f\x9f\x87\x33\x34\x40\x5f\x0e\x82\x10\x00\x98\xe1\x98\x64\x68\x95\xbc\xa3\x19\xf2\x42\x39\x62\x5c\x41\x2c\x6a\x9c\xdd\x1b\x2d\xa8\x0a\xaa\x09\x5c\xff\x37\x55\x1b\xb7\xe8\xb1\x68\x7f\x69\x60\xd4\x73\xc8\x3d\x4d\xa9\xda\x83\xe2\x20\x51\x01\x01\xe5\x76\xe6\x0c\x08\x08\x7c\x28\x50\xaa\x29\x41\xe2\x69\x3d\xaf\x65\xe8\xbf\xd1\x4d\x21\x88\xa2\x9c\x7d\x0b\xa2\xa7\x0c\x22\x6d\xf6\x1f\x2c\x84\xd4\xa7\x04\xce\xb5\x2d\xbc\x4f\x10\x38\xae\xe4\xc3\x8a\x8b\xf3\x23\xc7\xa9\x3d\x8f\xd5\xc7\x5a\x50\xe4\xc6\x9e\x36\x6e\x5a\xf7\x58\x1a\xfe\x1f\x86\xce\xd7\xaa\x3f\x5d\xa8\x27\xc5\x8f\xa3\xc8\x15\xc4\xea\xe3\x79\x71\xf2\xea\xee\xee\xcd\xb5\xe9\x0e\x9f\x22\x54\x0a\xa9\x78\x06\x9e\x0e\x9f\x14\x34\xed\xfa\xb9\x6d\x74\xe1\x42\xf7\xd9\x91\x1d\xfb\x16\x37\xdf\xe2\x66\x00\x87\x96\x34\x57\x60\x59\xd3\x06\xce\x51\xc2\xe8\xb4\x4c\x28\x93\x40\xd2\xd4\xd0\x3a\xd7\xe3\xa8\x50\x48\xcb\x6c\xcd\x76\x6e\xde\xbc\x78\x73\xab\x77\xcb\x39\x65\x2a\xd0\xd4\xd6\x83\x65\x09\x9b\x22\x23\xcc\x17\x0d\x3c\x47\xdb\x1d\x81\xda\xe7\x34\x26\x69\x6a\xce\xab\x12\x81\x08\x84\x9d\xa0\x4a\x21\xd3\x62\x09\x18\x6a\xbf\x75\x11\x72\xb1\x08\xd4\x3e\xc7\xa3\xd1\x2a\x95\x28\x62\x05\x65\x30\xec\xc0\x11\x6b\xcb\x52\xbb\xf5\x06\xb5\x13\x72\xa3\x59\x4d\xa8\xfb\x94\xc7\xbf\x35\x87\xf4\xde\x0c\x1f\xeb\x8b\x85\x7d\xea\xb4\x1f\xda\xda\xcf\x64\x82\x9b\x74\xcb\x14\x8a\x15\x89\xb1\x1d\x7a\xa7\x04\x92\x6c\x84\x2c\x17\x3e\x09\x46\x03\xd6\x05\xa0\xa3\x4a\x2a\xf5\x2f\x77\x8c\x36\xd0\x24\x6f\x91\x24\xd7\x29\x97\x28\xda\x50\xf2\x2e\x27\x8e\x36\x33\xdd\x4e\x38\x68\x12\x77\xbf\xd6\x07\xe0\x27\x45\x3f\x9b\xb9\xc4\xae\xd3\x5e\x17\xd9\xde\x46\x24\x49\xa4\xa1\x5b\xd3\x83\xf3\x71\xf6\x19\x06\x4b\x9b\x6e\x75\xde\x89\xde\x62\x8c\x74\x8b\xa2\x9e\x70\x2c\x20\xc2\x93\xca\x7c\xce\x39\xa0\xaf\x4a\xf4\x0e\xd5\x94\xbd\xc2\x36\xa7\x0d\x48\x71\x28\x9e\x90\xf5\x55\x41\x9c\x68\x57\x1f\xc3\x31\x98\x8e\x91\x70\x59\xdb\xe3\x91\xa9\x26\x62\x63\x72\xdd\xc6\x3e\x32\x6f\x3e\xbb\xe1\x1d\x22\x88\x27\x74\x2a\x0f\x9e\xc2\xfe\xae\xa6\x87\xe6\x8f\x59\x58\xeb\xba\xd4\xed\x9e\xe7\x43\x2f\x65\x34\x66\x78\x63\x8f\xec\xc9\x2f\xd1\x85\x0d\x39\xf3\x40\xee\x54\x97\x3e\x1d\x1c\x43\x5a\xf7\xd0\x18\x33\xd8\x53\x70\xe9\xfa\x12\x6d\xd1\x40\xdd\x1e\x2e\x03\xb6\xc0\x36\xf6\xfa\x67\x71\xb3\x85\x29\x42\x87\x96\x3f\x1b\x35\xfd\xd9\x09\xdb\x9f\x9d\xae\x06\x46\xa7\xf9\xa0\x2a\x5f\xa6\x13\xf8\xda\x65\xdf\xc9\xeb\x73\xfa\xd9\x30\xa9\x0f\x10\x3c\xac\x61\xe3\x08\x7d\x5a\x1d\x3b\x64\x41\xaf\x39\x7e\x7c\x1a\x9c\x61\xe3\x1f\x9d\x05\xa3\x7e\x1e\x72\xca\x72\x30\x26\x5d\x8c\xbb\x26\x52\x47\xb6\xa0\x0a\xef\xb8\xeb\xf3\xcd\x09\x00\xa5\x3b\x12\x58\xdf\xd8\xd3\x40\xfd\x19\xaa\x73\x64\xfe\x94\x0c\xde\xd9\x6f\x2e\xa0\x36\xdb\x26\x23\x37\x7e\x09\x02\xd7\x60\x3f\x16\x45\x6f\x71\x4d\xa5\x12\xfb\x10\xcc\xc7\x2a\x7b\xc0\xa0\x2b\xfd\x04\x57\x4b\x10\x9a\xe6\xf5\x4d\xf0\x99\x2d\x4a\xf8\x83\x91\xf2\xa7\x25\x30\x9a\x1a\x7c\x9b\x28\x40\x21\xcc\x39\x0d\x34\x88\x20\x50\xc2\xfb\x0f\x66\x7f\xe3\x84\x4e\x92\xac\xdb\x71\xe7\x6e\xc7\x0b\x43\x2d\x47\x2a\xfd\xe7\x47\x9e\xec\xcd\xfc\xb0\xfd\x3a\x65\xc9\xe8\x93\xc8\x92\xec\x45\x9a\xf2\xdd\xcb\x2c\x57\xfb\x5f\x48\x5a\xa0\x5e\x41\x57\x26\x30\xcd\xf3\xcb\x8f\xb9\x40\x29\xed\x51\xa8\xd1\x1e\xea\x93\x7c\x7b\xd3\x70\x2b\xff\x55\xa0\xd8\xd7\xcc\x0b\x00\x16\x0b\x78\xd0\x43\xd6\xb9\x46\x64\x1d\xe3\xde\xaa\x46\x1d\x7b\x47\xf1\x20\x06\x7d\x0a\x1d\x26\x5b\xa7\x9c\xd0\xd1\x20\x3c\x26\x6e\x69\xb8\x33\xb0\x5c\x3b\xa2\x0d\x94\xb1\xe5\x57\xcb\x91\xdd\x3d\x5c\x1e\x86\xae\x0c\xdc\x4a\x6d\xfa\x4f\x5c\x64\x44\x29\x14\x2e\x4e\xfd\xe7\xf9\xc8\xc6\xe1\x49\xd5\x1a\x5c\xaf\xcd\x45\x94\x2f\x34\x7a\xa7\x04\x65\xeb\x79\xe8\x0e\x79\xcd\x9f\x26\x79\xf4\xb8\xd0\x20\x3d\x60\x8a\x43\x7a\x36\x6b\xc8\xd0\xcc\xf6\x83\xa5\xe5\xc4\xdc\xbf\xf4\x79\x98\x35\x52\x2e\x47\xa4\x4f\x8a\x97\xa3\xba\x57\xfd\x5b\x23\x0d\x9c\xbb\x5c\x22\x6a\xd3\x65\x6a\x4e\xd4\x66\x90\xa8\x3d\x83\x9a\x95\xe3\xf6\x4c\xf1\xef\x10\xfd\x2f\x5a\x87\x0c\x30\xcb\x73\xfd\x61\x6d\xe9\x39\x3b\x3c\x4b\xf2\xf9\x94\x99\xea\x1b\x0f\xf1\x57\x48\x12\x14\x5d\xcc\x37\x66\x6c\x0a\xea\xde\xea\x6f\xb8\x9f\x85\xbb\x96\xea\xa1\xde\xec\xe9\x77\x09\x43\xe9\x78\x7a\x92\x6d\xaf\x8c\x8c\x53\x57\x5c\x64\xf6\xbf\x4e\x86\xfc\x7a\xe0\xd9\x46\x8f\xa3\x7e\x1d\xf2\xcb\x00\x16\x3d\x34\xfc\x1c\xd1\x37\xad\x7b\xc5\xd5\xa2\x56\x73\xd3\x98\x71\x4e\xe1\x5a\x7d\xd9\xc2\x35\x26\x6e\x62\xe1\x1a\x5b\x3e\xa5\x70\xad\x3e\xa7\x70\x8d\x6c\x1c\x9e\x54\xed\x51\x0a\xd7\x80\x29\x13\x0b\x57\x13\x37\xe3\xbc\x1c\x16\xfe\x08\x75\x6b\xe4\xf7\x39\x2d\x5d\xe5\x5f\xec\x7a\xe9\xc1\x76\x8e\x55\x4f\x27\xaf\x83\x6c\x3d\x73\xbd\xa1\x69\x7b\xd8\xd0\x8d\xa7\x19\xf1\xdc\xef\x06\x86\x5c\xa8\x23\xc4\x7e\x47\x1b\xf6\xc8\xfb\x0f\xd2\xf8\x58\xd3\x8f\x0b\xf8\xcf\x25\x6c\x8d\x2b\x4c\xef\x7b\xce\x59\xca\x3b\x33\x79\xc0\x84\x27\x93\xb1\xf3\xd4\x31\x1d\x97\x40\xf2\x1c\x59\x32\x3f\x32\xc9\x66\xab\x3e\x30\x5d\x0c\x0f\x2a\x92\x75\xea\xb6\x33\xe7\x44\x1c\x74\x0e\x7e\x03\x62\xdb\x29\x61\xaf\x2c\x68\x5f\x8c\xdb\xd8\x44\xf9\x11\xb4\x1b\x80\x3b\xe8\x9f\x85\xf6\x70\xe6\xfd\x9d\x29\xf6\x2b\xa7\x0c\x93\xb1\x64\xa8\x4f\xa9\xd1\x3f\x38\x65\x3f\xee\x2d\xf0\xc7\x69\x31\x2b\xcb\xe8\x9a\xa7\x29\xc6\x8a\x72\x66\x57\x54\xd5\x2c\x1c\x3d\x40\x35\xa7\x27\x62\x42\x74\x42\x93\x34\xa5\xd7\x1e\xb3\x49\xb3\x2b\x8a\xce\xed\x2f\x5c\xfa\xf1\x7b\x8c\xba\x74\x4e\xd6\x7a\x42\xa2\x7d\x14\xa5\xfd\x23\x40\xdd\xff\x8f\x2b\x6d\x6f\xa4\xda\x35\x09\x47\x69\x72\xa5\x2c\x72\xf3\xaf\xb1\x5b\x22\x28\x49\x04\x8d\x81\x88\x75\x91\x21\x53\xf2\x12\x24\x65\x31\xc2\x0e\xa1\x90\x98\x80\x4f\x16\x2b\x72\x87\x10\x13\xe6\xbe\xaf\x6e\x10\x56\x54\x48\x05\x54\x61\x06\xd4\xfe\x4b\xae\xd5\x88\x48\xa0\xea\xaf\xed\xe7\x59\x3d\x43\x02\x5f\xd9\x6f\xb5\x02\xb7\x94\x17\xd2\x8a\xb4\x0b\x2c\x62\xa0\xf8\x1a\xd5\x06\x85\x45\x3d\x45\x36\x3f\x02\x65\x08\x7f\x83\xef\xeb\x46\xea\xfc\x53\xcf\x11\xc9\xef\xbf\xff\x30\xb9\x5b\x1b\xfe\xc2\x1f\x1c\x7e\x90\xb4\x21\xd3\xd4\x29\xaf\x84\xe9\xd2\xf4\x2e\xde\x60\x46\xfc\x2f\xaa\xde\x98\xcd\x13\x30\x37\x4c\x68\x46\xdd\x25\x8a\xc9\xa5\x61\xff\xa5\xb9\x58\x19\x7e\xd5\x2b\xbe\x07\x97\x79\x3a\xe9\x4c\xe8\xfa\x3a\x7d\x74\x0f\xfe\xc6\xca\xf9\x17\xe9\x85\x7f\x8f\x00\x55\xd0\xe9\x50\xcc\xaf\xce\xe1\xc2\x11\x58\xa0\xf4\x89\xda\xda\xc8\x85\x8c\xae\x79\x96\x73\x49\x15\xfe\x62\xff\x77\x9b\x72\xf6\x52\xbf\xd1\xab\x74\xa6\x70\xfc\x72\x8b\x18\x4d\x83\x2a\xf8\x5f\x00\x00\x00\xff\xff\x42\x0a\x05\x41\xe5\x2f\x00\x00")
+var _templatesClientMockGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x4d\x6f\xdb\x38\x13\xbe\xeb\x57\xcc\x6b\xf4\x5d\x48\x81\x23\xdf\x53\xf8\x10\xa4\x5d\x34\xc0\xb6\x29\x9a\x2c\x7a\x58\x2c\x16\x8c\x34\x92\x88\x88\xa4\x40\x52\x49\x5d\x41\xff\x7d\x31\x14\xf5\x61\x5b\xb2\xbd\x3d\xc5\x11\x39\x5f\xcf\xcc\x3c\x33\x6c\x9a\x6b\x48\x31\xe3\x12\x61\x25\x54\xf2\xf2\xc8\x73\xc9\x6c\xad\x71\x05\x6d\x1b\x56\x4c\x33\x61\xe0\xaa\x69\xa0\x62\x26\x61\x25\xff\x89\x10\x7f\x61\x02\xa1\x6d\xbf\xba\xc3\xa6\x01\x9e\x41\x7c\x5b\xdb\x42\x69\xfe\x13\x53\x68\xdb\x35\xb0\xda\x16\xf7\x32\x53\xa0\x6b\x69\xb9\xc0\xf8\xae\xe4\x28\xed\xad\xff\xfc\x5d\x73\x8b\xba\x69\x50\xa6\x6d\xeb\x35\x7c\x62\xe6\xd1\x6a\x64\x82\xcb\xfc\x1b\x9a\x4a\x49\x43\x56\xd6\xf0\xe6\x2e\x03\x57\x71\x2f\x06\x28\xc9\x4c\x04\x5e\xf4\xb1\x4e\x12\x34\x66\x22\x15\x36\x0d\x68\x26\x73\x3c\x3a\x34\xd0\xb6\xf3\xf1\xac\x61\xd0\x3c\xfc\x40\xad\x95\x5e\xb4\x12\x0d\xf7\x02\xc2\x71\xf2\x73\x0a\xe9\xad\xce\x0d\xa1\x59\x5d\x80\xd7\xa0\xf0\x32\x50\x66\xed\x6f\x36\x70\xa7\x52\x84\x1c\x25\x6a\x66\x31\x85\xe7\x1d\xe4\xea\xda\xbc\xb1\x3c\x47\xfd\x1e\x3e\x3c\xc0\x97\x87\x27\xf8\xf8\xe1\xfe\x29\x0e\x82\xc0\x9b\xba\x53\xd5\x4e\xf3\xbc\xb0\x70\xdd\xb6\x9b\x0d\x81\x91\x28\x21\x50\xda\x83\xb3\xd1\x68\x10\x54\x2c\x79\x61\x39\xd2\xe5\x1e\xc6\x20\xd8\x6c\xe0\xa9\xe0\x06\x32\x5e\x22\xbc\x31\xb3\xef\x89\x2d\x10\xbc\x2b\x60\x95\x2a\x63\xba\xff\x31\xe5\x96\xcb\x1c\xec\x20\x27\x9c\x2b\x95\x56\xaf\x08\x59\x6d\x9d\xaa\x02\x25\xec\x54\x0d\x1a\xaf\x75\x2d\xf7\x34\xf5\x26\x9c\xcf\x4c\xa6\x41\xc0\x45\xa5\xb4\x85\x30\x00\x58\x65\xc2\xae\xe8\x2f\x57\xee\x8f\xd9\xc9\x64\x15\xd0\xaf\x9c\xdb\xa2\x7e\x8e\x13\x25\x36\xb9\xba\x56\x15\x4a\x56\xf1\x8d\x2f\x5a\x77\x85\xd0\x71\x9a\x0c\xc4\x1f\x30\x63\x75\x69\xef\xfd\xff\x6d\x7b\x70\x3e\x39\x88\x1c\x0c\x9f\x55\xf2\xf2\x84\x86\x42\x7b\x02\x6e\x9c\xc7\x15\xd3\x16\x54\x06\x57\xb6\x3b\x88\x9f\xa0\x36\x23\x34\xcc\x18\xd4\x96\x2b\x09\x05\x96\x15\x6a\x43\x77\x49\x4f\xd7\x41\x8f\xa8\x5f\x79\x82\x81\xdd\x55\x78\xa0\x5e\x5a\xd4\x19\x4b\x10\x9a\x00\xe0\x93\x13\x0e\xa3\x00\xe0\x23\xd5\x70\x16\x66\x4a\x0b\x66\xc1\x58\xcd\x65\xbe\x06\xa6\x73\x03\x71\x1c\x0f\x62\x4d\x1b\x05\xad\xf3\xfa\x0b\xbe\x1d\x19\x84\x44\x23\xb3\x68\x80\x01\xd5\x34\x39\x45\xde\x36\x0d\x14\xb5\x60\x72\xda\x47\x70\xfb\xf5\x1e\x12\xe7\xed\x1a\xac\x02\x8a\xd3\x45\x9e\x50\x55\xa6\x58\xa1\x4c\x29\xd7\x8a\x32\xc8\x8d\xbf\x0a\x6f\xdc\x16\xaa\xb6\xc0\xe4\x0e\x0c\xea\x57\xd4\x71\x90\xd5\x32\x99\x75\x27\x8c\xe0\xea\xd8\x47\x0a\x5c\xa3\xad\xb5\x84\xdf\x8e\x4e\x9b\xd6\x85\x77\x15\x1c\xcb\xf1\x21\x2c\x2e\xaa\x12\xa9\xe6\x99\x4b\x81\xca\x60\xef\x66\x1c\x04\xf7\x16\x34\x26\x4a\xa7\x5d\x36\x13\x56\x96\x2e\x45\xc8\x92\x02\x04\xda\x42\xa5\x6b\x60\x32\xf5\x8e\x74\xb7\x5e\x59\x59\xa3\x81\x4a\xab\x5c\x33\x21\x30\x75\xd1\xba\xa3\x07\xd9\x7c\x76\x52\x2d\x28\x0d\xdf\x9c\xd0\xf0\x85\xe2\x27\x47\x4c\x1c\xdc\xb1\xb2\x24\xd4\x98\x37\x02\x6f\x05\x4f\x0a\x28\x98\x01\xa9\x2c\x3c\x23\xca\xa9\xfe\xde\x3a\x93\xe0\x38\x2c\x0e\xae\x36\x63\xd1\xec\x87\x6f\xac\xae\x13\xeb\xca\x46\xd4\x40\xcd\x11\x7f\xae\x2d\xfe\xa0\xf2\xb7\x9a\x49\x43\x55\x7d\xc0\xe4\x4f\xfd\x77\xc7\x3c\x9e\x6a\x1f\x2a\x6a\x73\xf2\xd7\xb1\x83\x6b\x8e\x84\x09\xdc\xa3\xd9\xdf\x29\xa7\x14\x58\xd3\x80\x45\x51\x95\xcc\x1e\xcf\x9e\x18\xda\x76\x41\x9e\x80\x30\xf0\xd7\xdf\x14\xc7\x2c\x8f\xd3\x85\x29\x1f\xb6\x41\xf0\xca\x34\xfc\xb3\x9f\x4a\xd8\xce\xd7\x48\xd3\xcc\x47\xe3\xbb\x79\xd1\xe2\x50\x15\xcc\xd5\x84\x2b\xfc\x02\x61\xf6\x7e\x9f\xc2\xd3\x9d\xbd\x6c\x6a\x92\xb0\xaf\xe7\x27\xb4\x23\xa9\xeb\xe3\xa9\x13\x00\xdc\x5e\x32\xa6\xbd\xbc\x47\x73\x54\xb6\x30\x9b\x02\x80\xef\x07\x13\x7b\x5f\x03\x75\xe1\x66\x01\x98\xc3\xd6\x3a\xea\xa4\x49\x89\x77\x4d\xd5\x71\x44\x28\x66\xf8\x20\x9a\x37\x72\xae\xee\x1c\xae\x22\x16\x75\xfc\x87\x4a\x5e\x1c\x85\x8a\x78\xb9\x12\xb7\xc0\x2a\xa2\xb4\xf0\xc4\xa5\xf5\xe9\x84\x92\xc1\x3e\x95\x37\xd0\xed\x09\x6b\xf7\x6d\x29\x71\x63\xea\x6e\x86\xdd\x61\x94\x18\x52\x75\x49\xb2\xfa\x74\xdd\xf8\x9d\x62\x46\x4d\x4b\x18\x64\x12\x6e\xb6\x30\x1f\x24\xf5\x74\x0f\xda\x9f\xb2\xec\x60\x0b\x80\x6a\x2e\x93\xb0\xdd\x82\xe4\xa5\x83\x75\xe0\xe7\x93\xfb\x99\xe4\xe5\x64\x19\xcb\x84\x8d\xfd\x0c\x5b\xd5\x12\x7f\x54\x98\xd0\x52\xd3\x77\xd9\x2c\xaa\x37\x20\x15\x68\x34\x75\x69\x1d\x45\x1e\xd2\xa3\xa3\xdf\x07\x39\x2b\x3b\xe1\xe1\xb9\xe3\x15\x81\xd1\x8e\x93\x26\x93\xe1\x51\x45\x75\x2b\x1f\x15\x53\x3f\x53\x97\x6c\x79\x9f\xba\x8a\xef\xe9\xde\x31\x48\xb7\x12\xcc\x4a\x9d\xac\xf9\x05\x4b\x61\x26\x2f\x62\xdd\xc5\xc1\xba\xdf\x11\x29\x66\xa8\x0f\x12\xbe\xd4\x27\x54\x1d\xb0\x85\x4c\x8e\xa0\x09\x0f\xcb\x09\x9c\xf7\xa1\xf1\x13\xb4\xc3\xfc\x57\xa1\x39\x61\x6d\x7c\x30\xbc\xe3\x6b\x78\xa7\xfb\x16\xb9\xd9\xce\x56\x68\x7f\xde\x34\xf0\x8e\x93\xb3\x67\x9f\x14\xa8\x75\x37\x89\xcf\x6d\x2e\x22\x5e\xcc\xe0\x05\xe9\x5b\xe8\xb2\x5f\x0a\x6a\xdf\x7b\xaa\xfa\xbe\x9a\x67\xfd\x23\x26\xeb\x33\x34\x5d\x8e\xac\x3a\x49\xf6\x98\x82\x51\x90\x31\x7d\x32\x75\xcb\x16\xc3\xe8\xdc\x32\x70\x71\xf9\x76\xfe\x12\xcb\xb1\x17\x0c\xcf\x68\x5d\x43\x89\xf2\x14\xe9\x47\xd4\x27\x89\xaa\x76\xa1\xd3\xbb\x5e\xe8\x0e\xf2\xd0\x44\x63\xfe\xdd\x65\x8f\xf3\xad\x7b\x0c\x2c\xba\x80\xa9\x7f\x2e\x10\xdc\xcc\x2e\xc0\x3c\xf0\x9f\x67\x15\xa3\x04\x82\xac\xc5\x33\x6a\x5a\x5c\x69\xee\x9f\x9e\xa2\x67\xbd\x08\xed\xde\x53\x64\xdd\xe9\xa4\x17\x49\x04\xcf\x4a\x75\xd4\x6f\xe3\xc9\x9b\x84\x67\xbe\x38\x6e\xb6\x23\x8c\xf3\xea\x4d\x18\x45\xef\xfd\xed\xff\x6d\xbd\x6a\x52\x08\x30\xce\x85\x61\x2a\xcc\xaa\xa1\x31\xf1\x8c\x3d\xab\xfe\x3f\x75\x4a\x42\x13\xad\xe1\xb9\xb6\xc0\xad\x7b\xa4\x1e\x9d\xae\x7c\x1c\x6b\x27\x68\xa2\x69\x63\x65\xac\x34\xb8\x3f\x07\xac\xae\x31\x70\xfb\xa3\x9f\x9b\x9b\x0d\x3c\xe2\xb8\x28\xef\xed\x36\xe3\x5a\x6d\xd0\x76\x2f\x21\xf4\x0f\xa1\x93\xa9\x98\x2a\x0c\xcf\xee\xe6\xd1\x7f\x20\xee\x51\xd9\x76\x5c\xfa\x7d\x19\x0e\x0a\x7d\xac\x67\x63\xe8\x9e\x37\x53\x67\x4f\x46\x35\xdc\x0a\xa3\xa5\x50\x2e\x8e\xc4\x67\x43\xc4\x87\x41\x7c\x43\x83\x16\x32\xa5\x73\xb4\x53\x7a\x3a\x60\xa1\xae\x26\x5e\x10\xab\x5f\x59\x38\x9d\x91\xf0\x72\xdc\x17\x1f\x4f\xe7\x76\x4e\xc9\xcb\xc3\xad\xfa\xdf\x01\x00\x21\x87\x4a\xd2\xcb\x13\x00\x00")
+
+func templatesClientMockGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientMockGotmpl,
+		"templates/client/mock.gotmpl",
+	)
+}
+
+func templatesClientMockGotmpl() (*asset, error) {
+	bytes, err := templatesClientMockGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/mock.gotmpl", size: 5067, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf3, 0x2e, 0x16, 0xff, 0xe2, 0xc8, 0xfc, 0xd6, 0x4c, 0x39, 0xa7, 0x78, 0x43, 0x8c, 0x3e, 0xe5, 0x42, 0x5d, 0x96, 0xf1, 0x91, 0x78, 0x68, 0x8, 0x10, 0x7d, 0xc7, 0x34, 0xf8, 0xaf, 0x94, 0xfe}}
+	return a, nil
+}
+
+var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\x98\xd3\xf5\xf6\xa4\x20\x95\xf7\x39\x8b\x1c\xd0\x4d\xba\xd7\x1c\x70\xdd\x5e\x13\xec\x3d\x14\xc5\x81\x91\xc6\x36\x77\x25\x52\x25\xa9\xb8\x3e\x43\xdf\x7d\xc1\x3f\x92\x28\x59\xb2\xe5\xb4\x69\x77\x81\x3e\xd9\x12\xc9\xe1\xcc\x6f\x7e\x33\x1c\x92\x5a\x2c\xe0\x8a\x67\x08\x2b\x64\x28\x88\xc2\x0c\xee\xb7\xb0\xe2\xcf\xe5\x86\xac\x56\x28\x7e\x80\xeb\x9f\xe1\xf5\xcf\x77\xf0\xf2\xfa\xe6\x2e\x09\x82\x60\xb7\x03\xba\x84\xe4\x8a\x97\x5b\x41\x57\x6b\x05\xcf\xeb\x7a\xb1\x80\xdd\x0e\x52\x5e\x14\xc8\xd4\xa0\x6d\xb7\x03\x64\x19\xd4\x75\x10\x04\x25\x49\x7f\x23\x2b\xd4\x9d\x93\x37\xee\xbf\x6e\x58\x2c\xe0\x6e\x4d\x25\x2c\x69\x8e\xb0\x21\xb2\xaf\x8c\x5a\x23\x38\x6d\x40\x71\x9e\x27\xc1\x62\x01\x2f\x33\xaa\x28\x5b\x81\x6a\xc7\x15\x46\x9b\x52\xf0\x07\x84\x65\xa5\x8c\xa8\x35\x32\xd8\xf2\x0a\x04\x3e\x17\x15\xeb\x49\x6a\xa6\x30\x6a\x13\x96\x05\x01\x2d\x4a\x2e\x14\x44\x01\x40\x98\x72\xa6\xf0\xa3\x0a\xf5\x7f\x86\x6a\xb1\x56\xaa\x34\x0f\x8a\x16\x18\x06\xfa\xdf\x8a\xaa\x75\x75\x9f\xa4\xbc\x58\xac\xf8\x73\x5e\x22\x23\x25\x5d\xa0\x10\x5c\xc8\x70\xba\x83\xa8\x98\x95\x01\x90\x8a\x23\x9d\x16\x69\x4e\x91\xa9\x03\xd2\xa4\x12\xcb\xe2\x60\x87\x0d\x59\x1d\x68\x7e\x20\x39\xcd\x88\xb2\x26\x69\xd7\x1a\x0c\x24\x24\xd7\xb8\x24\x55\xae\x6e\xdc\x73\x5d\x0f\xda\xbd\x86\xd8\x38\xf0\x35\x6e\x76\x3b\x28\x89\x4c\x49\x4e\xff\x8f\x90\xbc\x26\x05\x42\x5d\xbf\x21\x82\x14\x12\x52\x81\x44\xa1\x04\x02\x0c\x37\x70\xa8\x27\xbf\xff\x15\x53\xa5\x45\x6e\xa8\x5a\x1b\x9f\x65\x56\x19\x78\x20\x79\x85\x12\x28\xa3\x8a\x9a\xb1\x59\x12\x2c\x2b\x96\x1e\x99\x3c\x8a\xe1\xec\xd0\x8c\x3b\x67\xdb\x12\x12\xf7\xa6\xae\x1f\x88\x30\x4c\xd8\xed\x40\x10\xb6\x42\xaf\xc9\x75\x7d\x45\xa4\x03\xa9\x7d\xc7\xb8\x82\xe4\x46\xfe\x44\x73\x34\xbd\x6d\xc3\x03\x11\x4c\xab\x93\xdc\x5c\xd7\x75\x33\xe4\xb2\x99\xf1\x46\xbe\x11\xb4\xa0\x8a\x3e\xa0\xee\x9d\xfc\x93\xdf\x6d\x4b\xac\xeb\xc8\x06\x8e\x7e\x07\xa5\xa0\x4c\x2d\x21\xfc\xdb\x5f\x1f\x42\x48\x86\xb3\xfa\x22\xa0\xae\xe3\x2e\xe2\x8c\x59\x36\xfa\xbc\x3f\x46\x6a\x00\xd0\xeb\x28\x50\x55\x82\xc1\x77\xfb\x38\x35\x30\xed\x4e\x42\x63\x4f\xc8\x85\x33\x98\xb0\x0c\x22\x07\xd4\x0b\x21\xc8\x36\x6e\x1f\xff\x4d\xca\xe6\x41\x8b\xa3\x32\xd5\x66\x31\xa2\xb8\x88\x21\xe2\x42\x83\xf5\xba\xca\x73\x72\x9f\x23\x40\x0c\x75\xfd\x9d\x67\x96\x8f\x33\xb4\x40\x9f\x8f\x82\x10\x00\x98\xd7\x29\x29\xd0\x5a\x7a\x47\x0b\xe4\x95\x72\xc4\xb8\x80\x54\x34\x38\xbb\x16\x2d\xa8\x0e\xea\x19\x5c\xff\x2f\x55\x6b\x37\xe8\xa9\x68\x7f\x6e\x60\xd4\x7d\xc8\x3d\xcd\xa9\xda\x82\xe2\x20\x51\x01\x01\xe5\x66\xe6\x0c\x08\x08\xfc\x50\xa1\x54\x73\x82\xc4\xd3\x3a\x6a\x64\xe8\xdf\xe4\xba\x12\x44\x51\xce\xbe\x05\xd1\xd7\x0c\xa2\x9b\xeb\x3f\x5d\x08\xa9\xc7\x04\xce\x95\x5d\x78\xbf\x42\xe0\xb8\x25\x1f\x96\x5c\x9c\x1e\x39\x4e\xed\x28\x55\x1f\x1b\x41\x89\x7b\xf7\x75\xe3\xa6\x73\x8f\x86\xfa\xdb\xfa\xf3\x84\xeb\x4f\x1f\xea\x59\xf1\xe3\x28\x72\x01\xa9\xfa\x78\x5a\x9c\xbc\xba\xbb\x7b\x73\x65\xaa\xc3\xaf\x11\x2a\x95\x54\xbc\x00\x4f\x87\x47\x05\x4d\x37\x3e\xb2\x85\x2e\x9c\xe9\x3a\x3b\xb1\xef\xbe\xc5\xcd\xb7\xb8\x19\x89\x9b\x8e\x34\x17\x60\x59\xd3\x05\xce\x41\xc2\xe8\xb4\x4c\x28\x93\x40\xf2\xdc\xec\x2a\x4a\xed\x6d\x54\x28\xa4\xad\x9e\x74\x45\xc5\x4d\xcb\x8b\x37\x37\x7a\xb6\x92\x53\xa6\x02\x4d\x6d\xfd\x72\xb7\x83\x75\x55\x10\xe6\x8b\x06\x5e\xea\x7d\x2a\xe5\x0c\xd4\xb6\xa4\x29\xc9\x73\xb3\x5f\x95\x08\x44\x20\x6c\x04\x55\x0a\x99\x16\x4b\xc0\x50\xfb\xad\x8b\x90\xb3\x45\xa0\xb6\x25\x1e\x8c\x56\xa9\x44\x95\x2a\xd8\x05\xe3\x0e\x9c\xb0\x76\xb7\xd3\x6e\xbd\x46\xed\x84\x52\xd7\x6d\x2d\xa1\xee\x73\x9e\xfe\xd6\x6e\xd2\x07\x3d\x7c\xac\xcf\x16\x01\x0c\x34\x33\x25\xf5\xa7\x32\xc1\x75\xba\x61\x0a\xc5\x92\xa4\xd8\xbd\xba\x55\x02\x49\x31\x41\x96\x33\x9f\x2c\x93\x01\xeb\x02\xd0\x51\x25\x97\xda\x3d\x6e\x1b\x6d\xbc\x95\xbd\x45\x92\x5d\xe5\x5c\xa2\xe8\x42\xa9\x95\xec\x30\x9e\x2a\x66\xfa\x95\x70\xd0\x26\xee\xe1\x5a\x1f\x80\x9f\x14\xfd\x6c\xe6\x12\xbb\x4e\xdd\x7d\x64\x07\x13\x91\x2c\x93\x9a\x41\x6d\x1d\xaf\xf8\x34\xfb\x0c\x83\xa5\xad\x51\x74\xa9\x9b\xbc\xc5\x14\xe9\x03\x8a\xa6\xc3\xa1\x80\x88\x8f\x2a\xf3\x29\xfb\x80\xa1\x2a\xc9\x2d\xaa\x39\x73\xc5\x5d\x4e\x1b\x91\xe2\x50\x3c\x22\xeb\x8b\x82\x38\xd3\xae\x21\x86\x53\x30\x1d\x22\xe1\x65\x63\x8f\x47\xa6\x86\x88\xad\xc9\x8e\x91\x4f\x69\xf2\x67\x29\x78\xf7\x2c\xbf\x45\xe5\x09\x9d\xcb\x83\xaf\x61\x7f\x5f\xd3\x7d\xf3\xa7\x2c\x74\x1d\xe0\x52\x97\x7b\x9e\x0f\xbd\x94\xd1\x9a\xe1\xbd\x7b\x62\x4f\x7e\x8e\x2a\x6c\xcf\xd4\x5b\x54\x7b\x72\xe7\xba\xb4\x1b\xd8\x79\xf5\xcb\xc0\x31\xa6\xf5\x00\x8d\x29\x83\x3d\x05\x2f\x5d\x5d\xa2\x2d\x1a\x59\xb7\x1b\xaf\xf7\x35\xb1\x0b\x6c\x6b\xaf\xbf\x17\x37\x53\xe8\xd6\x11\xcb\x9f\x4d\x9a\xfe\xec\x88\xed\xcf\x86\xc6\x4f\xe8\x14\x8d\xaa\xf2\x79\x2a\x81\x2f\xbd\xec\x3b\x79\xf1\x61\x28\x1a\x52\xef\x21\xb8\xbf\x86\x4d\x23\x34\x97\xec\xc7\x58\xd0\x2d\x06\x5f\x88\x06\x27\xd8\xf8\x67\x67\xc1\xa4\x9f\x47\x00\xb0\x67\x8d\x7b\x10\xb8\x18\x77\x45\xa4\x8e\x6c\x41\x15\xde\x71\x57\xe7\x9b\x1d\x00\x4a\xb7\x25\xb0\xbe\xd1\xfe\x23\xed\x35\x54\x6f\xcb\xfc\x98\x0c\xde\x9b\x2f\x12\xd0\x98\x6d\x93\x91\x7b\x7f\x0e\x02\x57\x60\x2f\x8b\x92\xb7\xb8\xa2\x52\x89\x6d\x0c\xe6\xb2\xca\x6e\x30\xe8\x52\x3f\xc1\xc5\x25\x88\xe4\x16\x9b\x43\xef\xe8\xc4\x12\x25\xfe\xc1\x48\xf9\xcb\x25\x30\x9a\x9b\x38\x6a\xa3\x00\x85\x30\xfb\x34\xd0\xb1\x02\x02\x25\xbc\x7b\x6f\xe6\x37\x4e\xe8\x25\xc9\xa6\x1c\x77\xee\x76\xbc\x30\x09\xc6\x91\x4a\xff\xfc\xc8\xb3\xad\x49\x04\x71\xbb\xc3\x71\x64\xf4\x49\x64\x99\xf8\x22\xcf\xf9\xe6\x65\x51\xaa\xed\x2f\xfa\x0a\x49\x8f\xa0\x4b\x3d\x22\x31\xcf\x2f\x3f\x96\x02\xa5\xb4\x5b\xa1\x56\x7b\xb7\x3b\xf0\x84\x27\x37\xf2\x3f\x15\x8a\x6d\xc3\xbc\x00\x60\xb1\x80\x0f\xfa\x95\xcd\xbf\xba\x5f\xe3\x21\x7f\x54\xab\x8e\xbd\x58\xfa\x20\x46\x7d\x0a\x3d\x26\x07\x00\xc7\x75\x34\x08\x4f\x89\xbb\x84\xb3\xf1\xe1\xda\x11\x5d\xa0\x4c\x0d\xbf\xb8\x9c\x98\xdd\xc3\xe5\xc3\xfe\xd0\x76\xa4\x36\xfd\x27\x2e\x0a\xa2\x14\x0a\x17\xa7\xfe\x73\x34\x31\x71\x7c\x54\xb5\x16\xd7\x2b\x73\x10\xe5\x0b\x4d\x6e\x95\xa0\x6c\x15\xc5\x6e\x93\xd7\xfe\xb4\xc9\x63\xc0\x85\x16\xe9\x11\x53\x1c\xd2\x61\xd8\x92\xa1\xed\xed\x07\x4b\xc7\x89\xc8\x3f\xf4\xf9\x10\xb6\x52\xce\x27\xa4\xcf\x8a\x97\x83\xba\x77\x07\x23\x6e\x3b\xab\x7d\xea\x0e\x97\x88\x5a\xf7\x99\x5a\x12\xb5\x1e\x25\xea\xc0\xa0\x76\xe4\xb4\x3d\x73\xfc\x3b\x46\xff\xb3\xce\x21\x23\xcc\xf2\x5c\xbf\xbf\xb6\x0c\x9c\x1d\x9f\x24\xf9\x74\xca\xcc\xf5\x8d\x87\xf8\x2b\x24\x19\x8a\x3e\xe6\x6b\xf3\x6e\x0e\xea\xde\xe8\x6f\xb8\x9f\x84\xbb\xe6\x84\x87\x7a\x3b\xa7\x5f\x25\xf8\xef\x1b\xed\x1b\x2f\x8c\xab\xee\xab\xe0\x74\x33\xe9\x76\xb1\xd0\x77\x44\x85\xfd\x5a\x65\xcc\xaf\x7b\x9e\x6d\xf5\x38\xe8\xd7\x11\x15\xc6\xb0\x18\xa0\x01\x30\x6d\x9a\x6b\xd9\xcb\x0f\x0d\x37\x8d\x19\x63\x16\xec\x89\x73\x87\xeb\xcb\xcf\xbb\x70\x2d\x3f\x6d\xe1\x5a\x7e\xc2\xc2\xb5\xfc\x94\x85\x6b\x62\xe2\xf8\xa8\x6a\xa7\x47\xc3\x8c\x85\x6b\xc4\x94\x99\x0b\x57\x1b\x37\xd3\xbc\x1c\x17\xfe\x04\xeb\xd6\xc4\x7f\x97\x8b\x66\x95\x74\x0d\x66\x46\xa2\x97\x1e\x6c\xe5\xe8\x49\x74\x89\xad\xad\x20\x3b\xcf\x5c\xad\x69\xde\x6d\x36\x74\xe1\x69\xde\x78\xee\x77\x2f\xc6\x5c\xa8\x4b\x3b\x7b\x8f\x36\xee\x91\x77\xef\xa5\x29\x4e\x02\xd0\x09\x04\xfe\x77\x0e\x0f\xc6\x15\xa6\xf6\x3d\x65\x2f\xe5\xed\x99\x3c\x60\xdc\x76\xa9\xa1\xcd\x08\xff\x9d\xa7\x0e\xe9\x78\x09\xa4\x2c\x91\x65\xd1\x81\x4e\x36\x5b\x0d\x81\xe9\x63\xd8\x6b\x70\x77\x62\x3a\x89\xf4\xfa\x1c\x89\x03\x37\x66\x52\x6c\xd7\x25\xf6\x92\x9d\xf6\x7b\x5d\x1f\x50\xbf\x8b\xf2\x03\x68\xb7\x00\xbb\x67\xbb\x93\x3d\x09\xed\x21\xab\xff\x90\x8a\xfd\xca\x29\xc3\x6c\x5f\x1d\x9b\x0c\xf5\x2e\x35\xf9\x17\xa7\xec\xc7\xad\xf5\xd1\x61\x5a\x84\xbb\x5d\x72\xc5\xf3\x1c\x53\x7d\xc8\x6d\x47\xd4\x75\x18\x4f\x6e\xa0\xda\xdd\x13\xd1\x46\xce\x29\x92\xe6\xd4\xda\x53\x36\xe9\x2c\x9b\x24\xa7\xd6\x17\x2e\xfd\xf8\x35\x46\xb3\x74\xce\xd6\x7a\x46\xa2\x7d\x12\xa5\xdb\x42\x5e\xdf\x17\xba\xfa\x7f\x5a\x69\x7b\x22\xd5\x8d\xc9\x38\x4a\xd0\x2c\x94\x55\xa9\xbf\xf6\xd4\x3b\x77\x4a\x32\x41\x53\x20\x62\x55\xe9\x8f\x7d\xe5\x39\x48\xca\x52\x84\x0d\x42\x25\x31\x03\x9f\x2c\xb6\xc8\xd8\x20\xa4\x84\xb9\xfb\xd5\x35\xc2\x92\x0a\xa9\x80\x2a\x2c\x80\xda\x4f\x72\xad\x46\x44\x02\x55\x7f\xef\xae\x67\x75\x0f\x09\x7c\x69\xba\x94\x02\x1f\x28\xaf\xa4\x15\x69\x07\x58\xc4\x40\xf1\x15\xaa\x35\xea\xd3\x05\xba\x84\x1c\x59\x74\x00\xca\x18\xfe\x01\xdf\x3b\xfc\x86\x4e\x6a\x0d\x7f\x94\x93\xde\x7d\xff\x7e\xcc\x49\x03\x37\xd9\x34\xd5\x38\x8b\x65\xbd\x68\xf4\xdf\xb6\x27\x20\xfe\x3a\xe5\x2d\x61\x7a\x69\xba\x4d\xd7\x58\x10\xff\x46\xd5\x7b\x67\xf3\x04\x44\x86\x09\xed\x5b\x77\x88\x62\x72\x77\x3c\x6c\x34\x07\x2b\xe3\x4d\x4d\x72\x99\x3a\xcc\xd3\x6b\xcf\x8c\xaa\xaf\x57\x47\x0f\xe0\x6f\xad\x8c\xc6\x85\xcc\x46\xf7\x8f\x0b\x50\xed\xd9\xdf\xfc\xeb\x6d\x2e\x1c\x81\x05\x4a\x9f\xa8\x9d\x8d\x5c\xc8\xe4\x8a\x17\x25\x97\x54\xe1\x2f\xf6\xdb\x6d\xca\xd9\x4b\xdd\x12\x09\x94\x49\x92\x34\x4b\xa1\x1b\xc4\x68\xee\x0e\x9b\xdd\xed\x93\x1b\x85\xd0\x7c\xfa\x2d\x87\x1f\x43\xf0\xe5\xde\xf9\xf2\xfe\xf7\x0e\xe7\x70\x4f\x74\xbc\x73\xd6\xbf\x75\x5b\xe3\xd6\x7c\xfa\x60\x92\x01\x65\x8f\x3e\xd7\x1c\x68\x3b\x76\xd1\x76\x6e\x92\x30\x51\x72\xfa\x60\x53\x63\xfb\xdc\xfb\x46\x48\x13\xb7\x2f\x99\x72\xe6\xbe\x6e\xdf\x3f\x96\xb4\xa3\xf7\xee\x6f\x74\x43\x27\x78\x52\x62\x6f\x15\x18\x3b\x47\x4d\x7b\xc3\xb0\x0f\x87\x39\x74\xd6\x56\xb7\x56\x8e\xf1\x5f\xeb\xdb\x56\x6c\x02\xe5\xb9\xee\xd2\x30\xa0\xd1\xd2\x25\x94\xc1\xe3\xe7\x65\x5b\x6f\x9e\x1e\xf5\x74\xcb\xb3\x34\x27\x52\x1a\xbb\x2f\x2e\x47\x9c\x1e\x4c\xe0\x7c\x1c\xe3\xc3\xf4\xf2\xe6\xed\x98\x35\x0f\xf6\x47\x92\x0d\x14\x16\x65\x4e\x14\x42\x58\x92\x6d\xce\x49\xe6\xe4\xb8\x68\xe3\x22\x84\x28\xa3\xa9\x82\xd0\xa6\x8e\xb0\xc9\x21\x10\x9a\xbc\x19\x42\x64\x96\x9e\x81\x41\x61\xa2\x1b\x7c\x3d\xe3\x18\x42\xbd\x62\x85\xfa\x6a\x51\xad\x21\xec\x72\x50\x68\xf3\xd6\x44\x01\x39\xeb\x4e\xa6\x4d\x98\xfb\x85\xa7\x97\xec\x4c\x56\x0b\x86\x1e\xef\xb1\xa1\xf7\xb7\x87\x8e\xcb\x37\xc8\xaa\xc2\x16\x95\x21\x24\x50\xd7\xc1\xef\x03\x00\x34\x86\x22\xed\x91\x34\x00\x00")
 
 func templatesClientParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -162,12 +297,12 @@ func templatesClientParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 12261, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0xd8, 0x41, 0xd2, 0x6, 0xe3, 0x24, 0x53, 0x68, 0xa4, 0xc6, 0xcc, 0x6d, 0xa3, 0x61, 0xd3, 0x49, 0x4e, 0xe2, 0x3d, 0xf3, 0x9b, 0x1e, 0x12, 0x19, 0xf, 0xd3, 0xd4, 0x4c, 0x37, 0x6f, 0x72}}
+	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 13457, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x65, 0xa4, 0xf3, 0x25, 0xa2, 0x6a, 0x78, 0x12, 0x33, 0x47, 0x74, 0x86, 0xb, 0x36, 0x1a, 0xfd, 0xf2, 0x53, 0xa1, 0x97, 0x76, 0xd5, 0x65, 0x6c, 0x3d, 0x9b, 0xdc, 0xee, 0x0, 0x31, 0xa, 0x65}}
 	return a, nil
 }
 
-var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x4b\x73\xe4\xb6\x11\x3e\x07\xbf\xa2\xc3\x78\xb7\x86\xca\x88\x63\xe7\x28\x97\x0e\xb6\x56\x5e\xeb\xe0\x5d\x95\xb4\xa9\x1c\x5c\xae\x14\x44\xf6\xcc\x20\x4b\x02\x34\x00\x6a\x3c\x61\xf1\xbf\xa7\xf0\x20\x09\x0e\xc1\x91\x36\x39\x65\x75\xd1\x10\x8f\x7e\x7e\xfd\xa1\x81\xb6\x85\x02\xb7\x8c\x23\x24\x79\xc9\x90\x6b\x89\xaa\x16\x5c\x61\x02\x5d\xb7\xd9\xc0\x07\x3c\xb4\x2d\xd4\x54\xe5\xb4\x64\xff\x46\xc8\x3e\xd0\x0a\xa1\xeb\x20\x97\x48\x35\x2a\xa0\x10\x9f\x3f\x30\xbd\x37\xa2\x69\x53\x6a\xd8\x23\x2d\x50\x2a\x78\xa6\x65\x83\x8a\x6c\x1b\x9e\x2f\x4a\x5e\xb5\x2d\xb0\x2d\xe0\xef\x90\xdd\x88\x02\xe1\xf2\x3b\xe8\xba\xdc\xfc\x62\x5c\xb7\x2d\x20\x2f\xa0\xeb\xdc\xa2\xec\x31\xdf\x63\x45\x87\x6f\xca\x0b\x58\x05\x3b\xd3\x7e\x45\x76\xa7\x1e\xb5\x44\x5a\x41\xd7\xad\xa1\x6d\x91\x17\x27\x32\xc2\x15\x07\xc9\x34\x4a\x60\x22\xfb\x87\xfd\x15\x6a\x75\x3f\x52\xb8\x88\xbb\xdd\x12\x00\x89\xba\x91\x1c\xde\x46\x57\x98\x05\x00\x31\x1f\xff\xa9\x34\xd5\x8d\x32\x03\x57\x60\x1c\x5e\xf7\x4b\x07\xe5\x92\xf2\x1d\x42\xf6\xb3\x0f\xe7\xe0\xc2\xcf\x54\xbd\xf3\xa1\xb6\x63\x73\xb5\x57\x36\x4d\x92\x71\xbd\x85\xe4\xcd\x5f\x9e\x13\xc8\xc6\x1d\x73\x45\xe7\x82\x1c\x09\xd8\x3d\x3d\x96\x82\x16\x57\xe0\x22\xb7\x24\xaf\x23\x1d\x21\x9b\x48\xe4\xba\x0e\xf6\x94\x17\x25\x2a\xd0\x7b\xa6\x20\xa7\x0a\x63\x08\xf2\x00\xca\x08\xf1\xa6\xbc\x43\x95\x4b\x56\x6b\x26\xb8\x53\xf4\x54\x8a\xfc\x73\x2e\xaa\x0a\xb9\x9e\x4f\x63\xa9\x70\x21\x40\xc6\xdc\x7d\x53\x51\x3e\x49\x96\x03\x0a\xb9\xd8\x10\x7d\xac\x71\x01\xea\x4a\xcb\x26\xd7\x36\xf5\xb1\xbc\x12\x80\x20\xb5\x06\xc5\x84\xbc\x2e\xad\x53\xf3\x6d\xe0\xce\xf9\x47\x00\x2e\x36\x83\x5c\xa7\x23\xee\x68\xf6\x5e\x7c\x32\xfe\xf4\xab\xc2\x1d\x93\x8c\x13\x00\x9f\x5b\x08\x2a\x8c\x0b\x1d\xa0\xe0\x47\xaa\xd0\x48\x4b\x4f\x27\xee\xb8\x46\xb9\xa5\x39\x86\x65\x78\x23\xaa\xba\xc4\x3f\x3e\x3e\xfd\x0b\x73\x7d\xba\xc3\x01\x2a\x85\xae\xbb\x38\x01\xe1\xe2\x42\xe3\x8d\x1f\x1e\x9c\x32\x7b\x4b\x65\x7e\x05\x25\xec\x32\x19\xba\xdb\x45\xb3\x45\x36\x1b\xb0\x9f\x3b\xd4\x06\x8e\x08\x2e\x79\xb6\x24\x61\x2b\xa4\x1d\x8b\xa1\x05\x7a\xee\x74\x04\x67\x88\x2c\x7b\xc0\x1c\xd9\x33\xca\x7e\x49\x9c\x36\x52\xab\x71\x95\x1a\x70\x84\x14\x12\x91\x90\x05\x58\x22\x1d\x19\xbd\x21\xff\x85\xd6\x5b\x29\x85\x5c\xa5\x06\xc1\x8c\xef\xa0\x25\x7f\xf2\x8a\xb7\x95\xce\x1e\x1d\x5d\xac\x92\x5f\xdb\x16\x9a\xba\x46\x09\xd9\x2f\xa8\xf7\xa2\xe8\x51\x74\x4f\xf5\x1e\xba\xee\xb7\x5f\xdf\x14\xbf\xf5\xd0\x19\x2a\x67\x02\x38\x9f\x8e\x86\x7f\xe6\xe2\xc0\x01\x8d\x5e\x58\xe4\x19\x78\xf3\xd7\xe7\x61\x32\x59\x47\xab\xea\x85\xd0\x8c\x3a\xcd\x42\xbb\xed\x0c\xb1\xad\x41\x64\x1e\xe7\x23\xc5\x1b\xb2\x9a\xd5\xc3\x97\xc7\xf8\x3d\x6a\x2f\x7a\x95\x7e\x1d\x45\x14\xe0\x64\x08\xdb\x14\x8a\x5f\x1e\x25\x89\xb4\x78\xf0\xe5\xb3\xea\xeb\x08\x64\xc3\x35\xab\x30\xbb\xb1\xad\x49\x3f\xbf\x86\x5c\x70\xd5\x54\x28\xc7\x05\x7e\x60\x6d\x0a\xb4\xa2\x5a\x19\x48\x1b\x10\x3f\xe0\x8e\x29\x2d\x8f\x69\x8f\x39\xc7\x00\x33\xc6\x25\x00\x9b\xcd\x50\xc0\xfd\x71\xd3\xb6\xfe\x78\xb2\xbb\x0c\x12\x6e\x04\x7f\x46\x69\xba\x03\x1b\xa1\x9c\x56\x38\xf1\x64\x6d\xf4\xc0\xd5\x35\x38\xd8\x8d\x8b\x07\xa7\xb2\xf7\xa8\x9d\xde\x55\x12\x54\x49\x92\xa6\x04\x2c\xcc\xa5\x84\x3f\x5f\x03\x67\x25\xb8\x5e\xc1\x87\xda\xda\xaf\xb2\x3b\xfe\x4c\x4b\x56\x98\x24\xad\x82\x1a\x5c\x43\xe2\x6c\x4e\xd6\x90\x4c\x18\x3e\x59\xc3\xab\x54\x7b\x6e\x9c\x15\x55\xfc\x10\xb1\x0e\xce\xbc\xf7\xf4\x6a\x60\x63\x82\x75\xa7\x6e\x1a\xa5\x45\xf5\x93\xcd\x89\x8b\x83\x5b\xb2\x1c\x37\x9f\xbf\xec\x9e\x4a\x65\x3d\x1c\x9a\x96\xdf\x13\xc8\x1e\x0f\x74\xb7\x43\xe9\x04\xda\x6d\x5f\x5b\x58\x2f\x56\xb1\xf0\x64\xab\x8b\x89\x76\x2b\xda\x87\x3a\xce\x85\x4b\xf2\x5f\x34\xda\x0a\x9e\x1f\x96\xd1\xde\xe0\xb4\x21\xec\x59\x6c\x5e\x50\xb5\xef\x22\xa8\x32\x67\x9c\x63\x34\x30\x3d\x15\x81\x7e\x2e\x2c\x1d\x2d\xee\x69\xfe\x99\xee\xd0\x9a\x95\xfd\x22\x0a\x2c\x95\x1f\x32\xde\xfd\x9d\x57\x54\xaa\x3d\x2d\xcd\xfd\x45\x8a\xba\x9f\x8a\xb1\xd8\xc4\xc2\x1f\xa4\xa4\xc7\xae\x7b\x2c\x59\x8e\x83\x73\x63\x6d\xfe\x28\x8a\xe3\x2a\x1d\x09\xe6\x65\xf0\x9c\x49\x71\xdf\x39\x5d\xf7\x1e\x9e\x94\xc7\x02\x9d\x77\x2f\xcb\xe3\x78\x58\xc5\x38\x3b\x3d\x69\x2c\xd9\x16\xe2\xc7\xcc\x62\x82\x46\x7f\xaf\xae\x87\x28\xf4\xf4\x3a\x8f\xd3\xa8\x63\x25\xe4\xa2\x47\xb1\x23\xe7\xad\xb3\x33\x0e\x5e\xef\x69\xfa\x7d\x18\xf9\xb7\x6f\xfb\x2f\x26\xb2\xdb\x8f\x3f\x9d\x49\xc5\xc9\xbd\x63\x6c\xa8\x38\x2b\xc3\x93\x6a\x6c\xf5\x38\x4a\xaa\xb1\x80\xa7\x23\xec\xc4\xa5\x72\x34\xf3\x3d\xbc\xfb\x08\x1f\x3e\x7e\x82\xdb\x77\x77\x9f\x32\x32\xb4\x03\x37\xa2\x3e\x4a\xb6\xdb\x6b\xb8\xb4\x32\x4c\xc5\xf6\x2d\xf9\x64\x2e\xec\xce\x6a\x0f\x51\xc7\x2e\x3d\x92\x6d\xbb\xf9\xc9\xdc\x79\xb6\xac\x44\x38\x50\x35\x35\xc6\xf6\x9f\xce\x1a\xd0\x42\x94\x99\x59\x7f\x5b\x30\x6d\xfa\x35\x3d\xec\xab\xac\xc6\x5a\x8a\x67\x84\x6d\xa3\xad\xa8\x3d\x72\x38\x8a\x06\x24\x5e\xca\x86\x4f\x24\xf5\x2a\xac\xd9\x94\x17\x84\x10\x56\xd5\x42\x6a\x58\x11\x80\x84\x89\xc4\xfc\xe3\xa8\x37\x7b\xad\xeb\xc4\xdc\x57\x92\x1d\xd3\xfb\xe6\x29\xcb\x45\xb5\xd9\x89\x4b\x51\x23\xa7\x35\xdb\x38\xfa\x4c\x96\x17\xf8\x13\xfa\xcc\x0a\x77\x52\x9f\x5b\x70\xa0\xbb\x33\xd3\x96\xb8\xa9\xc6\xc4\x5f\xab\x9c\x27\x6a\xb8\xe0\xde\xf9\xef\x81\xb1\xfa\xf9\x60\x22\xb5\x79\x88\x72\xe6\x83\x6b\x05\x98\x02\x0a\xfe\x77\x70\x09\x58\xbc\x0e\x36\x12\xb3\x33\x97\x46\x2f\x29\xb8\x3a\x2e\x34\x2e\xe3\x0d\xdf\xd5\x0e\xe3\xbb\xbe\x0f\x72\x1e\xf9\xc7\x8a\xc8\x6b\x05\x71\xe8\x7a\x08\x5a\x2b\xdb\x67\x19\x4f\x14\xca\x67\xd3\x3f\xf5\xe3\x8c\x6b\x61\x7d\x92\xae\x14\x8b\x28\x03\x7d\x71\x63\xe7\xdc\x4c\x27\x36\xfc\x0f\xed\x5d\x0a\xab\xe1\xf4\x68\x5d\xcf\x20\x64\xea\x9b\xba\x4b\x1b\xa8\x5e\x8a\xb2\xc1\x51\x07\xa6\xf3\xfd\x78\xe8\xf9\x6b\x56\xbf\x21\x38\xdb\x2e\xfb\x9e\x70\x10\xe0\x66\xc0\x3d\x46\x04\xb7\x88\x2b\x3b\x6a\x28\x45\x35\xa5\x36\x3c\xf9\xc2\x53\xd6\x2b\x1e\x50\xda\x16\xbe\x99\x45\xfb\xb0\xf4\xf8\xe4\x0d\x18\x89\xda\x99\x92\x45\x9b\xe8\x31\x9c\x96\xae\xe7\x6a\x3c\xf0\xa6\x64\xdb\x7a\x1d\x21\x73\xae\x3d\xc9\x9a\xbf\x8e\x4c\x66\xbd\x63\x77\xea\xb1\xc9\x73\x54\x26\x76\xce\xa6\xb5\xd9\xd8\x3f\xbc\x58\x19\x6e\x3c\x6c\x31\x4e\xf2\xe0\x5e\x3e\x6c\xe9\x4e\x91\xee\xa6\xed\xb3\xd0\xd2\x82\x41\xc2\x37\x27\x40\x80\xfe\x25\xe9\x2a\x58\x37\xa8\x7d\x65\x3a\x4f\x60\xf4\xea\xec\x2e\x04\xfe\xff\x21\xbf\x6c\x3b\x2b\x9e\x0d\x7c\xf7\xed\xb7\x70\x7d\x0d\x7f\x9b\x4b\x09\x92\x1e\x07\x4a\x00\x01\x32\x4b\x83\xfd\x28\xc3\x74\xbf\x22\x95\x91\x44\x06\x9a\x3c\x81\x7c\xc0\xc3\x0f\xf7\x77\xee\xb9\x23\x99\xbc\x42\x04\x7d\xfc\xfa\xd4\xd5\x74\x09\x9e\x53\x83\x4e\x50\xec\x9e\x0d\x62\x6c\x62\x1a\x5b\xac\xea\xd2\x9c\xbc\xb3\xe7\xf6\xcc\xaf\xf0\x52\x86\x27\xc0\x19\xce\xcf\x4b\x89\x6f\xe8\xbb\x90\xd1\xb0\xdb\x3f\xb4\xa4\x0e\xaa\xd6\xb6\xd8\xb3\xac\x7f\xbc\x19\xb5\x15\x22\x77\x4f\x45\xde\x5c\xdf\x4f\x5c\x55\xa6\x3f\x87\xe0\x1a\x41\x2e\x36\xc4\x84\x64\xdc\xaa\xac\xaa\xc1\xcd\x21\x5a\xff\x09\x00\x00\xff\xff\xe2\x7d\xea\x19\x81\x18\x00\x00")
+var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x5f\x73\xdc\xb6\x11\x7f\x2e\x3e\xc5\x96\x8d\x3d\xa4\x7a\xe2\x25\x7d\x54\x46\x0f\x89\xac\xd8\x7a\x88\xac\x91\xdc\xf4\x21\x93\x69\x20\x72\xef\x0e\x35\x09\x30\x00\xa8\xf3\x95\xc3\xef\xde\x01\x08\x80\xe0\x1d\xef\x24\xbb\x33\x9d\xa9\x9f\xc4\x03\x16\xfb\xf7\xb7\x8b\xc5\xaa\xeb\xa0\xc4\x15\xe3\x08\x49\x51\x31\xe4\x5a\xa2\x6a\x04\x57\x98\x40\xdf\x2f\x97\x70\x8b\xdb\xae\x83\x86\xaa\x82\x56\xec\xdf\x08\xf9\x2d\xad\x11\xfa\x1e\x0a\x89\x54\xa3\x02\x0a\xf3\xfb\x5b\xa6\x37\x86\x35\x6d\x2b\x0d\x1b\xa4\x25\x4a\x05\x4f\xb4\x6a\x51\x91\x55\xcb\x8b\xa3\x9c\xd3\xae\x03\xb6\x02\xfc\x03\xf2\x2b\x51\x22\x9c\x7f\x07\x7d\x5f\x98\x2f\xc6\x75\xd7\x01\xf2\x12\xfa\x7e\x20\xca\x1f\x8a\x0d\xd6\x34\xfc\xa6\xbc\x84\x34\x3a\x99\x79\x8a\xfc\x46\x3d\x68\x89\xb4\x86\xbe\x5f\x40\xd7\x21\x2f\xf7\x78\xc4\x14\x5b\xc9\x34\x4a\x60\x22\xff\x87\xfd\x8a\xa5\x0e\xe2\x33\x38\x9b\x37\xbb\x23\x00\x12\x75\x2b\x39\xbc\x9e\xa5\x30\x04\x00\x73\x36\xfe\x53\x69\xaa\x5b\x65\x54\xbf\x00\x63\xf0\xc2\x93\x06\xe1\x92\xf2\x35\x42\xfe\xce\xb9\x33\x98\xf0\x8e\xaa\x37\xce\xd5\x7d\x3f\x2b\xf6\xc2\xf0\x69\x24\xe3\x7a\x05\xc9\xab\xbf\x3c\x25\x90\x8f\x27\x0e\x05\x9d\x72\xf2\x8c\xc3\xee\xe8\xae\x12\xb4\xbc\x80\xc1\x73\xc7\xf8\xf5\xa4\x27\x64\x39\xe3\xb9\xbe\x87\x0d\xe5\x65\x85\x0a\xf4\x86\x29\x28\xa8\xc2\x39\x04\x39\x00\xe5\x84\x38\x55\xde\xa0\x2a\x24\x6b\x34\x13\x7c\x10\xf4\x58\x89\xe2\x63\x21\xea\x1a\xb9\x3e\xdc\xc6\x4a\xe1\x11\x07\x19\xff\x6c\xda\x9a\xf2\x78\xd1\x01\x85\x9c\x2d\x89\xde\x35\x78\x04\xea\x4a\xcb\xb6\xd0\xd0\x91\xf9\xb8\x12\x80\x28\xb4\xc0\xb8\x26\xe4\x65\x61\x9d\xaa\xbf\x3c\x7b\xc6\x3e\x02\x70\xb6\x0c\x7c\x09\x1c\x51\xb7\xeb\x20\x7f\x2b\x3e\x18\x7b\x3c\x55\x7c\x62\x12\x71\x02\xe0\x62\xeb\xb6\x6c\x86\x71\xa1\x23\x14\xfc\x48\x15\x1a\x6e\xd9\xfe\xc6\x0d\xd7\x28\x57\xb4\xc0\x38\x0d\xaf\x44\xdd\x54\xf8\xe9\xfd\xe3\xbf\xb0\xd0\xfb\x27\x06\x40\x65\xd0\xf7\x67\x41\xab\x41\xee\x51\xc2\xae\x0b\xcb\xc1\x28\x73\xb6\x52\xc6\xbc\x28\x85\x87\x48\xc6\xe6\xf6\xb3\xd1\x22\xcb\x25\xd8\xe0\xad\x51\x1b\x38\x22\x0c\xc1\xb3\x29\x09\x2b\x21\xed\xda\x1c\x5a\xc0\xd7\xce\xa1\xc0\x99\x42\x96\xdf\x63\x81\xec\x09\xa5\x27\x99\x2f\x1b\x99\x95\x98\x66\x06\x1c\x71\x09\x99\xe1\x90\x47\x58\x22\x3d\x19\xad\x21\x5f\x20\xf5\x5a\x4a\x21\xd3\x0c\x94\x96\x8c\xaf\xa1\x23\x7f\x72\x82\x57\xb5\xce\x1f\x86\x72\x91\x26\xbf\x76\x1d\xb4\x4d\x83\x12\xf2\x9f\x51\x6f\x44\xe9\x51\x74\x47\xf5\x06\xfa\xfe\xb7\x5f\x5f\x95\xbf\x79\xe8\x38\xde\x5d\x17\x3e\x61\x0c\x47\xcb\x3f\x72\xb1\xe5\x80\x46\xee\x18\x89\x7d\xd4\xc1\xab\xbf\x3e\x85\xcd\x64\x01\x73\x71\x7a\xc6\x35\xa3\x4c\x43\x68\x8f\x9d\x28\x6c\x0b\x10\xb9\xc3\x79\xa0\xc9\x4c\xb1\xda\x27\xfc\x02\x1f\xbf\x45\xed\x58\xa7\xd9\xd7\x91\x44\x11\x4e\x82\xdb\xa6\x50\xfc\x7c\x2f\x49\xa4\xe5\xbd\x4b\x9f\xd4\xe7\x11\xc8\x96\x6b\x56\x63\x7e\x65\x5b\x13\xbf\xbf\x80\x42\x70\xd5\xd6\x28\x47\x02\xb7\xb0\x30\x09\x5a\x53\xad\x0c\xa4\x0d\x88\xef\x71\xcd\x94\x96\xbb\xcc\x63\x6e\x28\x72\x07\x15\x97\x00\x2c\x97\x21\x81\xfd\x75\xd3\x75\xee\x7a\xb2\xa7\x0c\x64\xae\x04\x7f\x42\x69\xba\x03\xeb\xa1\x82\xd6\x38\xb1\x64\x61\xe4\xc0\xc5\xa5\x11\x32\x21\x0e\x46\xe5\x6f\x51\x0f\x95\x3e\x4d\xa2\x2c\x49\xb2\x8c\x80\x01\x9b\x39\xff\xe7\x4b\xe0\xac\xb2\xca\x86\x5a\x60\xf5\x57\xf9\x0d\x7f\xa2\x15\x2b\x0d\x56\xd2\x28\x07\x17\x90\x0c\x3a\x27\x0b\x48\x26\x15\x3e\x59\xc0\x8b\x44\xbb\xda\xb8\x1f\xb1\x7c\x36\x60\x70\x09\x73\xd6\x0f\x2c\x6c\xb2\x1b\x67\xdd\xa8\xab\x56\x69\x51\xff\x64\x63\x32\x38\x8d\xc0\xec\xc9\xe0\x37\x17\xbf\xfc\x8e\x4a\x85\x69\xdc\xb4\xfc\x91\x40\xfe\xb0\xa5\xeb\x35\xca\x81\xa1\x3d\xf6\xb5\xb9\xf5\x2c\x9d\x73\x4f\x9e\x9e\x4d\xa4\x67\xd9\xe8\xea\xbe\xff\x1c\xfe\xcf\x2a\x6d\x19\x1f\x5e\x96\xb3\xbd\xc1\x64\x29\xaa\x62\x87\x09\xd5\xb8\x2e\x82\x2a\x73\xc7\x0d\x15\x0d\x4c\x4f\x45\xc0\xef\xc5\xa9\xa3\xc5\x1d\x2d\x3e\xd2\x35\x5a\xb5\xf2\x9f\x45\x89\x95\x72\x4b\xc6\xba\xbf\xf3\x9a\x4a\xb5\xa1\x95\x79\xbf\x48\xd1\xf8\x2d\xaf\x4a\x70\xd4\xbe\x86\x3f\x48\x49\x77\x7d\xff\x50\xb1\x02\x83\x71\x63\x6e\xfe\x28\xca\x5d\x9a\x8d\x05\xe6\x79\xf0\x9c\x08\xb1\x2b\x8d\x70\xe9\x2d\x1c\x63\x36\x55\x6a\x5a\xce\xfb\xe7\xf9\x71\xdc\xa6\x73\x35\xdb\xa3\x22\xaa\xf9\xf3\xd7\xcc\xd1\x00\x8d\xf6\x5e\x5c\x06\x2f\xf8\xf2\x7a\xe8\xa7\x51\x46\x2a\xe4\x51\x8b\xe6\xae\x1c\xf3\x3a\xf2\xaf\xb0\x63\x96\x66\xdf\xc7\x9e\x7f\xfd\xda\xff\x62\x22\xbf\x7e\xff\xd3\x89\x50\x04\x07\x04\xf0\x3a\x2a\xce\x2a\xe2\x5b\x3c\xae\xf1\x93\xfe\xc5\x64\x3d\xd5\x68\x5e\x16\xf6\x63\x68\xf9\x9c\x37\x40\xac\x9e\xef\xf6\x16\xf0\x48\x15\x96\x20\xb8\xa5\x2d\x06\xc6\xc0\x34\x30\x05\xad\xd9\x61\xfc\x0b\x6e\xc4\x3d\x05\xd3\x42\x7f\xf2\xbc\x73\xb7\xf7\xc2\xdb\xee\xdc\xf7\x1c\x2e\x0e\x21\x4e\xef\xa8\x9a\x4a\x61\x82\xbb\xcb\xb0\xeb\x40\x63\xdd\x54\xc6\x35\x89\xf3\x86\x93\xee\x3c\x25\x64\x02\x69\xc9\x0a\x0d\xc9\xc0\x2e\x09\xfc\x93\x5f\xcc\x33\x2d\x81\xd4\xd6\xee\x3d\x93\x13\x1f\xdd\x24\x83\xc4\x74\x90\x09\xfc\x9e\x3c\x8a\x72\x97\xfc\x0e\xc9\x8d\xba\x6d\xab\x8a\x3e\x56\x98\x40\xfa\x3f\x69\x93\xb2\xcc\x1b\x7c\x7e\x04\x2d\x01\x46\xe3\xc3\x80\xa3\xa4\x1a\x4b\x78\xdc\xc1\x5a\x9c\xab\xe1\x52\xfa\x1e\xde\xbc\x87\xdb\xf7\x1f\xe0\xfa\xcd\xcd\x87\x9c\x84\xe6\xf1\x4a\x34\x3b\xc9\xd6\x1b\x0d\xe7\x76\xa4\x62\xea\xbb\x7f\xc0\x4d\xf6\x46\xbc\x12\xd2\xb8\x82\x66\xb2\x7c\xac\x7b\x16\xb9\x1f\xcc\x0b\x79\xc5\x2a\x84\x2d\x55\x53\x65\x0c\xfe\x9c\x36\xa0\x85\xa8\x72\x43\x7f\x5d\x32\x6d\xba\x7b\x1d\xce\xd5\x56\x9b\x46\x8a\x27\x84\x55\xab\xcd\xd2\x76\x83\x1c\x76\xa2\x05\x89\xe7\xb2\xe5\x13\x4e\x5e\x84\x55\x9b\xf2\x92\x10\xc2\xea\x46\x48\x0d\x29\x01\x48\x1c\x2c\x12\xf3\xcd\x84\xfd\xc3\x51\x2f\x37\x5a\x37\x89\x79\xe9\x26\x6b\xa6\x37\xed\x63\x5e\x88\x7a\xb9\x16\xe7\xa2\x41\x4e\x1b\xb6\x1c\x2e\xde\xe4\x38\x81\xeb\xed\x4e\x50\x0c\xa8\x3f\x45\xb0\xa5\xeb\x13\xdb\x3e\xe7\x13\xf7\x20\x1f\xac\x52\x61\x34\x72\xe3\x7e\xf7\xfd\xde\x7e\xb4\x91\xd9\x98\xcc\xe6\xf0\xbd\xed\x09\x4c\x15\xa0\xe0\xbe\xa3\xe7\xe3\x21\xbd\xe9\x59\xdb\x42\xb7\x12\xf3\x13\xe3\x06\xc7\x29\x1a\x3a\x1c\x29\x02\x0e\x7e\xef\xa8\x83\x3a\xe3\x6b\xdf\x41\x1b\x8c\x81\x1b\xd6\xc0\xcc\x9c\xcb\xd5\xc8\xfb\xa8\x29\xb7\x1d\xba\xb1\x44\xa1\x7c\x32\x9d\xb7\x5f\x67\x5c\x0b\x0b\x17\x39\x14\xb6\x72\xf6\xee\xfa\xec\x02\x68\x64\xa3\xcc\x26\x3a\xfc\x17\x0f\x83\x0c\xd2\xd0\x77\x74\xfd\x62\xa8\x8f\x59\x5c\x20\x73\xcf\x45\x59\xe7\xa8\x2d\xd3\xc5\x26\x98\x99\xbb\x07\xba\x3f\xe0\xfc\x34\xfc\x70\xaf\x89\xc0\xc0\x78\xd0\x74\x09\x76\x8c\x15\xbd\x3f\x2f\xec\x85\x65\xca\x8b\x32\x33\xad\x8b\xcb\xe7\x86\xa0\xae\x5a\x9d\x1a\xbd\x75\x1d\x7c\x73\xe0\xed\xed\x34\x9c\xe1\x23\x73\x0a\x8c\x57\xfc\xa0\x4a\x3e\xfb\xfc\x1a\xdd\xb9\x80\x59\x31\x0e\x78\xd3\x6b\xba\x73\x32\xe2\x2a\x6a\xfd\xed\xd6\x7b\x32\xd9\x75\x86\xdd\xa8\x87\xb6\x28\x50\x99\x9c\x1a\x74\x5a\x98\x83\x7e\x64\x67\x79\x0c\xeb\x71\x73\xba\x17\x07\xb6\x0a\xa9\xeb\x8d\xf1\xa1\x30\xb4\x76\xa0\x78\x8c\x20\x70\xf8\x66\x0f\x08\xe0\x67\x90\x3e\x7a\x13\xb1\x2f\x0c\xe7\x1e\x8c\x5e\x1c\xdd\x05\xfc\xdf\xc6\x97\xad\x0e\x92\x67\x09\xdf\x7d\xfb\x2d\x5c\x5e\xc2\xdf\x0e\xb9\x44\x41\xdf\x63\x14\x8b\x19\xa8\xc8\x41\x18\x6c\x4c\xaa\x38\xdc\x2f\x08\xe5\x4c\x20\x23\x49\xae\x80\xdc\xe2\xf6\x87\xbb\x9b\x61\x50\x96\x4c\xe6\x57\xd1\x0b\x30\x7a\x0b\x0e\xa6\x66\x64\x8f\xbd\x87\xe7\x54\xa1\xa0\x7a\xa8\xba\xe3\x6c\x22\x50\x9a\x9d\x49\x3b\xb6\xff\x8f\x9a\xdc\x51\x38\x61\x5d\x77\x2c\x11\x4e\x73\x99\x3f\xe0\x98\x46\x8a\x5d\x7f\xd2\x92\x0e\x85\xc8\x64\xeb\xec\x40\xdf\x8d\xfd\x46\x69\xa5\x28\x86\x21\xa3\x53\xd7\xf5\x16\x17\xb5\x79\xd9\x41\xf4\x00\x35\xb3\x76\xe3\x92\xf1\xa8\xf2\xdd\xa5\x11\xd6\x75\xe7\x80\xbc\x84\xbe\x27\xff\x19\x00\xfd\x61\x60\x48\xbb\x1a\x00\x00")
 
 func templatesClientResponseGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -182,8 +317,8 @@ func templatesClientResponseGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 6273, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xea, 0xba, 0xc6, 0xa7, 0xd9, 0x55, 0xaa, 0xa1, 0x8a, 0x48, 0xb0, 0xfb, 0x44, 0xde, 0xe3, 0x66, 0x5e, 0x1d, 0x3c, 0x1e, 0xd6, 0x48, 0xe4, 0xc2, 0x5b, 0x12, 0x1b, 0x3, 0xd2, 0x6f, 0x2f, 0xb8}}
+	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 6843, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xef, 0xba, 0x38, 0xbd, 0x28, 0xdb, 0x11, 0x36, 0x38, 0x53, 0xa9, 0xe1, 0x33, 0x65, 0xac, 0xac, 0x89, 0x29, 0xab, 0x27, 0xfa, 0xb7, 0x93, 0x4a, 0x27, 0x53, 0x60, 0x7f, 0x2e, 0x4c, 0x53, 0xf3}}
 	return a, nil
 }
 
@@ -287,6 +422,46 @@ func templatesDocstringGotmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesEasyjsonsupportGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x5b\x73\xd3\xc8\xf2\x7f\xd7\xa7\xe8\xf8\xc1\x96\xc0\x96\x9d\x84\x3f\x7f\x70\x50\xaa\x58\x12\x6a\x39\xbb\x84\x53\x5c\x76\x1f\x92\xd4\x32\x92\x5a\xf6\x90\xd1\xc8\x3b\x33\x8a\xf1\xf1\xfa\xbb\x9f\xea\xd1\x48\x96\x94\x40\x38\xbc\x24\x52\xab\xe7\xd7\xf7\xcb\x78\x3a\x85\x57\x45\x8a\xb0\x40\x89\x8a\x19\x4c\x21\xde\xc0\xa2\x98\xe8\x35\x5b\x2c\x50\x9d\xc0\xd9\x3b\xb8\x78\xf7\x11\xce\xcf\xde\x7c\x0c\x3d\x6f\xbb\x05\x9e\x41\xf8\xaa\x58\x6d\x14\x5f\x2c\x0d\x4c\x76\x3b\x6f\x3a\x85\xed\x16\x92\x22\xcf\x51\x9a\xf6\xc7\xdd\xce\xdb\x6e\x27\x80\x32\x85\xdd\xce\xf3\x56\x2c\xb9\x61\x0b\x24\xe6\xf0\xdf\xee\x99\xe8\xd3\x29\x7c\x5c\x72\x0d\x19\x17\x08\x6b\xa6\xbb\xca\x98\x25\x82\xd3\x06\x4c\x51\x88\x90\xe4\x9d\xa7\xdc\x70\xb9\x00\xd3\x9c\xcb\xad\x3a\x2b\x55\xdc\x22\x64\xa5\xb1\x50\x4b\x94\xb0\x29\x4a\x50\x38\x51\xa5\xec\x20\xd5\x22\xac\xda\x4c\xa6\x9e\xc7\xf3\x55\xa1\x0c\xf8\x1e\xc0\x20\xde\x18\xd4\x03\x7a\x42\x99\x14\x29\x97\x8b\xe9\x17\x5d\x48\x4b\xc9\x99\x59\xda\x07\x6d\x54\x52\xc8\xdb\xfa\x99\xcb\x45\x75\xa4\x94\x3c\x29\x52\x9c\x96\x26\x7b\x36\xf0\x88\xb2\xe0\x66\x59\xc6\x61\x52\xe4\xd3\x9c\x71\xa1\xca\x29\x32\xbd\x21\xc4\xe9\x17\x81\x5f\x51\x0d\x1e\xe2\x5a\x2b\x6e\x88\x2d\x70\xde\x22\x1b\x65\x62\x78\x21\x35\xc4\x28\x8a\x35\xe8\x72\x65\xf5\x27\x23\xff\xf5\xe1\xdd\x05\xe4\x4c\xe9\x25\x13\x02\x95\x86\x22\xb3\xc6\xe7\x45\x8a\x42\x8f\x61\xbd\xe4\xc9\x12\x98\x6a\x87\x7d\xcd\xcd\xb2\x28\x0d\x28\xcc\x04\x5a\xe4\xd0\x89\xda\x80\x42\x99\xa2\x02\xfc\xca\x12\x23\x5c\x44\x58\xee\xe4\x30\x6d\x09\x1d\x47\x81\x0b\x75\xe8\x79\x49\x21\xb5\x01\xb2\xf5\x57\xfc\x0a\x11\x0c\x66\x87\x47\xc7\x4f\xfe\xef\xe9\xff\x3f\x7b\xce\xe2\x24\xc5\x6c\x60\x4d\x22\x86\x3f\xc9\xc8\x0f\xd6\x93\x60\x0d\xd6\xc0\x40\xdb\xf7\x31\xa0\x4e\xd8\x0a\x53\x10\xfc\xa6\x2f\x2c\x2d\x50\x7b\xe4\x8f\x3e\x8a\xbf\x86\x47\x5f\x2c\x92\x0a\x2d\xb8\x1a\x83\x76\x88\x01\x6c\x3d\x80\x75\xf8\x9e\xad\x7f\xd9\x18\xf4\x47\x83\x51\xe0\x01\x68\xc3\x94\x81\x79\x04\x33\x0f\x20\x2b\x14\x70\xfb\x72\x02\x1c\x5e\x80\x40\xe9\xeb\xe0\xc4\x9e\x04\x2a\x84\x98\x3e\xea\x4b\x7e\x7d\x02\x31\xbc\x00\x8a\x78\xf8\xbe\x94\xf8\x01\x45\xe6\xb8\x1c\xdf\x69\x04\xb3\xaf\x47\x33\x18\x0e\x21\x86\x83\x08\x46\x83\xd1\xfe\xf9\xea\xaa\xf5\xf2\xa2\xf5\x7c\xda\x7a\x1e\x8e\x1a\x44\x00\xfe\xf8\x71\xf3\x9c\x14\xd2\x70\x59\xa2\x23\xec\xdc\x7f\x6b\x9a\xf3\x83\xbe\xb4\x76\xcd\xf9\x75\xe0\xbe\xea\x35\x37\xc9\x12\xe2\x06\x33\x61\x1a\x49\xab\xb1\x55\x67\xee\xa8\x1d\x0f\x5d\x5d\x8d\x82\x7b\xe8\x71\x4d\xac\x20\xae\x64\xff\xb4\x53\xe2\xf3\x95\xfc\xdc\x63\x55\xdf\x64\x55\x7d\x56\xf3\x4d\x56\xd3\x67\x8d\xad\x11\x59\xeb\xc0\x74\x5a\xe5\xa8\xcd\x21\xd0\xf8\x77\x89\x32\x41\x57\x16\x1a\x21\x59\x32\xc5\x12\x43\xa5\x92\xe2\x0a\x65\xaa\xa1\xa8\xfa\xc5\x2d\x2a\xcd\x0b\x49\xac\x8b\xa2\xc1\x73\xc9\x38\x86\xbf\x28\x01\x28\xeb\xc2\xb7\x55\xb9\xf9\x55\x76\xf9\x71\x10\x74\xd5\xf5\xdd\x99\xcb\xc3\x39\xa5\x91\x7b\x0b\x26\x87\xd7\x63\x90\x5c\xd4\xdc\x29\x66\xac\x14\xa6\xa3\x3a\x05\x58\x15\xa2\xad\x25\x93\x29\xfc\xfa\xf1\xed\xef\x2d\xda\x37\xbc\x53\xce\x66\x9f\x83\xee\x37\x1b\x35\x57\x91\x97\xf1\xe9\xe9\x93\xeb\xef\x32\x0c\x67\x5f\x5f\x5f\x07\xbd\xec\xda\x27\xa0\xcd\x2c\x88\x80\x7b\xf7\xa4\x63\x95\x8c\xc9\x18\x34\xff\x0f\x92\xaf\x6c\x89\x9c\x21\x35\x48\x2a\x94\x37\xd2\x29\xaa\x2f\xf9\xdc\x09\xe1\x19\x24\x10\x39\x56\x62\x3a\x57\xaa\x50\x30\x1c\x56\x20\x51\x04\x87\x4d\xd6\x7e\x3f\xc9\x7b\x9e\xc8\xb2\x2c\x6d\x7c\xc1\xe1\x71\x64\x01\x7f\xdc\x8c\x5a\xb1\xd1\x55\x79\x34\x3b\x7a\x36\x82\x7f\xfe\x69\x13\x9e\x8f\x7e\x4e\xad\xa3\xd9\x51\xa3\xd5\x5d\xf7\x27\x1d\xf7\xff\x8c\xd6\xad\x23\x3b\xaf\x2b\xbd\xd6\xcd\xe2\xef\x65\xdb\x5e\xb8\xeb\xf6\xe5\xd7\xa2\x60\x66\xdf\x96\x33\x7a\x1d\x53\x87\xcc\x99\x31\x3f\xdc\x97\x2d\xca\x7d\x6d\x39\xab\x10\x9f\x3e\x19\x43\xcc\x8d\x06\x2e\x4d\xd5\xa1\x79\x06\x34\x71\xc3\x37\xfa\x8d\xcc\xfc\x6c\x0c\xb3\x80\xfc\xee\x68\x17\xec\xc2\xcf\x02\xe7\xf7\xe9\x14\x14\xd2\x10\x74\x13\x89\xd2\xc6\x6b\xbc\xea\x77\xca\x34\x73\xf5\xa9\xd0\x94\x4a\x5a\xd7\x54\x0d\x3f\x67\xb6\xfd\xd3\x06\xe0\x8f\x32\xdb\xf0\x78\x06\x2c\xd6\x44\xb5\x52\x5f\xc6\xda\xcf\x82\x13\x4b\x3b\x88\x60\xe6\xa4\x53\x9b\x27\xcd\xa3\x08\x9e\x3e\x81\xe1\x10\x7c\x62\x78\x01\x87\x38\x79\x4a\x1a\xd3\xdb\x69\x04\x87\x78\x74\x68\x2d\xa8\x99\x8f\x8f\x2c\xb3\x35\xff\xf8\x88\x0e\x05\xad\x53\x1d\x72\x73\xbc\xce\x33\xa7\x6f\x04\x23\x1c\x35\x21\xdf\xd1\xce\x71\xcb\x14\xc4\x65\x06\x97\xc7\x47\xd7\x64\x8b\x07\x6e\x58\x55\x4b\x4b\xf8\x72\x45\x6d\xae\x0a\x47\x5c\x66\x97\xf3\xd9\xf5\x18\xb2\x3a\xa0\x63\x98\x1c\x56\x81\x70\xf6\xd7\x82\xac\xa4\xbd\xbb\x13\x81\x4c\x42\xb9\x02\x9c\xcc\x9e\x83\x29\x00\x27\xcf\x6b\x67\x48\x12\x47\xad\x2e\x0e\x4e\x40\xc2\x69\x04\xd6\x2b\xf1\xa5\x9c\x3c\xb9\xae\x91\x1c\xe1\xb8\x22\x4c\x1a\xc2\x51\x45\x98\xed\x4b\xaa\xa6\xda\xcf\x87\xd7\x35\xd5\x12\xe6\x0d\x65\xd7\x4e\x72\x3f\x76\xad\xb5\x97\xca\xbf\xe1\xa6\x4e\x64\xca\x93\x1b\xdc\x50\x7b\x67\x12\x8a\xf8\x0b\x26\x06\x72\xcc\x63\xca\xc9\x05\xbf\x45\x09\x4c\xc3\x78\x70\x83\x9b\xc1\xbc\x97\xcb\xbf\xe1\xe6\xde\x4c\xe6\x4a\x1b\x78\x14\x17\x85\x18\x5b\xec\xf6\xbe\xc1\x33\x78\x54\x31\xd0\x1b\xd4\x2f\x11\x64\x4c\xe8\xaa\xa8\xe9\x48\x44\x07\x2f\x0f\xe7\xd7\xf7\xd4\xec\x0d\x6e\xee\x58\xf4\xd6\x6a\xfc\x03\x46\xc1\x8d\x2c\xd6\x12\x98\x01\x55\x4a\xc3\x73\xec\xd9\x54\x01\x3d\x68\x96\xa4\xed\xaf\x67\xd7\x41\xc7\xb0\x56\x33\x19\xdb\x22\xda\x79\xf7\x58\xdb\xc8\x75\xb6\xad\x2b\xe8\x5e\x37\x9a\xdf\xed\x46\xe7\x79\x8c\x69\x8a\x69\xdb\xe4\x2a\x6c\xba\x6b\x36\x97\xa6\x00\x26\x0b\xb3\x44\x05\x85\xec\xdb\x5b\xe3\x3c\x68\xf1\x2d\x13\x25\x52\x57\x42\x95\xb1\x04\xb7\xe0\x1a\xc9\x39\xd3\x1b\x5a\x83\xfd\xde\xf1\x00\x76\x95\x67\xa8\x10\x57\xd4\xa4\xbb\xdf\x6d\x89\x8a\x12\xc3\x3e\xce\x90\x98\xc9\x01\x29\x33\x6c\x0c\xa8\x14\xd5\x11\x11\xc3\x5f\x4a\x2e\x52\x9a\xcd\xda\x77\x65\x49\x5f\x0f\x22\x4a\xf2\xb6\xdb\x7d\xc9\x85\x3d\x79\xa7\xc5\xd9\x43\x54\x93\x04\x4e\x6d\xe6\x98\x3a\x11\xbd\x5c\xce\xae\xed\x96\xb9\xad\x2b\x6e\x3a\x05\xcc\x57\x66\xe3\x1c\x59\xad\x1c\xb2\x14\xc2\xde\x1b\xf4\x0d\x5f\xad\x30\xbd\x17\xff\x67\x12\xc1\x72\xf9\x56\x91\x6a\x3f\xa2\xc7\xd6\x72\xb4\x0f\xff\x05\xa9\x40\xe2\x69\x0a\x59\x7d\x6c\x68\xf6\x71\x25\x06\x5f\xc0\xa3\xea\x66\x15\xfe\x4e\x7f\x03\xa0\x72\xac\x33\x55\xd0\xe0\x20\xae\xba\x91\x8a\xf0\xc3\x0d\x5f\xf9\x6d\x77\x81\x51\x65\x3d\x31\x1d\xa5\xd2\x75\xaf\xc9\x7b\x64\xa9\xbb\xad\x28\x64\x69\xfb\xb2\xa2\x70\x25\x58\x42\xf7\x18\x2e\x6f\x99\xe0\x29\x7c\xfa\xf8\x7a\xf2\xec\xe1\x21\xb9\xc7\xbc\x6b\x42\x05\x6e\x55\xb6\xc3\x48\x84\x8e\xd3\x25\x83\xdd\x97\xfe\x20\x69\x8e\xae\x6b\xfb\x9c\x01\xba\x33\x1c\x1c\x9e\xae\xd2\x0a\xd5\x83\xf7\x9d\xff\x6d\x8b\x8b\xab\x42\xa0\xcf\x7e\x12\xdc\xbb\x89\x38\xb5\xe2\xbd\x1d\x5d\xe7\xbe\xa9\xab\xad\xf6\xaf\x84\x52\x9a\x0d\xdd\x02\x7b\x31\xef\x70\xf7\x3d\x37\x86\x52\xe3\x45\x49\xcd\xc1\xa6\x41\xd0\x2a\xe3\x5d\x53\xa3\xfd\x02\xdf\xb9\xf6\x44\xd0\x7f\xd0\x37\x5f\x8c\x61\x68\xb9\x5a\x80\xc1\xde\x0c\xfb\xa9\x67\x81\x3d\x58\x6b\x5f\x15\xbc\xbd\x68\x77\x73\xa0\x6b\x87\x13\xd6\xb7\xe1\x8e\x7e\x77\xcd\xda\xba\xae\x41\x11\x14\xb6\xa0\x5c\x66\x1c\x88\xf0\xdd\x4d\x93\xed\x9d\x7a\x4d\x31\x69\xae\x30\x17\xb8\xae\x62\xaa\x7c\x5a\x19\x74\x78\x81\x6b\xd2\x08\x95\xad\xcc\xa0\xce\xb3\x46\x2c\x09\xa4\x2b\x4b\x12\x7e\xaa\x69\x7e\x5d\xe2\x22\x7c\x99\xa6\x76\x73\xf7\x89\xa1\x02\xf6\xad\x15\x41\x3f\xd0\x9f\xa4\xfb\xb1\x02\xd5\x7d\xce\xa2\x45\x89\xa6\x16\xf5\x47\x28\x6b\x5e\x81\xaa\xeb\xb8\x16\xca\xb7\xdc\x47\xac\x61\x8b\xef\x3b\x3e\xeb\xb8\xac\x65\x8c\x05\xda\x63\x90\x4e\x7b\xef\xec\x5a\x86\xbd\xe6\x28\xd2\x0b\x1a\x94\x39\x33\xc9\x12\xa9\x3f\xd0\x54\xb6\xf1\xa7\x79\x45\x93\xce\x4e\x2b\x5d\xe4\x08\x2b\x55\xac\x50\x19\x8e\x9a\xda\xc7\x82\xa9\x54\xa0\xb6\x9f\xe9\xae\xbc\xb7\xb4\x81\xf5\xf7\xbb\xc5\xd8\x61\x85\x61\x58\x0f\xe5\x56\xb3\xa0\x5f\x31\xfe\x72\x33\x7b\x1e\x81\x62\x72\x51\x0b\x6f\x36\x57\xc2\x8a\x22\x4b\x75\xc4\x26\xaf\x89\xd6\xd9\xad\x7e\x04\xaf\xee\x2d\xe7\x7f\x97\x4c\xbc\x2e\x44\x4a\xda\xba\xe1\xfe\xa0\x00\x47\x1f\x0c\x5a\xde\x3c\xe3\x3a\x51\x3c\xe7\x92\x99\xa2\x4e\x12\x72\xa2\x0d\x47\xfd\xfb\x56\xda\x61\xa2\x3d\x00\x56\x85\xd8\xe4\x85\x5a\x2d\x79\xe2\x26\xd9\xde\x95\x1d\x4c\x1b\x44\xb8\xb4\xcb\x72\x6f\xc1\x71\x77\x7a\x3b\x52\x0b\x97\x33\x82\x12\xa6\x9d\x62\xdb\x33\x66\xd8\x1c\x08\x65\xe7\x5a\x4a\x57\x9d\x0a\xa4\x2a\xa1\x66\x4e\x0d\x5d\xd9\x36\x46\x77\xce\xd8\xe1\x57\x37\x6d\x11\x9e\xa1\xe0\xb9\x3f\xda\xda\x31\x4a\x51\x38\xa0\x49\xe6\xa8\xbb\x51\x8d\x44\xb1\x9c\x47\xbd\x64\x11\xe1\x27\xa9\x59\x56\xff\x30\x16\xec\x17\x2d\x42\xfe\x93\x49\xf3\xaa\x10\x85\xf4\x83\x76\x46\x1c\x44\x30\x18\xc0\x70\x08\x07\xf7\x28\x0c\x3d\xfb\xa2\xa6\x10\x9d\x8c\xa1\xfb\x51\x63\x07\x28\x34\xd6\x98\x91\xc5\xac\x21\xaa\xc9\xfb\x1e\x93\x52\x69\x7e\x8b\x4e\xfc\xae\xa3\x56\x9e\xb3\x56\x57\xd9\x9b\xeb\x7d\xcb\x69\x22\x3c\x57\xaa\x50\x7e\xe0\xed\xbc\xff\x0e\x00\x12\x02\x7c\x4a\xe0\x16\x00\x00")
+
+func templatesEasyjsonsupportGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEasyjsonsupportGotmpl,
+		"templates/easyjsonsupport.gotmpl",
+	)
+}
+
+func templatesEasyjsonsupportGotmpl() (*asset, error) {
+	bytes, err := templatesEasyjsonsupportGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/easyjsonsupport.gotmpl", size: 5856, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1d, 0xd1, 0x71, 0xba, 0x34, 0xe6, 0xac, 0xac, 0xe6, 0xa, 0xf3, 0xbd, 0x5, 0x19, 0x93, 0xd3, 0xde, 0x4a, 0x44, 0x49, 0x97, 0x39, 0x7f, 0x71, 0x1e, 0xaa, 0x59, 0x2e, 0x86, 0xa4, 0x2d, 0xc4}}
+	return a, nil
+}
+
+var _templatesEasyjsontestGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x61\x6f\xdb\x36\x10\xfd\xce\x5f\xf1\x2a\xcc\x85\xb4\xc6\xf2\x86\x7d\x6b\x96\x01\x6b\xeb\x0d\x1d\xd0\x64\x58\xbc\x4f\xc3\x30\xd0\xd2\x49\x22\x22\x91\x2a\x49\x39\x75\x05\xfd\xf7\xe1\x64\xc5\xa1\x5b\xdb\x09\xb6\x6f\x34\x79\xf7\xde\xbb\x77\xcf\x5a\x2c\xf0\xd6\xe4\x84\x92\x34\x59\xe9\x29\xc7\x7a\x8b\xd2\xcc\xdd\xbd\x2c\x4b\xb2\x97\x78\x77\x83\xeb\x9b\x15\x96\xef\xde\xaf\x52\x21\xfa\x1e\xaa\x40\xfa\xd6\xb4\x5b\xab\xca\xca\x63\x3e\x0c\x62\xb1\x40\xdf\x23\x33\x4d\x43\xda\x87\x8f\xc3\x20\xfa\x7e\x0e\xd2\x39\x86\x41\x88\x56\x66\x77\xb2\x24\x2e\x4e\x7f\x9f\xce\x7c\xbf\x58\x60\x55\x29\x87\x42\xd5\x84\x7b\xe9\x0e\xc5\xf8\x8a\x30\xa9\x81\x37\xa6\x4e\x99\x6f\x99\x2b\xaf\x74\x09\xbf\xef\x6b\x46\x39\xad\x35\x1b\x42\xd1\xf9\x11\xaa\x22\x8d\xad\xe9\x60\x69\x6e\x3b\x7d\x80\xf4\x40\x31\xca\x96\x3a\x17\x42\x35\xad\xb1\x1e\xb1\x00\xa2\xf5\xd6\x93\x8b\xf8\xe4\xc9\x31\x51\x24\x92\x71\x14\x55\x40\xea\x1c\xe9\x7b\x9d\xd5\x5d\x4e\x1f\x4c\x4e\x35\x62\x92\x6e\xfb\xdb\xed\xcd\xf5\x8a\x1c\x3b\x98\xfe\x4a\xfa\x36\xab\xa8\x91\x09\xcf\x0d\x1e\xd8\x53\xd3\xd6\xd2\x13\xa2\xb0\x38\x0a\x6a\xbf\x70\x8b\x8f\x56\xea\x92\x90\x2e\x3f\x79\x2b\x77\x80\xee\x01\xf0\xb9\x52\x26\x05\xe7\x35\x3c\x82\x06\xe4\xc1\x31\xa7\x42\xe9\xaf\xda\xf6\x4d\xdf\xf8\x6d\x4b\x78\x7d\x85\x56\xba\x4c\xd6\xea\x33\x21\xbd\x96\x0d\x31\xac\xd8\x48\x0b\x27\x9b\xb6\xa6\xbe\x9f\x2a\x87\x81\x15\xe2\x0a\x7f\xfd\xcd\x46\xc7\x7d\x8f\xd6\x2a\xed\x0b\x44\xb3\x8f\xd1\xe3\x0c\xb7\x63\xdb\x6e\x86\x64\x17\x13\x72\x3e\x80\x59\x4e\x85\xc8\x2a\xca\xee\x1c\x7c\x25\x3d\x24\x8f\x5a\x75\x8d\xd4\xa1\x10\x58\xd2\x39\x59\xae\x21\xd6\x43\x60\x06\x18\x9d\x11\x2c\xc9\x1c\x6b\x99\xdd\x89\xa2\xd3\xd9\x29\x92\xd8\xe3\xdb\x29\x0d\xe9\x2a\x41\x2f\x80\xc5\x62\x84\x2b\x94\x75\x7e\x22\xe0\x50\x66\x86\x75\x7b\xda\x93\xf1\x14\xf7\xca\x57\xe3\xef\xcf\x64\x0d\x36\xb2\xee\xc8\x41\x79\xd4\x24\x37\xe4\x60\x3a\x2f\x80\x5c\x7a\xc9\x46\x1e\x35\x4c\x00\x85\xb1\x50\x5c\xf0\xdd\x25\x14\x7e\xc4\x0f\x97\x50\xaf\x5e\x8d\x5a\x00\x76\x7a\xc4\x45\xd0\x38\xbe\xa8\x02\x64\x2d\xf7\x8d\xef\xe9\x9f\xba\x91\xd6\x55\xb2\x1e\xe7\x62\xd2\xe4\x72\xac\x78\x71\x05\xad\xea\x09\x0f\xf0\xe9\x2f\xd2\xcb\xba\x88\xa3\xee\xa1\xa3\xe6\x01\x67\xee\x35\x66\x9b\xe8\x62\xd4\x7b\xc1\x9d\xc9\xd8\xc1\x81\xc0\x64\x04\xe5\x17\x87\xa4\x1f\x02\xca\x24\x94\x75\x92\xf4\xf9\x94\xaa\x80\xc2\x4f\xf8\x1e\x2f\x5f\xe2\x05\x67\xca\xa5\xcb\x8f\x9d\xac\xe3\x9d\xc0\x07\x45\x49\x40\xb2\xb4\xd6\xd8\x22\x8e\xe8\x53\x4b\x19\xff\x6b\x67\x0e\xde\x4c\xa5\x8f\x29\x39\x0c\xc8\x05\xd6\x9d\x47\x69\x3c\x66\x6e\xaf\x65\x8f\x1e\x08\x62\x5e\x5c\xed\x89\x05\x0b\xdd\x7d\xe9\xde\x90\xce\xaa\x46\xda\xbb\x60\x49\x81\x35\x68\x48\xba\xce\x4e\xd9\x09\x1d\x30\xc5\x89\x68\xef\x52\xfb\x04\x6e\xbc\x7e\x4c\xef\x9b\x9d\x0f\xa7\xf2\x72\x3e\x2d\x47\xa3\x79\x2c\x3e\xeb\xdd\x1e\xe3\x69\x55\x83\x10\x7c\xf7\x07\xf1\x57\xf6\xe7\xba\x36\x99\x8b\x93\xe9\xca\x91\x5f\xa9\x86\x6c\x9c\x1c\xc9\xf8\x3a\xbd\x0e\x53\xae\x0a\xfc\x73\x2e\x59\x47\x83\xfc\x85\x16\xde\xc6\xf9\x8d\x1c\xcc\x7c\xb8\x93\x4e\xff\xcf\xad\x1c\xfa\xf9\xf5\x5e\x8e\x98\xf4\x84\x27\xff\xf5\x9f\xff\xec\x5d\x9e\x71\xb0\xef\xe7\x20\x9d\x63\x18\xc4\xbf\x03\x00\xfe\x4f\x48\xe3\x4c\x08\x00\x00")
+
+func templatesEasyjsontestGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEasyjsontestGotmpl,
+		"templates/easyjsontest.gotmpl",
+	)
+}
+
+func templatesEasyjsontestGotmpl() (*asset, error) {
+	bytes, err := templatesEasyjsontestGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/easyjsontest.gotmpl", size: 2124, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe4, 0x3b, 0x6, 0x2b, 0xbd, 0x1e, 0x95, 0x1e, 0xea, 0xe0, 0x71, 0x6e, 0x1, 0x9a, 0x3e, 0x4, 0xd3, 0x80, 0xab, 0xfb, 0xa9, 0xde, 0x1, 0xde, 0x49, 0xb7, 0xc0, 0x8f, 0x58, 0x72, 0x1c, 0x79}}
+	return a, nil
+}
+
 var _templatesHeaderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x31\x6e\xf3\x30\x0c\x85\x77\x9d\xe2\x21\xd3\xff\x0f\xb6\x0e\xd0\x31\xc9\x90\xa5\xe9\xe0\x0b\x28\x36\x2d\x0b\xb5\x44\x41\xa6\x1b\x18\x82\xef\x5e\xc8\x4d\x8a\x04\x48\x37\x4a\xe4\xf7\xf8\xf8\xb4\xc6\x9e\x3b\x82\xa5\x40\xc9\x08\x75\xb8\x2c\xb0\x5c\x4d\x57\x63\x2d\xa5\x37\x1c\xce\x78\x3f\x37\x38\x1e\x4e\x4d\xad\x54\xce\x70\x3d\xea\x3d\xc7\x25\x39\x3b\x08\xaa\x75\x55\x5a\x23\x67\xb4\xec\x3d\x05\x79\x6c\xae\xab\xca\xb9\x02\x85\xae\x94\x2a\x9a\xf6\xd3\x58\x42\xce\xf5\xc7\x4f\x59\x7e\xb5\x46\x33\xb8\x09\xbd\x1b\x09\x57\x33\x3d\x5b\x91\x81\x70\xf3\x02\x61\x1e\xeb\x32\x7f\xec\x9c\xb8\x60\x21\xbf\x9c\xdf\xf6\xc5\xc4\x5f\x84\x7e\x96\x4d\x6a\xa0\x80\x85\x67\x24\xaa\xd2\x1c\x9e\x94\xee\x2b\x36\xd3\x26\x74\x4a\x39\x1f\x39\x09\xfe\x29\x60\x67\x9d\x0c\xf3\xa5\x6e\xd9\x6b\xcb\x15\x47\x0a\x26\x3a\x3d\x49\xea\xbd\xec\xb6\x8b\x4a\x06\x07\xea\xcd\x3c\xca\x69\x03\xa7\x72\x20\x4a\x0c\xee\xf6\x7e\xd1\x7f\xc8\xe2\x2e\xf2\x27\xfd\x1a\xfb\xaf\xbe\x03\x00\x00\xff\xff\x6d\xc8\xeb\xdb\xb0\x01\x00\x00")
 
 func templatesHeaderGotmplBytes() ([]byte, error) {
@@ -307,6 +482,86 @@ func templatesHeaderGotmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesMarkdownIndexGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x4d\x6f\xe3\x36\x10\xbd\xf3\x57\x0c\x6c\x1f\x62\x60\xad\x00\x3d\x1a\xd9\x05\xd2\x24\x6d\x83\x26\x4d\x50\xbb\xbd\x2c\x16\x0d\x2b\x8d\x63\x6e\x24\x52\x20\xe5\xa6\x09\xc5\xff\x5e\x0c\xbf\x2c\x3b\x5a\x34\x3d\x35\x87\x78\xc8\x79\x1c\x3e\xbe\xf9\x90\xb5\x0b\x78\x16\xdd\x16\x8a\x6b\xb9\x51\xb0\x70\x8e\x4d\xc1\x5a\x10\x1b\x28\xd6\xa2\xab\x11\x9c\xb3\xf6\xc0\xc6\xda\x44\x6b\xbb\x6b\xb8\x14\xaf\x08\xb3\xe2\x17\xde\x24\xb7\xac\xc0\x39\x46\x81\x29\xc8\xef\xa8\x8d\x50\x92\xb6\x58\xb4\x97\x74\xc1\xd0\x41\xd8\xa3\x63\x97\x68\x4a\x2d\xda\x2e\x1d\xb5\xf6\xcd\xde\xc8\xa9\x35\xea\xc6\xdc\x6d\x56\xa8\xff\x12\x25\xf1\x61\xcc\x6f\x81\xda\x80\x09\x9b\xe1\xf2\xb7\xc0\xa3\x68\x41\x93\x0b\x25\x3b\x5e\x76\xe4\x67\xd1\x5e\x26\x75\x7e\xfb\xf5\x06\x9c\xfb\x1c\x57\xfb\xf7\x0f\xa5\xc8\x4a\x45\x74\x96\xe7\xcb\xc9\x7e\x73\x7e\x88\x1c\x1c\xf7\xd0\x78\xc1\x55\xc3\x45\x0d\xce\xc1\x99\xb5\xfb\xd5\xa7\x0c\x1b\x7f\xc0\x8d\x28\x51\x7a\x12\x8c\x45\x7b\xe4\x01\xe9\xce\xff\xc0\xea\xf8\x3a\x0f\xcd\xd5\x93\x0b\x23\x9d\x1b\x67\x77\xf5\x77\x87\x5a\xf2\xfa\x52\x95\x86\x3c\x6c\x85\x08\xbc\x36\x6a\x09\x49\xd7\xc3\x9c\x5b\x0b\x0d\xd7\x4f\x95\x7a\x96\x17\x58\xd7\x23\xee\x77\x4a\x3e\xe4\xc3\xa6\x53\xb8\x92\x55\xab\x84\xec\x18\xeb\xe1\x7b\x6e\x10\x08\xd7\xc3\x85\x92\x66\xd7\xa0\x81\x1e\xee\xb5\xaa\x76\x25\x99\xac\x5f\xe4\xbf\x6f\x98\xac\x27\x91\x35\x97\x8f\x08\x33\xf1\x01\x66\xa6\xdc\x62\x83\xb0\xfc\x08\xc5\xca\x9b\x26\x67\x76\x26\xc0\xb9\xb3\x3f\xf5\x3e\x95\x0f\xd6\xe6\x13\xce\x2d\x4f\x4f\x69\x5d\xfc\xa4\x0c\x15\xa2\xb7\x89\xe2\x3d\xef\xb6\x11\x1c\x8e\xc1\xf1\xa5\x8f\x5a\xed\x5a\x7f\x67\x7e\x87\x73\x7b\xc8\xd7\x0f\x30\x6b\xb0\x12\x9c\x20\x01\x5c\x9c\xd7\xf5\x0a\xb5\xe0\xb5\x78\x45\xbd\xe7\xa8\x34\xcc\x04\xcc\xbe\x8e\x32\xf5\x31\x8a\x5b\xfa\xbf\x7e\x69\xf1\x80\xd3\x7b\xc8\x65\x65\xff\x47\x72\x79\x82\xdc\xb5\xa8\x39\x4d\x9d\x1f\x49\x0f\x93\xea\x23\x6f\x1b\x8f\x0c\x99\x1d\x03\x03\x90\x7f\xd6\xf2\x47\x9f\xed\x56\x0b\xd9\xc1\x89\x91\xfc\x89\xe6\xe4\x49\xcb\x4d\xe9\xc5\x0d\x7d\x3e\x9f\xc3\xe4\x0f\x95\x63\x17\x4d\x35\x89\x37\x4e\x7d\x07\x74\xd8\xb4\x35\xef\x10\x26\xa9\xec\xd7\xfc\x71\x02\x45\x2a\xe7\x70\x0f\xd5\x33\xeb\x21\xb3\x81\x1e\x6e\xb1\xdb\xaa\x8a\xca\x96\xaa\xa4\x87\xd5\xae\x69\xb8\x7e\x39\x2c\xde\x45\x7f\x64\xe4\xf5\xa2\x67\x00\x63\x0f\xf5\x6f\xec\xe1\xcd\xd0\x48\x44\xa6\x83\x0e\x3d\x97\xe5\x56\xe9\x8c\x9b\x87\xfa\xdc\xb5\x2d\x6a\x28\x22\x41\x5f\xb5\x94\x91\x22\x95\x73\x40\xa5\x18\xa1\xcb\x13\x7b\x42\x47\x62\x83\x59\x32\x6e\xd2\xf0\xb8\x55\x15\xd6\x39\x87\x61\x15\x86\x4c\xb7\x45\xf8\xdc\xf8\x0d\xd0\xb8\x41\x8d\xb2\xc4\x2f\x27\x61\xa7\x68\xaa\x79\x31\x16\x6f\x85\xe5\x4e\x8b\xee\xe5\x12\x37\x42\x8a\xac\x07\x0d\x90\xe4\xa2\x01\xe2\x1f\xdc\x83\x6f\x86\x1e\x6e\x54\x99\xb2\x32\x9c\x56\x3d\x3b\x14\x3d\xfe\x1c\x99\x6f\x53\xf1\x0d\x0e\x3d\x9c\x71\x90\xbc\xc1\x8f\x93\x91\x14\x5c\x5f\x82\x73\x93\x4f\x67\xa7\x9c\x7a\x37\x2c\x83\xd0\x45\xec\x8a\xb0\xa2\x47\x5e\x9b\xf3\xfb\xeb\x9f\xf1\xe5\x7c\xe7\x13\xe2\xf1\x34\x5c\x97\xf0\x30\xc8\xfa\x43\x9a\xb5\xe1\xc8\x1d\xa1\xbf\x8b\xf0\x1f\x6a\xf5\x9c\xbb\xb3\x20\x8f\xd2\xe2\xd5\x8b\x40\x63\x35\xb4\x29\x1f\x6e\xd3\xb8\xf5\xdf\xa5\x31\xf4\xb0\x5f\x29\xe0\x5a\x3d\x61\x74\x51\xa0\x8e\x96\xfb\x00\x03\xef\x58\xa3\xc3\xbf\x7c\x42\x8e\x0b\xec\x3d\xda\x03\x04\x14\x91\x5b\x95\xaa\xc5\x54\x15\x53\xd8\xab\x6d\xbc\x83\x79\xf0\x61\xd0\x7c\x62\x11\x14\xa6\x2e\x18\xc0\x32\x91\x11\x5e\x71\x61\xed\x02\x50\x56\xe0\x1c\xfb\x67\x00\x11\x73\x09\x3d\xd0\x09\x00\x00")
+
+func templatesMarkdownIndexGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMarkdownIndexGotmpl,
+		"templates/markdown/index.gotmpl",
+	)
+}
+
+func templatesMarkdownIndexGotmpl() (*asset, error) {
+	bytes, err := templatesMarkdownIndexGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/markdown/index.gotmpl", size: 2512, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0x43, 0x88, 0x83, 0x88, 0xe7, 0x27, 0x51, 0xe9, 0xce, 0x77, 0xe4, 0xd3, 0xf6, 0xa8, 0x49, 0xd5, 0x58, 0x76, 0xf2, 0x4, 0x65, 0x92, 0x38, 0xb9, 0xc7, 0x3, 0x81, 0x35, 0xff, 0x59, 0xf9}}
+	return a, nil
+}
+
+var _templatesMarkdownModelsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x41\x4f\xe3\x3a\x10\xbe\xfb\x57\x8c\x0c\x87\x56\x7a\xe4\xdd\x2b\x8a\x54\xbd\x07\xab\x1e\x58\xd0\x82\xb8\x20\xa4\x0e\xcd\x04\xcc\x26\x76\xd6\x71\x97\x76\xdd\xfc\xf7\xd5\x24\x6e\x92\x26\x14\xd8\xc3\xf6\x52\x7b\xfc\xcd\xe7\x99\x6f\x66\x1c\xef\x4f\x20\xa6\x44\x69\x02\x99\xa1\xfd\x1e\x9b\x57\x7d\x69\x62\x4a\x25\x94\xa5\x00\xe0\xf3\x63\xd4\xcb\x67\x63\x61\x32\x85\x1d\x64\x56\x5b\x46\x39\x16\x4b\x4c\xd5\x2f\x82\xe8\x2b\x66\x34\x66\x27\x71\x74\x04\xde\x83\x4a\x40\x53\xe3\x3b\xea\x79\x36\xf0\x53\x04\x8d\x19\x4d\xa5\xf7\x0d\xb8\x2c\xe5\xd9\xe9\xbf\x78\xe6\x3d\x90\x8e\xa1\x2c\xbd\xaf\xf9\xdb\x98\x54\x02\xd1\xad\x72\x69\x65\x12\xde\x77\x76\x35\xa0\x76\xec\xa0\xff\xa7\x62\x69\x55\xee\x94\xd1\x8d\x4f\xcf\x76\xc8\x53\x31\x2a\x53\x1a\x9d\xb1\x17\x8a\xd2\xea\x5c\xec\x99\x27\xb0\xf0\xfe\x00\x74\x71\x88\x78\x5e\xdc\xac\x1e\x6f\x37\x39\x0d\xf9\xe0\x27\xa6\x2b\x7a\x8b\xf5\x8e\x0f\xde\x63\x9d\xa5\xe9\x55\x52\x9b\x42\xfd\x72\xb4\xa4\x5d\xc1\x05\x4c\x30\x2d\x82\x4a\xf5\xa1\x45\xfd\x44\xad\x53\x28\x9c\x71\x1c\xdd\x4c\x1b\xbd\xc9\xcc\xaa\xa8\x4b\xd0\xf0\x4c\xc1\xd9\x2a\x86\x6e\x81\x9a\x38\x9a\x48\x1a\x3c\x67\xf7\x9f\xc9\x72\x53\x50\x31\xe1\xde\x38\x2e\x28\x47\xcb\xd9\x70\x4c\x92\x7b\xcd\xfb\x3f\x0a\xa5\x25\xa8\x80\x8e\xb2\x3c\x45\xd7\x69\xe2\x9b\xe5\x33\x65\xc8\xe2\x4a\x88\x06\x4e\x53\x90\xff\x80\x7c\x3f\x83\x66\x3b\xd8\x1c\x3b\x7c\x4c\xa9\x27\xe7\x4e\xfe\x6b\x6b\x72\xb2\x4e\xd1\x2e\xd4\x1a\x3c\xd4\x4c\xbc\x53\x00\xd4\xf1\x7e\xd6\x9f\xa7\xed\xf3\x73\x21\x6a\x2c\x97\x61\x0b\xd5\x1c\x6d\x81\x95\x81\x2d\x7c\xa3\x1f\x2b\x65\x29\x86\x2d\x74\x87\x61\x0b\x77\x98\xaa\x18\x79\x5a\x0a\xd8\x8a\xed\x49\xf5\xdb\xff\xeb\x2d\x87\xbb\x46\xca\x61\x79\xda\x74\x24\x8c\x62\xb5\x74\x20\xaf\x2d\x25\x6a\x2d\x41\x4a\x90\x75\xf5\x24\x44\xe3\x36\x11\x62\xa9\x77\x1d\xb1\x93\x4b\x08\x4e\x64\x12\x1e\x1c\x96\xcc\x5a\xdc\x34\x3a\x46\x73\x47\x19\x2b\x76\xff\xf0\x71\x97\xec\xb0\xac\x20\xdf\x55\x96\xc8\x64\x7b\xca\x86\x18\xa2\x79\x71\x89\x79\x7b\xcd\x2c\x8e\x15\x6b\x85\xe9\x5e\x9d\x32\xcc\xc1\x24\xf0\xf1\xd5\x07\xfc\xdb\x48\xcc\xe3\x0b\x2d\xdd\x81\x50\xe6\xda\x91\x4d\x70\xc9\x40\xd4\x9b\xfd\x43\x1e\xbc\x94\xd6\x57\x15\xc1\x1e\x13\x63\xaa\x0c\xa2\x9b\x57\x7c\x7a\x22\x1b\x9e\xa2\x90\x53\x30\x5e\x18\x9b\xa1\x83\xb2\x84\x91\xf7\x43\xeb\xf8\xcd\xc6\xab\x5b\xef\x55\xb9\xe7\xe6\x93\xd1\x6d\x28\x1e\x48\x21\x3a\x96\xaa\x80\xd1\xe7\xa6\x8f\xd3\x3a\x5f\x23\x67\xc5\x08\x11\xd6\x13\x21\x16\x8b\xc5\x4b\x61\x74\xf5\xb4\x77\x10\x8b\x45\xef\xad\xdc\x2d\x4f\xd8\xfd\x08\xaa\xef\x5d\x21\xc4\xfd\x5c\xc7\xb4\x7e\x18\x29\xfe\x8b\xb2\x78\x2c\x3a\xf3\x59\x83\xda\x28\x86\x05\x0d\x9f\xcd\xe8\x0b\x85\xda\x0e\x66\xfc\x7c\xed\x2c\xd6\x67\x81\xe9\x23\xae\x81\x04\xbd\x65\x20\xbe\xca\xc9\x06\x69\xff\xee\x9d\xbf\x07\x00\xcb\x8d\x41\xcd\x2f\x08\x00\x00")
+
+func templatesMarkdownModelsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMarkdownModelsGotmpl,
+		"templates/markdown/models.gotmpl",
+	)
+}
+
+func templatesMarkdownModelsGotmpl() (*asset, error) {
+	bytes, err := templatesMarkdownModelsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/markdown/models.gotmpl", size: 2095, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6d, 0x55, 0x10, 0xbc, 0x8, 0x82, 0xad, 0x21, 0x31, 0xcf, 0x51, 0x92, 0x6d, 0xf8, 0x38, 0x80, 0x80, 0x84, 0x3f, 0x62, 0xe2, 0xb5, 0x45, 0xc5, 0xd4, 0xce, 0x26, 0x84, 0x2, 0x6d, 0x15, 0xe}}
+	return a, nil
+}
+
+var _templatesMarkdownOperationsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x55\xcd\x6e\xdb\x30\x0c\xbe\xfb\x29\x08\xa7\x87\x16\x68\xfc\x00\xc1\x36\x60\x48\x0f\x0d\xb0\xae\x41\x5b\xec\x32\x0c\x88\x6a\x31\x8d\x3a\x5b\xf2\x24\x1b\x6d\x60\xf9\xdd\x07\xfd\xda\x8e\xe3\x1d\x86\xdd\xd6\x43\x6d\x5a\xfc\x28\x7e\xe4\x47\x66\x01\x6d\x0b\x35\x96\x55\x41\x6a\x84\xb4\x24\xf2\x27\x15\x6f\xfc\x89\xbc\xa4\x90\x41\xd7\x25\xc9\xf7\x0d\xa7\xf8\xfe\xe3\x92\x99\x47\x56\xd2\xab\xa4\x6d\x97\x20\x09\x7f\x41\xc8\xee\x2b\x94\xa4\x66\x82\x2b\xeb\xbb\xb0\xe1\xb2\xaf\xa4\x44\x6b\xef\x76\xbb\xa4\x6d\xa1\xa9\x2a\x94\x90\xdd\x61\x7d\x10\x14\xba\xce\x3a\x6d\x49\x7d\x30\x4e\xc6\x07\xc0\xc4\x64\x7b\xc8\x1e\x9b\xb2\x24\xf2\x68\x0e\x0c\x72\x68\x3b\x27\xe4\xb4\x37\x0c\xe2\x06\x55\x2e\x59\x65\x92\x88\xa8\x93\x6f\x33\xc8\xb5\xe0\xaa\x29\x51\xdd\x21\x65\xe4\xe9\x58\xa1\x23\x11\x3e\xaf\x4c\x9a\x8e\xe7\x05\xbb\x86\x8b\xd2\xb8\xc1\xea\xe3\x0c\xb0\x6d\x4d\xd0\x0b\x06\x5d\x77\x6d\x90\xee\xba\x5d\xdb\x06\xa4\x33\x46\x59\x4c\x52\xda\x4a\x41\x9b\x7c\x92\x52\xf8\x3c\x9f\xd2\x59\xe0\x3f\x49\xe9\x11\xf3\x46\xb2\xda\xb5\x24\x18\xab\xf3\xb2\x09\xc7\xe9\x18\x36\x47\x96\x48\x52\x06\xe1\x2c\xc0\x9a\x58\xa3\x54\x49\xa2\xc1\x6a\x48\xc3\x86\x83\x06\xd3\x1b\xd0\xf0\x80\xbf\x1a\x26\x91\x82\x86\x61\x83\x35\x7c\x23\x05\xa3\x5e\x86\x3a\xd1\x4b\xfb\xa7\xe3\xbf\xf8\x38\x79\x9d\x5a\x09\x00\xc0\x40\xde\x7d\x86\x7a\x28\x6c\x70\xd6\x17\x91\x13\x2f\x31\xd0\xe7\x0b\x62\x03\x98\xf4\xdd\x34\x39\x37\xa3\xda\xc8\xa5\xeb\x8e\xa8\x62\x13\xe6\xe2\x0c\xf8\xba\x48\x3e\xcc\x2d\x51\x37\xb8\x27\x4d\x51\x43\xd7\x7d\x78\x96\x9f\xbc\xb5\x02\xd3\xe5\x80\x5e\x63\x51\xc0\xe5\xab\x12\xdc\x8c\x8b\x75\xb8\x1a\xf5\xbe\xcf\xeb\x31\x3f\x60\x69\xb4\x3a\x80\x0f\xcb\x3b\x72\xc0\x42\xe1\x1f\x5c\xbd\x93\xbf\x21\xd6\x36\xea\x60\xa6\xd4\xc6\x2f\x8a\x84\x70\x1a\xef\xf4\xcf\x6c\xa3\xd6\xa2\xac\x0a\x7c\xbf\x7f\x7e\xc5\xbc\x0e\xe7\xd9\x46\x7d\xe6\x82\x1f\x4b\xd1\xc4\x3c\xb3\xad\x14\x15\xca\x9a\xf9\x41\x5a\x2c\xc6\x2b\x0a\xaa\x78\x3e\x10\xdd\xdf\xeb\x6d\xa4\xa8\xc1\xeb\xd4\xb2\xfc\x03\xd1\x33\xc2\x89\x79\xa5\x70\x49\x59\x5e\x43\xba\x95\xb8\x67\xef\x29\xa4\x29\xa4\x8e\x71\x1a\x68\x5e\x8d\xeb\x76\x52\xe1\x68\x0e\x8c\xc4\x14\xe2\x01\x55\x25\xb8\x42\x3b\x70\x6b\x41\x71\xc2\xd4\x57\xe2\x16\x09\x45\x39\x25\xbb\x1c\x59\xfd\x47\x9d\x8c\x7a\x1b\xef\xe9\x27\xc9\xde\x16\x84\x17\x48\x5b\x9d\x9e\x2c\x74\xe7\xf1\xc6\xea\x43\x20\xeb\x64\x35\x2d\x99\x3b\xed\x87\xed\x44\xdd\x83\xb5\x79\xb0\x74\xec\x2a\x0f\xcc\x46\xcb\xd2\x0c\x52\x44\x9b\x41\xf2\x88\xa0\x9a\xdd\xcc\xfa\x73\xc1\x5c\x06\x1e\x32\x99\x81\x49\x43\x1c\x35\x3f\x96\xa1\x52\xc3\xf6\xb1\x3d\x08\x39\xfe\x4d\xeb\x2b\x91\x68\xa0\x7e\x01\xfc\x77\xa5\x9c\x57\x77\xdb\x2e\x01\x39\x85\xae\x4b\x7e\x0f\x00\x49\x20\xc6\x77\xe1\x08\x00\x00")
+
+func templatesMarkdownOperationsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMarkdownOperationsGotmpl,
+		"templates/markdown/operations.gotmpl",
+	)
+}
+
+func templatesMarkdownOperationsGotmpl() (*asset, error) {
+	bytes, err := templatesMarkdownOperationsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/markdown/operations.gotmpl", size: 2273, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc5, 0xb, 0x73, 0x57, 0xa8, 0x59, 0xc6, 0x66, 0xc9, 0x2b, 0xba, 0xdc, 0x7f, 0x78, 0xa, 0x42, 0xf7, 0x45, 0xe5, 0xee, 0xc, 0x88, 0xe0, 0x51, 0xd0, 0xe4, 0x39, 0xec, 0x7d, 0x3e, 0x58, 0x3f}}
+	return a, nil
+}
+
+var _templatesMarkdownSharedGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x56\x4b\x6f\xe3\x36\x10\xbe\xe7\x57\x0c\x54\x1f\x24\x20\x25\x7a\x0e\xba\x05\x8c\xf4\x95\xc3\x36\xc1\x26\xe8\x65\x1b\x60\x67\xc5\x91\xcd\x84\x22\x55\x4a\x6e\xec\x72\xf5\xdf\x0b\x8a\x94\x44\x59\x72\x63\x04\x05\x5a\xa0\x37\x91\x9c\xc7\x37\xf3\xcd\x43\xd6\x7e\x0d\x9c\x0a\xa1\x08\x92\x12\xcd\x33\xd7\x2f\xea\x3e\xdf\x52\x89\x0f\x87\x8a\x12\x68\xdb\x0b\x00\x27\x24\x0a\x40\xc5\x21\x55\xba\x01\x76\x53\xaf\x95\x56\x87\x52\xef\xea\x0c\x52\x6d\xdc\xcd\xb5\x2e\x2b\x49\xfb\xdb\xcf\x4f\x94\x7b\x11\x29\xb0\x26\x9e\x41\xdb\x7e\xb4\x16\xb8\xd1\xd5\x1d\xe6\xcf\xb8\x21\x60\x3f\x69\x67\x1e\xda\xf6\x31\x2d\x35\x27\x59\xb3\x92\x7f\x65\x2d\xf4\x10\xd6\x2a\xdf\x6a\x03\xe9\x82\x92\xb3\x97\x05\x50\x24\x6b\x02\x51\x74\xde\x8c\xc1\x83\x87\x3b\x00\x66\x37\x0d\x95\xb5\xf3\xff\x68\x2d\x34\x54\x56\x12\x9b\x13\x81\x0e\xb2\xd6\x7a\xb3\x6d\x8b\xce\xa4\x3b\x2a\x3e\xe6\x21\x72\xf9\x1e\xab\x63\x87\x6b\xce\x45\x23\xb4\x42\x79\x67\x74\x45\xa6\x11\xe4\xfc\x97\x58\x81\x2e\xe0\x75\x10\x27\xf4\x47\x4c\xba\x4b\xef\xdf\x80\xba\x51\x0d\x99\x02\x73\x27\x8c\xea\x30\x17\x98\xf2\xd4\x5b\x8c\xe5\xda\xd6\x5a\x60\xf7\x2f\xb8\xd9\x90\x09\x3c\x59\xdb\xd9\x0f\x97\x3f\x6a\x53\xa2\x53\x86\xd4\xda\xf9\x6d\x36\xc3\xe7\x0f\xd1\xe7\xc5\x52\xe1\x75\x14\xcc\xeb\x8e\x5d\x6f\x85\xe4\xa7\x69\x8c\xd4\x06\xd1\xff\x44\x3c\x77\x68\xb0\x5c\x88\xc7\x37\x98\xcf\xea\x2b\x05\x31\x88\x1e\x13\xf9\x86\x9c\x84\x98\xaf\xb5\x94\x94\xbb\x2a\x3b\x0a\x7b\xe1\x61\x1e\xf9\xbf\x9a\xd0\x9f\x09\x39\x99\x85\x8c\xfe\x2f\xb3\xf1\x3d\xd5\xb9\x11\x95\xc3\x1e\xa5\xa3\x7f\xbd\x26\x29\x21\xad\x8c\x50\x8d\x1f\xd1\x0f\xa2\x91\x04\x49\x92\x41\xea\x26\x79\x38\xb3\xc8\x0a\x24\xbf\xa9\x24\x0c\xf4\xc9\x75\x92\x65\xe7\x00\x1a\x67\x56\x84\x67\x55\x19\x2a\xc4\x1e\xae\xde\x01\xbb\xf3\x9f\xc3\x9b\x41\xe5\xb6\x81\x2f\x72\x36\x19\x79\x17\x5f\xdc\xb8\xec\x95\xbb\x8c\xb2\x5f\xb0\x74\xc9\x85\x2f\x67\x4c\xd2\x41\xce\x91\xfc\x81\x7e\xdf\x09\x43\x2e\xab\x07\xaa\x87\x74\x9f\x32\x14\xc5\x9e\x00\x1b\xe9\xfc\x40\xc8\x6f\x95\x74\x6b\x06\x52\x43\xc8\x41\x2b\x79\x18\xe9\x0b\x62\x3f\xec\xd1\x8d\x58\x68\xdb\x6f\x3f\x9b\xef\xc2\xe9\x0a\x3e\x45\xfb\xad\x23\x27\x12\xfc\x74\x84\xa9\x97\xfb\x15\xa5\xe0\xe8\x18\xae\x43\x48\xf1\xba\xe9\x68\x5c\xda\xbc\xfd\x72\x1e\xd6\xf5\x7b\xac\xb2\x7e\x57\xf9\x1a\x9b\x47\x1d\xb3\x97\x72\x91\x37\x90\x78\xbe\x92\xbe\x8c\x7a\x36\x3c\x11\x09\x4b\x32\x48\x3c\x77\x09\xb0\xc1\xfe\xd0\x18\x23\xc2\xb5\xdb\xa4\xe3\x8a\x8d\x60\x0c\x32\xee\x69\xe1\x2f\x22\x5c\xcf\x22\x0a\xf7\xd3\xb8\xfe\xb1\xc8\x3e\x3e\x4e\x63\xeb\x80\x47\x8e\xa2\x46\x98\x1d\x67\x87\x69\x91\xaf\xa5\xbc\x2d\x62\x45\x51\x4c\x29\x7b\x3b\x4b\x7d\x10\xa7\x38\x59\x40\xf8\x5a\x47\x3f\xe0\x26\x6e\xe5\x06\x37\xae\x8f\xb7\xbb\x12\x95\xf8\x93\x42\xba\x86\xf7\x17\xd1\x6c\x81\xdd\x56\x64\x42\xc9\x76\x2d\xd1\xdd\xa6\x42\x71\xda\x03\x83\x6f\x32\xf6\x80\x9b\xf0\xd4\x19\x7c\x07\xc3\x5b\xf8\xd5\xe9\x9b\x29\x20\x8b\x9c\x9f\x01\xf9\x9e\xf2\x9d\x11\xcd\x21\xc2\xed\x19\x58\x89\x4b\x58\xa1\x6c\xc8\x28\x6c\xc4\x1f\xe4\x22\x61\x71\x82\x44\x01\x2b\xe1\x9a\x4c\x1b\x88\xbd\x4f\x8c\x3c\x5d\xc2\xca\xf8\x71\x52\x92\x6a\x9c\x91\x89\xd1\xa0\x31\x5a\x7c\x72\x16\x5d\xa7\x0e\x26\xdd\xdf\x70\x6c\xa3\xcf\xe2\xa3\x4f\xd2\xf2\xaf\xf0\x92\x42\x76\xe4\x2a\x16\xb9\xcf\x75\xd5\x0d\x52\x48\xeb\xee\xf3\xca\xc5\x14\x82\x78\xbe\x84\x55\x77\xdb\xc1\x5f\x54\xf3\xb3\x6c\xf5\x0c\x6d\x7b\x39\x42\x77\x73\x2a\x68\xc6\x43\x6b\xb2\xc1\xce\xaa\xb6\xbf\x06\x00\x2e\x01\x03\x0d\x78\x0c\x00\x00")
+
+func templatesMarkdownSharedGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMarkdownSharedGotmpl,
+		"templates/markdown/shared.gotmpl",
+	)
+}
+
+func templatesMarkdownSharedGotmpl() (*asset, error) {
+	bytes, err := templatesMarkdownSharedGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/markdown/shared.gotmpl", size: 3192, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x84, 0xe7, 0x0, 0xc3, 0x43, 0xa6, 0x29, 0xce, 0xac, 0xed, 0x9f, 0xd1, 0xd6, 0x3a, 0x55, 0x27, 0x93, 0x74, 0x73, 0x93, 0xbd, 0xb5, 0x49, 0x45, 0x12, 0xe1, 0xf1, 0x4c, 0xd6, 0x84, 0x31, 0x44}}
+	return a, nil
+}
+
 var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\xcd\x4e\x2b\x31\x0c\x85\xf7\x7d\x8a\xa3\x2e\x2b\xdd\x99\xfd\x5d\x22\x8a\xc4\x02\x36\xf0\x02\x56\xe2\x4e\x23\xe5\x67\x14\xa7\xa2\x60\xe5\xdd\x51\xda\xd2\x4e\x07\x54\xb1\x60\x97\xb1\xbf\xb1\xfd\x1d\x55\x14\x0e\xa3\xa7\xc2\x58\x6e\x99\x2c\xe7\x25\x3a\xd4\xba\x50\xfd\x07\xb7\x41\xf7\x18\x8d\xdf\x59\x7e\x4a\x96\x7d\xab\x03\xe7\x8e\xac\xf7\x63\xca\x85\x6d\xab\xf7\x3d\x54\x31\x92\x18\xf2\xee\x83\xd1\x3d\x53\x60\xd4\x8a\xab\x15\x36\x19\x29\xd9\xc5\xe1\xb4\x05\x38\xce\xbb\x10\x14\x63\x2a\x54\x5c\x8a\x72\x66\x1a\xc1\xd1\x5e\x3e\x2e\xb8\x98\x2d\x07\x9a\xdc\x7c\xe2\x16\xaa\xc8\x14\x07\x46\xb7\xde\x97\x4c\x2f\x07\x4e\x66\x06\xdf\xdc\xfe\xde\xee\x37\x7e\x33\xc3\x9b\x8e\x57\xec\xec\x69\x79\xe3\xe2\x7c\x47\xad\xaa\xfd\x0a\x93\x1a\x4a\xc2\xc0\x91\x73\x9b\x2e\x23\x1b\x6c\x72\x0a\x90\xb4\xcb\x86\xb1\xea\xa7\x19\xc5\x54\x5a\x16\x77\x24\xfc\xfa\x3e\xf2\x31\x8b\x16\x87\xbc\xd1\x30\x70\xfe\x1f\x0e\xe1\xa9\x9e\x23\xf9\xba\xd0\xcb\x0f\xb4\x75\x62\xb2\x0b\x2e\x52\x49\x79\xfa\xd7\xe1\x7d\x3f\xed\x3e\x38\xf6\xf6\x96\xf1\x67\x00\x00\x00\xff\xff\x75\xb1\xeb\x60\xbc\x02\x00\x00")
 
 func templatesModelGotmplBytes() ([]byte, error) {
@@ -347,7 +602,7 @@ func templatesModelvalidatorGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemaGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5d\x6f\xdb\x36\x17\xbe\xcf\xaf\x38\xaf\xdf\x6c\x90\x82\x54\xde\x7a\xb5\x75\xc8\x45\xd2\xac\x6b\x07\xac\x29\xea\xae\x05\xd6\x15\x05\x2d\x1e\x59\x6c\x29\x52\x25\xa9\xb4\x9e\xe0\xff\x3e\xf0\x43\x32\x65\x4b\x4e\x82\x6c\xc0\x30\x2c\x57\x8e\x48\x1e\x3e\xe7\x39\xdf\x6c\xdb\x07\xc0\x0a\x20\x82\x42\xf6\x4c\x5f\x10\x8d\xaf\xd6\x35\xda\xdf\x3f\x7e\xa9\xa5\x32\x48\x21\x11\xd2\xd8\x0f\x8b\xa6\x46\x75\xce\x19\xd1\x29\x6c\x36\x47\x00\xf6\xac\xc1\xaa\xe6\xc4\x20\xcc\x74\x5e\x62\x45\x5e\x48\xbe\xae\xa4\xaa\x4b\x96\xcf\x20\xb3\xfb\xec\x2e\xe4\x1a\xed\x35\x03\x29\x5e\x88\xb1\xd7\xb5\x2d\xd4\x44\xe7\x84\xb3\x3f\x10\xb2\xe7\xa4\x42\xd8\x6c\xec\xd7\xad\x78\xbb\x6f\xe1\xae\xb0\x00\xbd\xec\xb6\x9d\x9f\xc0\x13\xa9\x9c\x10\x0d\x14\x73\x4e\x14\x52\x20\x1a\x8e\x15\x16\x20\x05\x68\x59\x21\x48\x53\xa2\xdf\x74\x0a\x1f\x1a\x6d\xba\x9d\x60\x4a\xf4\x00\x88\x06\x02\x2b\xc9\x89\x58\xc1\x7b\x62\xd1\x21\x7d\x1f\x4e\x60\xb6\xca\xfc\xae\x73\x38\x83\x8b\x0c\x9e\x4b\xa8\xd0\x94\x92\x82\x2e\x09\xe7\xb0\x44\x50\xd8\x5d\x9e\x01\x9c\xcc\x7b\x7a\xbc\xce\x3d\xad\xee\x3b\x0c\x15\x5b\x86\xc5\x05\x2a\xe6\x08\x50\x91\x72\x6f\x4a\x14\x0e\xa5\xc3\xb4\xbd\xc6\xc2\xad\xb7\x54\x07\xa4\x14\x0b\x26\x10\x0a\x92\x1b\xa9\xd6\x01\xa4\x86\xcf\xcc\x94\x60\x4a\xa6\xbd\x94\x2c\x06\x88\x82\x0e\x8c\x14\x23\x97\xca\x82\x7f\x2c\xab\x9a\xe3\x97\xab\xe5\x07\xcc\x9d\x1f\xbc\x6a\x6a\xee\x3c\xe4\x9c\x52\x66\x98\x14\x84\xbf\x50\xb2\x46\x65\x18\xea\x0e\xf8\xab\xab\xcb\xab\xa4\x50\x48\xd3\x47\x50\x12\x41\x39\x42\x4e\x34\x82\x2c\x40\x37\x4b\xc7\x26\x13\x25\x2a\x66\x98\x58\x41\xa1\x64\x05\x96\x08\xcf\xb3\x03\x3c\x26\xfd\x14\x98\xd6\x0d\xc2\xff\x1f\x3e\x7c\xf8\x4d\xa7\x46\x60\xd4\x52\x1d\x3c\xa7\xf3\x29\x56\x40\xf0\xdd\xde\x99\x2d\xbc\x7e\x5f\xdb\x76\x4a\x8f\x3a\xa0\x5d\x16\x34\xfe\x31\xb4\x9c\xf7\xf8\x0b\x49\xd7\xc1\xd5\x3d\x92\x07\xa0\x88\x58\x21\x64\x03\x56\x7a\xa0\x53\x4e\x61\xff\xe6\xf3\x91\x50\xd8\x6c\x60\x85\x46\x3b\x37\x68\x5b\x28\x9b\x8a\x88\x41\x9c\xc8\xc2\x5b\xb7\x27\xd0\x59\xc0\x7a\x66\xbd\x45\xf0\xb9\x64\x79\x09\xd6\xe9\x65\x01\x24\x22\xdb\xee\x21\x2b\xab\x10\x33\x1a\x98\x30\xa8\x0a\x92\x63\xcc\x2e\x40\xd1\x88\x1c\x92\xb6\x85\xe3\xec\x25\xe6\xc8\xae\x51\x05\x68\x27\x03\xc0\xc7\x01\x71\x3a\xaa\x47\x92\x8e\x11\x18\xc5\x73\x7f\x5f\x4f\x14\x7e\x82\xe3\xec\x92\xe9\x5c\xb1\x8a\x09\x62\xa4\x7a\xc2\x90\xd3\x5e\xf9\xe8\x04\x80\x42\xd3\x28\xe1\xae\x56\x4c\x98\x02\x66\x5f\x7d\x9a\xed\x9e\x7f\x4d\x78\xb3\x73\x72\xe8\xfd\x63\xf2\x86\x6a\xc3\x66\x93\xb5\x6d\x4e\x2a\x8c\xb5\x73\xc0\x76\xa5\xfa\xf0\xea\x3e\x6d\x8e\x62\x53\x2f\xd0\x8c\x5a\x5b\xdf\xcd\xda\xf7\x30\xd2\x04\x82\xe4\x9a\xf0\xc3\x96\x4a\x61\xc4\x56\x02\xef\x60\xab\xbb\x90\x0a\x67\x70\x4d\xf8\x4d\xd4\x8e\x2e\x8d\xfc\x6b\xc3\xef\x12\x0b\xd2\x70\xb3\x9f\xad\xe0\x41\x9f\x62\xbe\xfd\xee\xfb\x38\x08\x3a\x76\x0f\x73\xdb\xe9\x9a\xc2\xaf\xa2\x22\xca\x16\x88\x9f\x17\x57\xcf\x93\x25\xbc\x7d\xb7\x5c\x1b\x4c\x01\x95\x92\x2a\xa2\x6f\xba\x00\xfa\x2a\x39\xba\xd4\x9f\xbe\x26\x0a\xcc\x81\xe3\xfd\x46\x1b\x4b\x4a\xc1\xa3\x33\xf8\xa0\xa5\xc8\x7a\x74\x89\xc7\x95\xb4\x6d\x1c\x33\x89\xdd\xd4\xd3\x94\x6e\x36\xe9\x29\x7c\x6d\xd2\x1f\x9c\x8c\xff\x9d\x81\x60\x7c\xe0\x01\x21\x52\x50\xa9\x3d\x83\x1c\xb8\x7a\x79\x0f\xa1\x27\xfb\x96\x38\x1b\xe7\x21\x31\xe9\xd1\x8e\x48\xc1\x3a\x6f\x1a\xf1\x92\xdd\x6c\x70\x9f\x0e\xe5\x68\x44\x36\x2b\x20\x09\xfd\xd6\x0b\x1b\x29\x86\x5d\xfb\x72\xea\xdb\x0e\x57\x73\x1b\x6d\x64\xf5\x44\xaa\x8a\x18\x83\xca\xb7\x60\x89\x36\x8a\x89\xd5\x63\x29\x0c\x61\x42\x43\xf6\x1b\x2a\x09\xb3\xe4\xf7\xd9\x2c\x4d\xd3\xd1\xde\x22\x74\x32\xbb\xad\xc5\x04\x2a\xd7\x9d\x2d\x77\x1a\x95\x50\xcb\xce\x39\xbf\x2a\xe2\x32\x76\xa8\xc8\x4d\x95\xb9\xbf\xa8\xce\x85\x06\x62\x2f\xef\xfd\x57\x9b\xfe\x29\xb5\xe9\xde\x16\xfa\x17\x16\xa6\x91\xc5\xed\x87\xa9\x9e\x79\xb4\xc5\x66\x22\x6a\xdf\xfa\x0a\xb5\x57\xe9\x22\x96\x2a\x52\x5f\xa9\x05\x67\x39\xfe\x84\x36\xa1\x4c\xa5\x81\x3d\x62\xf7\x32\xc7\xce\xcc\xd0\x8f\x8e\x22\xe7\x0d\xc5\xd7\x84\x33\x6a\xf9\x9d\x18\x1a\x43\x63\xea\xf3\x9c\x1f\xda\xb6\x83\x15\x50\xe9\xda\x75\x3b\xe4\xb8\x39\xa6\x9b\x5f\x86\xf3\x94\xbd\xcf\xe7\x43\x3f\x9f\x3c\xeb\x3b\x56\x7b\x9d\x51\x48\xaa\x34\xf5\x8b\x2f\xf1\x53\xc3\xec\x40\x98\x3d\x25\x3a\x60\x63\xd2\xa6\xce\xa7\xa4\x4f\x4c\xe3\x99\xd3\xab\x7f\xdd\xe9\x33\x24\x2c\x4c\xb3\x37\x80\xb0\x07\xe6\x73\x08\xf7\x22\x04\x61\x56\x67\x1b\x23\x63\x01\xe4\xf8\xf1\x11\xe4\xee\x07\x66\x67\xaf\x0a\x45\x08\x3a\xd5\x08\xc3\x2a\xcc\x82\x4c\xb2\xe4\x18\x75\xec\xcb\xc6\x40\x49\x34\x08\xd9\xdd\xe5\x94\x35\x12\xf2\x12\xf3\x8f\x9e\xc7\xa9\x36\xc6\x8f\x4b\x5e\x9b\x7e\xc2\xdb\x9b\xfd\x26\x46\xbe\x93\x78\x54\xf2\x62\x92\x9d\xc9\x2b\x85\x38\x02\xc3\xa0\x08\xc3\xa8\xba\xc3\x48\x96\xf6\xbc\x26\x85\x2b\x93\x1a\xb4\x51\x45\x65\xb2\x97\xb8\x62\xda\xa8\x75\xdc\x69\x45\x65\x7f\xa7\xc2\x3b\xc6\xa3\x91\x10\xa8\x44\xed\x2c\xdb\x53\x7f\x33\xf3\x8f\x40\x48\x59\x4f\x8d\xd5\x83\x68\xc9\xde\x10\x61\xf4\x2f\xbe\x01\xba\x60\x82\xa8\xf5\x48\xf0\x55\xf1\xfa\x4d\x31\x18\xa2\x65\x24\xc8\x83\x7a\x9a\xad\x04\x31\x8d\x42\x28\xa4\x1a\x4f\x29\x36\xa8\xb6\x0b\xcf\x0c\x56\xda\x16\x63\xdb\x7b\x58\x87\xd9\x8d\xc1\x60\xdb\xfd\x77\x0d\xaf\xe3\x53\x32\xe5\x28\x51\xe2\x73\x43\x7e\x76\x78\x5f\xe8\x14\xe2\x67\x26\x2a\x73\xdf\x07\x75\xaf\x24\xf1\x62\x98\x77\xd7\xdb\x58\xbf\x94\xf9\x22\xda\x1e\xe5\xe2\xb1\x4e\x31\x85\x8a\xd4\x6f\xbd\xfc\x77\x07\xeb\xcc\xad\xa7\x8d\x5d\x35\x3d\xb7\xbd\x80\xbf\x47\xbf\x29\xed\xde\xde\x42\xa9\x03\xbd\xf0\x7c\x7e\x8b\x82\xa4\x4b\xd9\x50\x58\x62\x28\x61\xd4\xbf\xb8\x71\xf6\x11\x41\xe1\xaa\xe1\x44\x45\xcf\x12\x7b\x75\x8f\x50\x0a\x45\xc3\x39\xe8\xa6\xb6\x89\x63\xda\x65\xc7\xaa\xa0\x05\x68\x7c\x02\xad\xa4\x8d\xdd\x53\x17\xbc\xc3\xc7\x0e\x4b\x20\x30\x0d\x75\xa3\x4b\xa4\x40\xe5\x67\x61\x73\xa4\xdd\xb8\xed\x5b\xc6\x83\xf8\xcf\x00\x00\x00\xff\xff\x67\xbc\x6f\xc6\x2e\x15\x00\x00")
+var _templatesSchemaGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x8f\xdc\xb6\x11\x7f\xbf\x4f\x31\xbd\x5e\x0a\xe9\x70\xd1\xa6\x79\x6a\x5d\xdc\x83\xef\x2e\x6e\x5c\xa0\x3e\xc3\xeb\x26\x40\xdd\x20\xe0\x4a\xa3\x5d\x3a\x12\x29\x93\xd4\xf9\xb6\xc2\x7e\xf7\x62\x48\x4a\xa2\xb4\xd4\xfd\xa9\x9b\x97\xa0\xd8\x97\x5d\xfe\x19\x0e\x7f\x33\xf3\x9b\xe1\x6c\xd7\x7d\x0d\xbc\x04\x26\x0a\xc8\x5e\xeb\x2b\xa6\xf1\xfd\xbe\x41\xfa\xfe\xdd\x7d\x23\x95\xc1\x02\x12\x21\x0d\x0d\xac\xdb\x06\xd5\xcb\x8a\x33\x9d\xc2\xe1\x70\x02\x40\x7b\x0d\xd6\x4d\xc5\x0c\xc2\xa9\xce\x77\x58\xb3\xb7\xb2\xda\xd7\x52\x35\x3b\x9e\x9f\x42\x46\xeb\x68\x15\x56\x1a\xe9\x98\x89\x14\x9a\x04\x30\x74\x5c\xd7\x41\xc3\x74\xce\x2a\xfe\x6f\x84\xec\x0d\xab\x11\x0e\x07\xe8\xba\x40\x3c\xad\x5b\xdb\x23\x48\x41\x27\xbb\xeb\x56\xe7\xf0\x4a\x2a\x2b\x44\x43\x81\x79\xc5\x14\x16\xc0\x34\x9c\x29\x2c\x41\x0a\xd0\xb2\x46\x90\x66\x87\x6e\xd1\x05\x7c\x6c\xb5\xe9\x57\x82\xd9\xa1\x1d\xa6\x1d\x0c\xb6\xb2\x62\x62\x0b\x3f\x33\xba\x23\x16\x3f\xfb\x1d\x98\x6d\x33\xfb\x15\x5e\xc2\x25\x5c\x65\xf0\x46\x42\x8d\x66\x27\x0b\xd0\x3b\x56\x55\xb0\x41\x50\xd8\x1f\x9e\x01\x9c\xaf\x06\x78\x78\x39\x81\xd5\x8e\xc3\xf4\x62\x1b\x3f\xb9\x46\xc5\x2d\x00\x2a\xb8\xdc\x8f\x3b\x14\x56\x4b\xab\xd3\x78\x0c\xa9\xdb\x8c\x50\x7b\x4d\x0b\x2c\xb9\x40\x28\x59\x6e\xa4\xda\x7b\x25\x35\x7c\xe6\x66\x07\x66\xc7\xb5\x93\x92\xf5\x0a\x8e\x2a\xfe\xc8\x84\xd1\xdf\x31\xbd\xff\xdb\xfa\xf6\x0d\xf8\xc9\x99\x9e\xe8\xa7\xfb\xcb\x78\xf3\xf6\x62\x50\x14\xa3\x57\xf8\x1f\x83\xe9\x87\x89\x33\x92\xf2\x51\x4b\x01\x2f\x2e\xa1\x97\xb8\x6e\x9b\xde\xd5\x86\x21\x6b\x69\xc8\x02\x4f\xe3\x25\x48\x45\x68\x5e\xcb\xba\xa9\xf0\xfe\x76\xf3\x11\x73\xeb\x98\xef\xdb\xa6\xb2\x2e\xfb\xb2\x28\xb8\xe1\x52\xb0\xea\xad\x92\x0d\x2a\xc3\x51\xf7\x48\xbe\xbf\xbd\xb9\x4d\x4a\x85\x45\xfa\x02\x76\x4c\x14\x15\x42\xce\x34\x82\x2c\x41\xb7\x1b\xc2\x0f\xb8\xd8\xa1\xe2\x86\x8b\x2d\x94\x4a\xd6\x40\x96\xb1\xc8\x3a\x04\x63\xd2\x2f\x80\x6b\xdd\x22\xfc\xfe\xdb\x6f\xbf\xfd\x66\xc4\x95\x50\xb0\xfe\xee\x5d\xb9\x77\x72\x5e\x82\x0f\xa6\x21\xba\x48\xbd\x61\x5d\xd7\xf5\x78\x45\x23\x82\xa6\x85\xdf\xe2\x20\x9e\x9a\xc8\x85\xe0\x95\x2c\xf6\x33\xe3\x28\x26\xb6\x08\xd9\x04\x95\x41\xd1\x25\x2f\xa5\xcf\x6a\x15\x89\xcd\xc3\x01\xb6\x68\xb4\xf5\xcb\xae\x83\x5d\x5b\x33\x11\xaa\x49\x98\x5a\x77\x1b\x00\xb4\xbe\x4c\xa1\xd2\x8c\x1a\x7c\xde\xf1\x7c\x07\x4c\x59\x13\xb0\x00\x6c\x5a\xc3\xb6\xe4\x73\xdc\x68\xe0\xc2\xa0\x2a\x59\x8e\x21\xba\x00\x65\x2b\x72\x48\xba\x0e\xce\xb2\x77\x98\x23\xbf\x43\xe5\x55\x3b\x9f\x28\x7c\xe6\x35\x4e\xa3\xf7\x48\xd2\x18\x80\x01\xc1\x0c\xe7\x0d\x40\xe1\x27\x38\xcb\x6e\xb8\xce\x15\xaf\xb9\x60\x46\xaa\x57\x1c\xab\x62\xb8\x7c\xb0\x03\x40\xa1\x69\x95\xa0\x33\x1a\xc5\x85\x29\xe1\xf4\xab\x4f\xa7\xf3\xfd\x3f\xb0\xaa\x9d\xed\x9c\x06\x4e\x4c\xde\xf4\xda\x70\x38\x64\x5d\x97\xb3\x1a\xc3\xdb\x59\xc5\xe6\x52\xfb\x30\x75\x9f\xc3\xc9\xf0\x75\xb5\x82\x35\x9a\x18\x4a\xa0\x9f\x67\xed\x2f\x30\xd2\x82\x06\xc9\x1d\xab\x1e\xb6\x54\x0a\x11\x5b\x09\x7c\x86\xad\x9e\x03\x2a\x5c\xc2\x1d\xab\x1e\x83\x36\x3a\x15\xf9\xd9\xe7\xdf\x6b\x29\xb4\x51\x2d\x91\x37\xc1\x34\x24\xde\x3e\x30\xd3\x20\x15\x6f\xdc\x40\x9c\xaa\x1d\x38\x81\xb8\x25\xae\x1e\xce\x1f\x79\xd9\xd3\x65\x81\x25\x6b\x2b\x43\xd7\x6c\x51\xdb\x30\x55\xc8\x0a\xd8\xec\x6d\xd8\x13\x47\x43\xcd\x94\x4d\x80\xa8\xf4\x18\x9c\x83\xeb\xf6\xb7\xfa\x87\xf0\xeb\x6e\x9c\x48\x7d\x3c\x64\x23\x40\x3f\xe5\xbe\x6e\xc9\xf7\x2c\xb2\x26\x46\xcf\xfd\xec\xda\x28\x9e\x9b\x85\x15\xf8\x09\x92\x0a\x05\x64\x2f\xab\xea\xb6\x4c\xe1\x9b\x25\x58\x3d\x26\x7a\x9e\xa9\x8f\xee\x9d\xf9\x7b\x1d\xe7\x1e\xf8\x7a\x48\x18\x7f\xfc\xd3\x9f\x43\x4a\xeb\x63\xe5\xe1\x48\xe9\x3d\x37\x85\x01\x43\x32\x45\xb2\x81\x0f\x3f\x6d\xf6\x06\x53\x40\xa5\xa4\x0a\x82\x61\xb9\xbe\x72\x45\x58\x74\x6a\xd8\x7d\xc7\x14\x98\x07\xb6\x0f\x0b\x89\x19\x95\xa2\xac\x4e\xd9\x7d\xb4\x70\xe2\xf4\x4a\xba\x2e\x64\xc0\x84\x16\x0d\x30\xa5\x87\x43\x7a\x01\x7f\x30\xe9\x5f\xac\x8c\xdf\x5d\x82\xe0\xd5\x24\x9e\x3d\xef\xa1\x52\xc3\xe0\xe1\xf1\xa3\x37\x5f\x20\xf4\xfc\xd8\x12\x97\x71\x1c\x12\x93\x9e\xcc\x44\x0a\xde\x73\x43\x24\xe6\x06\x47\xf1\x30\x2f\x1b\xe8\xf1\x02\xf8\x24\x22\x9b\x97\x90\xf8\x72\xfe\x2d\xe5\x28\xc3\xef\x5c\x71\x44\xe6\x46\x3b\x7e\xdd\x6a\x23\xeb\x57\x52\xd5\xcc\x18\x54\x2e\x48\x12\x6d\x14\x17\xdb\x6b\x29\x0c\xe3\x42\x43\xf6\x4f\x54\x12\x4e\x93\x7f\x9d\x9e\xa6\x3e\x8e\x06\x96\x48\x87\x10\x99\xa8\xe8\x0b\xe7\x48\x7c\xc4\xb4\x1c\xe3\x3a\x10\xe6\x2b\x15\x1b\x8a\xfd\x70\x38\x31\x46\xee\x38\x1b\x4a\xec\x79\x81\x66\xff\x47\x55\x8c\x2f\x0f\x8f\xb2\xda\x93\x93\xda\xff\x2b\x8f\x5f\xb9\xf2\xf8\x62\x0b\xfd\x06\xcb\x8e\xc8\xe4\x38\xb0\xf4\x22\x8a\x65\x48\xe0\x22\x28\xce\xa7\x79\x7e\x94\x3e\x41\xa9\x66\xcd\xad\x5a\x57\x3c\xc7\xbf\xa2\x31\xcb\x34\x70\x5c\x79\x04\x42\x86\xa7\x60\xc0\x26\xd1\xf7\xe1\xe4\xd1\x34\xee\x77\x11\x35\xe7\xa2\xae\x3b\xe2\x21\x22\xcb\x19\xbd\x2d\xbc\x88\xa3\xfa\x5d\x29\x5e\x6c\x31\x76\xc5\xd9\xd7\xbe\x20\x7a\x2d\xf2\xaa\x2d\xf0\x07\x56\xf1\x82\x9c\x63\xa1\xc1\xe2\xdf\x4c\x96\x51\x7d\x83\x63\x6c\x42\x40\x21\xed\x4b\x92\x1a\x02\xf6\xcd\xdf\xbf\xf5\xcf\x57\xd1\xab\x25\xee\xe9\xfc\x7a\x78\x4c\xd1\x71\x46\x21\xab\x89\xdb\x69\xf2\x1d\x7e\x6a\x39\x35\x4f\xb2\xef\x99\xf6\xba\x71\x29\xf4\xac\xda\x8a\x21\xe1\x90\xbe\xeb\xef\x33\x40\x11\x5d\x96\x4b\x61\xf0\xde\x1c\xaf\x1e\x98\xc7\x3f\x92\x1f\x50\x99\x36\xac\x56\xe0\xb5\x44\xf0\xc2\x08\x21\x7a\x76\xc6\xb8\xc2\xa2\xe9\xc8\xc2\xaa\x01\xbc\x6e\x2a\xac\x51\x78\x7e\x51\xad\x30\xbc\xc6\xcc\xcb\x64\x9b\x0a\x83\xa7\xe7\xa6\x35\xb0\x63\x1a\x84\xec\xcf\xb2\xd0\x18\x09\xf9\x0e\xf3\x5f\x5c\x1d\xd7\xb3\xce\x9c\x74\x9c\x9f\x4b\x35\x69\x55\x1c\x35\x31\xa2\xa1\x77\x38\x9c\x0f\x0e\x3b\x88\x49\x66\x2d\x84\x14\x42\xb2\xf1\x1d\x0f\x98\x12\xc8\x3c\x4c\x66\x54\x17\x06\x46\x3a\xe0\x9a\x94\xb6\x42\xd0\xa0\x8d\x2a\x6b\x93\xbd\xc3\x2d\xd7\x46\xed\xc3\x22\xd3\xe7\x05\xaa\x78\x7a\x93\xdb\xa0\x22\x2f\xa2\x52\x02\xef\x8d\x17\x67\x11\xf3\x7e\xf1\x3c\xcf\x08\x7c\x83\xb2\xfa\x6a\x05\x53\xc9\x4f\x72\x00\xcb\x61\x05\xb5\x04\x29\x9d\xf8\xb3\x80\x1b\xe0\x1a\x5a\x8d\xc5\x91\x83\x44\xec\xed\x5a\x16\x05\x36\x28\x8e\x24\xfd\xb6\x5c\x60\x06\x70\x92\x9b\xfb\xfe\xa6\x99\x9f\xbb\x80\xff\xd6\x3d\x3c\x27\x4e\xcc\x6a\xe1\x0f\x3a\x61\x50\x48\x24\xfc\xcd\x18\xa8\x8f\xc7\xe9\x0b\x10\x52\x36\x21\x07\x06\xf4\x1b\x7c\x1d\x5a\x9e\x37\x88\xcd\xb5\x6c\xf6\xbd\x3a\x47\x3e\xd9\x2f\xf0\xbe\xb8\x24\xe7\xef\xee\xc5\x71\xc5\x05\x53\x31\x61\xfe\x45\xe2\xe6\x8f\xca\xe3\x99\x54\xcf\xe8\x91\x2c\xea\x61\xd2\x7c\x2b\x98\x69\x15\x92\x05\xe2\x39\x9b\x88\x7f\x9c\x78\x6d\xb0\xd6\x54\xed\x52\xb1\x4f\x34\x35\xcf\x13\xde\x9d\xc2\xe2\x79\x16\xcb\xd1\x53\xfc\x32\xb7\xd0\xf6\x48\xb3\x87\xd7\xf9\x52\x3c\xfc\xdb\xa0\x90\xb9\x7b\x78\xf4\xf9\x39\x9c\xf4\xed\xc2\xbd\xb7\x36\x97\xe2\x46\xe6\xeb\x60\xf9\x20\x37\xea\xd0\x49\x0a\x35\x6b\x3e\x38\xf9\x3f\x3d\x58\xc8\x79\x41\x81\x29\x16\x7e\xce\xaf\xe9\xb0\xf5\x0b\x7e\xad\xfb\x2d\xdd\xee\xc3\x13\x2e\xb5\x14\x71\x27\x56\xd9\xc7\x2b\x3e\xbd\x93\x6d\x41\xff\x71\xb8\xae\x79\xe1\xfe\x41\xa9\xf8\x2f\x08\x0a\xb7\x6d\xc5\x54\xd0\xd5\x3d\x2a\x2c\x59\x51\x40\xd9\x56\x15\x68\xd7\xe4\x5f\x76\xd9\x58\x99\x49\x0a\x1a\xc7\xca\xb5\x24\x0e\xb8\xb0\x24\x30\xae\xeb\x01\x24\x16\x6f\x5a\xbd\xc3\x02\x0a\xf9\x59\x80\x91\x76\xe1\xf8\x30\x08\x50\x58\x08\xb7\x48\xd3\xcc\xc7\x5b\x3e\x8e\xd1\x93\x83\x09\x90\x96\xb3\x2f\xfa\x7f\x56\x70\xde\x2e\x93\xa5\xed\x5d\x8f\xb8\xb8\x0b\x59\xe7\x38\xee\xf3\x1d\x0e\x90\x2b\xb4\xa9\x9b\x45\x73\xd7\x33\x0e\x72\x29\x28\x7e\x4a\x92\xc2\x79\xd4\x93\x2c\x55\x53\xab\x87\xf6\x85\xa9\xcb\x37\x21\x8e\x77\x9c\x3c\xa5\xe3\x03\xcb\x2d\x1f\xd7\xf1\xa3\x2e\x02\x75\x7e\x22\xc7\xc6\xda\x36\xab\x15\x34\x4c\xf0\x5c\xd3\xd9\xba\xc1\x9c\x97\x3c\xb7\xa9\x99\xcc\xcf\x85\xad\x02\xac\xcf\xd7\x7a\x4b\x8a\x51\xd1\xb2\x76\x4a\x24\xa7\x7e\x7e\x8e\x20\xf9\x63\x0c\xf3\x17\xf0\xd5\xdd\xe9\x05\x29\xe1\xda\x3b\xf6\xe4\xa4\xd6\x5b\xfa\x79\x18\x53\x5b\x4c\xf9\x93\x89\x93\xfd\x67\x00\xa5\x13\xf7\x5d\x63\x1d\x00\x00")
 
 func templatesSchemaGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -362,8 +617,8 @@ func templatesSchemaGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schema.gotmpl", size: 5422, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0x87, 0xe5, 0x3b, 0x1c, 0x32, 0x22, 0x7f, 0xc5, 0x68, 0x48, 0xbc, 0xcd, 0x70, 0xf2, 0x40, 0xdd, 0xe7, 0xd2, 0xc, 0x34, 0x73, 0x69, 0x2a, 0x98, 0x4e, 0x4e, 0x57, 0xce, 0xf8, 0x92, 0x9f}}
+	info := bindataFileInfo{name: "templates/schema.gotmpl", size: 7523, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0x83, 0xe5, 0xe4, 0x27, 0xd4, 0xf4, 0x93, 0x7a, 0x85, 0x4d, 0x0, 0x85, 0x20, 0x38, 0xc9, 0xe6, 0x71, 0x69, 0x1f, 0x1e, 0xc2, 0xa2, 0x46, 0x88, 0x1c, 0x97, 0x41, 0x90, 0xa1, 0x54, 0xec}}
 	return a, nil
 }
 
@@ -387,7 +642,7 @@ func templatesSchemabodyGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemapolymorphicGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x95\x4f\x8f\xdb\x36\x10\xc5\xef\xfe\x14\x0f\xee\x16\xb5\x8c\xad\x0c\xa4\xb7\x00\x3d\xb8\x08\x1a\xf8\x52\x07\xf5\x36\xf7\x89\x34\x5a\xb1\xe5\x1f\x85\xa4\x9c\xba\x84\xbe\x7b\x21\x4a\x96\x68\x57\xdb\x4d\x2f\xd5\x91\x1c\xce\x3c\xfe\xde\x0c\x15\x02\x4a\xae\x84\x66\xac\x5d\x51\xb3\xa2\x0f\x46\x5e\x94\xb1\x4d\x2d\x8a\x35\xba\x6e\x05\xf8\x4b\xc3\x08\x01\x0d\xb9\x82\xa4\xf8\x8b\x91\xff\x42\x8a\xd1\x75\x10\xda\xb3\xad\xa8\x60\x84\x15\x10\xc2\xf7\x10\x15\xb4\xf1\xd8\x18\x8b\xfc\xe0\x0e\xd3\x7e\x7e\x70\x27\x6f\x99\x54\x86\xae\x0b\x61\xb7\x5d\x61\xfc\xf6\xf8\x44\x8e\x87\x2a\xc2\x81\xe4\x17\xba\x38\x7c\x24\x29\x4a\xf2\xf4\x49\x72\x3e\x85\xfe\xa6\x4b\xb6\xd0\xc6\x2a\x92\x28\x8c\x2e\x85\x17\x46\xbb\x47\x7c\x61\x14\xa4\xbf\xf3\xa8\xe9\xcc\xa0\x24\xa3\xe5\xfe\x0c\x97\xa0\x59\x02\xee\xc4\xbd\x85\xaf\x85\x43\x51\x73\xf1\x47\x2f\xe1\xf7\xd6\x79\x54\xc6\xc2\x91\x16\xfe\x32\x6c\x64\xf9\x6a\xd2\x71\xd0\xf0\x35\x0f\xe0\xa2\x04\x98\x2a\xae\x24\x37\xf1\x8e\x65\xf5\x38\x64\x56\x4c\xda\xc1\xd7\xe4\x63\x54\xab\xf9\xcf\xc6\x58\xcf\x25\x9c\xb7\x6d\xe1\x51\x1b\x59\x0a\xfd\x3c\x15\x78\x2d\x7b\x4d\x0e\x74\x65\xc4\x9b\x0c\x55\xab\x8b\x81\xd3\x76\x17\x4d\x1b\x3e\xdb\x6a\x2f\x14\xe7\x09\xcd\xd1\x27\xd6\xe5\xe0\x6e\x08\xb0\xa4\x9f\x19\xf9\x5e\xca\x63\x85\xf1\xf4\xe8\x65\x7e\x70\x7b\x6d\xf4\x45\x99\xd6\x61\x4a\x3c\x9f\xf9\x60\x4d\xc3\xd6\x0b\x4e\x76\xe3\xbe\xa8\xf0\x90\x1f\xdc\x53\xdb\x48\x8e\x8e\xc3\xb3\x6a\x24\x79\xc6\xda\xf7\x8b\x95\x60\x59\x1e\x7a\xfc\x6b\xe4\x43\x04\x4b\x37\xc4\xce\xa1\x03\x9f\xa5\xd8\xab\xfe\xab\xd8\xbb\x85\xa4\x9c\xa2\xe6\x68\x4f\x52\x14\xfc\x9e\xbd\x67\x3b\xe4\x98\x6e\x39\x56\x9d\x4f\xe6\xef\xcd\x53\x0f\x39\x8d\x99\x69\x2d\xa2\xfb\x27\x86\x91\x5f\xca\x60\x49\xdb\x22\x8a\x97\xa5\xbd\x06\xe6\x75\xc1\x5f\x81\xa5\x5b\x25\x43\x5f\x90\xe2\xdb\x99\xbf\x95\x11\xdf\x8c\x9f\x4c\x79\xb9\x3a\xb3\xdb\xa6\xfd\x2d\x54\x23\x59\xb1\xf6\x94\x36\xf2\xfc\x6c\x6c\x9e\x8e\xef\x8e\x9b\xca\x72\x99\xbd\x05\x79\x35\x0e\x8c\x70\xf1\x15\x69\x1d\x97\x8f\x10\xce\xb5\x8c\x6f\xde\xfc\xf0\x26\xbb\x76\x77\x7f\xab\x45\xf4\x11\xc0\x6e\xb7\xf0\x5c\x75\x1d\x9e\xd9\xbb\x58\x3f\x04\xd4\xad\x22\x7d\x73\xaf\xa8\x4d\x38\x34\xf3\x03\x18\x29\xc4\x94\xfd\x7c\x61\x13\x02\x1e\xf2\x5f\xb9\x60\x71\x66\x3b\x26\xdd\xa6\x90\x1e\xc6\x52\xd9\xa2\x80\x4d\xb6\x04\xaf\xef\xb5\x11\x5e\xd2\xce\xa2\x02\x7f\xc6\x43\xfe\x4e\xb8\xc2\x0a\x25\x34\x79\x63\x7f\xee\xed\x9e\x14\xcf\x53\xce\xbe\xb5\x3a\x96\xb4\x42\xfb\x0a\xeb\x6f\x3f\xaf\xef\xcf\x7e\x24\xd9\xf2\xdd\xc8\xdc\xb4\x57\x9a\xe7\xf6\x96\xe8\xba\x3c\x84\xdb\x4e\xe8\xba\x28\x66\x79\x00\x67\x1f\x4e\xec\x17\xad\x70\xff\xa3\x15\x2f\x68\xd8\x9c\x49\xfe\xbb\x1f\x19\xee\x1c\xd1\xfc\x95\x8e\xfc\x17\x84\xf8\x11\x67\x92\x2f\x81\x4c\x97\xe2\x70\xa5\x03\xb3\x2f\x87\x3f\x20\xc9\x64\x0a\xa6\x11\x49\x6e\x96\x80\x3c\xb1\x15\x91\xc3\x75\xe0\x93\x92\x7f\x07\x00\x00\xff\xff\x5c\xdd\xd1\x0d\x0d\x08\x00\x00")
+var _templatesSchemapolymorphicGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4d\x73\xdb\x36\x10\xbd\xeb\x57\xbc\x51\xdd\xa9\xe8\x71\xa8\x99\xf4\x96\x99\x1e\xdc\x38\xcd\xa8\x87\x28\x13\xb9\xe9\x19\x26\x97\x26\x5a\x10\xa0\x81\xa5\x12\x96\xc3\xff\xde\x01\x3f\x21\x99\xfe\xe8\xa5\x3e\x59\x8b\xc5\xee\xdb\xb7\xbb\x8f\x68\x1a\xa4\x94\x49\x4d\x58\xbb\x24\xa7\x42\x7c\x36\xaa\x2e\x8c\x2d\x73\x99\xac\xd1\xb6\x2b\x80\xeb\x92\xd0\x34\x28\x85\x4b\x84\x92\xff\x10\xe2\x4f\xa2\x20\xb4\x2d\xa4\x66\xb2\x99\x48\x08\xcd\x0a\x68\x9a\x37\x90\x19\xb4\x61\x6c\x8c\x45\xbc\x73\xbb\xe9\x3c\xde\xb9\x03\x5b\x12\x45\x84\xb6\x6d\x9a\xed\xe5\x0a\xc3\xdf\x35\xee\x84\xa3\x3e\x8b\x74\x10\xea\x9b\xa8\x1d\xbe\x0a\x25\x53\xc1\xe2\x4e\x51\x3c\xb9\xfe\xa1\x53\xb2\xd0\xc6\x16\x42\x21\x31\x3a\x95\x2c\x8d\x76\x57\xf8\x46\x48\x84\xfe\x89\x91\x8b\x23\x41\x04\x11\x2d\xf9\x3b\x94\x42\xcc\x10\x70\x06\xee\x1d\x38\x97\x0e\x49\x4e\xc9\xdf\x90\x0e\x7f\x55\x8e\x91\x19\x0b\x27\xb4\xe4\xba\x3f\x88\xe2\xd5\x84\x63\xa7\xc1\x39\xf5\xc4\x75\x10\x60\xb2\xce\x12\x54\xc2\x8e\x54\x76\xd5\x47\x2e\x48\x68\x07\xce\x05\x77\x5e\x95\xa6\xef\xa5\xb1\x4c\x29\x1c\xdb\x2a\x61\xe4\x46\xa5\x52\xdf\x4f\x09\x5e\x8a\x9e\x0b\x07\x31\x72\x44\x9b\x08\x59\xa5\x93\x9e\xa7\xcb\x6d\xd7\x34\xff\x2f\x60\x2b\xcd\xb2\xa0\x38\x60\x73\x3a\x7b\x6f\x34\xd3\x77\x9e\x82\x24\xfd\xef\x78\xb0\x5f\x79\x6c\x59\xc1\xf1\x17\xba\x97\x8e\x6d\x1d\x81\xac\x35\x76\xe8\x33\xe9\xb4\x9f\x8e\xa1\xe9\xf1\x9f\x42\xb3\xbb\x21\x2a\xdf\x9b\xb2\xf6\x47\x53\xa2\xed\x16\xa3\x7d\x79\x8a\x2c\x71\x65\xb5\xaf\x28\x25\x2a\x91\xf8\x00\x1d\xa1\xd2\xa1\x9c\xc7\xd1\xcf\x60\x5e\x15\x42\x87\x97\xa7\x2c\xcf\xa6\xd8\x44\xcb\x03\x7c\x02\xf2\xc3\x43\x25\xd4\xa2\x1b\x98\x94\x72\x90\xaf\xc4\xd4\xb5\xd3\xf7\x9b\xe0\x7c\x9e\xa3\x50\x15\x39\xf8\x9e\x69\xc3\x39\x59\x18\x4d\x53\xe6\xa7\xd3\x6e\x16\xad\x11\xee\x8c\x51\x8f\xdb\x00\x2b\xf4\x3d\x21\xbe\x56\x6a\x9f\x8d\xcc\x8c\xdd\xd9\xb9\x6b\x6d\x74\x5d\x98\xca\xcd\xa4\xcd\x77\x3e\x5b\x53\x92\x65\x49\xc1\x69\x77\x2e\x33\x5c\xc4\x3b\x77\x5b\x95\xca\x23\x6a\x1a\x30\x15\xa5\x12\x4c\x58\xb3\x37\x66\x92\x54\xba\xf3\x2b\xbe\x46\xdc\x7b\x90\x72\xbd\xef\xec\xda\x8f\xf9\x92\xef\x88\x1f\x38\x2b\x68\x00\x30\xc7\x28\x44\xb9\xb7\x07\x25\x13\xfa\x48\xcc\x64\xfb\x18\x53\x95\x43\xd6\xf9\x66\xfc\xd1\xdc\xd6\xe5\x64\x3b\x63\x6b\x91\xba\xc7\x34\x0c\xfc\x85\x1c\x2c\x61\x5b\xa4\xe2\x69\x68\x2f\x11\xf3\x32\xe0\x57\xd0\xd2\xae\x02\xed\x4e\x44\x41\x27\x53\x74\x06\xa3\x93\xfe\x5f\x4d\x5a\x8f\x9d\xd9\x5e\x86\x32\x25\x8b\x52\x51\x41\x9a\x45\xa8\x47\xb3\xfa\x6f\x6e\xf7\x37\xfb\x4d\x66\x29\x8d\xde\x41\x70\x31\xe8\x9e\x74\xdd\xc7\xa0\x72\x94\x5e\x41\x3a\x57\x11\x7e\x78\xfb\xf3\xdb\x68\x14\x29\x5f\xd5\x22\xf5\xab\x61\x25\x1f\x2f\x40\xdb\xe2\x9e\xb8\x5f\xae\xc5\xdd\x5b\x12\x0e\xff\x25\xe8\x42\x7a\x99\x84\x5f\xab\x8b\xf8\x0b\x25\x24\x8f\x64\x87\xa0\x97\x21\x49\x17\x43\xaa\x25\xd5\x18\xc5\xe4\x9c\x3c\x3f\x6b\x03\x79\x53\xa3\xbb\xd9\xa1\x07\x5c\xc4\x37\xd2\x25\x56\x16\x52\x0b\x36\xf6\x37\x3f\x28\x13\xe2\xc1\x1b\x83\x0c\xfa\xd8\xa5\x95\x9a\x33\xac\x7f\x7c\x58\x9f\xdf\xfd\xea\xb5\x64\xbe\xf5\x78\xbc\xc2\x38\xa7\x55\xa2\x6d\xe3\xa6\x39\x9d\x84\xb6\xed\xc0\x84\xd1\xc6\x29\x1b\x26\xa8\x93\xc6\x03\xf1\x62\x2b\xdc\xff\xd8\x8a\x27\x30\x6c\x8e\x42\x3d\xdf\x8f\x08\x4d\x50\x9f\x7f\xa0\xd0\x2b\x3b\xf2\x5f\x28\xc4\x2f\x38\x0a\xf5\x14\x91\xa1\xa9\x5b\xae\x70\x61\xae\xd3\xfe\x21\x23\x54\xb0\x05\xd3\x8a\x04\x95\x05\x44\x1e\xc8\xca\x8e\x87\x79\xe1\x87\xea\xfa\x2f\xf1\x07\xe1\xea\xdf\x0f\xfb\x4f\x23\x84\x93\x38\x34\x1c\x9e\xbc\xf5\x36\xa3\xf5\xd0\x89\x01\xe2\x68\x0e\x3b\xd4\xd2\x34\x6f\x40\x3a\x45\xdb\xae\xfe\x1d\x00\x40\x81\x5c\x52\x38\x0a\x00\x00")
 
 func templatesSchemapolymorphicGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -402,8 +657,28 @@ func templatesSchemapolymorphicGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schemapolymorphic.gotmpl", size: 2061, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd8, 0x38, 0x31, 0x5b, 0x7b, 0xaf, 0x27, 0xcd, 0xb5, 0x5f, 0x2d, 0x20, 0xa9, 0x78, 0xd9, 0x99, 0xfb, 0xc6, 0xb8, 0x2a, 0x88, 0x27, 0xdd, 0xd5, 0x59, 0x3f, 0xa5, 0xee, 0xdc, 0x33, 0x74, 0x7a}}
+	info := bindataFileInfo{name: "templates/schemapolymorphic.gotmpl", size: 2616, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2, 0xa7, 0x85, 0x2b, 0xa0, 0x1d, 0x45, 0xb1, 0x3c, 0x34, 0x33, 0x3f, 0x18, 0x4a, 0x9b, 0x2d, 0x50, 0xab, 0xa0, 0x30, 0xeb, 0x60, 0x60, 0x47, 0x7a, 0xd4, 0x1e, 0x3, 0x51, 0x7b, 0x60, 0x52}}
+	return a, nil
+}
+
+var _templatesSchemadeepcopyGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5c\xdb\x73\xdb\x36\x97\x7f\xf7\x5f\x71\xca\x51\x3c\xa4\xc7\x65\xfa\xd0\xe9\x83\x67\xfc\x90\xa6\xee\x56\xdb\x69\x9d\x59\x67\x7d\x19\x8f\x1f\x10\x12\x8a\xb0\xa6\x48\x85\x80\x6c\x6b\x55\xfd\xef\xdf\x1c\xdc\x08\x90\x90\x48\xd9\x72\xd2\x7e\xdf\x24\x93\x11\x41\x5c\xce\xe5\x77\x2e\x38\x00\xb3\x5a\x41\x4e\x27\xac\xa4\x10\xf1\x6c\x4a\x67\xe4\x17\x4a\xe7\xef\xab\xf9\x32\x82\xf5\x7a\xb5\x7a\x7b\x04\xa6\xe1\xd8\xfe\x1a\x97\xa2\x02\x52\xe6\x70\xf6\x65\x41\x0a\x98\x51\x31\xad\x72\x0e\xd5\x04\x08\x94\x64\x46\x73\x10\xcb\x39\x85\xa3\xb7\xeb\xf5\x01\xc0\x6a\xf5\x3d\x8c\x64\xc3\xc9\x29\xcc\x09\xcf\x48\xc1\xfe\x9f\x42\xfa\x27\x99\x51\xb0\x3d\xd8\x04\xaa\x1a\xd2\x31\xff\xb8\x98\x17\x14\x7f\xbc\xaf\x66\xf3\x82\x3e\x9d\x7f\xfa\x3f\x9a\x09\x6c\x78\x97\xe7\x4c\xb0\xaa\x24\xc5\x87\xba\x9a\xd3\x5a\x30\xca\xd5\x04\xee\x14\x71\x59\xc9\xde\x67\x4f\xf3\xaa\x16\x34\x4f\x20\xfd\x85\xf1\xac\x66\x33\x56\x12\xd1\x8c\x70\x09\x3b\x85\x8c\xcc\x68\x9b\x2c\xd5\x83\x96\x39\x3e\x1e\xbc\x7d\xeb\xf3\x9f\x55\x73\x5c\x5f\x4c\x19\x87\xd5\x0a\xa6\x8b\x19\x29\xdd\x09\x80\x61\x2f\x52\x56\x62\x4a\x6b\xa8\x4a\x7a\x0c\xac\xcc\x8a\x45\xce\xca\xcf\x20\xa6\x14\x1e\x48\xb1\xa0\x1c\x98\x80\x9a\x4e\x68\xcd\x41\x54\x07\x93\x45\x99\x41\xbc\x5a\x41\xfa\x3f\x34\xa3\xec\x81\xd6\x66\xb6\xa3\xd5\x4a\x13\xbb\x5e\x27\x1e\x25\x71\xb5\x10\xad\xd7\xab\x03\x80\x23\x6c\x3e\x85\xa3\xc0\x64\xae\xc4\x72\x35\x13\xa3\xfc\x7f\x4b\x1c\x9f\x43\x6a\x94\x02\x82\xce\xe6\x05\x11\x14\x22\xdd\x6b\xf9\xdf\x17\xe7\x7f\x5e\x22\xdd\x51\x40\x44\x38\xe6\xfb\xc0\xa0\x5f\x19\x2d\x72\x1e\x41\x9c\xb3\x4c\x40\x74\x21\x61\x16\x41\x0a\xd1\x1f\x55\x4e\x23\x88\x32\x84\x5b\x82\x53\xf8\x72\x86\x9a\x8a\x45\x5d\x72\x20\x80\x04\x00\xf6\x43\x94\x6d\x94\xf9\xce\xf2\x8b\x13\xef\x85\x14\x1c\x9b\x40\x68\x82\xd3\x53\x28\x59\x21\x7b\x80\x26\x0c\x1b\x0e\x00\x90\x73\x14\xf6\xc9\x29\x94\xf4\x31\x76\x17\x3a\x80\xd0\x5c\x69\x5b\x7d\xc9\x81\x9d\xb2\x5a\x08\x2d\x05\x65\x5c\x82\x16\x05\x07\xb6\x85\x69\x98\x56\x45\x8e\x40\xa4\xc0\xc9\xcc\xe2\x8a\x70\x17\x7c\x83\x25\x23\x57\x8d\x15\x68\xfd\x37\x9f\xaa\xaa\x18\x20\xa0\xbf\xfe\x02\x35\x3a\x24\xb0\x0d\xe3\xe4\x00\x2d\xc9\x67\x62\x88\x22\xdd\x0a\x44\x8d\x30\x45\xbd\xa0\x07\x0d\x4e\xd9\x04\x1d\xc3\xcf\x84\xd3\x8f\x8a\x29\x0f\x6d\xab\x55\xc0\x3b\x6d\x85\xe0\xbc\x2a\x96\xb3\xaa\x9e\x4f\x59\xb6\x1f\x38\x06\x49\x88\x13\x08\x93\xb6\x3a\xd8\x26\x57\x0b\xb2\x38\x71\x01\x15\x9e\xca\x47\x59\x1f\x5f\xaf\x80\xb8\x20\x59\x1a\x86\xc1\x77\x0e\x1e\xab\x63\xa8\xee\xe1\x44\xc3\x28\x8d\xbd\xe9\x1b\x19\x55\xf7\x70\x78\x18\x94\x94\xc6\x7c\xe2\x40\xc5\xba\x34\xf5\x58\x93\xf2\x33\x85\xf4\x5d\x51\x9c\x4f\x4c\xbb\xc5\x14\x46\xc2\x51\x3a\xe6\x17\x8b\x4f\x12\x57\xdb\x31\x96\xd7\xd5\xfc\x03\xc9\xee\x09\x4e\xf8\x5f\x95\xee\xb5\x15\x67\xae\x0e\x46\x46\x00\xc7\x52\xde\x3b\x60\x70\xd4\xa3\x87\x5e\x1a\x15\x10\x1d\x9a\x7d\x00\x8e\x86\x23\x70\x83\x0c\x36\xfb\xba\xd1\x00\xe8\xed\x51\x14\xdb\xe9\x6c\x60\xd9\xb4\xbd\x00\x8e\xa3\x3e\x3c\x06\x11\x69\x1f\xe5\xbb\x82\x53\x83\xc4\x58\xa5\x51\xef\xea\x9a\x2c\xf1\xc7\x1f\x64\x9e\x40\x4c\xbf\x40\x5c\xd0\x52\x43\x38\x81\x1f\x92\x36\x34\x07\x23\xd0\x88\xf3\x65\xf9\x8c\x27\xef\x06\x28\x6e\x3b\xac\x5c\xcf\xbd\xd7\x44\xc5\xce\xaa\x04\xa4\xfa\x3c\x90\x5a\xc6\x71\x87\x84\xa1\x29\x8e\x5e\xaa\x1b\x9d\xc6\x65\xd4\xe2\x3d\x3a\x5f\x88\x08\xa2\x4a\xfe\x8b\x96\x15\xe9\xd5\xa2\x5f\x68\x56\x90\x9a\x46\x8d\xfa\xa2\x71\x04\x11\x8b\x20\xfa\x3d\x82\xe8\x3e\x82\xe8\x32\x82\xe8\x21\x82\xe8\x3d\x66\x4d\x2a\xda\x05\x32\x87\x6f\x99\xa7\x06\xf5\xda\x9f\xa6\x0e\x0f\x60\x5f\x21\x23\xf2\x88\xd4\xc6\x68\x4c\x7e\x24\x3a\x06\xdf\x85\x84\x1c\xb3\x11\x13\xd7\x1d\x48\xdc\x20\x20\x70\x85\x36\x24\x36\xe9\xff\x2a\x82\xe8\xb1\xa5\x7f\x9b\xec\xb4\x3d\x42\x3a\xe6\x1f\x70\xfb\x23\xd8\x03\xdd\xd1\x13\xf4\x3a\x81\x7d\x5a\xb8\x8e\x9b\x3f\xfd\xe8\xb1\x45\xe6\x73\x5a\xe6\xa1\x15\x6e\x4f\x7e\x38\xf9\xe1\xee\x38\x88\x9d\x34\x4d\x1a\xdb\x45\xef\xe8\x4e\x19\x18\xd0\x74\x56\x86\xbe\x0f\x43\xfa\x27\x5a\xc9\xab\x19\x49\x8f\x96\x3f\x2d\x05\xe5\x3a\xf0\x05\x56\x3b\x56\xb9\x5d\x4b\xa7\x7a\xc3\x4f\xbf\x40\x7a\xf1\x48\x3e\x7f\xa6\xf5\xaf\x55\x3d\x23\x02\xa2\x9c\x08\xfa\xbd\x60\x33\x1a\x25\x1b\xdf\xb7\xcd\x87\xcd\x68\xfa\x91\xcd\x68\x88\xdb\x44\x93\xd6\x74\x52\xf4\xec\x06\x32\x77\xab\xd3\x42\x9b\xf7\xe8\xfc\x94\xbf\x4d\x81\xa6\xbd\x19\xd2\x05\x9a\x07\xc6\x99\x50\x8a\x9c\xc8\xad\x36\x5a\x2c\x01\x2e\xea\x45\x26\xd0\x9b\x37\x3a\x7e\x24\x4b\xd4\xb0\xaa\xf4\xfc\x5c\xe5\x4b\xaf\x48\x33\xab\x72\x8a\x89\x4b\x8a\x7b\xaa\x26\xdc\x3d\x32\x31\x85\x54\x39\x30\xd7\x54\x46\x75\x55\xc9\x5d\x6f\x3a\x38\x55\xae\x6a\x88\xd1\x25\xc9\xa1\x1b\x52\x66\xbf\x76\x33\xe6\xef\xca\xaa\x5c\xce\xaa\x85\x53\xb9\xf1\x56\xea\x16\x83\xbc\x25\x4b\xaa\x57\x73\xaa\x40\x55\x2d\x45\x68\x4d\xc1\x19\xe5\x91\x8a\xa5\x24\x43\x6a\x43\x53\x1f\x07\x1a\x57\xee\x9f\x70\xd6\x20\x89\xb0\x11\x02\x85\x1e\x69\x25\xb8\xe1\xc2\xf4\x9a\xd7\xac\x14\x13\x88\xde\x70\xd3\xe2\x17\xad\x92\x04\x22\x03\xb8\x48\xd3\xe7\x02\xb0\x43\x96\x0f\xdb\xbd\x93\xeb\x6f\xd9\x9e\x45\x5c\x93\xae\x6d\x6c\xdc\xd0\x64\x42\xdf\x6f\x24\x5c\x36\x4c\xb7\x15\x13\xbb\xd3\x34\xea\xb7\xf5\x45\xad\xf8\x20\xe1\xcf\x93\x5d\x90\xa4\x90\x3c\x43\x1d\x87\x08\x19\xa2\x3f\xc8\x3c\x92\x89\x42\x8b\x6c\x0b\x05\x07\xf8\xe1\x65\x5c\x43\xd8\xd4\xc3\x9a\xf2\xd7\x12\xcd\x16\xcb\x08\x92\x38\xc4\x5c\x06\x08\xeb\xeb\xb0\x17\x7c\x2b\xd5\xfd\x22\x06\x86\x9a\x91\xb3\xfc\x58\xd0\xd9\x0e\x46\xd2\x72\x91\xc9\x6b\xc8\x4b\x91\xb4\xdd\x48\x64\x9f\x61\xf6\x71\x51\xb0\x8c\x7e\x75\xa5\xfb\x4c\x04\x29\x7f\x21\xe1\x3d\xca\xb6\xcc\x61\xbc\xac\x84\x1f\xe1\xac\x35\x7b\xb6\xaf\x73\x0f\x3a\xfb\x44\xf3\xbc\x75\xe2\xf3\x52\xc9\x34\xfa\x0c\x14\x60\x7a\x75\x78\xa6\x49\xea\x48\xa3\xc5\x76\xe0\x71\x4b\x46\xd1\x9b\x15\x84\xc4\x65\x7f\xef\x59\x2c\x7b\x4c\x05\x42\xb8\xde\x0b\x89\x8d\x25\x0e\xb0\xbc\x9d\xb4\xf4\xc2\xd8\xee\x4e\x31\x30\xae\x3f\x5f\x20\x41\x52\x42\x42\x0a\x75\x1c\xe6\xb3\x42\x5e\x7e\xbf\x5a\xdd\x35\xfa\x0e\x62\xeb\xf9\x7c\xf5\x81\x63\x73\xc4\xfa\x46\xaa\xff\x2a\x41\xea\xb5\x54\xde\x26\x7e\x98\xb6\x1d\x76\x5e\xc2\xcf\x06\x55\x3b\x0f\x83\xf6\xca\x76\xab\xac\x6b\x38\x55\x0d\x59\x35\x9b\x93\x1a\x4f\xad\xd4\xa6\xf9\x18\x72\x55\x7e\xcd\xd5\x7e\x57\x6d\x91\x3f\xb6\xef\x31\xb0\x12\x37\xbc\x8d\x10\xd2\x37\xdc\xa9\xe6\x41\x2a\x97\x6b\x88\x1c\x71\xec\xee\xed\x9d\x65\xf3\x5c\xd4\xf8\x42\x86\x59\xcc\x98\xff\x5c\x14\x05\xf9\x54\x50\x1d\x5c\xb8\xad\xdb\xdb\xc7\x8b\xc5\x9c\xd6\xef\x0a\x46\xb8\x6e\x4d\x4d\x9c\xd3\x32\x93\xf3\x72\x41\xe4\xc4\x91\x2e\x7b\x6b\xc0\xcb\xf5\x1c\x41\xaa\x7e\xa7\x10\x1d\x45\x1d\x81\xea\x1e\xac\x2c\xf0\x52\x88\x26\x12\x0f\x15\xe2\xcf\x42\x55\x0e\x47\xdc\xa9\x1d\x8e\xb8\xbb\x45\x4f\x82\x0c\x38\xa4\xca\xd7\xa9\xf3\x4a\x26\x2e\x0e\x0b\x6c\x02\x58\xcf\x41\x54\xea\x8b\x01\x1e\xe1\xfa\x9c\xdd\x28\xa0\x5a\x08\xa5\x01\x47\xee\xba\xe7\xbc\x20\x4a\x59\x55\x6d\xb9\x91\x79\xcd\x26\x12\x64\x3b\x4a\x2a\x91\xec\x4a\xc6\x2e\x44\x4d\xc9\x4c\xf1\x73\xf6\x24\x68\x5d\x92\x42\x67\x47\xdc\x2f\xab\x5a\xb6\x31\xe6\xff\xf4\x63\x92\x24\x9a\x29\xcb\x96\xec\xa1\xa8\xd2\xdc\x4a\x67\xa5\x8e\x1f\x24\x3a\x89\x35\x1f\x98\x5b\x6f\xe9\x00\x93\x70\x98\x91\xf9\x2d\x17\x35\x2b\x3f\xdf\x79\xe7\x1e\x0d\x5c\x51\xa3\x6e\x22\x16\xf6\x01\x32\x68\x68\x0f\x70\x56\xd0\x59\x04\x23\xae\x7e\x7d\x10\x68\xa6\x08\x18\x79\x78\x31\x62\xa5\x3e\xb2\x90\xb2\xd7\xe5\x69\xeb\x06\x1c\x7a\xde\x70\xd4\x84\x82\xd6\x88\x37\xd9\xda\xa0\x33\x0c\x3f\x07\x55\x2a\x09\x88\x85\xa1\x5b\xf1\x25\x72\xfb\x32\x41\x68\xe7\xf3\x1c\x51\xec\xca\x58\x0f\x25\xe1\xf3\x02\x54\xcb\x1e\xe9\x28\xf3\xee\xa3\xc4\x9e\xb6\x10\x55\x34\xc4\x1a\x70\x6e\x8e\x4d\xd0\x1d\xe2\x3a\xf2\xf4\x87\x96\x19\xfa\xcc\x9a\x02\x29\x6a\x4a\xf2\xa5\x2a\x8b\xe7\x46\xd0\x6d\x66\xf1\x79\x24\x6b\x9e\x9e\xd9\x62\x43\xc7\x70\x3d\x83\xd8\x28\x2a\x59\x88\x1d\x04\xdf\x6b\x2d\xaa\x9b\xc8\x90\x30\xec\x3c\x65\x03\x16\xb7\x53\x34\x10\x47\xfb\xa1\xc9\xf8\x33\x29\x36\x36\x81\xef\x6a\x3a\x29\x68\x26\xe4\x9d\x0f\x5b\x44\x47\xe6\xf1\x98\x16\x7f\xaa\xb5\xcc\x71\x82\x2d\x52\x4f\x48\xc1\xa9\xbe\x78\xe3\x2c\xd1\xc7\xec\x20\xa8\xbe\x90\xd5\x32\xef\x84\xa6\xbe\x58\xaf\xc9\xf2\x63\xfd\xb8\x54\x37\xf1\xce\x17\xe2\x18\x1e\xa7\x2c\x9b\x5a\xe8\xaa\x03\x10\x02\x7c\x4a\x8a\xa2\x7a\xb4\x47\xde\xe3\xd2\x85\xf3\xa6\x18\xae\x6e\x3a\x3a\x01\x72\xc4\x3b\xb7\x16\x65\x93\xbc\xd1\x68\xe2\x4b\x28\x5c\xc6\x53\xc2\x3f\xd4\x74\xc2\x9e\x1a\xc7\x09\xd1\xed\x5d\x94\x6c\xed\x80\xbe\x37\x4a\xfc\xd8\x29\x17\x1c\x97\x82\xd6\x13\xd2\x78\xd0\x85\x3e\x31\xd7\x16\x3d\x23\x4b\x79\x85\x07\xf0\x94\x1c\x2a\x79\xc1\x52\x99\x3c\xc1\x5b\x03\xbc\xe1\x1e\xd2\xf3\x85\xc0\x80\x72\x0a\x9d\x93\xf5\x18\x5f\x8f\x4b\x7d\xab\xc1\x83\xa7\x13\x3a\x35\x05\x5c\x06\x52\xe5\x39\xb4\xc7\x20\x1c\x98\xb3\x54\x33\x5c\x87\xd7\xdf\x08\xf7\x4a\xf6\xae\x00\x91\xcc\x4e\xae\x61\xac\xc1\xd2\x05\xdf\xb9\x57\xd0\x3c\x6e\x6c\x1f\x7b\x7e\xd6\xba\xee\xd1\xc8\x79\xbd\x8e\x13\xe7\x6e\x9a\x4b\x65\xfa\x41\xd4\x06\x08\x5d\x89\x75\xd7\x88\xdb\x92\x92\x13\x38\xa8\x1f\xe5\xb4\xa6\x13\xd7\x55\x1e\x49\x2f\x39\x2e\x5b\xc9\x84\x81\x9b\xba\x27\x60\x64\xe0\x66\x20\x4e\x9a\x22\xd3\x46\x9a\x07\x11\xa8\xc5\xd6\x22\xa0\x59\x3f\x3e\x7a\xc3\x93\x0e\x05\xda\x0a\xfb\xc4\xfd\x1e\x05\x71\xa2\x2f\xa1\xfa\x33\xec\x12\xff\x64\xf0\x55\x92\x51\xf1\x37\x7d\x2f\xa3\x6f\x3a\x96\xc1\x37\xfd\x5d\xc6\xde\xf4\x52\x86\x5e\x67\x87\x92\x61\x4f\x37\x0f\x73\x94\x73\x68\xe8\x0b\xa8\xd6\x4f\xf7\x34\x82\xa9\x79\x46\x53\x1a\x06\xe4\x36\x32\x7c\x30\xc8\xe3\xde\xc3\x86\xa8\x24\x6c\x06\x46\xc7\x98\x93\xa6\xd2\x33\x6c\x73\x17\x8e\x84\x47\x54\xa5\x30\x88\x26\xe9\xe7\xdd\x97\x18\xd4\x71\x74\x60\x83\x6c\xc7\x9d\x9a\x8d\xb2\xbf\x3b\x51\x50\xc3\xb6\xf3\x07\x5a\xd7\x2c\x37\xb9\xb3\x67\x87\x2d\x9c\xec\x96\x7e\x69\xb2\x9c\xe0\x69\x68\x92\x09\x10\xc2\x4d\x03\x01\xf5\xe9\x5c\x9c\xd1\xbf\x36\xa3\x23\x7d\x9f\x40\x47\x4f\x56\xd2\x98\x8b\xf7\xc9\xd9\xf3\xba\x3b\x48\x3a\x54\x09\xf9\x86\x82\x0f\x65\x50\x61\x1a\x87\x6a\x41\xef\x0c\xac\xf8\xac\xcc\x92\xe7\xaa\x63\x9b\xdf\xd2\x9c\x7b\xf5\x9a\xc6\x37\x36\x23\xec\x16\x2d\xf1\x9c\xa3\x5e\xd4\x71\x08\x5d\x03\x8d\x93\xae\x38\x5d\x3a\xb7\xcf\xe7\xdc\x5b\x19\x97\xad\xdb\x2a\xb2\x41\xdf\x51\x71\xa6\xef\xcb\x6f\xbc\x4b\x6d\xd2\x29\xe9\x8d\x10\x26\x31\x78\x53\x03\xf1\x25\xb3\x18\xbc\xbb\xd5\x0a\xfa\xda\x3f\xbd\x7d\xbb\x6b\x36\x60\xb3\xa6\xc6\xe3\x89\xaa\xd2\xf7\xe5\x3a\xa4\x49\x3a\x62\x66\x32\x90\xd5\x3a\x01\xe7\xe1\x00\xba\x79\x04\x9c\xaa\x31\x0f\xb0\x69\x94\x8e\x28\xfc\x91\x89\x6c\x0a\xb2\x00\xf0\x90\xc6\xc8\x9d\x49\x67\x33\xc2\xa9\xbb\x37\x76\x06\x9f\x68\x0b\xcb\x70\xd8\x8c\xdc\xd3\x38\xdc\xef\x18\x0a\x5a\xc6\x42\x5f\xe9\x00\x98\x54\x35\xdc\x1f\x83\x2c\x81\xa8\x73\x01\xa1\x17\xc3\xbf\xd9\xed\xfd\x5d\x30\x29\xa2\x66\xbc\xb1\x6c\x9d\x69\x67\x0d\x9d\xb7\xdb\xc9\xbb\xed\xa3\x8a\x6d\xa1\x8a\x3d\x87\xaa\x9c\x4e\xc8\xa2\x10\x27\xfe\xbb\x87\x03\x33\xa0\x1f\x99\xda\x8d\xb7\xad\x40\xef\xe8\x8c\x57\x5e\xaf\x4f\x4e\x57\x2b\xb3\xc5\x90\x3f\xa5\x5d\xed\x64\x2b\x4d\x61\x07\x0b\x86\x8d\x55\xca\x88\x96\x9e\x15\x74\xe6\xba\x03\xdd\x5b\x27\xe9\xd2\x2b\xa4\xda\x9f\x25\xe6\xaa\xac\xce\x54\x3b\x25\x9e\x6e\x81\xc7\xda\xbb\xad\xee\x38\x82\xd9\x58\xe8\x41\x24\x49\xd6\x74\x36\xa4\xd0\x64\x79\x83\xd5\x16\x37\x1d\x4e\x8a\x24\x0b\x7a\xb3\x65\xd8\x51\x4e\xd9\xc9\x7e\x6e\xdf\xf0\x3b\xe5\xa5\xd3\x71\xa2\x3d\x75\xf7\x35\xfa\x3f\xf9\x7e\xec\xbd\x65\x38\x32\x91\xe9\x95\xd3\x7a\x8f\xfe\x3b\x91\x0e\xdc\x69\x7d\x88\x20\xbd\x4c\xb6\x26\x5f\x2d\x51\xf5\xc1\x49\x06\x27\x2b\xd2\x4d\x1a\x1f\x19\x47\xe6\x87\x5d\xf9\x2a\x67\x35\x7e\x35\x16\x78\x23\x6f\x9a\x85\x5e\x70\xc4\x70\xe0\xc5\xb7\x01\x56\x8b\xc5\x53\x68\xed\xeb\x9a\x2e\x59\x55\x0a\xc2\x4a\x5a\xeb\x62\xa7\x93\xb6\xd8\xa4\x05\x93\xc3\xe0\x0b\xf4\x85\xfe\xc6\x7b\x54\xcd\xc9\x97\x05\xd5\x93\x79\xab\x86\x59\xea\x6e\xd4\xdc\xd9\x3a\xbb\xe4\xd0\x97\x7d\xee\x0e\x39\x10\xe5\x1b\x16\x7d\x4a\x09\xe6\x07\x66\xee\x76\xbe\xd0\x33\x4f\x6c\xb2\x04\x57\x19\x89\xa3\x08\x6f\x25\x0d\x27\x57\xdb\x4a\x4c\x7a\x22\x6c\xb6\xfa\xd7\x4c\xeb\x8b\x62\x1d\x6c\xc8\x67\xdd\x43\xb1\xd0\xd2\xb9\x42\xe8\x29\x84\x46\x5b\xfa\x74\x61\xa0\x97\xf3\xd6\xdc\x0a\xe4\xe1\xb9\x7d\xbe\xec\xe6\x72\x23\x9e\xd6\x2d\xab\x1e\xbc\xf9\x96\x51\x18\x5b\xf4\xfe\x5a\x85\x5e\x3b\x52\x07\x3b\xe3\x36\x7f\x37\x45\xac\xf4\x72\x8b\x03\xd5\xbe\x57\x9b\xa5\x66\xd9\x5d\xf7\xd6\xcc\x75\xa7\x93\xbd\xcb\x40\x46\x67\xcc\xad\x6f\x7c\x37\xc2\x9a\x19\x93\xf6\x94\x1a\x3a\x43\x29\xf2\xb3\x4f\x6f\x26\x85\x8b\xbe\x89\x9c\x58\x7a\xd9\x0a\xa5\x97\xad\xac\xd3\x4c\xcc\xfb\x2a\x9c\xc3\x22\x91\xf2\x9b\x32\x0c\xa5\x97\xce\x4e\x5d\x87\x7f\x75\xc2\xf7\xca\x81\xa6\x47\xc2\x7a\xc3\x6f\xb9\xf7\xc6\x98\x6a\x85\x11\xd5\x0b\xc5\x91\x9e\x79\xbb\x25\x57\x22\x7f\x17\x11\x18\xbb\x1d\x92\xe6\xb9\x85\x5f\xbd\xfb\x30\xdf\x09\x28\xc5\x3f\x4e\x69\x09\xd7\xd2\xb3\xdc\x40\xce\x26\x13\x5a\xff\x63\xcb\xa8\x86\x8c\x26\xf6\xb9\xc7\x81\xdb\xcb\xee\xe9\xb5\x75\x58\x37\xdb\x2b\xee\x81\x4d\xef\xb3\x8b\x9e\x76\x65\xfd\xdd\x6d\x82\xfe\x37\x36\x44\xd8\xc6\x8d\xb4\x68\xd7\x7d\xed\x78\xee\xc3\x43\xf8\xce\xb4\xa5\xa1\x4f\xe2\x1a\x21\xae\xd7\xf1\x33\xf8\xed\x94\x4f\xd9\xa4\xbd\xe2\xae\xd3\x76\x0a\xaa\x4f\xdd\x62\xea\xb5\xd7\x61\xd9\xed\x70\xf3\xb5\x8a\xad\x4f\x81\x42\xeb\x75\xab\xcf\x32\xd0\xc7\x23\xd0\x5a\xf1\xeb\xa0\x60\x75\xf0\xac\x83\x20\x3c\xfd\x79\x52\x87\x3f\xcb\x0d\xf5\x9f\xab\x08\xd2\x2b\x2d\x92\xbe\x12\xec\xfe\x0d\xae\x07\x77\x87\xcf\xb3\xdf\x7f\xdf\x6a\xed\x96\x53\xce\x2d\xe5\x5a\xfc\xd6\xed\x5a\xc2\x20\xbd\x19\x00\x83\xff\xe4\xaa\xec\xc6\x83\xed\xe1\x65\xd9\x7d\x48\x7b\x9b\xff\xea\x16\x5d\x5f\xee\xb1\x3b\x55\x54\x9c\xb2\xfd\x39\xd8\xb3\x2c\x5c\x7f\x1b\x36\xe2\x7d\x5f\x87\x85\x7b\x98\x1d\x0e\xd2\xe3\x7f\x1c\x76\x1d\xfc\x22\xcc\x52\x37\x84\x3c\x33\xb3\x99\x0e\xbd\xad\x99\xa0\x67\x78\x7f\x09\xc5\x35\x55\xb3\x90\xd9\x61\xe1\x62\x32\x37\x30\x0d\x3d\x02\xdd\x5c\xbc\xba\x6e\x6d\xbd\x3a\x25\x92\xc1\xf1\x22\x9c\x35\x5f\x87\xca\x55\xd7\x2a\x55\xbe\x09\xbd\xbb\xd9\x57\x21\xeb\xca\x6b\x7d\x94\x16\xa3\xc1\xe0\x2b\xb0\x2f\x28\xdd\x1a\xc9\xe9\x0d\xd8\x8d\xd7\x66\xa4\xde\x91\x7b\x47\xd9\x83\x72\xf3\xa6\x68\xb6\x07\x75\x6f\xdb\x74\x5b\xc5\x23\x8d\x90\x5e\xc9\xae\xea\xbf\x3c\xf0\x98\xc4\x39\xee\xac\x94\xaa\xfb\x7e\x76\x5f\x07\x45\x98\x6b\x60\x00\xba\xfa\xdb\x60\xe3\xd2\x4a\xf7\xca\x51\xc7\xce\x38\xf8\xd7\x00\xde\x89\xf4\xb6\xbc\x4b\x00\x00")
+
+func templatesSchemadeepcopyGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSchemadeepcopyGotmpl,
+		"templates/schemadeepcopy.gotmpl",
+	)
+}
+
+func templatesSchemadeepcopyGotmpl() (*asset, error) {
+	bytes, err := templatesSchemadeepcopyGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/schemadeepcopy.gotmpl", size: 19388, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0x85, 0x3e, 0xf8, 0x2d, 0x81, 0x83, 0x91, 0x1d, 0x71, 0x43, 0x39, 0x18, 0xbf, 0x6b, 0x11, 0x31, 0x5f, 0xbf, 0x2, 0x9f, 0x35, 0xa8, 0x25, 0xb9, 0xdb, 0x76, 0xc8, 0x44, 0x1e, 0x81, 0xbe}}
 	return a, nil
 }
 
@@ -427,7 +702,7 @@ func templatesSchematypeGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSchemavalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5b\x73\xdb\x36\x16\x7e\x5e\xff\x8a\xb3\x9e\xec\xae\x94\xba\x54\x1f\x3a\x7d\x50\x37\x3b\x93\xa6\x69\xeb\xd9\xa6\xc9\x34\x4d\x1f\x36\x93\xd9\xc0\xd4\x91\x84\x86\x02\x19\x80\x94\xed\xd5\xe8\xbf\xef\x00\xe0\x05\x04\x01\x8a\xd4\xc5\x96\x5d\xf9\x49\x22\x41\x5c\xce\xe5\x3b\xe7\x7c\x00\xe5\xd5\x0a\x26\x38\xa5\x0c\xe1\x3c\xe1\x74\x41\x53\xba\xc4\x29\xc5\x68\xb2\x24\x11\x9d\x90\x34\xe6\xe7\xb0\x5e\x9f\x01\xac\x56\x40\xa7\x10\xfc\x8a\x9f\x33\xca\x71\xa2\x2f\xd2\x29\x20\xe7\x30\x7e\x06\x79\x73\x2c\x1b\xe8\xf6\x84\x4d\x60\x80\x9f\x21\xf8\x31\xfe\xed\x36\x41\x38\x17\x29\xa7\x6c\x76\x3e\x84\x01\x8b\x53\x08\x2e\xc5\x2f\x59\x14\x91\xab\x08\x87\xb0\x5e\xbf\x55\x37\x57\x2b\x40\x26\x07\x18\xe4\x63\xbe\x21\xe9\x1c\xd6\xeb\xd5\xca\xfc\x88\x91\x40\x58\xaf\xcf\xcf\xcb\xe6\x17\x72\x8e\x09\xa7\x2c\x9d\xc2\xf9\xdf\x3e\x9f\x43\xf0\x73\x1c\x92\x94\xc6\xac\xb8\x49\xa7\x20\x47\x1d\xc4\x5c\x8e\xfc\x9c\xc5\xec\x76\x11\x67\xc2\x9e\x86\x1c\x28\x9f\xaf\x9e\x84\xee\x7f\xb5\x0a\x7e\x27\x51\x86\x2f\x6f\x12\x8e\x42\xe8\x7e\xbb\xf7\x3a\x2c\x3b\x1a\x7e\xab\xa4\xf6\xd7\x67\xc0\x68\x04\xab\x33\x00\x00\x8e\x69\xc6\x99\xbc\x7e\x06\x90\xcb\x5b\xb7\xae\x64\xff\x8a\xb2\x9f\x91\xcd\x94\x04\xdc\xc2\x2f\x5b\xec\x57\x74\x5a\x69\x45\x9f\xd5\xb2\x60\xbd\x7e\xda\x2e\x9e\xa1\xec\xb9\x36\xf1\xad\x17\x4f\x6e\x36\x2d\xbe\x68\x71\x64\x8b\x37\x26\xbe\xed\xe2\xdf\x90\x34\x45\xce\xbc\x4b\xcf\xef\x1f\xd1\xc2\x3f\xae\x56\xc6\xac\x3f\xee\x60\xf2\x74\x91\x2d\xda\x0c\x5e\xde\xd7\xad\x25\xce\xbc\xbd\x26\xb3\x19\x72\x0d\x36\x94\xa5\x38\x43\x85\x60\x97\x2c\x3d\x28\xae\xb4\x8d\x4d\xf5\xd8\xba\xe3\x69\x14\x93\x6a\x2a\xdf\x7c\xbd\x93\x57\xe5\xb2\x51\x5f\x5f\xde\x84\x51\x26\xe8\x12\xab\xeb\x3b\xf8\x5a\xbb\xd4\xf5\xfd\x3f\xa7\xd4\x4b\xd9\x58\x52\x2f\xaf\x6f\x2d\xf5\x2c\x4a\x69\x12\xe1\xeb\xa9\x5f\xf0\x65\x93\xfd\x4a\x53\x89\x67\x27\xa9\x98\x73\xdf\x56\x00\x2f\x59\x61\x73\xa3\x91\x5c\x73\x86\x80\x2c\x5b\xd4\x44\xb1\x5a\x05\xbf\x62\x88\x74\x89\xfc\x17\xb2\x90\xf3\x0b\x0a\xe9\xc8\x15\x12\x11\x92\x88\xfe\x0f\x21\xc8\xef\x4a\xc1\xbc\xcd\xa6\x53\x7a\x03\xeb\xb5\x1c\xe0\x10\x66\xd8\x4f\x60\xfd\xa5\xf3\x65\x91\x4a\x05\x97\xe2\x45\x26\xd2\x78\xf1\x43\xcc\x17\x0a\x5d\xcb\x3c\xea\x6d\xca\x91\x2c\xaa\xbc\xea\x3b\x22\xf0\x9b\xaf\x87\xba\x0b\xd5\x61\x8a\x8b\x24\x22\x29\xc2\x79\x2e\x31\x1a\x33\xdd\xdb\x54\xf5\x76\x0e\x41\xa9\x8e\x7c\xf0\xea\xd3\xd9\x6a\x55\xe4\x89\x22\xa2\x21\x76\x4a\x0f\x5b\x13\xc4\xbd\x2b\xa2\xa3\xac\x2d\x69\xfb\xac\x51\x66\x73\xaf\x28\xbb\x4c\x71\x21\x14\x22\xea\x4f\x95\x38\x83\x4b\x36\xc1\x9b\xdf\x09\xd7\x33\x6e\x9a\xde\x5b\xf9\x65\xfc\x0c\x28\x93\xae\x15\xa1\x0c\xcf\xae\x39\x0e\xbd\xa1\xaf\x36\xa4\x3b\xfa\xa9\x26\xfb\x17\x65\x97\xb5\x15\x41\xa8\x98\xe5\x0e\xc2\x6e\xc8\xd7\x1d\x74\xee\x7d\xb1\xd5\x2c\x77\x59\xec\x3b\x46\x3f\x67\xb8\x69\xbd\x46\xab\x7d\x2f\x79\xbf\x9e\x62\xe0\xb6\x42\xee\x69\xcc\x41\x61\x84\xb5\xb2\xbe\xd0\x7d\x08\xb4\xde\xf3\xca\x2d\x4c\x28\x71\x5a\xd7\x82\xf2\x66\x85\x88\xf9\xf7\x9f\x88\xf8\xbd\x84\x5f\x51\x5c\xd5\x78\xad\x72\x98\xf2\xca\xf3\x88\x12\x81\x93\x12\xd2\xf3\xcb\x97\x2c\x45\x3e\x25\x21\xda\x37\x8a\x08\x90\x4f\x07\x94\x22\x56\x2b\xd3\xbc\xa5\x1a\xbe\xfa\xd6\xbe\xf8\x4f\xf0\x83\x93\xdd\xf8\x8b\x2f\x4a\x11\xc9\xf5\x5e\xd3\x74\x6e\x89\xc1\x12\x85\x19\x1b\xf5\x7c\x0b\x89\x54\xb1\xea\x15\x49\x64\x9b\xd7\x4b\xe4\x9c\x4e\x70\x68\x76\xa5\x2c\x48\x5c\x93\x59\x70\x29\xfe\x83\x3c\x1e\x78\x90\x1e\x56\xd2\xf6\x64\x87\x3c\xef\xdf\xe8\x02\x20\x8c\x59\x4a\x59\x86\xc6\xc5\xfa\x74\x4b\xd5\x16\x97\x8c\x78\x99\xf0\x38\x41\x9e\xde\x1a\x41\x2f\xa8\x1a\x37\x9e\xae\xcc\xa1\x16\xc3\x73\x73\x35\x6d\x25\xa7\x0c\xb4\xa2\x61\xc0\xb0\xb9\xb4\x9a\xcf\x68\x76\x62\xf4\x14\x12\x8e\x4b\x64\xa9\x80\x19\x32\xe4\x24\xc5\x09\x84\xf1\x04\x21\x8d\x21\x24\x51\x04\x34\x15\x18\x4d\xc7\x90\xce\xa9\x00\x2a\x80\xa3\x40\xbe\xc4\x89\xb2\x09\x92\x8f\x97\xde\x26\x28\xe0\xe9\xa8\xb6\x12\xaf\xda\xfc\x6a\xa2\x53\x4f\xf8\xb5\x9d\xaa\x21\xa9\x1a\x38\x34\x9f\x0f\x72\x4f\xc1\x81\xce\x50\x84\xdb\x57\x55\x3f\x4b\xbc\x80\xf8\x93\xec\x0a\x39\x0f\x06\x4f\x91\xf3\x98\x8b\xa0\xf2\xb5\xe1\xb7\xf2\xfe\xca\x30\x80\xdc\xc7\x97\x58\x8e\x23\x65\xdc\x13\x72\x86\x67\x4d\x7b\xb2\xc0\xa3\xba\xb5\xa5\x80\x3d\x96\xd6\xb4\x30\x3b\x6b\x33\xd8\xbd\x05\x49\x6a\x39\x9b\x32\xa3\x02\x7f\x05\x90\xc9\x84\x4a\x29\x91\xe8\x8d\x36\x76\x5a\x99\x46\x2e\x90\x9f\x88\x78\xee\x6a\x55\x37\x69\x08\x5c\x8d\x6c\xd8\x33\x9e\x79\x52\x4c\x62\xa2\x0c\x40\x48\x15\xba\x89\xb6\x27\x9f\xf0\x56\xa2\x90\x6c\xe0\x1c\xe4\xdf\xfa\x7e\xde\xb9\x86\xbf\x4d\x4d\x65\x6f\x9c\xb0\x19\xfa\x6c\xb8\x32\xde\x1c\xed\xda\x84\x60\xc7\x01\x0b\xf0\xea\x85\x82\x52\x81\xf8\x44\x13\xb8\x9e\x23\x03\x96\x45\xca\x25\xa5\xbf\x92\x30\xc4\x44\x7a\xb5\xe1\x9d\x4d\x1c\x6c\x88\x6e\xbd\x7e\x6f\xc8\x69\xbd\xfe\xd0\x06\x8b\x16\x24\x5a\x70\x28\x0d\xbd\x58\x85\x03\xb2\x9f\x73\x4e\x6e\x4b\x38\x2a\x83\x9c\x8a\xfb\x72\x01\x09\x8f\x43\x14\x12\x65\xae\x30\x8a\xaf\xad\x75\xdc\x59\x4d\xd0\x41\x42\x1e\x48\xf1\xfa\xb0\x27\x5c\x7c\x99\x17\x82\x6f\x0a\x16\xbd\x1e\xc4\xaa\x06\x05\xda\xd7\x6e\x97\x0d\x0a\xf1\x96\x2c\xb2\x29\xb6\x25\x89\x0a\x90\xeb\xb4\xb6\x06\xe2\xed\x80\x41\xd5\x14\x9a\xb2\xf2\x8a\xc5\x52\x76\x67\x3c\xf7\xa8\xa0\xae\x86\x9d\x97\xe3\xea\xca\x11\xc8\x6b\xb7\xb5\x0d\x9a\xe0\x29\xad\x2d\x96\x13\x28\x34\x16\x5f\xfd\x81\x61\x5a\x0b\xac\x55\x17\x39\xd6\x04\xcf\xa3\xa8\xe0\x79\x7c\x4d\xdc\xe8\x52\x6b\xd9\x35\x45\xa9\x3d\xd4\xd0\x91\x57\x79\x7d\xe6\xb3\xc5\x6c\xdc\x73\xf1\xcc\xa4\xda\x4f\x0a\x2e\xc5\x6f\x59\x12\xa1\x89\xc4\x56\x06\x3a\x1a\xc1\x6f\xaf\xbf\x7f\x3d\x2e\x75\xc4\x66\x46\x84\x03\xaa\x5a\x8b\x79\x9c\x45\x13\x98\xc5\x30\x47\x8e\x17\xb2\xfb\xdb\x38\x03\x81\xa8\xd3\x26\x4e\xa8\x40\x20\x0c\xa8\x10\x19\x2a\x95\xcb\x4e\x07\x53\x09\x84\x63\xa0\x6c\x89\x22\xa5\x33\xb9\xe0\x74\x8e\x10\x12\xa1\xf2\x2f\x8e\x8b\x78\x29\x2f\x91\x14\xc2\x78\xb1\x40\x96\x8e\xc1\x9e\xa9\x1e\x9b\xfd\x43\x35\x41\xa0\x0c\x16\x24\x11\x01\xbc\x4b\x84\xca\xe2\x4d\xe3\xa2\x02\x18\xe2\x44\x26\x6c\x31\xcc\x32\xc2\x27\x40\x66\x84\x32\x91\xea\x79\x1a\x76\x36\x1a\x01\x49\x61\x9e\xa6\x89\x18\x8f\x46\x33\x9a\xce\xb3\xab\x20\x8c\x17\xa3\x59\xfc\xa5\xd0\x3c\xa9\xf9\x51\x2d\x4c\xd8\x62\x6e\x08\xbf\xc2\x5e\xbb\xa9\xa9\x71\xcf\xe6\x61\xd0\xec\xab\x09\x9d\x66\xb4\x71\x71\x5c\x32\x4f\xb6\x0b\xa7\x2a\x26\xe5\xfe\x18\xaa\xa7\x40\x43\x8b\x02\xd2\x90\x24\x69\x26\xc3\x12\x91\x01\xa9\xc0\x65\xcb\x2f\x0b\x64\x3e\xed\x6c\xde\xd1\xce\x66\xab\xa3\x37\x92\xa7\x36\x66\xb3\xf4\xc9\x31\x14\xcd\xd4\x93\x96\xfd\xe4\x8e\x7e\x85\xb0\xc8\xd2\x8c\x44\xd1\x2d\x60\x41\xdb\x4b\xd7\x53\x49\x17\x47\x11\x47\x4b\xe4\x4d\xd8\xee\x43\x9d\xb6\xac\xad\xd0\x6c\x9e\x05\xc8\xfc\xa9\xe1\x1a\xd5\x38\x36\xc9\x1a\xf8\xbb\x7a\x45\x92\x96\x8e\xea\x79\x7f\xd3\x1b\x6d\x02\xa7\x5e\x94\x41\x3f\xca\x46\x5b\xd1\x21\x58\xf6\x83\x24\x72\x9b\xf4\xa4\xed\xfe\x45\xbc\x48\x22\xbc\x79\xad\xc2\xba\x11\x7d\x2e\xdd\xe5\x90\x2f\xc5\xdb\x90\xe0\xed\x27\xbd\xdb\x3a\x1b\x6a\x4b\xed\xee\x23\xb1\xdb\x7a\x21\xad\xc9\x44\x43\xf5\xee\xb0\xd6\x9e\xa3\x1d\x51\x46\xd4\x61\x1e\xbd\x67\xe1\x85\x65\x57\x5e\x70\xaf\x29\xd9\x9e\x92\x1e\x57\x4a\xe2\x32\x9a\xfa\xd5\xc6\x37\x17\x13\xce\xe3\x85\x4c\xec\x1a\x44\x78\x4f\x58\x3d\x08\xa2\xee\x42\x85\x37\x37\x28\xcd\xd0\x56\x47\x3f\xd3\x93\x0d\x32\xbc\xe2\xb1\x5d\x91\xe7\xe0\xcc\x40\xe7\xd5\x7b\x03\x88\x83\x8c\xab\xbc\x41\xc6\xe4\x26\xe7\xe5\x2a\x1b\x73\x61\xc9\xf4\x1f\xae\xb2\x14\x26\x31\x0a\x15\x24\x3e\xb1\xf8\x1a\xc8\x55\x9c\xa5\xe0\x8a\x33\x63\xc0\x60\x16\x00\xd5\x15\x84\xd0\xb6\x46\xe0\x09\xc7\xa9\x83\xd2\xf5\xd3\x0a\x65\x93\x27\x65\x2a\xa1\xa6\x79\x8d\xba\x34\xc9\xfb\x5d\x90\x24\x47\x9b\x3a\x93\x53\x8f\x59\x0e\xa9\x5a\x41\xca\xcf\x9d\x6c\x53\xc1\x3b\x26\xdf\x81\xac\x70\x21\x7f\x67\x0e\xbb\x65\x11\x2d\x31\x52\x5b\x6f\x35\xcb\x25\x89\x2a\xbb\x75\x0f\x5c\xda\xf2\x7e\x83\xeb\x5e\xf8\x12\xbf\x16\x63\xde\xd0\x45\xcb\x63\xbd\x09\x6d\xe3\x18\x82\xa6\x59\x9a\xe7\x10\x46\x4f\x25\xf4\x7e\xff\xf2\xbb\x77\x3f\xea\x93\x24\x0a\x45\xc7\x4a\xbd\x39\x9e\xe6\xd7\x4b\xa8\xd2\x37\x2d\xe4\xca\x5b\x14\x1e\x53\xb6\xa9\xbb\x90\x6e\x55\x88\xb1\x68\x64\x90\xbc\x79\x9b\x02\xc7\xc6\x39\xfa\x9b\xb5\xa6\xee\xa3\xd8\x00\x2c\xfa\x28\x37\x04\xcb\x36\x96\x8d\x8c\x3d\x16\x5b\x8e\x58\x45\x98\xb1\x2b\xe6\x54\xf4\x7e\x2b\x44\x38\xf1\x3b\x2f\x09\xcb\x75\x1a\xf3\x1d\x3e\x50\x3c\xdf\x44\xdc\xd7\xf5\xd1\x8e\x19\xcf\x3c\x63\x32\x1a\x39\xc6\x6c\xc9\x2a\xee\x66\x97\x50\x51\x57\x46\x30\xda\xd7\xc6\xe0\x69\x6b\xb0\x81\x9c\xf7\xb6\x35\x58\xdb\x7c\xf6\x54\x38\x9b\x2a\x8a\x5e\x9b\xe2\xad\xc9\x72\xcb\x30\x9d\x07\x69\xa4\xa0\xee\x03\x73\xed\x85\xc9\xa1\xca\x92\x6d\x8b\x92\x4d\x51\xcf\x21\x10\x73\xcd\xae\x0d\xa8\x96\xad\xa7\x9a\xac\x1b\x01\x35\xa8\x59\x53\x2d\x61\xea\xc9\xfd\xba\xac\xf3\x5e\x28\xdf\x13\xd9\x7b\xe7\x64\xaf\xbf\x4e\xea\x78\x74\xb5\x2f\x03\xeb\xc6\x3e\x07\xed\xda\x85\x70\x75\x98\xaa\xcc\x6d\xa5\x94\xca\xad\xf0\xf2\x9c\x56\xde\xa0\x70\xb3\xda\x61\xad\xd2\x66\xf1\x26\xc4\x24\x55\xa7\x13\x68\x71\xf7\x42\xed\x20\x71\x64\x13\xe4\x94\x4d\x6b\xa7\x74\x54\x65\x48\x05\x24\xc8\xe5\x52\x71\x02\x57\xb7\x60\xf9\xaa\x69\xe0\x9b\xb8\x5f\x73\x45\x7b\x22\x38\x3b\xc1\x88\x0f\xd9\xca\x13\x2a\x16\x7a\x6c\x86\xb5\x1e\x10\x74\x42\x9c\x13\xe2\x3c\x40\xc4\xa9\x57\xd2\x1d\x7d\xbb\xed\xe8\x7e\x38\xc7\x05\xf1\x9c\xdd\x37\xc9\x4b\x7d\x69\xd0\x78\xbf\xa6\xb4\x42\x53\x52\x4f\x66\xb1\xda\x42\x1b\x3f\x33\xd4\x7f\x16\xc6\x4c\xa4\x30\x68\xa4\x96\xb5\xb7\x3c\x8c\xa7\xed\xc3\xd7\xb2\x48\xc8\x7d\x4b\xa8\xf7\x40\xf2\x57\x42\x6c\x5b\x95\x7d\xfd\x65\x43\x3f\xb5\xdb\xf0\xcc\xd9\x87\x9d\xb7\x0e\xad\x24\xf3\xac\x38\xda\xac\x64\x78\xb6\x24\xea\x74\x59\x48\x16\xd8\xe0\x6a\xe1\xfd\x87\x12\xda\x57\xeb\xb3\x69\xc6\x42\xa0\x8c\xa6\x83\xa1\x32\x5a\xf9\xa8\x5c\xd4\xfb\x0f\x35\x8d\x4e\x90\xe3\x74\x8a\x93\xb7\x6a\x00\x29\xc6\x72\x66\x15\x5c\xfc\x21\x62\x16\xbc\x63\x0b\xc2\xc5\x9c\x44\x83\xf7\x1f\xae\x6e\x53\x1c\x7c\x5c\xad\xd4\x9d\x52\xba\x1f\x87\x17\xf0\x77\x8e\xce\xaa\x28\x21\x8c\x86\x03\xe4\x7c\x98\x7b\x8c\x5c\xd5\x7f\x2f\x60\x59\x1d\x85\x93\xb3\x5b\x15\xeb\x77\x2f\xf1\x19\x90\x24\x41\xa6\x0a\x79\x67\x8b\x0b\x58\xea\x01\xd6\x67\x5a\x02\x03\x17\x07\x51\xc1\x80\x85\xf1\xe6\x5b\x34\xb9\x2b\xbc\xbc\x49\x62\x9e\xe2\xc4\x77\x4e\xbf\xce\xa8\x19\x17\x35\x78\xc0\x66\x8e\x3d\x21\xe9\xfc\x02\xa2\x02\xfd\xb4\xb5\x5f\x54\x86\x57\x86\xcc\x32\x42\x36\x62\xa7\xe7\x74\x60\x6d\x39\x9b\x75\x3e\x04\x55\xa4\x2a\x25\x38\x62\x85\x9c\xec\x0b\x22\xd0\x9a\x70\x3e\xd3\x0b\xaf\xd6\x8c\x17\x96\x54\x17\x97\xb0\x5e\x4f\x49\x24\xb0\x12\x5d\xca\x33\xec\x07\xb8\x06\xb7\xb1\x6e\x39\x26\x5f\xf8\xbd\xcf\x71\xaa\x46\x75\xef\xd9\xc9\x7d\x8c\x5a\xaf\xbb\x0f\x99\xd3\x3d\xa8\x23\x55\x03\xb5\x7a\x53\xd9\xac\x9b\x4b\x3d\xad\xc8\x66\x9f\xc7\x3c\x71\xba\x4c\xfd\x72\x17\xa7\x29\x67\x76\x24\x9e\x53\xbe\x9d\xb3\x7f\xf7\x31\x94\x70\xe7\x3e\xb4\xf9\xdc\xb1\x15\xc2\xf3\x50\x65\x10\x19\x09\x8f\x13\xe1\xf5\xbd\xf2\xdc\xc6\x3e\x7d\xef\x18\x43\x57\xb5\xd0\x56\x8f\x2b\x9b\xf5\xf2\xb8\x43\x87\xa8\xea\x74\xcd\x71\x78\xdb\x61\x1c\xcd\x90\xfd\xe1\x1d\xad\x91\xbb\x17\x9f\x47\x23\x28\x98\x5f\xe3\x2d\x09\xc5\xf6\xad\x56\x30\xcf\x16\x84\x99\x93\x2e\x8d\xc3\x93\xe0\xec\x43\x1b\x52\x14\xdf\x53\x11\xca\x24\x89\xa9\xe9\xa8\xcb\x0d\x01\xd6\x4f\x87\xed\x6a\x91\x36\xcf\x2e\x8d\x6d\xba\x48\x83\x5f\x71\x46\x45\xca\x6f\x4d\x0b\xa8\xd0\x41\x5d\x3b\x3b\xf3\x72\xcd\xe6\x9e\x93\x8b\x04\xd6\x2f\x80\x17\xfb\xe4\x0a\xcb\xf4\x31\xbd\x30\x5e\x24\xb1\x50\xb2\xd2\xb0\x58\xab\x39\x37\x95\x7f\x5a\x0d\xd5\xc9\x03\xbb\xec\xac\xf6\xb0\x54\xd3\xea\xb8\x42\x9d\x17\xf0\x15\x8a\x4e\xf2\xa1\x3b\xb7\x5e\x16\xa9\x32\x40\x1b\x9a\x8e\xf9\x0f\xb2\x63\xad\x9d\xee\x53\x83\x9d\xde\xbe\xec\xb2\xd9\x2c\x75\x5d\xe2\x28\x47\x71\x01\x39\x34\x17\x7f\xdd\xb7\x7e\xeb\xac\xbd\xef\x55\x23\x63\x37\x64\xd8\xf1\x1d\x26\xca\x80\xb0\x9c\x2f\x83\xeb\x39\x0d\xe7\xea\x40\x75\x9c\xe6\xbb\x5f\xf9\xd9\x86\xda\xa9\xcf\x6e\x07\x28\x1d\x2b\xe8\x71\x1e\x6a\x87\xe3\x40\xfa\x9d\xe0\x0d\xfa\x69\xd5\x8d\x6f\x97\xa6\x62\x1e\xba\x1a\x59\xfb\x32\x6a\xd3\x1f\x4c\x78\x9c\xbc\x21\xe1\x27\x22\xbd\x40\xfb\xec\xb0\xcf\x56\x5e\x87\x25\xf9\x4e\x10\x74\x71\xd4\x76\x1f\xdd\xbf\x7f\x1e\xce\x37\x3b\x09\xca\x15\xf5\x8e\xc6\x0b\x7b\x51\x5d\x5d\x3d\xcf\x3b\x51\xdd\x40\xce\x11\x52\xf5\x70\x39\x8f\xc3\x7a\x69\x8b\x9e\xdc\x95\xb4\x5a\xa1\x4a\x5f\x07\x36\x65\x32\xb4\xd5\x72\x56\xc5\xd1\xea\x87\x54\x76\x3f\x86\x78\x7e\x7e\x01\xe7\x57\xf1\xe4\xf6\xfc\xc2\xd5\xc3\x8e\x0b\xd5\x69\x64\x84\x4c\xb6\x1a\xc2\xbf\xe0\xab\x46\x22\x17\x73\x11\xbc\xc8\xb3\x00\xac\x3c\xeb\xa5\xbc\x23\x9f\x0a\x82\x60\xe8\x4a\xf6\x3a\xf9\x77\x9b\xeb\xda\x40\x1f\xf8\x18\x8d\xb2\x8c\x96\x08\xe7\x94\xa4\x1c\xfe\x31\x97\x5b\x3d\x24\xe0\xac\xc3\xba\x3f\x6f\x16\x68\xf5\x54\xaa\x0b\x79\x0d\x6d\x04\x76\xbd\x89\x2c\xa0\x99\x1c\x5b\x36\x2a\xb2\xfe\x3a\xc7\x0d\xad\x3c\xb7\x9b\x7d\x31\xae\x96\x23\xec\xca\x84\xef\x36\x52\x77\xae\x1c\x1c\x7c\x39\x34\x38\x73\x39\x98\x09\x42\x1d\x88\xab\xb6\x2a\xa7\x41\x5d\x6d\x45\x75\xf5\x66\xba\x1e\x6d\xd9\xdd\xc7\xd5\xee\xa2\x1e\xb7\x93\xd2\x6e\x24\xb2\xdb\xd0\x37\x51\xcb\x8f\x8f\x59\xee\x2a\x87\x76\xd4\x6d\x7f\xb8\x27\x0b\x7d\x84\xce\xdc\x9f\xb6\xce\x7f\x62\xa7\xf0\xeb\xfc\xab\xed\xdd\xc5\x0f\xf7\x1c\x25\x91\xdd\x59\xbb\xf7\xea\xe5\xde\x7a\xd9\xd7\xe2\xc8\xb2\x30\xe7\xfc\xbb\x03\x84\x67\x71\x8f\x28\x49\x7b\x98\x88\xd1\x07\x2c\x6c\x1d\xfa\x2a\xd2\x26\x80\x34\x1b\xec\x01\x4a\x9a\x16\xf9\xa8\xb3\x07\x27\xb2\xd8\xdf\xbb\xfe\x90\x8f\xbd\xa9\x66\x6d\xa8\xe5\x8f\x18\xc9\x6d\xbf\xbc\xe4\xd1\x6f\xbb\x75\x5d\xfe\x16\xe9\xc8\x76\x5b\x74\x47\x09\x2e\x8f\x7b\x5f\xaf\xb3\x1a\xef\x1f\x1f\x1a\x3c\xfe\xee\x54\xaf\x77\x5f\xf0\x98\x0d\xb2\xcb\x5e\x9f\x29\xaa\xda\xcb\x52\x25\xfe\x6c\xff\xa3\x8d\xb5\xb7\xa5\xfc\x8a\xab\xb1\xd5\x4f\x4c\xaf\x50\xaf\xdf\x9e\x4e\xdc\xde\xe9\x0f\xba\x38\xdf\x09\xdd\xfc\x26\x4f\x97\xea\xc0\xbf\x59\xe1\xd9\x5c\xde\xf0\x7e\xd1\xde\x37\x5a\x4f\x84\xf0\x51\xd6\x1a\x39\xfb\x28\xcd\xee\xc4\x3d\x1e\x73\x6a\x70\xc4\xd5\xc3\x21\xf9\xc7\x13\x01\x79\x22\x20\x4f\x04\xe4\x3d\x11\x90\x2d\xae\xde\x42\x42\x9e\x68\xc8\xc7\x91\x1a\x3c\x4c\xdc\x38\xd1\x90\x0f\x27\x91\xf0\xe0\x4b\xf3\x4a\x37\x32\xb2\x7b\x91\xd1\x8b\x6a\x7c\x74\x05\xc6\x1d\x31\x8d\x5d\xc8\xe0\x87\x89\x31\x27\x36\xf2\x88\x60\xe2\xec\x44\x20\x1e\x98\x40\x3c\x24\x43\xe5\xf9\xdf\x19\xf6\x7f\x12\xeb\x93\x4e\x1e\xb4\xa4\x7c\x40\x59\x63\xc7\x8a\xf2\x54\x3b\xfe\xb9\x12\xc1\x7b\xaa\x26\x2b\x97\x6f\x85\xeb\xa6\x32\xbb\xe8\xba\x1b\x98\xfa\xf5\x5d\x31\xd8\xf6\x1d\xd7\xf9\xe6\xe2\xbf\xe6\x58\xff\x35\xac\xed\x5f\xe4\x04\xfe\xb9\x1b\xbf\xef\xd6\x0e\xb4\x4e\xe8\x69\x62\xab\xf7\x3d\xd9\xea\xd3\xff\x03\x00\x00\xff\xff\x9c\xc8\x2c\x0b\xd2\x7c\x00\x00")
+var _templatesSchemavalidatorGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xdd\x73\xdc\x36\x92\x7f\x9f\xbf\xa2\x8f\xa5\xdb\x9b\xb1\x65\x4e\x1e\x52\x79\x50\x4e\x57\xe5\xd8\xce\x46\xb5\xeb\xb5\x2b\x4e\xf2\x70\x5e\xd7\x19\x9a\xc1\x48\x5c\x73\x48\x9a\xe0\xc8\xa3\x63\xf1\x7f\xdf\x6a\x10\x00\x1b\x20\xf8\x31\x1f\xb2\x65\xc5\xf1\x43\x44\x12\x04\xfa\xf3\xd7\x8d\x06\x88\x29\x4b\x58\xf2\x55\x94\x70\x08\xb2\x3c\x5a\x47\x45\x74\xc3\x57\x11\x8f\x97\x37\x2c\x8e\x96\xac\x48\xf3\x00\xaa\x6a\x02\x50\x96\x10\xad\x20\xfc\x95\x7f\xdc\x44\x39\x5f\xd6\x37\xa3\x15\xf0\x3c\x87\xb3\x73\x50\xcd\xb9\x69\x50\xb7\x67\xc9\x12\xa6\xfc\x23\x84\x7f\x4d\x7f\xbb\xcd\x38\x04\xa2\xc8\xa3\xe4\x2a\x98\xc1\x34\x49\x0b\x08\x2f\xc4\x3f\x36\x71\xcc\x2e\x63\x3e\x83\xaa\x7a\x23\x1f\x96\x25\xf0\x04\x07\x98\xaa\x31\x5f\xb3\xe2\x1a\xaa\xaa\x2c\xe9\x9f\x3c\x16\x1c\xaa\x2a\x08\x4c\xf3\x53\xa4\x31\xcb\xa3\xa4\x58\x41\xf0\x9f\x1f\x03\x08\xff\x9e\x2e\x58\x11\xa5\x89\x7e\x18\xad\x00\x47\x9d\xa6\x39\x8e\xfc\x34\x49\x93\xdb\x75\xba\x11\x2e\x19\x65\x69\xe8\xad\x89\xa8\xc9\x29\xcb\xf0\x0f\x16\x6f\xf8\x8b\x6d\x96\x73\x21\xea\x7e\xc7\xf7\x3a\x33\x1d\xcd\x7e\x94\x52\xfb\x8f\x73\x48\xa2\x18\xca\x09\x00\x40\xce\x8b\x4d\x9e\xe0\xfd\x09\x80\x92\x77\x3d\x6c\x23\xfb\x97\x51\xf2\x77\x9e\x5c\x15\xd7\x9d\xc2\x37\x2d\x8e\x2b\xba\x5a\x69\xba\xcf\x86\x2d\xa8\xaa\x47\xfd\xe2\x99\xa1\x52\x2c\xc2\xf7\x66\x9e\x6d\x87\x98\x67\xdb\x7b\xc9\x3c\xdb\x1e\xcc\xfc\x6b\x56\x14\x3c\x4f\x3a\x59\x57\xcf\xef\x11\xe3\xef\xcb\x52\x53\x05\x55\xf5\x7e\x6f\xad\x47\x49\xb4\xde\xac\xbb\x75\x5e\x3f\xaf\xf9\x46\x9c\x79\xf3\x89\x5d\x5d\xf1\x5c\x3a\x6f\x10\x25\x05\xbf\xe2\x12\xc1\x2e\x92\xc2\x74\x7f\x5c\x31\x0d\x8f\x1d\xd5\x63\xd7\x1d\xaf\xe2\x94\x35\xa4\xfc\xf0\xfd\x9e\xf2\x2d\x4b\x22\x1b\x24\x22\x7c\xb1\x5d\xc4\x1b\x11\xdd\xf0\xe6\xfe\xde\x52\x67\xdb\x7e\xa9\xb3\xed\x9f\x57\xea\x6c\xeb\x97\x3a\xdb\x1e\x2a\xf5\x4d\x5c\x44\x59\xcc\x5f\xad\xba\x05\x6f\x9a\x1c\x57\x9a\xd2\x28\x0f\x92\x0a\xa5\x7d\x5f\x01\xbc\x48\xb4\xcd\xcd\xe7\x88\x6d\x1b\x0e\x3c\xd9\xac\x2d\x51\x94\x65\xf8\x2b\x5f\xf0\xe8\x86\xe7\xff\x60\x6b\xe4\x32\xd4\xd2\xc1\xe8\xcf\xc4\x82\xc5\xd1\xff\x73\x08\xd5\x53\x8c\xe5\x6f\x36\xab\x55\xb4\x85\xaa\xc2\x01\x8e\x2b\xb8\x7d\x04\xb6\xbb\x74\x9e\xe8\x54\x2a\xbc\x10\xcf\x36\xa2\x48\xd7\x3f\xa7\xf9\x5a\xa2\xab\xc9\xa3\xde\x14\x39\x67\xeb\x26\xaf\xfa\x89\x09\xfe\xc3\xf7\x98\x78\xc8\x11\xca\x12\x0a\xbe\xce\x62\x56\x70\x08\x94\xc4\xa2\x34\xa9\x7b\x5b\xc9\xde\x02\x08\xa1\xb2\x07\x6f\xfe\x9a\x94\xa5\xce\x13\x45\x1c\x2d\xf8\xa8\xf4\xb0\x37\x41\x3c\xba\x22\x46\xca\xda\x91\x76\x97\x35\x62\x8e\xf8\x32\x4a\x2e\x0a\xbe\x16\x12\x11\xeb\xbf\x1a\x71\x86\x17\xc9\x92\x6f\xff\x60\x79\x4d\x7c\xdb\xf4\xde\xe0\xc5\xd9\x39\x44\x49\xf1\xc3\xf7\xd3\x98\x27\x53\xbf\x3d\xcc\xbc\xe3\x37\x83\x77\x8b\x52\x37\x39\xbe\x28\xc7\xf0\xa6\x83\x90\xa6\xf2\x00\x61\xb7\xe4\xeb\x63\x96\x6d\xbf\x38\xb3\x6c\x7b\x0c\x66\x7f\x4f\xa2\x8f\x1b\x3e\xc4\x2f\x69\x75\x6c\x96\x8f\xeb\x29\x04\xb7\x25\x72\xaf\xd2\x1c\x24\x46\x38\x9c\xed\x0a\xdd\x77\x81\xd6\x47\xe6\xdc\xd2\x21\xc1\xe9\x7a\x2e\x88\x0f\x1b\x44\x54\xd7\xbf\x30\xf1\x87\x81\x5f\xa1\xef\xd6\x78\x2d\xb3\x56\x73\xe7\x69\x1c\x31\xc1\x97\x06\xd2\xd5\xed\x8b\xa4\xe0\xf9\x8a\x2d\xb8\xfb\x40\x47\x00\x45\x0e\x48\x45\x94\x25\x35\x6f\x74\xa8\xef\x7e\x74\x6f\xfe\x37\x74\x83\x93\xdb\xf8\xf1\x63\x23\x22\xe4\xf7\x53\x54\x5c\x3b\x62\x70\x44\x41\x63\x63\x4d\xaf\x96\x88\xa1\x5f\xbc\x64\x19\x26\x1c\xaf\x6e\x78\x9e\x47\x4b\x4e\x58\x50\x16\x24\x3e\xb1\xab\xf0\x42\xfc\x2f\xcf\xd3\x69\x07\xd2\x43\x89\xb6\x87\x03\xe4\xaa\x7f\xd2\x05\xc0\x22\x4d\x8a\x28\xd9\x70\x72\xd3\x26\xd7\xa8\x16\xa0\x15\x2f\xb3\x3c\xcd\x78\x5e\xdc\x2a\x73\x4d\x73\x13\x2a\xbd\x6f\x57\x93\xd6\x6d\x79\x51\x9b\x2b\xb5\x15\x55\x32\xa8\x15\x0d\xd3\x84\xb7\x59\xb3\x7c\x06\x25\x53\x96\xf3\x47\x90\xe5\xfc\x86\x27\x85\x80\x2b\x9e\xf0\x9c\x15\x7c\x09\x8b\x74\xc9\xa1\x48\x61\xc1\xe2\x18\xa2\x42\xf0\x78\x75\x06\xc5\x75\x24\x20\x12\x90\x73\xc1\xf3\x1b\xbe\x94\x36\xc1\xd4\x78\xc5\x6d\xc6\x05\x3c\x9a\x1b\xc2\x7b\xd5\xd6\xad\xa6\x68\xd5\x11\x7e\x5d\xa7\x6a\x49\xca\x02\x87\xf6\xfb\xa1\xf2\x14\x3e\xad\x33\x14\xe1\xf7\x55\x69\x22\x37\xfc\x14\xd2\x0f\xd8\x15\xcf\xf3\x70\xfa\x88\xe7\x79\x9a\x0b\xdd\x43\x94\x26\xb3\x1f\xf1\x79\xf3\x8e\xf1\xf1\x1b\x6e\xc6\x41\xc0\xdf\x11\x72\x66\xa6\xc3\x6a\xe2\x74\xac\xc1\x03\xe0\x40\x01\x57\x7e\xf9\x59\x97\xe4\xa2\x01\x2a\x52\xdd\x5b\xb3\x8c\x98\xaf\x32\x23\x75\x87\x0b\x60\xcb\x65\x84\x41\x91\xc5\xaf\x6b\x63\x8f\x1a\xd3\x50\x02\xf9\x85\x89\xa7\xbe\x56\x84\x9a\x68\x05\x10\xfa\x1a\xb9\xb0\x47\xde\x39\xd1\x44\x2c\xa5\x01\x08\x54\xa1\xc7\x96\xca\x12\x4e\x3e\xf0\x5b\x44\xa1\xb3\xf3\x8e\x41\xfe\x56\x3f\x57\x9d\xa3\xa9\x97\xe5\x50\x53\x1c\x2e\x67\xc9\x15\xef\xb2\x61\x6d\x31\x65\xa9\xd0\xae\x4f\x08\x44\x10\x98\xaf\xbb\x80\x47\x95\xae\x54\x20\x3e\x44\x19\x7c\xba\xe6\x09\x24\x9b\x58\xba\x24\xfa\x2b\x5b\x2c\x78\x86\x5e\x4d\xbc\xb3\x8d\x83\x2d\xd1\x55\xd5\x5b\x22\xa7\xaa\x7a\xd7\x07\x8b\x0e\x24\xda\x0c\xc8\x44\x4a\x73\xe1\x81\xec\xa7\x79\xce\x6e\x0d\x1c\xe9\xe7\x75\xdc\x47\x06\xb2\x3c\x5d\x70\x21\xf8\x12\x2e\x79\x9c\x7e\x72\xf8\x50\x4e\xaf\xc9\x37\xfd\x1f\x3b\xdc\x8f\x91\x50\x07\xa4\x74\xfa\xb0\xe5\x6a\xf4\x16\x12\x7e\x21\x5e\xeb\x2a\x3a\x7d\x4c\x1b\xa8\xb0\x6e\x3f\x36\x0d\xb4\x78\x4d\x15\xd9\x34\x43\x8c\x63\xb1\x06\xb9\x51\xbc\xb5\x10\xef\x00\x0c\x6a\x48\x68\xcb\xaa\x53\x2c\x8e\xb2\x47\xe3\x79\x87\x0a\x6c\x35\x1c\xcc\x4e\x35\xc4\x80\xdd\x80\xc4\x70\x0a\x9e\x68\x6d\x29\x3a\x8a\xd6\x58\x7a\xf9\x2f\xbe\x28\xac\xc0\xaa\xff\x95\xa5\xc2\x9a\xf0\x69\x1c\xeb\x3a\x4f\x57\x13\x3f\xba\x00\xec\x91\xa2\x34\xff\x9a\xd8\xe0\xde\xf7\x28\x6f\x17\x7a\xf6\xa0\xc6\x4f\x4b\x07\x25\xcd\x7a\x52\x78\x21\x7e\xdb\x64\x31\xa7\x48\xec\x64\xa0\xf3\x39\xfc\xf6\xea\xf9\xab\x33\xa3\xa3\xe4\x8a\x44\x38\x88\x64\x6b\x71\x9d\x6e\xe2\x25\x5c\xa5\x70\xcd\x73\x7e\x8a\xdd\xdf\xa6\x1b\x10\x9c\xd7\x69\x53\xce\x22\xc1\x81\x25\x10\x09\xb1\xe1\x52\xe5\xd8\xe9\x74\x85\x40\x78\x06\x51\x72\xc3\x45\x11\x5d\x21\xc3\xc5\x35\x87\x05\x13\x32\xff\xca\xf9\x3a\xbd\xc1\x5b\xac\x80\x45\xba\x5e\xf3\xa4\x38\x03\x97\xd2\x7a\xec\xe4\xbf\x64\x13\x0e\x51\x02\x6b\x96\x89\x10\x7e\xcf\x84\xcc\xe2\xa9\x71\x45\x02\x12\xce\x97\x98\xb0\xa5\x70\xb5\x61\xf9\x12\xd8\x15\x8b\x12\x51\xd4\x74\x12\x3b\x9b\xcf\x81\x15\x70\x5d\x14\x99\x38\x9b\xcf\xaf\xa2\xe2\x7a\x73\x19\x2e\xd2\xf5\xfc\x2a\x7d\x82\x39\xf4\x15\xcf\xe9\x9f\x92\x31\xe1\x8a\xb9\x25\xfc\x06\x7b\x27\x3d\x1a\xef\x58\x3c\x74\xf4\xde\xee\xdf\x8d\x36\xbe\x1a\x17\xe6\xc9\xee\xc4\x49\xc7\x0c\x13\x84\x16\xf2\x2d\xcc\x70\xd7\xac\x90\x21\x6f\xc1\xb2\x62\x83\x73\x2f\x26\xc0\x10\xe8\xfa\xa5\x02\x10\xd3\x5f\x67\xf1\x55\x37\xf8\xb6\xb2\x79\xd8\xca\x66\xaf\xa3\x13\x38\x1f\xae\x6c\x1a\x9f\x3c\x03\xdd\x4c\xbe\xe9\xd8\x8f\x72\xf4\x4b\x0e\xeb\x4d\xb1\x61\x71\x7c\x0b\x5c\x97\xed\xd1\xf5\x64\xd2\x95\x73\x91\xc6\x37\x3c\x77\xcd\xc3\xb1\xf3\x81\xd2\x69\x0f\x6f\x5a\xb3\x2a\x0b\xc0\xfc\xc9\x7d\x87\x8c\xe3\x16\x59\xc3\xee\xae\x5e\xb2\xac\xa7\x23\x3b\xef\x6f\x91\xd9\x2a\xe0\x50\xd3\x2f\x4b\x7b\xfa\x39\x58\xb2\x91\x69\xe8\x9d\x54\xd9\xef\x24\x91\x1b\xd2\x53\x6d\xf7\xcf\xd2\x75\x16\xf3\xed\x2b\x19\xd6\x49\xf4\xb9\xf0\x4f\x87\xba\x52\x3c\x65\xd9\xca\x88\x1b\x67\x52\x8d\x8e\x93\xde\x11\xf7\x21\x4e\x3a\x22\x1b\xea\x4b\xed\x3c\x42\xba\xf3\xc4\x6e\x6f\x46\xaa\x3e\xd2\xab\x89\x37\x9d\x9b\xf8\x13\x9e\x8e\x1c\xed\x1e\x65\x44\x23\xe8\xd8\x99\x0a\xef\x68\x1d\x37\xbf\x6c\x4a\x76\xa4\xa4\xa7\x2d\x74\xfb\x4e\x35\xf1\xdd\x6d\x5d\xf9\x2a\xe1\x79\xba\xc6\xc4\x6e\x62\x3b\xcc\xce\xb0\x7a\x27\x88\xea\x09\xf9\x7e\xaf\x75\xbc\xb5\xcd\x3e\xcd\xe0\xda\xe8\x47\x3d\xd9\xe4\x58\xb4\x8e\xad\x1b\xf6\x26\x5d\xc7\x66\x7f\x34\xf7\x1e\xb4\x6a\xf8\xe2\x89\x97\x4d\x59\x2a\x6d\x65\xac\x2a\x5f\x71\xa6\x8d\x4a\x58\x98\xfe\xc3\xe5\xa6\x80\x65\xca\x85\xcc\xba\x3e\x24\xe9\x27\x60\x97\xe9\xa6\x20\xb3\x87\xc6\xd3\xcf\x80\x87\x57\x21\x44\xf5\x0c\x42\xd4\xb6\xc6\xe0\x24\xe7\x2b\x9a\xc2\x28\xb2\xba\xa2\x0e\x69\x72\x62\x52\x09\x49\xe6\x27\x2e\x3b\xd6\xfd\xae\x59\xa6\x50\xef\xd1\xbc\xbb\x24\xe1\x91\xaa\x13\xa4\x3c\x40\x62\x4b\x6e\x07\xa8\xf7\x12\x3f\xa2\x58\xe1\x43\xfe\xd1\x35\xec\x1e\x26\x9c\x5b\x8e\xcb\x5b\x54\xde\xb0\xb8\xb1\x5b\xff\xc0\xc6\x96\x8f\x1b\x5c\x0f\x90\x76\x2f\xb7\xaa\xdf\x34\x6f\xe9\xa2\xe7\x35\xe7\x86\x75\x49\x2e\xbc\xdb\x10\xea\x32\x4b\x7b\x1f\xc2\xfc\x11\x46\x84\xe7\x2f\x7e\xfa\xfd\xaf\x13\x89\xc2\x72\x3d\xf1\x4c\xaa\x57\xe1\xa9\xba\x6f\xa0\xaa\x7e\xe8\x20\xd7\x7c\x6e\x79\x8c\x69\x63\xbb\xd0\x7c\x4e\xc5\xa8\x1b\x19\xb1\x9a\x36\x1a\xc7\xce\x54\x52\x4d\xe7\x9a\x75\x1f\x7a\x01\x50\xf7\x61\x16\x04\x4d\x1b\xc7\x46\xce\x3a\x2c\x56\xb5\xa6\x11\xe6\xcc\x17\x73\x34\x4c\x28\xcd\x75\x41\x84\x17\xbf\xd5\x94\xd0\xf0\x49\xe8\x9d\x7d\xa5\x78\x3e\x54\xb8\xb7\xf5\xa1\xd8\xf3\x6b\x00\xce\x3b\xc6\x4c\xa2\xd8\x33\xa6\x9d\x47\x58\x57\x9f\x67\x95\x50\x96\xae\xd2\xd5\xd1\x17\x06\x77\x80\x55\x47\x0e\x36\x76\x7a\xde\xff\xb6\x34\xb8\xe3\xd2\x20\x89\x77\x9d\x33\x9c\xa1\x19\xc5\x0e\x73\x09\xd7\x90\x9d\xab\x9e\x61\x46\x0f\x42\x3b\x6d\xf1\x3b\x76\x62\x72\x57\xd3\x92\x7d\x27\x25\x84\x11\x6f\xd4\xf3\x08\x84\xf2\xec\x5b\x80\x6a\x1e\x59\xf1\xad\x25\xeb\x56\x40\x25\x92\x76\x13\x26\x47\x49\x43\xb5\x5f\x4b\x3d\xa4\xbf\xcf\x5e\xf2\xd5\xb2\xf8\x56\xec\xfd\x6c\xc5\x5e\x8f\xee\xb5\x44\x07\x0b\xbc\x3e\x6b\x1b\xac\xc0\x5a\x03\x52\x53\x73\xcb\xae\x63\x0a\xae\x1e\x53\xc5\xb9\x1d\x4a\x49\x93\xab\xbc\x6a\x06\x53\xd5\x40\xbb\x99\xb5\x59\xcb\xd8\x2c\xdf\xe2\xd2\x3e\xda\x2c\xee\x0c\xad\x9f\x9e\xca\x15\xa4\x9c\x27\x4b\x9e\x47\xc9\xca\xda\xa5\x23\x67\x86\xb8\xa2\xce\x73\x64\x15\x57\xd4\x6f\xd5\x1a\xa3\x21\x97\x1a\xf8\x50\xed\x97\x72\x74\xa4\x02\xe7\x28\x18\x21\x6a\x31\x26\x34\xa1\x3b\x54\x1c\xf4\x18\x86\xb5\x1d\x20\xe8\x1b\xe2\x7c\x43\x9c\xaf\x10\x71\xec\x99\xf4\x48\xdf\xee\xf3\x31\xfc\x9e\x42\x7e\x59\x21\xcc\x1e\xb0\x45\x9a\x88\x82\xe1\x5e\xc2\x3a\x76\x62\x06\x84\x68\x54\x37\x93\x35\xaa\x44\x7e\x87\x71\x0a\x7c\x9b\xc9\x9d\x0d\x0c\x96\xd1\xa2\xa8\xb7\x43\x61\xd3\xd7\x39\x5f\x45\xdb\x53\x40\xcd\x9f\x82\x5a\xae\x40\x37\x7b\xce\xc5\x22\x8f\x32\x5d\xec\xc2\xb6\xd8\x53\xed\x1d\x72\x60\x98\x2a\xb2\x55\x46\x68\xd6\x3a\x26\xf5\x34\x96\xcc\xda\xb5\xa7\x09\x49\x8d\xfa\x40\xc4\xcc\x2e\xb4\x00\x9a\xe6\xb8\x86\x12\x2a\x63\x84\xf3\x76\x4b\x23\xaa\xd9\x64\x32\x9f\xc3\xd3\x18\x4b\x32\x61\xcd\x8b\x5e\xcb\x12\xca\xbe\x04\xe0\x7c\xc9\x16\x0b\xb6\xa6\x0c\x56\xd5\x64\xb5\x49\x16\x1d\x1d\x4d\x67\xf0\xf6\x1d\xde\xd7\x14\x95\x13\x63\xbc\xd6\x83\xb2\x4b\x20\x00\x16\x7f\xa7\x36\x13\x38\x15\x18\xd4\xfa\xe2\x9a\x2f\x3e\x18\xcd\x17\x3c\x8e\x05\x7a\xa1\xe1\x0b\x12\xb6\xc6\xea\x6c\x2d\xa7\x48\x34\x1a\xb3\x1e\x23\x05\x7e\x6b\x90\x2f\x9e\x02\x36\x90\x0e\xf7\xec\x42\x23\xa1\xc6\xc1\x67\x17\x50\x55\x35\x98\x89\xf0\xc5\xc7\x0d\x8b\x7f\x4e\xe3\xe5\xb4\xf9\xd8\x50\xf3\x37\xa3\x5f\x20\x1a\xcd\xcd\x66\x0d\x84\x91\xc6\x70\x6e\x2b\xb8\x91\x03\x11\x11\x15\x49\xc6\x72\xb6\xe6\x05\xcf\xf7\xf2\x08\x2d\x16\x73\x61\xba\xd3\x0e\x83\xd1\x52\x42\x06\xe5\x5f\xa9\xf4\x35\x36\x26\x2a\xa5\xe5\x97\x9f\xd2\xe5\xad\x7c\xae\x1f\xab\x4d\x92\x57\xa9\x5c\xa5\x3e\x3b\x27\x08\x4b\x9f\x67\xb5\xd9\x9e\x9d\x63\x0c\x4a\x0a\x98\x36\xab\x08\x27\x52\xa2\x33\x08\x64\xbf\x22\xa0\xcf\xc2\x8b\xe7\x16\xea\x3d\x81\x13\xc9\x0a\x2a\x1a\xa3\x93\x96\x2f\x6d\xb0\x88\xe4\x93\x0b\x81\xcb\x12\xcf\x2e\xec\xa7\x12\x13\x50\x34\xca\x6e\x35\x61\x0a\xa0\x00\x5a\x40\x46\x15\x30\x95\x96\x14\xd4\xae\x13\x18\xae\x02\x64\x38\x30\x42\x08\xfe\x50\xcd\x43\x08\x88\x03\x22\x63\x92\xf7\xc0\x58\xad\xd2\x4e\x40\xb9\x0a\x1a\x65\x69\xed\x05\x30\xbd\xde\xac\x59\x62\x49\xab\x51\x61\x30\x93\x32\x9a\xcf\xe1\xa2\xce\x0e\xca\xd2\x90\x56\x55\x8d\x1b\x31\xe5\x25\x91\x80\x34\xe1\xba\xf3\xb6\xd5\xe8\xbf\xcb\x92\xd2\x55\x55\x6d\xc2\xca\x12\x1c\xc2\xd0\xd6\x0d\x61\x35\xde\xf8\x88\x9a\x1a\x74\xd4\x42\xab\xaa\x19\x5c\xa6\x69\x1d\x36\x31\xbf\xfc\xbf\xd3\x46\x4f\xcd\x26\xd8\x1a\xbc\x4e\xb2\x16\x78\x95\x7a\x11\xcb\xaf\x3c\x85\x2c\x4a\x81\x28\xc2\xa0\xc6\x1d\xd9\x41\x00\x81\xfe\xff\x8d\xfa\xff\xb3\x8b\x00\x4d\x69\x46\xb7\xd8\x2a\x38\x2c\x72\x35\x89\xae\x54\x48\x57\xf7\x57\x2c\x16\x7c\x42\xad\x4d\x39\x76\xeb\x92\x5c\x58\x88\xa8\xbd\x5f\x2c\xae\xf9\x9a\x91\xf8\xd9\xf5\x39\x4b\x59\xf6\xb8\x9e\xde\x11\x6c\xd8\x14\xa6\x31\x31\xf7\xd1\xc6\xae\x5e\xdd\xc7\xd8\xfd\x06\x8c\x74\xb5\x6c\xb7\x31\x57\x59\xba\xf0\x5b\xd8\x90\x05\xd7\x86\x87\xc0\x7c\x62\x55\x1e\x75\xd8\x55\xb4\xa3\xcd\xa9\x61\xa7\x6d\xeb\xbb\xf1\x5a\x9e\x79\xf5\x20\xcb\xbb\xb1\xad\xce\xa1\xb2\x36\x3e\x83\x60\xfb\xda\x20\x31\x2d\xfd\xd9\x93\xb4\xab\xc9\x0d\x93\x3b\xcf\x17\x6c\xcd\x5b\xeb\xb8\xf0\xf6\x9d\x99\xf6\x95\x2a\x63\x88\x92\xa8\x50\x8c\xe2\xab\x98\xe2\xbc\x7d\x67\x71\xbb\xe4\x39\x5f\xad\xf8\xf2\x8d\x1c\xa0\xb6\x90\xd0\x9d\x4a\xfc\x4b\xa4\x49\xf8\x7b\xb2\x66\xb9\xb8\x66\xf1\xf4\xed\xbb\xcb\xdb\x82\x4f\xdf\x97\xa5\x7c\x62\x0c\xfb\xfd\xec\x14\xfe\x92\x73\x6f\xc5\x34\x63\x49\xb4\x98\xf2\x3c\x9f\x29\xb6\xb5\xae\x1a\x3d\x21\x75\xa5\xe6\xdf\xcf\xe2\x39\xb0\x2c\xe3\xc9\x72\xda\xd5\xe2\x14\x6e\x66\x2a\x63\x69\x4c\xc9\x67\x49\x4d\x60\xa4\xf3\x3f\xfa\x85\xad\x4a\x93\x5f\x6c\xb3\x34\xc7\x0d\xf3\x1d\xdf\xf0\x79\xb3\x06\xd3\xcd\x0c\x86\xd7\xdf\x33\x56\x5c\x9f\x42\xac\x67\x46\x75\x6e\xa2\xad\xb8\xf9\x66\x94\xcc\x9e\x5b\xf3\x6a\xef\x74\xda\x61\x67\x58\xe7\x33\x9c\xec\xe0\xb7\x0d\xfe\x79\x24\x12\xfb\x8c\x09\xee\x10\xac\x28\x3d\xed\xd4\x9a\x9e\xe8\x19\xb7\xc0\xcf\xe6\x11\x71\x1b\xd1\xa1\x53\xec\x36\x19\x53\x37\x70\xdd\xa3\x9a\x50\x8f\x31\x68\x2b\xb7\x89\x68\xc8\xed\x72\x9c\xa6\x91\xed\x3d\x07\xb9\x0f\xa9\x03\x8f\xf7\x21\x4a\xee\x9d\x3a\x52\x33\x50\xaf\x37\x99\x66\xe3\x5c\xea\x51\xb3\x10\xdd\xe5\x31\x27\x5e\x97\x81\x93\x5d\x9d\xc6\x50\x76\x4f\x3c\x47\xab\xfb\x0e\xdc\x87\x28\xe1\xb3\xfb\xd0\xf0\x37\x49\x8a\x24\xed\x63\x2a\x54\x91\x45\x0e\x5c\x53\x10\x9d\xbe\x67\xf6\x74\x1e\xd3\xf7\xee\x63\xe8\x6a\x18\xed\xf5\x38\xd3\x6c\x27\x8f\xbb\xeb\x10\x65\xa8\x7a\xd0\x71\x8a\xc8\xfe\xee\x1d\xad\x35\xa7\xd0\x7f\xcf\xe7\xa0\x8a\xc4\xdc\xb0\x23\xda\xe9\xb4\x26\xda\x18\x47\xcb\x36\x8e\xa7\x0d\xb4\xb0\xe7\x11\x4e\x85\xd7\x51\x22\xc9\xa9\x2a\x9f\x00\xb5\x78\x8e\x63\x91\xee\x1a\x3c\x16\x6c\x56\xeb\x22\xfc\x95\x5f\x45\xa2\xc8\x6f\xa9\x05\x34\xe8\x20\xef\x4d\x26\x74\x1d\xd8\x5a\x87\xa6\x05\x11\x8b\x63\x95\xa1\xd7\x87\xc3\xa8\x8a\x6c\x8d\x65\x72\xc7\x02\xee\x57\xcb\x52\x21\x57\x79\xeb\x89\x99\x55\x8f\x6e\x6b\xd4\xf3\xc9\x3e\xd9\x95\xe8\x96\xa4\x9b\xfd\x2d\xb2\x60\x6d\xea\xf9\xce\x9a\x41\x57\x11\xd9\xbb\x30\xd1\x34\xd4\xa5\x21\x0f\xbf\x1a\xc1\xb1\x9e\x86\x9f\x88\x9f\x50\x4d\xa7\xf9\xcf\xd8\x31\xa8\x49\xdf\x58\xd2\x00\xa8\x4f\xee\x7a\x32\xc3\xd0\x9e\x0b\xfc\x87\xba\x36\x38\x9a\x73\x71\x0a\x0a\x9a\xf5\x7f\x94\x3d\x4b\x29\x1d\x37\x94\x04\x3a\x3f\x43\xd6\xb5\xfc\x97\x2c\x9b\xe9\x4a\xde\xc0\xf7\xcd\x51\x22\x4b\x75\x75\x7e\xfe\xe9\x3a\x5a\x5c\xe3\xa7\xab\xd8\x4f\xbd\x33\x46\xed\x7b\x54\x15\x3c\x8f\x52\xbd\x45\xf8\x0e\x0e\xb4\x0a\x1b\x5f\x6f\xd8\x20\xa9\xa8\xab\x19\xd8\x4d\x35\xb2\xa7\x21\xfd\xf4\xea\x86\x50\x4c\x38\xa0\xab\x12\x63\x8d\xac\x9f\x0d\x8b\xfc\xe9\x32\x4f\xb3\xd7\x6c\xf1\x81\x21\x1c\xd4\x3e\x3b\xdb\x65\x9b\xcf\x08\x96\x2c\x9d\x38\x17\x43\x8e\xda\xef\xa3\xc7\xf7\x4f\x2a\xba\xdd\x0c\xe0\x28\x82\xb2\x44\x43\xfe\xbe\x17\x5e\x38\xec\x81\xae\x72\x47\x78\x5e\x27\xa1\xd2\xa1\x24\x8d\x50\x48\xb7\x35\x74\xf4\x9b\x77\xaf\x92\x46\x78\x69\x8f\x9e\xda\x7a\x31\x1c\xca\x3c\x79\xea\x96\x4c\x66\xae\x5a\xf4\x16\x25\xeb\x90\xb5\x83\x38\xc2\x81\xa7\x41\x70\x0a\xc1\x65\xba\xbc\x0d\x4e\x7d\x3d\x1c\xc8\x68\x3d\x4f\xc7\xa3\x6a\x70\x06\x00\xff\x03\xdf\xb5\x12\x39\x3c\xf1\xe3\x99\xca\x02\x78\xe3\x59\x2f\x30\xe3\xc0\xbe\xc3\x30\x9c\xf9\x92\xbd\x51\xfe\xdd\xe7\xba\xba\xa5\x6e\x1b\x76\x55\x34\xcc\x34\x1a\x11\xce\x2b\xc9\xd7\x79\x9a\x3d\xe4\xe9\xd6\x0e\x12\x30\x66\xb1\xdf\xfb\x74\x82\xa6\xe1\xaf\xb7\xa0\xaf\x1b\x8c\x58\x4c\xa3\x6b\x68\x7a\xcf\xad\xd5\x07\x3a\x95\x5e\x45\x43\xcb\x41\x82\xb0\x21\x59\x5b\x71\xc6\x4c\xb3\x8e\x55\xb7\x9a\xa8\xce\x65\x37\xff\x42\x84\x1a\x45\xa9\x5e\xff\xf3\x97\xd0\x3d\x8b\x11\x86\xfc\xc3\xd7\xde\xc8\xd2\x84\x66\x12\x57\x27\xf4\x4e\x46\x6c\xe8\x59\xbf\xf0\xad\xb9\x19\xa2\x0e\x5c\x75\x6b\x13\x84\x33\x75\x4a\x90\xd5\xc8\xa8\xc3\x5d\x6e\x23\xf4\x1c\xba\xe0\x46\xba\xba\xbf\x4b\x6e\x16\x1a\xcf\xe7\x52\x62\x34\x86\x0c\x56\x41\x4e\x7a\x27\xa9\x27\xee\xbc\x73\xaf\x4a\xe5\x89\x67\xa2\x3a\x1c\xba\x1e\x62\xd5\x64\x17\xa4\xfc\x1c\xe5\x14\x6a\x3e\xe6\x7a\x70\x0d\x80\xea\xb3\xcd\x41\xf3\xaa\x1d\x2e\x0f\x89\x96\x64\x36\x36\x3e\x64\x52\x1e\xee\x36\x6e\x0e\xc8\xa1\x3f\x68\xf6\xbf\xbc\x53\x49\xf3\x5e\x3a\xb3\x61\x65\xb4\x47\xe3\x0b\xc4\xaf\xd5\xa5\xeb\xdd\xea\xf6\x11\x7c\x5c\x5b\xd7\x5d\x38\x7a\xbf\x48\xbe\xb0\x97\x37\xb2\x23\x0e\xd6\xd7\xe2\x9e\x25\xd1\x5e\xfa\xc7\x03\x44\x07\x73\x0f\x28\xc7\x7e\xf8\xe1\xdf\xd5\xa1\x81\x8d\xf6\x03\x07\x40\xda\x0d\x8e\x00\x25\x4e\xa7\x0f\x3d\x7b\xf0\x22\x8b\x7b\x3d\xf6\x8c\x46\x77\x4d\xb4\xa9\xf8\xe8\x89\x00\xa6\x6f\x24\xb9\xdd\x2d\x2f\x31\x2b\x55\xc7\x44\xa0\x5d\x21\xe7\xb3\x40\xcc\x00\xfb\x7b\xa4\x23\xe6\xe5\xaf\x1f\x5c\x0c\x2b\x63\x11\xe6\xeb\x9c\x60\xf4\xf3\x7e\x1f\xf0\xa1\xb9\x71\xb4\x4a\xbd\xb6\xc8\xaf\xca\x20\xc7\x2c\xd5\x52\x51\x59\xdf\xc1\x1b\xfc\xd9\xff\x3c\x6e\xa2\x36\xbd\xdc\x60\x2b\x0a\xa0\xb5\xe4\x77\x42\xbd\x02\xbf\xbe\x6b\x13\xe4\x5a\xb1\x6e\xf0\xed\x63\xaa\x43\x3f\xa6\x6a\xad\x9c\xe9\x5b\x0d\xd2\xe8\xc2\x99\xbb\x10\xe3\xf7\x52\x43\xc0\xc4\xbd\x72\xfe\xf6\xef\x0d\xe8\xae\xd2\x1f\xd7\xbb\x3b\x32\x85\xfb\x31\x15\xb9\x97\x89\xc0\x67\x9d\x6b\xa8\xea\x23\x9a\xdd\xb7\xda\xe3\xb7\xda\xe3\x88\xda\xa3\x8d\x2f\x96\x73\x9b\x0a\x49\xaf\x87\xf7\x17\x57\x8e\xe9\xde\x7b\xd5\x17\x28\x13\x5f\x20\xe3\x6f\x86\xdf\x23\xe3\x37\x2f\x7f\xfd\x19\xbf\x61\x65\xb4\x5b\xab\x7a\x80\x76\x6e\x75\xe9\xba\xb8\xba\x7d\x04\x47\xbf\xcb\x5a\x41\xbf\x48\xee\x81\xab\x37\xf2\x23\x4e\xd6\xdf\xe6\x9e\xc5\x7e\x2f\x07\xe3\x61\xa2\x83\xb9\x07\x94\x1a\x3c\xfc\x4c\xc0\xd5\xa1\x01\x8f\xf6\x03\x07\x46\xda\x0d\x8e\x00\x28\x4e\xa7\x0f\x3f\x91\xe8\xc0\x97\xf6\x9d\x71\xc5\x48\x03\x3c\x83\x40\xd3\x5f\x67\x71\x50\xe6\x10\x90\xd9\x15\x55\x3e\x0b\x8a\x0c\x70\xbf\x47\xde\x61\x5e\xa6\xf8\x31\xa6\x18\xfc\x75\x62\x8c\x61\x77\x2c\xd0\x7c\x9d\x53\x8e\x7e\xde\xef\x0b\x4c\x34\x81\xea\x5b\x01\xf1\x6e\x0a\x88\x8d\xf5\xe9\x52\xc1\xf1\x2a\x54\x66\xdb\xad\xbd\x13\x9a\xa4\x64\xa6\x41\x47\xc6\xd5\x42\xf9\x56\xba\xfc\x27\xcd\x1a\xdb\x72\xf0\x22\x7b\xab\xd9\xd7\x9f\x03\x1a\x56\xc6\xe2\xf3\x9f\x29\x11\x6c\x09\xe9\xf3\x00\xb9\x79\xbd\x1f\xae\xdb\xca\x1c\xa3\xeb\x71\x60\xda\xad\xef\xa6\x82\xed\x3e\xb1\x2b\xda\x48\x7e\xf3\x83\x88\xe4\xe7\x5c\x1b\x5f\xf4\xa3\x70\xd8\x4d\xbb\x22\x6e\x18\x68\x1d\xd2\xf4\x61\x55\xb6\x70\x5b\x92\x6f\x1e\x95\xa5\xef\x00\xa7\x25\x8f\x39\xfe\xf6\x16\xfe\x8a\x21\xdf\xd2\xf3\x06\xd5\xc7\x1c\xba\x81\xd0\xfb\x81\x95\x30\xe0\x52\x1e\x5d\x98\x26\xf2\xbe\x7a\x1d\x7f\x5a\x0b\x2f\xe5\x1e\x67\xb5\x51\x58\xd9\x60\xd7\xa1\x67\xb5\xe5\xcb\x05\x2a\xbc\x94\xb2\xc3\x6e\xd4\x48\xfc\xb4\x6e\x8a\x9f\xb6\x64\x2a\x97\x94\x4b\x48\xea\xfb\x11\x75\xd6\x52\x71\x8d\x2a\x08\xfe\x19\xfc\x33\xb0\x4f\x72\x3d\xd1\x0b\x4e\x4a\x50\xba\xf5\xb9\xf5\xc4\x3a\x2c\xae\xa6\xa8\xf3\xbd\xf6\x73\xe7\x3b\x99\x27\x70\x92\x44\x72\xa5\x48\x6d\x5a\xaf\x0f\xb4\x54\xaf\x35\xeb\x48\x6a\xd5\xcc\xdc\x77\xcf\x26\x9e\xb9\x2f\x9a\xa3\xb3\xf5\xad\x5f\x98\x20\xf0\x9b\xe6\x6a\xc5\xa5\xf9\x45\x61\x25\x5c\xc2\x45\xb4\x6a\xa8\xd3\xc1\x4b\x62\xac\x3e\xf2\xcb\x76\x6c\x67\xc9\x4a\xf5\x80\x50\xb1\x2d\x78\x8e\xca\x50\x66\x82\x1a\x17\x10\xad\x15\xb0\xcb\xe1\xb7\x4f\xae\xd2\x27\xf8\x00\x58\xde\x7c\xe2\x8b\x36\x13\xdf\xd6\xbf\xd5\x59\x5c\xf3\x5b\xfa\x0b\x0d\xd4\x94\x94\x82\xf1\x17\x75\xf4\xb9\xd3\x24\x8c\xab\xe5\x44\x94\x20\xe1\xe7\x2f\xc6\xc8\x2d\x9e\x66\xe1\xb4\x79\x15\x9e\xd5\xb6\xaa\xfc\x9a\x4f\xd5\x80\xa1\xba\x7f\xda\x85\x22\x15\x3d\xdf\x9a\xa0\x70\xd8\xea\xb0\xd8\x9e\xaa\xa3\x2d\xbd\x21\xdd\xf9\x7e\x40\x8d\xaf\xe4\x93\xe6\x12\xb3\x02\x65\x6f\x55\x65\xed\x27\x77\x0f\x11\x6e\xc8\xb0\x18\xde\x99\xa4\x1d\x09\xaa\x7a\x8c\xc3\x36\x2f\x4f\x4b\x72\xe1\xc7\xa4\x8e\xf1\x95\xa5\xe1\xf6\x7e\x84\x11\xf5\x18\xa1\x48\x36\x68\x20\x45\x3a\x2a\x45\x1f\xfc\xee\xac\x6e\x43\x50\x43\x02\xc5\xae\xc7\x9a\x77\x1f\x68\x1e\xaa\x93\xca\x09\x06\xe3\x3a\x0a\x61\x96\xb2\x98\xb1\xdb\x38\x65\xcb\x4e\xd4\x55\x77\xf0\x13\x3a\xb8\x4c\x97\xb7\xf4\x4c\xb3\x1c\x18\xa6\x7c\x59\x9a\x08\xe4\x55\x76\xe4\x45\xe3\x21\xd0\xad\x8f\xcb\xad\x1d\x5f\xe3\xef\x6b\x22\x3b\xdd\xf7\xa3\xf9\x18\x58\x51\x9f\x06\xd6\x07\x75\xd2\xb5\x97\x56\x1b\x1d\x59\x6d\x23\xa5\xbf\xe3\xfe\x5c\x07\x9d\x56\x93\x26\x04\x47\xea\x57\xd8\x23\xf3\xc3\xeb\xd4\xe3\x7f\x84\xe8\xf1\x63\x9f\x71\x77\x07\x3c\xf5\x05\x48\x2d\x1b\x53\xe0\xd7\x9f\x7f\xa8\x0f\x73\xf4\x10\xc1\xdb\xe8\x5d\x20\x0f\x03\x2c\xae\xc9\x43\x29\xbe\xf7\xf0\x18\x82\x30\x80\xc7\x88\x24\x8b\x34\xb9\x09\x2f\x8a\x94\x4d\xa3\xd9\xfb\x99\x42\x68\x2a\x16\x65\x1d\x00\x76\x18\x52\xe2\x94\xfb\x34\x3a\x72\x4a\x8f\x6c\x7d\x2d\xbb\x44\xed\x6d\x3b\x46\xf2\x1f\xac\x74\xa7\x11\xba\x12\x37\xfe\x5c\x8c\x0b\x48\x6f\x3f\xbc\x3b\x44\x15\x3e\x52\xad\x0f\x73\x82\x21\x4d\x7c\xd8\x45\xf6\x83\xf6\xa7\x5f\x50\xf9\x24\x09\xcd\x27\x64\xa3\xc8\x98\x00\xdb\xa2\xa3\x23\xc8\x8e\x8a\x84\xd3\x1e\x62\x66\x5f\x51\x78\x24\xf8\x69\x07\x3f\xa2\xa6\xa3\x86\x3f\x6b\xc0\x6a\x40\x31\x83\x1a\xef\xe8\xc0\xba\x1c\x0c\x82\x7a\x0a\x30\x2a\x44\xe8\xc6\x5d\x61\x00\x7f\xe2\x0a\x83\x06\x5b\xbe\x4a\xe2\xdb\x66\xcb\x07\xfe\xe8\x02\xc7\x99\x2c\x5f\x62\x8c\xc4\x26\x1f\x37\x5c\x98\xe4\x4b\x9b\xe2\xaf\xfa\x4d\x47\xea\x9a\x06\xd3\xa0\x96\xb4\x9a\x50\x1e\x6d\xab\x97\xaf\xae\x35\x46\x8b\x2d\x89\x7b\xcf\x92\x56\xb7\xbd\x01\xab\x37\x58\xf5\xcd\x06\xbf\xfb\xb1\x75\xd7\x84\x29\x3f\x3b\x4e\x73\x7f\xf4\xea\xb6\x0a\xca\xc0\x08\x88\xd3\xd1\xfa\xae\x7e\x0d\x47\x5b\xd0\x01\xa0\x6f\x00\xde\x25\x6c\x66\x31\x44\xf5\x6b\x00\xdc\x39\xf0\xfb\x89\x53\x41\x24\x51\xa4\xa5\xf1\x1d\x42\xa8\x65\x00\xde\xf7\xfe\xc6\x6f\xc7\x55\x08\x94\xb2\x07\x09\xe9\x8d\x4c\x12\x17\xf0\x90\x07\xf5\xd9\x2e\x4e\xbb\x70\x86\xc4\x96\x4b\x1c\x09\xc3\x00\xd5\x8b\x1e\x6c\xec\xf2\x5a\x17\x1e\xdc\x5f\x44\x70\x30\x01\xa0\xcd\x0e\xf1\x8f\x26\x71\xf1\x74\x3f\x39\x86\x25\xe3\x37\xc4\x81\x31\x5f\x2f\x11\xed\x20\x37\x1e\x02\x86\x54\x68\x0d\x55\x4d\x3c\x37\x6d\x37\xda\x0b\x24\x4c\xb7\x87\x89\xca\x1d\xc8\x74\x6d\xd1\x4b\xa9\xb5\xf6\xeb\x92\xc6\xbe\x0d\xb0\xf6\x41\xe2\x9d\xc2\x52\x0b\x13\x5d\xae\x0f\xe0\xe1\xb5\x47\x3f\x6e\xf7\x44\xec\xad\x1b\x9e\xcb\x1e\x72\x47\x13\xbb\x3b\xa9\x7d\x74\xb5\x8c\xa5\x07\x9e\x76\xb0\x9e\x3b\xb2\x1d\x42\xfa\x6e\x39\x17\x96\xac\x4d\xde\x55\xff\xbc\x0c\xce\xa9\xf1\xb6\x06\x3c\xfc\xb9\x87\xe1\x3c\xac\x63\x3a\xfe\x32\x5d\xf2\xb8\x99\x82\xeb\x4e\x5a\xf3\x6e\xf3\x84\x94\xd1\xc1\x5b\x47\xc7\x32\x5f\x28\xbb\x1d\x58\xa0\xa9\xdb\xa8\x57\x35\x18\x93\xb7\xfb\x16\x6b\xbc\xef\xaa\xb9\x45\xeb\x99\x5a\xb8\x59\x58\x76\xe1\xaf\xe9\x63\x12\xa9\x85\xa6\x53\x79\x93\xbe\x77\x4d\x3f\x4a\xf7\x00\xaa\x01\xe3\xf6\x95\xca\x07\xcc\xa3\x3e\xee\xbc\xdd\x9f\x63\x18\xa8\x72\xdb\xfc\xb9\x34\x15\x55\xc9\x56\x2f\x4a\x14\xa9\x8f\x69\x5a\x72\x5c\x95\x73\xac\x45\x9a\xc2\x1a\xa5\x88\x87\xa9\x6d\xb0\xd6\x1e\x25\x75\xf4\x9e\xcf\x5b\xfd\x8f\x38\x3f\xd1\x6b\x93\xf8\xc3\xb7\xaa\xfb\x1e\x8b\x3a\xde\x4e\x86\x2f\x73\xc0\xa2\x23\xad\x03\xec\x6b\x10\x65\x9b\xc5\x64\x69\x96\x2e\x76\x7b\x82\x90\x91\xec\xf0\x99\x89\xcd\x8b\x83\x01\x81\xf4\x3e\xe6\x43\x8e\x6e\x96\xdc\x59\xb6\x6b\x1c\xe1\xc2\x7a\xad\xdb\xa3\x8d\x84\xfd\x53\xb7\x8e\xd3\xab\x5c\x7e\x94\x5b\x76\xde\xb2\x12\x82\x3e\xa6\xf6\x3f\xfe\xb2\x5f\x1c\xe3\xce\xe3\xdb\xbd\x40\x31\x20\x1f\x47\x10\x9e\xcb\x1e\x63\x79\x48\x86\x32\x2c\x88\xda\xe1\xa6\x9e\x7a\xb5\x5e\x71\xeb\x29\xbb\x9a\x1c\x65\xb7\x68\xe3\x90\x72\xdc\x33\xd8\x2c\x1e\x3d\x41\xcd\x87\x3d\x7b\x23\xcf\x80\x29\x1d\xc9\x98\x74\x67\x23\xa5\x8c\xa1\xcb\xe4\x83\x32\xf5\x08\xe0\x04\x8f\xe1\x92\x71\xe8\x36\x80\x90\xe8\xcd\x11\x98\xe7\x86\x75\xd9\xba\xe8\xe4\xfe\x88\x9c\x1f\x9b\xeb\x2e\x8e\xca\x12\x78\xb2\x84\xaa\x9a\xfc\x7b\x00\x1d\x49\xc1\x03\xc2\xa6\x00\x00")
 
 func templatesSchemavalidatorGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -442,8 +717,8 @@ func templatesSchemavalidatorGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/schemavalidator.gotmpl", size: 31954, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0x45, 0xe4, 0x3d, 0x35, 0x81, 0x2f, 0xdb, 0x7b, 0xba, 0x7e, 0x80, 0x2d, 0x28, 0x7b, 0x73, 0x23, 0x69, 0x4e, 0xfc, 0x8d, 0xdc, 0xa2, 0x16, 0x87, 0x17, 0xff, 0xf7, 0xee, 0x89, 0x9, 0x71}}
+	info := bindataFileInfo{name: "templates/schemavalidator.gotmpl", size: 42690, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x16, 0xe, 0x58, 0xdf, 0xcd, 0x8d, 0xbb, 0x72, 0xc9, 0x1b, 0x45, 0xbc, 0xde, 0x53, 0x3b, 0x3b, 0x31, 0x19, 0x40, 0x35, 0x96, 0xe7, 0x33, 0xa4, 0xe4, 0x36, 0x8a, 0x75, 0x3, 0x88, 0x25, 0xcc}}
 	return a, nil
 }
 
@@ -487,7 +762,7 @@ func templatesSerializersAliasedserializerGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesSerializersAllofserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5d\x6f\xdb\x36\x14\x7d\xf7\xaf\xb8\x33\x8a\xc2\x0a\x5c\x79\xcf\x19\xf2\x90\xae\xd9\x96\x01\x8d\x83\xa4\xdb\x1e\x82\x60\x65\xac\x6b\x87\xad\x44\x6a\x24\x95\x34\x23\xf4\xdf\x07\x52\xd4\xa7\xa9\x8f\x00\xc3\x9a\x62\x7e\x68\x63\x9b\xe4\xe5\x3d\x87\xe4\xb9\xf7\x68\x0d\x11\x6e\x29\x43\x98\x93\x38\x5e\x6f\xaf\x51\x50\x12\xd3\xbf\x51\xcc\x21\xcf\x67\x00\x5a\xbf\x81\x57\x02\x37\x48\x1f\x50\x5c\x90\x04\xe1\xf8\x04\xc2\xab\xe6\x0f\x79\x3e\x5b\xad\xe0\x37\x96\x10\x21\xef\x49\xfc\xeb\xf5\xfa\x02\xb2\xf2\x9b\x04\x75\x4f\x25\xf0\xbb\x4f\xb8\x51\xb0\x15\x3c\x01\x02\x76\x8a\x54\x22\xdb\xa8\x4c\xe0\x6c\x9b\xb1\x0d\x2c\xb4\x6e\x85\xcd\x73\x38\xd2\x1a\x52\x22\x37\x36\x21\x08\xdd\x66\x41\x7b\xab\x85\x20\x8f\x70\x73\x7b\xf7\xa4\x30\x00\x14\x82\x0b\xd0\x2e\x6f\x41\xd8\x0e\x21\x3c\x35\xc0\x0a\x34\x00\xab\x15\x78\xa3\xda\x41\xb3\x88\x6e\x81\xb0\x08\xc2\x73\x79\xca\x38\x7b\x4a\x78\x26\x21\xbc\x14\x3c\x45\xa1\x28\x4a\xc8\x73\xad\x57\x47\x35\xc0\x98\xb2\x1d\xa4\xf5\x38\x65\x40\xe2\x18\xb8\x09\x53\xae\x2f\xd0\x4b\x38\x5a\xb9\x8d\x1c\xaf\x29\x11\xca\xf0\xd9\x97\x0f\xc0\x03\x11\x10\x11\x45\xb4\x76\xb3\xf3\xdc\x11\x67\x51\x96\x91\x1c\xd2\x56\x9a\x6e\xb8\x42\xc5\xb8\x32\xa8\xde\x12\x89\x1f\x9e\x52\x6c\xce\x68\xcd\x79\x15\x9e\xcb\xb3\x2f\x29\x17\x0a\xa3\xf6\x24\x33\x0d\x14\x26\x69\x4c\x14\xc2\x3c\x15\xf4\xa1\xc8\x65\x4b\x31\x8e\xe6\x10\xee\xc7\xc4\x58\xa2\x27\x48\x07\x6f\x9e\xb7\x23\xcb\xcd\x3d\x26\xc4\x64\x59\x04\x85\x8f\x9f\x24\x67\xc7\x73\xad\x21\x5c\x0b\xba\xa3\x8c\xc4\x8e\x27\xad\xcb\x13\x5b\x58\x80\x57\xf8\x57\x46\x05\x46\x81\xc1\x7a\x96\xa4\xea\x69\x9d\x50\x55\x40\x59\xf2\x84\x9a\x4d\xd4\x93\xd6\x80\x2c\xaa\x96\x87\xe7\xd2\x5c\xa5\x6b\x25\xcc\x61\xe6\xf9\x52\xda\x4f\xd5\xac\xf9\xc7\x2e\x2c\x16\xb5\x19\xf6\x00\x2d\x42\xff\xcb\x8c\x4e\x27\xd4\x30\x16\x5e\x91\xc7\xf7\x28\x25\xd9\xe1\x0b\xa3\xd0\xc7\x60\xf3\x97\x3d\x92\xdd\x05\x0d\x7f\x21\xf2\x34\x8a\xa8\xa2\x9c\x91\xb8\xef\xbe\x37\xe9\xf0\xcd\x0e\x5b\xc8\xdd\xc3\x68\x9c\xd0\x4f\x86\xfd\x2a\x25\x48\x48\x7a\x53\xc0\xb9\xed\xbf\xa6\x3d\x59\x95\xbc\xbf\xa9\x08\xe8\x85\x56\x47\x38\x57\x98\x4c\x82\x64\x27\xb6\xd1\x70\xe1\x8e\xb1\x46\x64\x0f\xf2\x3a\xbb\x73\xcf\xbe\x83\xee\x66\x12\xa8\x32\xa5\x11\x3c\xe6\x1f\xdd\x1a\x1d\x36\xba\x26\x1f\xc9\x2e\xbc\x42\x12\x95\x42\xbd\x84\xd7\x1d\x35\x0b\x7e\xb0\x93\xbf\x3b\x01\x46\x63\xa7\x69\x02\x55\x26\x98\xf9\xdd\x45\x74\x14\xf4\xa8\x9c\x89\x26\xda\x05\x29\xf4\x6a\x3c\x9c\x74\xb5\xd4\x3f\x6f\xe6\xbb\x93\x95\x9a\x15\x97\xbd\x2e\x0e\x76\xdc\xe8\xb4\xd6\xe6\x0f\x33\x31\xaa\x1d\xcd\x73\xfb\x99\xd7\x7a\x5b\x73\x53\x3d\x9b\x52\x90\xf3\xbc\xaa\x6b\xad\x55\x0b\x53\xda\x64\x78\x81\x8f\x6f\xb3\xed\x16\x85\xe1\x31\x58\xc2\x6b\xcf\x76\x41\x2d\x10\x5e\xee\xfb\x96\x58\x94\x53\x0e\xc2\x24\xf6\x3b\x89\x33\x3c\xfb\x92\x0a\x94\x92\x72\x56\xf0\xea\x89\x3c\xf3\x5c\xf6\x76\x29\x6a\x72\xe8\x29\xaa\xb6\x21\x01\x13\x33\xea\x56\xd0\xff\x11\xdd\x57\xfb\x37\x3b\x12\x3c\xbd\x24\x9b\xcf\x46\xd6\xeb\xc4\x07\x4f\xa1\x3e\x84\xf6\xe7\x42\x79\xf6\x75\x74\xb5\x02\xc6\x1f\x61\xcb\x05\x08\xdc\x65\x31\x11\x8d\x1e\xa7\x3a\xca\xd1\x06\xe6\xc1\xad\x93\xbd\xfd\xcb\x48\xf7\x32\xde\xbb\x4c\xea\x5c\x9e\x51\x65\xfd\x5d\xcb\x37\xdd\xb3\x78\x2b\x4e\x17\xe3\x57\xe4\xf1\xe5\xb6\x2a\x7e\xe6\x9a\x7a\xd6\xfc\x56\xfc\x3f\x5c\xfe\xba\xaf\xc1\x57\xff\xba\x52\x50\x46\x1e\x7d\x2b\xd3\xeb\x60\x37\x8d\x81\x42\xd8\x15\x8c\xa6\x92\x8c\x76\x64\xab\x15\x7c\x58\xbf\x5b\x1f\x83\x6f\xca\x7e\xc0\x81\x46\xc8\x13\xc9\x8e\x76\x83\x38\xe6\x18\x8d\x67\xf9\xcc\x18\xd3\xf7\x0d\x5b\xea\x35\xa5\x8a\x4f\xb7\xa4\x3d\x8e\xb4\xb1\xc7\x22\x80\x45\x61\x47\x97\x85\x1d\x0d\xec\xa9\xfe\x69\x88\x96\xe6\x56\x24\xe4\x33\x2e\x6e\x6e\xcb\x39\xdf\x2f\x4d\xd0\x18\x59\x6d\x54\x83\x59\xb3\xdd\x69\xd9\xd7\x69\x0e\x75\xba\xc7\x3c\xf8\xcb\x17\xa6\xd5\x3d\xfe\xd2\xaf\xa5\x5f\x99\xd3\x97\xab\xdb\x43\x3c\xb6\xe5\xfc\xe0\x32\xbf\x49\x97\x39\x6a\x0a\x27\x19\xbd\xa2\x65\x9e\x56\x34\xf7\x6e\xcc\x0c\xec\x03\x78\xd7\xde\xc8\x8a\x7e\x53\x4d\xcb\x3e\xe0\x0f\x41\x15\xda\x02\xd1\x75\xc0\x95\x4f\x69\x2e\xf3\x19\x03\x46\xe3\x6e\x78\x67\x14\x5c\x75\x39\x01\x92\xa6\xc8\xa2\x45\xf1\x7d\xe9\x4b\x30\x18\x73\xb3\x3e\x27\x56\x0d\x2b\x73\xb8\x1b\x9e\xa4\x5c\xda\x23\x2b\x0d\x99\xc7\x79\x2c\x5b\x6d\x50\x0d\xdf\xef\x21\x6b\x16\x06\xa1\x8f\xe0\xf5\x39\xae\xe7\xf9\xd0\xd9\xb3\xc1\xf8\xae\x8f\xdf\xa1\xfd\x97\x18\xbd\x8d\x55\xfb\x91\x1c\x8c\xde\xc1\xe8\x1d\x8c\xde\x10\x73\xc3\x46\x6f\xf0\x46\x4f\x33\x58\xcf\x2e\x40\xed\x3c\x4a\x79\xbf\xec\x6c\x36\xa1\x08\xed\xf9\xd0\x86\x77\xed\xad\x43\xa3\xb5\xa8\xa4\x66\xac\x1e\x75\x13\x0e\xfa\x14\x6b\xb0\x11\x1b\x5f\xd1\x68\x71\x7c\x26\xd1\x72\xf2\x23\x67\x1b\xa2\x2c\x29\x45\x9a\x61\x18\x06\x4b\x67\x20\x1b\xab\xfe\x09\x00\x00\xff\xff\xa8\x0c\x30\x10\x2b\x1d\x00\x00")
+var _templatesSerializersAllofserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdf\x53\xe3\xb6\x13\x7f\xf7\x5f\xb1\xdf\x0c\xdf\x1b\x9b\x09\x4e\x9f\xe9\xf0\xc0\x15\xda\xd2\x99\x23\x0c\x5c\xdb\x07\x86\x29\x22\x96\x83\xee\x6c\xd9\x27\xc9\xe4\xa8\x46\xff\x7b\x47\x8e\x6c\xcb\x8a\x9c\x98\xa1\xed\xc1\x94\xb7\x44\x96\x76\xf7\xf3\xd9\xd5\xfe\x90\x94\x90\xe0\x94\x50\x0c\x13\x94\x65\xf3\xf4\x0a\x33\x82\x32\xf2\x27\x66\x13\x50\x2a\x00\x90\xf2\x00\xf6\x18\x5e\x60\xf2\x80\xd9\x39\xca\x31\x1c\x1e\x41\x7c\x69\x2f\x28\x15\xcc\x66\xf0\x2b\xcd\x11\xe3\xf7\x28\xfb\xe5\x6a\x7e\x0e\x55\xf3\x8f\x83\xb8\x27\x1c\x8a\xbb\x4f\x78\x21\x20\x65\x45\x0e\x08\xea\x2d\x5c\xb0\x6a\x21\x2a\x86\x83\xb4\xa2\x0b\x08\xa5\xec\x89\x55\x0a\xf6\xa5\x84\x12\xf1\x45\x6d\x10\xc4\x46\x59\xd4\x57\x15\x32\xb4\x82\xeb\x9b\xbb\x47\x81\x23\xc0\x8c\x15\x0c\xa4\xb1\x9b\x21\xba\xc4\x10\x1f\x6b\x60\x6b\x34\x00\xb3\x19\x78\xa5\xd6\x1f\x35\x58\x92\x02\xa2\x09\xc4\x67\xfc\x98\x16\xf4\x31\x2f\x2a\x0e\xf1\x05\x2b\x4a\xcc\x04\xc1\x1c\x94\x92\x72\xb6\xdf\x01\xcc\x08\x5d\x42\xd9\x7d\x27\x14\x50\x96\x41\xa1\xc5\x34\xe7\xd7\xe8\x39\xec\xcf\x8c\x22\xc3\x6b\x89\x98\xd0\x7c\x0e\xd9\x03\xf0\x80\x18\x24\x48\x20\x29\xcd\x6e\xa5\x0c\x71\x20\x2d\x49\x06\x69\xcf\x4c\xf3\xb9\x45\x45\x0b\xa1\x51\xbd\x47\x1c\x7f\x7c\x2c\x2d\x1d\xce\x9e\xbd\xf8\x8c\x9f\x7e\x2d\x0b\x26\x70\xd2\xdf\xa4\xb7\x81\xc0\x79\x99\x21\x81\x61\x52\x32\xf2\xb0\xb6\x25\x25\x38\x4b\x26\x10\x6f\xca\xc4\x19\x77\x34\x81\x87\x7f\xa5\xfa\x92\xf9\xe2\x1e\xe7\x48\x5b\xb9\x16\x0a\xb7\x9f\x78\x41\x0f\x27\x52\x42\x3c\x67\x64\x49\x28\xca\x0c\x4f\x52\x36\x1e\x0b\x6b\x80\x97\xf8\x4b\x45\x18\x4e\x22\x8d\xf5\x34\x2f\xc5\xe3\x3c\x27\x62\x0d\x65\x5a\xe4\x44\x2b\x11\x8f\x52\x02\xa6\x49\x7b\x3c\x3e\xe3\x3a\x94\xae\x04\xd3\xce\x54\x6a\xca\xeb\x5f\xed\xae\xc9\xad\x0b\x8b\xf6\xa8\x91\xd2\x03\x54\xca\x7f\x80\xd1\xf1\x84\x6a\xc6\xe2\x4b\xb4\xfa\x80\x39\x47\x4b\xfc\xc2\x28\x34\xab\xc1\xd0\xca\x06\xc9\x26\x40\xe3\x9f\x11\x3f\x4e\x12\x22\x48\x41\x51\x36\x14\xef\x36\x1d\xbe\xdd\xcd\x25\xeb\x5c\xd4\xf7\xd0\x8f\x9a\xfd\xd6\x24\xc8\x51\x79\xbd\x8e\x88\x9b\xe1\x30\x1d\xb0\xaa\xe1\xfd\x60\x72\xbb\x0b\x5a\x27\xe1\x4c\xe0\x7c\x14\xa4\x7a\x63\x1f\x4d\xc1\x20\x74\x10\xd5\x77\xe1\xaa\xba\x33\xd7\xde\x41\x77\x3d\x0a\x54\x63\xd2\x0e\x3c\x0e\x26\x7d\x2f\xf7\xe2\x36\x5f\x9f\xe0\x14\x55\x99\xe0\x9e\xb5\xdf\x50\x56\x35\x6e\x24\xa9\x4e\xe4\x3a\x31\xf2\x15\x5a\xc6\x97\x18\x25\xfa\x7a\x86\xeb\x2c\x1f\x6a\xff\x32\x42\x45\x0a\x93\xff\x7f\x99\x40\xa8\x0d\x1a\x94\x18\xe9\x8a\x31\x85\x77\x4e\x12\x8d\xbe\xaf\x55\xfc\xef\x08\x28\xc9\x4c\x2a\x65\x58\x54\x8c\xea\x75\x07\x48\x8b\x6e\xc8\x30\x86\x56\xcf\x52\x31\x94\xbf\xa5\x74\x6a\xaf\x52\x71\x3f\x14\xcc\x2a\x1c\xb9\x55\xc2\xbf\xaf\xd3\xd8\x62\x32\x10\x75\x5a\x21\x69\xbf\xec\xd5\xdf\x75\x05\x92\x52\x17\x22\x8a\xf2\x4e\x92\x96\x11\xff\x54\x74\x95\xa4\xe3\xa6\x4d\x08\x4d\xa9\x51\xaa\xf5\x4d\xef\x54\xa8\xdd\xc9\xe3\x73\xbc\x7a\x5f\xa5\x29\x66\xba\x94\x47\x53\x78\xe7\x51\x17\x75\xa9\xcf\xcb\xfd\xd0\x91\x1a\xe5\x18\x47\x68\xc3\xea\x18\x3c\xfd\x5a\x32\xcc\x39\x29\xe8\x9a\x57\x8f\xe4\x60\x23\x30\xda\x80\x37\x37\xcf\xe6\xd0\xd3\x2e\xd4\xad\x16\x68\x99\x89\xdb\x1b\xfc\x87\xe8\x76\x9a\x48\x1d\xb1\x09\x2b\xca\x0b\xb4\xf8\xac\x0b\x56\x67\xf8\x56\x2f\x74\x4e\xe8\xff\xae\xdd\xb1\x71\xa3\xea\x06\x90\x16\x2b\x48\x0b\x06\x0c\x2f\xab\x0c\x31\xab\x7b\x6b\x5d\xb9\xb3\x35\x7b\x30\xe7\xf8\x60\x67\xb6\xa3\x2f\xeb\x07\x4c\xe3\xbb\xee\xfb\xc8\x9e\xec\x09\xfd\x83\xbf\x1f\x7b\xd5\xdd\x98\x73\x09\xfd\x18\xbf\x21\x8f\x2f\xb7\x09\xf3\x33\xd7\x2d\xb4\x67\xea\xcf\x2a\x78\x76\x51\xb7\x53\xd6\xdf\x57\xd6\xdd\x2b\xe8\x2b\xba\x6e\xfe\xb1\xe1\x58\x18\x87\xcc\x5b\x67\xbc\xe7\x2a\xda\x9a\x09\xc6\x57\x79\xd7\x8c\x2d\x65\xde\xf6\x60\x0f\xaa\x94\xbb\x3b\xe9\xd9\x0c\x3e\xce\x4f\xe6\x87\xe0\xdb\xb2\x29\x70\x4b\x03\xeb\x91\x54\x7f\x75\x85\x18\xe6\x28\xc9\x02\x15\x04\xb3\x19\x7c\xb0\x9e\x13\xbc\x8f\x09\xa2\x18\xff\x94\xe0\xa5\x29\xb2\x75\x84\x11\x98\x48\x9c\x6a\xcf\x16\x2c\xaa\xf3\xf8\x1f\x3a\xb7\x73\x1d\x15\x39\xfa\x8c\xc3\xeb\x9b\x66\xcf\x77\x53\xed\xb8\x0c\xd3\xee\x81\x21\x0a\xec\x66\xae\xf7\xec\x60\x5d\x9d\x5e\x7b\x30\x10\x10\x3b\x0b\xd0\xdb\xbb\xc0\xeb\x78\x17\xf0\x57\x8a\x6f\xcc\xe9\x4b\x7e\x1a\x18\xe6\xd1\x5e\x92\xd2\xfd\xff\xf6\x3a\xf0\x2a\x5e\x07\xda\xd5\xa1\xd4\x34\x6a\x8c\x85\xa3\xf1\x45\xb3\xd3\x68\xcc\x08\x00\xb4\x91\x27\x7d\x45\x75\xd2\xb7\xfe\xb7\x7d\xc0\xef\x8c\x08\xac\xe3\x37\x74\x4c\x8b\xda\x29\xcc\x3e\xd6\x6b\x08\xba\x9a\xe6\x8a\x37\x63\x90\xa9\x2e\x47\x80\xca\x12\xd3\x24\x5c\xff\x9f\xfa\x0c\x8c\xda\x3a\x32\x30\xab\xfb\xe6\xcc\xf6\xb3\xd0\x93\xd4\xa2\xc8\xcb\x82\xd7\x51\xd4\x8c\x9b\x9e\xb9\x6a\xda\x6b\x83\x3a\xf8\xfe\x09\xb9\x63\x61\x2b\xf4\x1d\x78\x3d\x66\x44\xc1\x46\xf8\xb4\x97\xc1\x44\xb0\x8d\x3e\x78\x32\x98\x3d\x36\x76\xfe\xfc\x37\x31\x6e\xfc\xd9\x9c\x62\xdf\xc6\xd8\xb7\x31\xf6\x6d\x8c\x7d\xde\x18\x3b\x18\xd1\xe3\x06\xac\x27\x17\xa0\xbe\x1d\x4d\x7a\xbf\x70\x94\x8d\x28\x42\xae\x7d\x91\x35\xbb\xda\x27\x7b\x89\xca\x93\xac\xac\xbd\x16\x35\xbb\xea\x91\x6b\x70\x34\x94\xb1\xb6\x36\x62\xbb\x4f\x58\x2d\x4e\x6f\xb3\x01\x51\x73\xf2\x43\x41\x17\x48\xd4\xa4\xac\xcd\x8c\xe3\x38\x9a\x6a\x80\x81\x0a\xa4\x3c\x00\x4c\x13\x50\x2a\xf8\x6b\x00\xd8\xc9\x69\x54\xe3\x1e\x00\x00")
 
 func templatesSerializersAllofserializerGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -502,8 +777,8 @@ func templatesSerializersAllofserializerGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/serializers/allofserializer.gotmpl", size: 7467, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xeb, 0x21, 0x72, 0xcc, 0xc6, 0xf2, 0x55, 0x19, 0xde, 0x64, 0xfe, 0xf4, 0xa7, 0xcd, 0x92, 0xd9, 0xd4, 0xdf, 0xe9, 0x53, 0xb, 0xee, 0xae, 0xaf, 0x60, 0x18, 0x73, 0x49, 0x75, 0x9b, 0x2c, 0xff}}
+	info := bindataFileInfo{name: "templates/serializers/allofserializer.gotmpl", size: 7907, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5c, 0xd4, 0x72, 0xfb, 0x3c, 0x50, 0xa, 0xed, 0x17, 0x50, 0xa3, 0x94, 0x41, 0x4e, 0x21, 0xab, 0x3, 0xe2, 0x7f, 0x16, 0x6f, 0x3c, 0xbc, 0xd4, 0xb9, 0xdf, 0x67, 0xe1, 0x58, 0x4, 0x2f, 0x9d}}
 	return a, nil
 }
 
@@ -527,6 +802,46 @@ func templatesSerializersBasetypeserializerGotmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesSerializersDefaultsserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x41\x4b\xfb\x40\x14\xc4\xef\xfb\x29\xe6\x1f\xf8\x4b\x52\x6a\x7b\x57\x7a\x10\x3c\x79\xa8\x60\xd1\x8b\x78\x78\x4d\x5e\xc8\x2b\xe9\x26\xbe\xdd\x34\xd4\x65\xbf\xbb\x24\xad\x95\x42\x15\x3c\xee\xb0\x6f\xe6\x37\x13\x02\x0a\x2e\xc5\x32\x92\x82\x4b\xea\x6a\xef\x56\xac\x42\xb5\x7c\xb0\x26\x88\xd1\xcc\xe7\x78\xb6\x5b\x52\x57\x51\xfd\xb0\x7a\x5c\xa2\xfb\x7a\x39\xf8\x4a\x1c\x9a\xf5\x86\x73\x3f\x45\x2f\xbe\x82\xaf\x18\x47\x23\xec\xa8\xee\xd8\xa1\x29\x47\xb5\xd5\xa6\x65\xf5\xc2\x0e\x7d\x25\x79\x05\x52\x06\xad\x1d\x5b\x6f\xca\xce\xe6\x48\x43\xc0\xec\x89\x73\x96\x1d\xeb\x92\xb6\x8c\x18\x31\x09\x01\x2d\xb9\x7c\x04\xc2\xec\x28\x67\xe7\x48\xa9\x52\x8f\xd7\xb7\xf5\xde\x73\x06\x56\x6d\x14\xc1\x00\x7e\xdf\x32\x42\x40\x4e\x5b\x3e\x3b\xbf\xab\x85\x1c\x2e\x3a\x1b\x60\x47\x8a\x82\x3c\xfd\x7c\x6a\x00\x29\x87\x20\xdc\x2c\xb0\x71\x8d\x9d\x9d\x70\xd2\x03\xc6\xd0\xa5\x55\xb1\xbe\x44\xf2\xff\x3d\x41\x3a\xfc\xc2\xf7\xb7\xfb\xc3\x44\x2f\xe3\x42\xd9\xd0\x68\x8a\xab\x21\x34\xbb\x1d\x7d\xff\x2d\x60\xa5\x1e\x5b\x00\xca\xbe\x53\x3b\xe8\x06\x88\xbf\x85\x2b\xf5\x7f\xf1\x99\x5c\x5a\x7c\x71\x79\x98\x74\xa4\x33\x27\x17\x2b\xb5\x89\x26\x84\x6b\xb0\x2d\x10\xa3\xf9\x1c\x00\xe5\x02\x74\x2b\x4a\x02\x00\x00")
+
+func templatesSerializersDefaultsserializerGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSerializersDefaultsserializerGotmpl,
+		"templates/serializers/defaultsserializer.gotmpl",
+	)
+}
+
+func templatesSerializersDefaultsserializerGotmpl() (*asset, error) {
+	bytes, err := templatesSerializersDefaultsserializerGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/serializers/defaultsserializer.gotmpl", size: 586, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2, 0xfc, 0x0, 0x10, 0x5, 0x98, 0xe6, 0x73, 0x6c, 0x5b, 0xdb, 0x55, 0x2e, 0xb1, 0x8c, 0x5f, 0x9d, 0xd7, 0x47, 0xf6, 0x2c, 0x17, 0xa0, 0x52, 0x48, 0x25, 0xb4, 0xd9, 0x70, 0x27, 0x4d, 0x9a}}
+	return a, nil
+}
+
+var _templatesSerializersEasyjsonserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x3c\x6b\x6f\xdc\x38\x92\xdf\xfb\x57\xd4\x08\x4e\x22\x79\x7b\xe4\xc1\xe2\x3e\x39\xf0\x01\x79\xcd\x5d\x2e\x3b\x49\x90\xd7\x06\x30\x8c\x05\xdd\x62\xdb\x1c\xeb\xd1\xa1\xd4\xee\xf4\x35\xf4\xdf\x0f\xc5\x97\x48\x8a\x52\xab\x6d\xe7\x32\xc0\x3e\xb0\xb1\x24\xb2\x58\xac\x77\x15\x8b\xbd\xdb\x41\x46\x97\xac\xa4\x10\x51\x52\x6f\xff\xe7\xe3\xbb\xb7\x1f\x29\x67\x24\x67\xff\x4b\x79\x04\x6d\xbb\xdb\x9d\x1c\x03\xbe\x86\x82\xf0\xfa\x9a\xe4\x39\xe5\x35\x6c\x58\x73\x5d\xad\x1b\xe0\x74\x99\xd3\x45\xc3\xaa\x72\x0e\x9b\x6b\xb6\xb8\x06\x4e\xcb\x8c\x72\x68\xae\x29\xd4\xa4\xa0\x72\x2a\xa9\xc5\x8b\xaa\xb9\xa6\x1c\x6a\x03\xbf\x86\xe3\x93\xb6\x9d\x01\xec\x76\xbf\xc2\xd1\x8a\x34\xd7\x70\x7a\x06\x1a\x8f\xf7\xf8\x9c\x42\x37\xa0\xd9\xae\x28\x0e\x58\x91\x7a\x21\x10\x84\xf4\x2d\x2e\xd1\xb6\xb3\x93\x13\xf8\x43\xa2\xf7\x4a\xcd\x86\x0d\x67\x0d\xc5\x75\x59\x0d\xbb\x1d\x5c\xaf\x0b\x52\xda\x73\xa0\xa9\x80\x40\x37\x94\xcf\x03\xbb\x9a\x2d\xd7\xe5\x02\xe2\xdd\x0e\xd2\x0f\x74\x41\xd9\x2d\xe5\x7a\xfa\x6e\xa7\x30\x6a\xdb\xc4\x5f\x3c\xde\xc0\xf1\x9f\x12\x6a\xfa\x4f\xf1\x4f\x02\x3b\xb5\x0d\xb6\x04\xfa\x4d\xed\x36\x6a\xd6\xab\x9c\x46\xdd\x1e\x1b\x5a\xac\x72\xd2\x58\xdc\x10\xd3\x3f\xc9\x61\x16\x31\x68\x5e\x53\x17\x14\xc9\xf3\x77\xcb\xfd\xa0\x9e\xc9\x61\xa3\xa0\x32\x56\x2f\x38\x2b\x58\x49\x1a\x9a\x29\x90\x8a\x07\x4b\x46\xf3\xac\xb6\xd9\xf4\xd2\x1e\xfc\xbb\xfc\x9c\x42\xc3\xd7\x48\xe4\x19\xc0\x26\xfd\x40\x36\xcf\xb7\x0d\x8d\x9f\xec\x9e\x24\x06\x12\x5b\x1a\x60\x62\xd8\x92\xf1\xba\x41\xb8\x38\xd3\x8c\xa2\x65\x66\x2f\xcf\x49\x79\x45\xdd\x79\x23\x5b\xfd\x83\x16\x97\x28\xc4\x71\xc6\x16\x0d\x44\x1f\x17\xd7\xb4\x20\x11\xa4\xf2\x0f\x88\xde\xd0\x6d\x04\xe9\x1b\xba\x85\xe8\x6b\x04\xe9\x17\x92\xaf\x69\x62\x2f\x67\x56\xb7\xf6\xd0\x3e\x49\x06\xe9\x46\x38\x27\xdb\xfd\x2c\xf8\x98\xb3\x05\x35\x68\xbd\xca\x69\x11\x41\xfa\xba\xa1\x45\x2d\x11\x71\x24\x2d\x7a\x1d\x41\xc4\x22\x88\xde\x44\x10\xdd\x44\x10\x7d\x89\x20\xba\x8d\x92\x31\xfe\x15\x64\xb5\x1f\x8b\x3f\xc8\xca\xc7\xe1\x59\x96\x31\xd4\x65\x92\xbf\xe7\xd5\x8a\xf2\x86\xd1\x87\x42\x69\x59\xf1\x82\x34\x9d\x38\x09\x8a\x0a\xbd\x7a\x96\x33\x52\xd3\xec\x13\x2a\x77\xdb\x86\x54\x2d\x49\x95\x7a\x21\x67\xe3\x64\x98\xfe\x2b\x14\xda\x86\xdd\x76\x1a\x65\xa4\x54\x08\x04\x87\x58\x3f\x3f\x27\x35\x5b\x88\x35\xd3\x04\xe2\x15\x67\x65\xb3\x84\xe8\x51\x1d\x3f\xaa\x93\x68\x60\x98\x83\x97\xbf\xdb\x7d\xe4\x7e\x77\xf9\x27\x5d\x34\xae\xe2\x49\xf1\x6a\x67\x96\xf9\xb2\x2d\xed\x98\xf1\x62\x65\x53\x09\xe3\x75\xa0\x85\xc2\x29\x71\x02\xf1\xf9\xc5\xe5\xb6\xa1\x73\xa0\x9c\x57\xca\x38\x6d\x50\xfb\x5c\xab\xb5\x53\x54\xf4\x41\xa7\xbe\xbd\x7b\xbc\x41\xae\x70\xda\xac\x79\x09\x9b\xf4\xf9\x9a\xe5\x19\xea\x7c\x1d\x27\x6a\x7f\x9f\xcb\xc2\x9d\x03\x9c\x92\x6c\x6c\x8b\x4b\x5e\x15\xda\x42\xe7\xf4\xfb\xc1\x06\xfa\xd8\xd9\x7f\x6f\xfd\x38\x87\xe3\x3f\x05\xdc\xf4\x1f\xf8\xff\x92\x08\xac\xfe\x54\xad\xfe\x41\x6f\x69\x8e\xd4\xc8\xd3\xd7\xf5\xc7\x86\xf0\x26\x4e\x06\x08\xb1\xee\x83\x9d\xc3\x92\xe4\x35\xc5\x19\x6c\x69\x03\x44\xf8\x00\x79\xfa\xa2\x2a\xeb\x75\x41\x33\x01\xb4\xf5\xe9\x83\x40\x60\x5d\x4e\x90\x01\x41\xa0\x3d\x32\x30\x40\x04\x9c\x15\x67\xa4\x21\x20\x05\x21\x91\x82\x20\x48\x20\x76\x6e\x53\x66\xf7\x92\x34\xe4\x14\x70\xf8\x90\x3c\x7c\xee\x91\xe1\x71\x6e\x49\x44\x9e\xbe\x42\xf0\x42\x18\xf6\x78\x41\xfc\x1c\xd0\xa1\x0f\x94\x64\x87\x7a\xc1\x11\x50\xf7\xf1\x82\x03\x20\x1d\x3f\xb8\x0f\xcb\x80\xa1\x9d\x80\x74\x70\xd6\xe8\x42\x75\xc3\x19\x5a\x9d\xb6\x9d\x4d\x16\x92\xf5\x3e\x4d\x99\xc3\xbf\xe0\xb2\xaa\x72\xa9\x31\xb7\x84\x03\xa7\xf5\x3a\x6f\x6c\x83\x23\x85\xff\x97\x3f\xeb\xaa\x7c\xbb\xce\xf3\x58\x0d\x1e\x32\x91\xb8\x3d\x6d\x21\x7d\x77\x0d\xd1\x27\xc2\xaf\x68\x13\x41\x24\xd7\x89\x20\xfa\x5c\xd3\xb7\x6b\xe9\xdd\x23\xa1\x6d\x11\x44\x1f\xd5\x5e\x31\x80\x50\xd6\x59\xa1\x91\xa7\xef\x6e\x62\x8d\xc0\x71\x88\x02\x67\x6a\x0b\x4a\x21\x87\xc8\x59\x29\x14\x0d\xa7\x7e\x85\xa3\x8c\x2e\xc9\x3a\x6f\x44\x4c\x14\x39\x9f\xd8\x12\x48\x99\x41\xa7\x1c\x2f\xf5\xd0\xde\x2b\x11\x7b\xd4\x10\x97\x55\x03\xe9\xeb\xfa\x39\xa9\x29\x3a\xa7\xc4\xbc\xf9\xb8\xbe\xb4\x5f\xfc\x37\x09\x8c\x09\xb9\x6f\xfd\x55\x12\x67\x60\x04\xfd\x06\x71\x4e\x4b\x48\x85\x5a\x24\xf0\x9b\x22\x5f\x7f\x8f\x67\x80\x1c\x1d\xc4\xdf\xda\xbc\x26\x5e\xaa\x46\xec\x83\xe8\x0d\x73\xc2\x2f\x43\xce\x6e\xda\xfd\x04\x9a\x95\x63\x12\xcd\xc9\x06\x99\xc9\x4a\x11\xa3\x28\x3b\xfe\x0b\x2b\x6d\x29\x92\x86\x4d\x88\xcb\x0c\xe0\xe4\x44\xe4\x37\x0a\x3b\xb8\x95\xdc\x24\x9c\x0a\x27\x27\x63\xdb\x31\x55\x19\x56\x8a\xf7\x84\x37\x75\x48\x27\x14\xbd\xea\xa8\xa3\xa5\xe2\xda\xb8\x80\x5b\xe4\x55\x61\xcb\x43\x1a\x86\xb5\xd6\x4b\x8b\x9c\x6c\x09\x7d\x33\x60\xd1\xef\x7e\x36\xc1\xc5\xd9\x31\x0c\x06\x19\xcb\x38\x08\x63\x21\x08\x15\x90\xb3\xb0\xd2\x77\x51\xfd\x8f\xa6\xd4\x30\x1d\xf6\xa4\x0c\x26\x80\x8d\x8f\x45\xfc\xea\xa0\x97\x40\x84\xa6\x23\x82\xf4\xbf\x2a\xfc\x63\x98\x48\x6e\x3c\x0f\xd1\x8b\x08\xa2\x45\x64\xa8\x35\x9e\x6c\xfc\x3c\xe2\x1c\x92\xc9\xfc\x4c\x4a\xb9\x39\xd0\x8f\xf2\xc7\xa8\x6b\x48\x15\x63\xa2\x29\xc7\x98\x34\x3e\xee\x27\x5b\x49\x68\xf5\x24\x19\xd9\x82\x9b\x61\xfd\xa8\x2d\x84\x23\x87\x01\xbb\x66\x2d\x14\x5b\x09\x1f\x92\x60\x30\xe1\x6b\x5b\x1d\x7a\xab\x8d\xca\x4c\xcc\xfa\x73\x16\x28\x8c\x3d\xe7\x2c\xbb\xa2\xa6\x28\xa6\xb6\x24\xea\x61\x19\xcd\xe9\x15\x69\x58\x79\x85\x65\x25\x5a\x2e\xaa\x8c\x95\x57\x27\xb8\x89\x39\x2c\x2b\x0e\x88\x61\xad\x4a\x64\xe8\x17\xd0\x65\xd7\xeb\xd5\xaa\xe2\x0d\xcd\x02\x79\x8d\x53\x19\x1b\x29\x7c\x19\xd7\xa8\x62\x80\x57\xdf\x15\x48\xcb\x71\x4a\x4f\x78\xe6\x4f\x52\x3b\x7d\x88\xd2\xd9\x88\x20\x38\x72\x30\xb1\x4c\x26\x8b\x03\x48\x3c\x9d\x68\x86\x40\x27\x0f\x98\x5a\x4e\x96\xe4\xcf\xfb\x24\xf9\x67\xa6\x91\x0f\xa8\x8e\x21\x0b\xac\x2d\x8b\x08\xf6\xd0\xa6\x04\x56\xb2\x66\x26\xb3\xbd\x1a\xf5\xbe\xca\xb7\x45\xc5\x57\xd7\x6c\x61\xd4\x6a\x49\x16\x4d\xc5\xd1\x5e\x57\x4b\x20\x70\x49\x6a\x2a\xb4\x47\x40\xce\xe0\x72\x2b\x42\x2d\xb5\x01\xa1\x7c\xd5\x52\xbc\x52\x2a\xc6\x9a\x6b\x58\x75\x70\x61\xd5\x39\x80\x89\x3a\x75\x72\xa2\x75\xdb\xa2\x9a\xad\x18\xc4\x59\xe0\x00\x0d\x09\x83\xed\xab\xc1\x5c\x86\x90\x76\x94\x28\x59\x50\x6f\x58\xb3\xb8\x86\x5b\xc4\x5c\x0c\x49\x63\xc4\x4f\x7e\x5c\x20\xa9\x4a\x96\x9f\x0a\xcd\x17\x4a\x84\x61\x7f\x79\x15\x47\xe5\x3a\xcf\xa3\xc4\x8d\xbc\xba\x82\xaa\x66\xc7\x8b\xaa\x5c\x70\xda\x88\xb4\xa2\xd6\x19\xac\x80\x8a\xf2\x83\xcf\x12\x34\x5b\xc2\x2d\x9c\x9d\x41\xc9\xb4\x34\x0e\x2f\x67\x2d\x08\xe0\x99\x1e\x93\xa0\x15\x73\xa8\x6e\xac\x3d\xb1\xb2\xa1\x7c\x49\x16\x74\xe7\xdb\xa6\xb8\x67\x30\xda\xe4\x29\x4e\x96\x78\x14\xbd\xca\xd4\xc6\xdb\x75\x1b\xb6\x2f\x62\x61\x63\x51\xd6\x65\x98\x53\x9d\xe6\x4c\x12\x02\xaf\x40\x33\x04\xd5\x2d\xc4\xc4\xd6\x57\xa7\x3e\xa7\x92\x0c\x81\x29\x54\x4b\x14\x8e\xd4\x2a\x3a\x54\x5c\x14\xdf\x51\xdb\x33\xda\x50\x5e\xb0\xd2\x78\x1c\x94\x11\x14\xc9\x05\xa7\x58\xb7\xc0\xac\xd4\x20\x23\x80\x0a\x0c\xb0\xa6\x38\x03\xb0\xea\x1e\x15\x17\x18\x20\x67\x90\x5a\xce\x6a\x02\x6b\x61\x03\x74\x48\xf5\xe8\x5b\x34\x80\x91\x32\x62\x08\xea\x17\x5b\x6c\x54\x79\xa8\x64\xb9\x58\x47\xa7\x53\x6a\xa8\x94\x07\x96\x91\x86\xa6\x1f\xe8\xb7\x35\xe3\x34\x53\x12\x36\x69\xd5\x39\x44\x97\x55\xb6\x8d\xe6\xee\x96\x92\xa7\x93\x11\x51\xea\xe6\x4c\x87\x9d\x7f\x1c\x71\x33\x87\x23\xa1\x91\x36\x1a\x3a\x1b\x16\xea\xe3\xa1\x7b\x74\x63\x54\xc9\x4d\x09\x71\xe3\xdf\x20\x5e\xaf\x56\x18\xba\x74\xa6\xe9\x48\x88\x54\x92\xe8\x4f\x47\xb7\x18\xc3\xec\x76\xb0\x20\x05\xb5\x46\xc8\x97\xaa\x1e\x8d\x72\x74\xab\xde\x68\x7d\xb3\x69\x5b\x6f\xc8\x55\x8a\xf6\xdc\xc8\xe0\x1c\x1e\x4b\x5c\x42\x24\x0a\x11\x09\xc9\x64\x7d\x51\xb3\xe7\x38\xc4\x10\xc9\xd1\x75\x0f\x44\xc5\xeb\xf4\x2d\xdd\xc4\xff\xf1\xf7\xbf\xcf\x21\x62\xa5\x60\xf7\x88\x60\x0b\xd9\x3f\x85\x47\xdf\x7a\x3c\xdd\xef\x6b\x74\xbd\x64\xaf\xa3\x81\x8c\x2e\x72\xc2\x69\x26\x8e\x2d\xf1\xc5\x33\x38\x83\xe7\x32\x22\x73\x7c\x43\xdf\x77\x3c\xb0\x97\x08\x2f\x30\xea\x2f\xfa\x53\xa4\x73\x08\x02\x55\x69\x8e\x06\xab\xc0\x84\xac\x60\x1f\xec\xc3\xda\xc3\x30\xfc\xbe\x65\xec\x8f\x73\x6c\xa4\x92\xaf\x75\x39\x61\xbb\x08\x7b\x42\x8c\xe2\x9c\xde\x28\xd1\xe9\x62\x8a\x39\x9a\xcf\x12\xba\x82\xae\x1d\x6f\xb0\x12\xbf\x42\xc5\xf1\x44\x5c\x46\x29\x8c\xc3\x0d\xdd\x9a\x50\xa4\x77\x38\xaa\xa2\xf9\x8a\x43\x6a\x65\xae\x03\xc5\xbd\xf0\xc9\xa9\xb5\x1f\xdb\x4c\xd9\xf0\xda\x76\x30\xa1\x1e\x3d\x39\xd5\x67\xa6\xef\x38\xbb\x62\x25\xc9\xd1\x2a\xb9\x69\xf5\xa3\x3a\x7d\x84\x35\x29\x37\x2e\xb4\x4c\x99\xb2\x64\xaa\x56\xd5\xc3\x95\x2d\x87\x2a\x99\xda\x80\xe1\x94\x23\x8b\xde\xa7\x67\x9d\x6d\x95\x8b\x0f\xaf\x1d\x82\x9b\x5a\xa7\x79\x82\x35\xa7\x67\x50\x90\x1b\x1a\x9f\x5f\x60\xf5\xbc\xbc\x9a\xc3\x6f\x73\xc8\x69\x89\xe2\x67\x2f\x2c\x32\x10\x10\xd9\x9d\x88\x5d\x24\x99\x7b\x83\x94\xf9\x14\xa0\xcf\x80\xac\x56\xb4\xcc\x62\x7c\x9a\xc3\x4d\xa2\xcc\x62\x5d\xf1\x26\x95\xae\xad\x16\xdf\x34\xe0\x7f\xcd\x6d\xd8\xf8\x45\x81\x43\x77\x6c\xb1\x0a\x75\xf7\xb1\x10\x04\x05\x75\x94\xbd\x22\x80\xef\x73\x77\x7f\xd1\xe4\x51\x7d\x7e\x73\x11\xd9\x1b\x4c\x20\x7a\xdf\x70\xeb\xc4\xf4\x7d\xc3\xc3\x90\x12\xef\xdc\xb8\x2b\x9f\x28\xe2\xb7\xb3\xf1\x53\xf7\x29\x7a\xaa\xce\x75\x94\x9a\x16\x82\x36\x26\x41\x28\x49\x41\x33\x61\xd1\xb5\xda\x5a\xba\xaa\xc6\x90\xb2\x2a\xb7\x45\xb5\xae\xf5\xb8\x9c\xdd\x50\xe9\x2a\x31\x3a\x26\x0d\xee\x71\x50\x7b\x43\xaa\xa8\xb4\x4f\x60\x66\xcb\xb0\x92\x74\xb3\x9e\xfa\xf4\x97\x57\x59\x00\x8f\x4d\x6e\x35\xd9\x12\xcd\x57\xc5\x25\xcd\x32\x9a\xd9\xc2\xb9\xdb\xf9\x0b\xb5\x6d\x8a\xdc\xe4\xd5\xea\x3d\x59\xdc\x90\x2b\xdb\x4e\x27\xb3\xde\x6a\xbd\x87\xbf\x30\xa9\xee\x2b\xcd\x9f\xac\x73\x51\x6b\xfa\xb9\xf1\x14\x2a\x00\x65\xd9\xf7\x39\x1c\xa1\x30\xa3\xad\xe8\x91\xc2\xc8\xdb\x11\xcb\xbe\xf7\xa0\xcd\xad\xa6\x1c\x83\xec\xc1\xc6\x43\xae\x7e\x18\xb1\xc4\x1c\x45\xb1\x90\x19\x11\xdf\x7d\xb3\xe1\x5b\x8d\x1e\xda\x58\x4b\xb3\x2d\x90\x6c\xac\x69\xdb\xce\xa2\xb2\x86\x16\xae\xc1\x76\x51\x94\x12\xc9\x96\x5e\x3d\x2d\x18\x7d\x38\xe1\xb6\x89\xc6\xed\xaf\x65\xd6\x39\x81\x01\xb2\x1f\x44\xe9\x54\x88\x64\x84\x9b\x88\x82\xc6\x77\x1f\xc5\x7a\x54\xb3\xb0\xba\x98\x28\x99\x5a\x85\x94\xa1\x25\xca\xd4\xa2\xa5\x25\x25\xc8\x63\x52\xd9\xae\x21\xac\x6a\x55\x20\xba\xab\x66\x0b\xd5\x0a\xb9\x82\xe3\x58\x53\x6b\xfb\xbb\xd5\x06\x15\xd1\x3a\x5a\x35\xdc\x69\x03\x6c\xb8\x69\xd9\xea\x06\x95\x55\xf3\x4a\x00\xb4\x4e\x5d\x95\x94\xeb\x89\xef\x0a\x86\x0d\x47\xee\x64\x7f\x7a\xb7\xce\x5b\xfd\x4a\x8f\x17\x88\xa4\x5f\xa1\xed\x11\x4c\xab\x93\x81\xa2\xcf\xbb\x77\x3b\xe7\x25\xec\xfc\x99\xc6\x36\xbe\xa1\x5b\xcf\x2c\x6a\x3c\xb0\x1f\x4d\x34\xa5\x49\xf3\xa7\xd6\xc2\x94\xbd\x03\x2d\x50\x53\xb4\x17\x55\xda\x55\x25\x4a\x26\xc0\x6a\xb8\x29\xab\x4d\x29\xca\xc1\x4d\x05\x97\xa2\x20\xa4\xc9\xab\x36\xff\xdd\x8e\x99\xf4\x39\x88\xda\xa7\x59\x10\xcf\x84\x0d\x4a\xac\x34\x64\x4c\x20\x2a\xaa\x8c\xe6\x8a\xe6\x16\xd0\x33\x0f\xc8\xdd\x8d\x89\xe6\x00\x0a\xfa\xd1\x77\x25\xe4\xe2\xe4\x6e\x8a\x31\x30\x8e\xe8\x5e\xeb\xa6\x5f\xd5\xba\x82\xd6\x13\x96\x1d\x17\x0e\x6f\xd8\x14\x1d\x53\x38\x1a\xa0\x47\x37\xc8\x06\x4b\x33\x6c\xb6\x38\x6b\x57\x78\xee\xf1\x4d\x4d\x88\x4c\x31\x2d\x4a\xec\xd7\x28\x8a\x7a\x03\x03\x05\xf7\xaf\x3a\xc6\xf5\x0f\x83\x24\x04\x2b\xdf\x53\x68\x06\xb3\x2e\x49\x54\x2f\xf9\xda\x08\x99\x4f\xbf\x5a\x52\xae\x17\x48\xdf\x37\xdc\xd6\x28\x31\xc8\x2d\x37\x86\x8b\x8d\xad\xe4\x7d\x57\x9f\x71\xb0\x75\xa5\x56\xc3\x1d\xaa\x17\xda\x08\xa1\xee\x59\x84\xd3\xc7\x78\x5c\xd0\x53\xcd\xfb\x5d\x9f\xed\xc9\x28\x5e\xef\x5a\xd1\xd7\x52\x7c\x85\xf5\x66\x0e\x32\xbb\x88\x8f\x1d\x4a\xbb\x2b\x87\x16\xd5\x10\xbb\x0e\x4a\x7b\x1f\x5d\x8b\xe4\x10\x24\xbf\x49\x32\xd4\x26\xe9\xf1\xcc\xb8\xf4\x63\x74\xe8\xe9\x57\xb3\x2b\xb3\x82\xf5\x7c\x67\x8d\x8b\x7b\x26\x29\xe9\xa9\x7e\xfa\x5a\x68\x7e\xfa\x46\x28\x7e\xfa\x25\x09\x1a\x9c\x76\x36\xb4\x79\x5b\x08\xc6\x45\xe0\x61\x05\x60\x98\xfd\x13\xf4\xcc\xe7\xfd\x3e\xce\x4f\xe3\xfb\x5e\xae\x6b\x6b\x1e\x06\x57\xcb\x8e\x84\x7d\x86\x36\xd8\xb8\xa0\xd6\xb1\x5a\x9e\xbf\x8e\x71\x37\x8c\xc0\x5d\x9b\x9c\xd5\xe2\xa1\x14\x75\x2a\x2e\xd3\x6d\xb8\xda\xfe\xbd\xad\x99\x15\xa2\x9d\xab\xc0\x11\xcb\x03\x08\xef\xb5\xa8\x74\xe3\x5f\x5f\xd0\x4e\xda\x71\xad\xe0\xa0\x82\x60\xd6\xc7\xf1\xf0\x9f\xf0\x9b\x79\x1f\x0c\x4b\x75\x65\xf7\x2e\x3a\x8d\x5c\x56\x2d\xf5\x4a\x81\x8d\x96\xe0\x27\x34\xef\x29\xfe\x21\x03\x55\xa3\xf6\x8f\x6a\x86\x64\x4f\x04\xdd\xad\xb7\x37\x28\x11\x89\x10\x09\xeb\xed\xad\x60\x8a\xe2\x8a\xc6\xd6\xda\xc8\x85\xd8\xc8\x34\x2e\x89\x06\x12\x15\x4e\x89\x7c\xdf\x3b\xc0\x2f\xc8\x4a\x77\x73\xe1\xe5\x16\x9a\x4d\xa8\xec\xdd\x87\xdb\xc8\xcc\x37\xd0\xb6\xe3\xb5\x28\xdb\x69\x68\x61\x78\x33\x2e\x02\x1d\x60\x53\x89\x32\xaf\xe6\x06\x40\x62\x51\xd4\xa9\x4c\x99\xb1\x89\x4f\xed\xdd\x13\x17\x8f\x4e\x28\xfb\x18\xe1\x9b\xfa\xbe\x52\x19\x30\xa8\x1a\x76\x32\xeb\xcd\x3f\x7d\x92\xdc\x5f\x9a\x2d\xe1\x3b\x7f\x54\x5f\x08\xab\x25\x04\xf3\x67\x89\x78\x3b\x4d\xc4\xed\x3e\x3a\x93\x33\x50\xaf\xf6\x65\x65\x6d\x05\x69\x16\xd7\x34\x0b\x68\x02\x64\x55\x77\x8a\x8e\x2d\x08\x2f\x69\xce\x0a\x97\xfb\xbf\x60\xb3\x83\x7a\xdf\x3e\xe9\x5a\xb7\x30\x9d\x46\xa9\x5f\x98\x16\x4f\x10\x05\x39\x7d\xb6\x88\x58\x2a\x5e\xe6\x9a\x59\x37\x74\xab\x3f\x8b\x23\x20\xcc\xaa\x63\x9c\xe4\x24\x4c\xf8\xb6\xd6\x76\x5d\xf1\xdf\xb8\x8c\xb6\x1d\x83\x95\xa7\x9f\xcb\x9a\x2c\xb5\x10\x25\xd3\xe0\xea\x30\x03\xff\x9b\xa7\xff\x24\x65\xf3\xa2\xca\xab\x32\xd6\x68\xab\x53\x43\xc4\x7e\xe7\x16\xa9\xb4\xef\x79\x88\x5a\x20\x40\xf8\x70\xd1\x2d\x5f\xe9\x73\xc6\x61\xd9\x37\x1d\x1d\x7d\xd1\x1f\xa8\xe7\xc8\xd6\xcb\x40\xd9\x6b\xa8\x1c\x61\x75\xd1\x1d\xa5\xe6\x61\xb4\x8b\xae\xc3\xd8\x22\xb7\xf3\xd8\x7b\x70\x69\xfc\xef\x46\x2e\x87\x1c\x88\xbe\xea\x0a\x3e\x1d\xd1\x3f\x14\xdf\x67\x59\x26\xef\x66\x2c\x8b\x46\xde\xd2\x58\xc6\x11\x6a\xc9\x29\xac\x4b\x59\x4b\x10\xd7\xed\xe4\xa1\x2b\x2a\x9f\x1b\x63\x3a\xc0\x3e\xde\xb0\xd5\x07\xba\x58\xf3\x9a\xdd\xd2\x38\x09\xa1\xe5\x2b\x4e\x51\x10\xa5\x38\x9e\x51\x99\x50\x24\xb5\x1a\xa4\x95\x61\x33\x66\x4c\x35\x5e\xe3\x81\x63\x5d\x15\x7e\x6b\xb6\x2a\xff\x8b\xfe\x0c\xec\xf6\xb6\x8b\xfa\x9a\x58\xba\xc1\xda\x29\x1d\xe2\x99\x61\xe7\xcd\xce\x2f\xe4\xd1\xe4\x4e\xfe\x13\xfb\xa2\x65\x81\x48\xe6\xc0\xc9\x46\x7b\x62\x71\xc5\xe6\xf1\xe8\x1d\x1b\x9b\xbc\x7b\x26\x21\xe0\x3e\xa9\xc3\x4d\x94\x63\x62\xad\x9d\x84\x2f\xd7\xca\x02\x1e\x78\x0d\xa3\xeb\xb4\xee\x98\xdb\x35\x1f\x98\x1b\x41\xc1\x4e\x03\x56\x76\x72\x49\x39\x1f\xe8\x24\x9a\xe4\xf9\xcc\x89\xd0\xbe\xf6\x38\x4f\x3d\xff\x9f\x6e\x0e\xb8\xfd\x1f\x7d\x1c\x5c\xdb\x16\x72\x1c\xfa\xf2\x87\xed\x3c\x64\xb7\xd0\x20\xc0\x49\xe2\x04\x0a\xaf\xe1\xd3\x99\x3d\x5d\x8b\x0f\xcf\x6e\x00\x4f\xca\x7b\x0f\x6c\xe9\xbb\xef\x8e\x1c\x13\x0e\xfb\x30\xd1\xe7\xf4\x6a\x9d\x13\x6e\x0d\xbf\xc7\x85\x1e\x83\xd8\x9d\x6f\x77\xc4\x63\x17\x6e\xba\xe0\xd0\x33\x18\xf7\x59\x31\xea\x3c\xf0\x30\xb1\xf7\x5d\x32\x99\xa2\x99\x81\x0c\xfc\xe1\x14\x35\x1f\xd3\xd3\x09\x5a\x17\x36\x9f\xe8\x55\x14\xb6\x22\x34\x45\x4c\xfb\x4b\x61\xe4\x27\xf3\x35\x3d\x6f\x2c\x28\xec\x76\xff\x93\x22\x15\xa5\xe7\x18\xad\xdc\x29\x48\x31\xa6\xff\xb0\x00\xa5\x73\x0c\xa1\x63\xbb\x3e\x55\xb8\x23\x33\xc3\xd4\xc7\x18\xa5\x47\x7e\xe4\xb8\xe9\x91\xb2\xac\x84\xd8\x0e\xb4\xed\xb1\x39\xaa\xb3\x32\x00\x59\x06\xeb\x30\xbe\x1b\x9d\x23\xb1\xee\xe0\x59\xdd\xef\x9c\xd6\xd7\x91\xe8\x18\xb8\x03\x55\x95\x99\xed\x4c\x75\x5f\x98\xdd\xf2\x83\x6b\xd8\x43\xa3\x65\xc5\xa1\x20\xab\x73\x49\xc4\x8b\xbb\x50\x4c\xdb\xf0\x76\xb6\x7f\xc9\x73\xe4\xd8\x05\xa8\xee\x5f\x47\x2a\xb4\x09\x39\x28\xdd\x9c\x92\x5a\xee\xc9\xdb\x26\x67\x9d\x18\x26\x3c\x35\x5a\x6d\x54\x37\x8a\x3a\xed\x74\xe5\x16\x5d\x24\x22\xa7\x17\x15\xc3\xf1\x85\x3b\x41\x89\xff\x16\x87\xdf\xd0\x6d\xd2\x0f\xea\xf1\xd1\xf7\x6b\x58\xa3\xd2\xe9\x3b\xa7\x57\x84\x67\x39\xad\x45\x7e\x8f\xcb\xcc\xe1\x72\xdd\x40\x55\xe6\x5b\xa0\xdf\xc9\xa2\x11\xf9\xb7\x9c\x94\xd3\x65\x03\x15\x7e\x5d\x86\x7b\xd9\xcc\x82\x3a\x02\xce\x4d\x88\x63\x84\xd0\xb9\x66\x2b\xff\xa7\x00\x6c\xf7\xc5\xbb\xc1\xad\xeb\xc9\x0e\x05\xbc\xac\x45\x8f\xd1\xf1\x85\x3d\xcc\xda\xc8\x01\xcb\x5b\xac\xea\x00\xb8\x3c\xf3\x70\xe8\x86\xf5\xb1\x68\x67\xee\xbf\x93\xd2\x9e\xbb\x5f\x5c\x9e\xe0\x76\x5f\x06\xae\xb5\x1f\xf4\xd3\x2e\xc2\x2e\x59\xf3\xac\x46\x5c\x2a\xce\x27\xbd\x01\x81\x1f\x6d\x31\xe1\x94\xdb\xe7\xdb\x99\xb4\x00\xe0\x33\xeb\x37\x65\x3c\x6f\x62\xef\xfa\xaf\x10\x3b\xa8\xcd\x79\x1b\x10\xa8\xa2\x1f\xb2\x5e\x57\x5c\x79\x2a\x77\x17\x43\xe1\xc7\x41\x26\xf0\xce\x15\x2f\xcb\x7c\x1f\x54\xde\xf2\x58\x3c\x10\xc5\xc8\xd6\x86\xd3\x29\x52\x30\x44\x06\x65\x89\x9c\x49\xc3\xa6\x1e\xc0\x0b\x91\x71\x45\xeb\xe6\x91\xbd\xde\xb0\x87\x77\xae\x2a\xa5\xe1\xb0\xfb\x8e\x51\x82\xce\xaf\xcd\x8f\x10\x05\x43\x05\x7d\x9e\xe8\xc4\x08\xa8\x12\xfb\x43\x04\x47\xb2\xc6\x0a\x45\xc3\x75\x9c\x3b\xda\xb0\x11\x4d\x60\x4b\x8f\x83\xbf\x9c\x85\xa3\x84\xe0\x55\x00\x63\x15\x4f\x8e\xe1\x6d\xd5\x98\x0b\x60\xb0\xa1\x4f\x38\x85\xbc\xaa\x6e\xf0\x14\x76\x59\xf1\x14\x8e\x4f\x66\x9e\xc5\x7e\xd0\xab\x07\xf6\x5e\x0d\x61\x7f\x90\xf1\x76\xa4\x50\xd7\xbc\x3a\x2f\xeb\xdf\x98\x93\xf7\x7d\x54\x1d\x0c\x1b\xae\x5c\x8a\x7b\x15\x2f\x55\x39\x7d\x5d\x3f\xc3\x1b\xef\x9d\x80\x0c\x5c\xe1\xd7\xd2\xae\x85\x56\x6c\x48\xdf\xfc\x00\xf0\xce\xb4\xbc\x30\x5c\x5c\xcc\xf4\x0e\x9c\xad\x05\x2d\x61\xd2\xe7\x9d\x00\x21\x83\x77\x61\x19\xbc\xf1\x00\x05\xa0\xcf\x95\xee\x3f\x02\x29\x73\xd9\xc9\xb8\x04\x57\x12\x35\x81\xf0\xdc\x3a\x78\x99\x40\x43\x03\xe8\x0b\x9c\xff\x55\x91\xc1\x1c\xc4\xe9\xca\xa4\xba\x82\xa1\x87\xd9\x8a\x37\xa4\x7e\xfd\x51\x86\x7a\x17\x4f\x92\x61\x66\x89\xa5\xba\xd0\xae\x9d\xf5\x0d\x9a\x92\x0d\x51\x64\x52\xdb\xd7\x57\xb1\x1e\x58\x40\x1c\xf5\xd9\xcf\xcc\x21\x56\x1e\xc2\xc8\x7d\x2c\x1c\x63\x60\x88\x94\xd6\x80\x83\xa8\xd7\xf6\x09\x60\x3d\x4c\x30\x0b\x5d\xa3\xf0\x8f\x8f\x7f\x82\xac\xb6\x8a\x9a\x3d\xd5\x45\x95\x65\xc8\x8c\xdf\x9e\xfa\xaa\xfb\x14\xd8\xdf\xfe\xa6\x40\xa8\xb0\x82\xf9\x41\xc5\xde\xe6\x66\x1d\x65\xa8\xee\xe6\xd3\x99\x67\x6a\xec\x08\x47\xf0\x5b\xf4\x15\x77\xf4\x3f\xd4\x5f\x77\xad\xce\x6e\x66\xaf\xba\x94\x0e\x77\xcf\x30\xd8\x83\x1c\xea\x95\x36\xbf\x7a\xe0\xe5\xe4\x7a\x57\x8f\x4d\x5e\xae\x65\xd2\x12\x20\xcf\xdf\xef\xe9\x97\xd6\xd4\x39\xc2\xee\xdd\xda\xee\x1a\x35\x67\x81\x0e\xd2\xbd\xea\x91\x0b\x46\x6b\x82\xd3\x55\xad\xbe\x3b\x0b\xed\x5d\xc7\x6d\xb4\x76\x08\xe9\x6c\x55\x4a\x01\x62\xff\x13\xca\x3e\xa3\x1d\xda\x83\x55\x9f\x43\x44\x86\x29\x56\x39\xdd\x1c\xe6\xad\x6c\x74\x4f\x02\x46\x7d\xd2\x51\x5d\x3b\x0b\x39\x1b\x47\xbf\xd1\xb9\x4c\xb2\x4f\x8a\x4e\x77\x68\x65\x0d\xb5\xb1\xea\x01\xba\xbb\xc6\x44\xfe\xaf\xf5\x10\xfd\xe3\x04\x86\xb0\x7e\x5b\x80\x03\x19\xa7\x2b\xa0\xbd\x9f\x38\x78\xac\x97\xd9\x0f\xb0\xeb\x5b\x35\xd8\xa7\x82\xcd\x4e\xd7\x85\xdf\xa8\xdd\x6b\xd2\x0e\xa7\x3c\xd6\x6e\x4b\xba\x89\x43\xc1\x93\xd7\xf4\xd9\xb6\x43\xc6\xda\x86\x25\xc2\x35\xc7\x0f\x0f\x36\x2e\x1d\x80\x44\xc0\xa1\x4d\xef\xc8\x0d\xf8\xa3\x30\xf1\x87\xf8\xe9\xf7\x4b\x76\x6c\x35\xa7\x28\xf2\x37\x71\xf4\x92\x87\xb5\xcb\x9a\x86\x5d\xf9\xe3\x33\xb6\x9c\xf7\x9b\x28\xdb\xd6\x85\x6d\x3d\xdf\x35\x47\xdc\xdb\x27\x6b\xe8\x14\xd9\x34\x73\xba\x19\x45\x15\x39\x7d\x61\xec\x89\xc3\xaa\x76\x36\x44\x8c\x60\xfb\xec\x64\x7e\x4d\xe3\xd6\x00\xaf\x8c\x22\x26\xb3\xa9\xac\xda\xab\x49\xd3\xb8\x77\x9f\x1e\xd8\xc1\xdf\xee\x0a\xb6\xc0\xe2\x9a\x96\xaf\xc0\xc7\x70\xde\x3f\x8d\xad\x77\xeb\x9b\x1d\xf8\x45\x2d\x85\x70\x17\x27\x04\xda\x66\x7f\x04\xfe\x93\x63\x60\xd3\x6a\x3b\xd8\xc6\x89\xcd\x96\x98\x71\xd3\x06\x7f\xdb\x88\x82\x60\x9f\x65\x76\x0f\xb5\x94\xf8\xf5\x85\x6a\x6f\x14\x67\x26\xf8\x42\xc9\xcd\x1c\x7e\x4b\x94\x03\xf5\xf2\xd8\xb1\x0c\x16\xa3\x15\xd3\xc9\x6b\x49\x27\xf2\x40\x00\x16\x4d\x85\x5a\x69\xef\x60\x46\x0e\x68\xcd\x1d\x8a\x50\x3c\xde\x4d\xec\x62\x14\x92\x69\xbd\xc5\x22\xda\x8b\xee\xf8\xda\x22\xa6\x1d\xcb\xa4\x2f\x8c\xe7\x45\x92\x24\xb3\xa1\x0c\xb8\x9d\x05\xb3\x5e\x57\xd7\x05\xb4\xa9\x7d\x23\xfb\x7a\x82\x7d\x61\x2a\xc8\x4a\x34\x07\xc8\xdf\xb8\x57\xc9\x3d\x1e\xad\x14\x94\x5f\x89\x8e\xe1\xa6\x02\xd6\xdc\x43\xdc\xa6\x39\x66\x5f\x10\x83\xf4\x99\x52\x3e\xd6\xed\xb4\x87\x9e\xa2\xfd\x9b\xcb\x30\x8a\xdb\xb9\xa6\xdd\x85\x12\xbc\x2f\x52\xf0\x0e\x2a\x9e\xee\x76\xbf\x02\x2d\x33\x68\xdb\xd9\xff\x0d\x00\x3d\x63\x75\xbe\xa5\x61\x00\x00")
+
+func templatesSerializersEasyjsonserializerGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSerializersEasyjsonserializerGotmpl,
+		"templates/serializers/easyjsonserializer.gotmpl",
+	)
+}
+
+func templatesSerializersEasyjsonserializerGotmpl() (*asset, error) {
+	bytes, err := templatesSerializersEasyjsonserializerGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/serializers/easyjsonserializer.gotmpl", size: 24997, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x13, 0xc3, 0x9d, 0xf3, 0xff, 0x2f, 0xce, 0x24, 0xc, 0x3c, 0x57, 0xd3, 0x39, 0x87, 0xa9, 0xf4, 0xb9, 0x24, 0x94, 0x2a, 0xe3, 0xec, 0x2d, 0xd0, 0xeb, 0x6b, 0xe7, 0x4a, 0x3f, 0x81, 0x59, 0xbd}}
+	return a, nil
+}
+
 var _templatesSerializersMarshalbinaryserializerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x90\xc1\x4b\xc3\x30\x14\x87\xef\xf9\x2b\x7e\xee\x20\xcd\xa8\xdd\x5d\xe9\xc5\xa3\xe0\x84\x0d\xf1\x20\x1e\xd2\xf6\x57\x0d\xb4\xd9\x78\xcd\x26\x33\xe4\x7f\x97\xb4\x22\x9b\xf4\xea\x25\x87\xf7\xc2\xf7\xf8\xbe\x10\xd0\xb0\xb5\x8e\x58\xf4\x46\x86\x0f\xd3\xdd\x5b\x67\xe4\xb4\xa5\x58\xd3\xd9\x2f\xca\x02\x31\xaa\xd5\x0a\x8f\xe7\x6b\x58\xe7\x29\xad\xa9\x09\xdb\xef\x3b\xf6\x74\xde\x78\xbb\x73\xaa\x3d\xb8\x1a\x59\x08\xc5\x86\x35\xed\x91\xb2\x36\x3d\x63\xc4\x32\x04\xec\xcd\x50\x8f\x50\x14\x69\x8a\x18\xf5\x25\x36\xd3\xc8\x5e\xdf\xaa\x93\x67\x0e\x8a\xec\x44\x23\x28\xc0\xb6\x08\x01\x17\x44\xc4\x88\xb2\x84\xb3\xdd\xf8\x03\x10\xfa\x83\xb8\x34\xc8\xd3\xa3\x80\xa8\x7e\xa7\xc3\xa7\x79\x2f\x5e\xc4\x7a\x3e\x6c\x9f\xd6\xd9\x0c\x4c\xab\xa8\x92\xe5\xb3\xeb\xff\xc7\xf3\x0f\x38\xab\x30\x89\xea\x49\x74\xb4\x38\x1a\x81\x70\xc0\x2c\x62\xea\x40\x11\xdc\x96\x93\xd0\x86\xa6\x19\x7d\xaa\x1c\xd7\xc2\x41\xdf\x8d\xeb\xab\x99\x2c\x14\xf9\x29\xb2\x9c\x2d\x99\xce\xaa\xf3\x88\x2a\xaa\x10\x6e\x40\xd7\xa4\xd3\xdf\x01\x00\x00\xff\xff\x22\x3a\x4f\x6c\x26\x02\x00\x00")
 
 func templatesSerializersMarshalbinaryserializerGotmplBytes() ([]byte, error) {
@@ -707,7 +1022,7 @@ func templatesServerOperationGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x6d\x73\xdb\x36\x9a\x9f\x57\xbf\xe2\x59\xdd\x35\x43\x7a\x64\xb2\xb7\xd7\xd9\x0f\x6e\xdd\x99\xc4\x76\x1b\x4f\x9b\x97\x8b\xd3\x7e\xb8\x6c\x66\x0b\x8b\x90\x84\x0d\x49\x30\x00\x64\x47\xa7\xe1\x7f\xbf\xc1\x1b\x09\x90\x20\x45\x25\x6e\xda\x4e\xb7\x1f\x1a\x91\x00\x81\xe7\x05\x78\xde\x01\xef\xf7\x90\xe1\x15\x29\x31\xcc\x6f\x49\x99\x55\x8c\x14\x44\x90\x3b\x5c\x21\x86\x8a\x39\xd4\xf5\x7e\x9f\x9e\x00\x2a\x01\x17\x95\xd8\x81\xc0\x5c\xe8\x0f\x88\x20\xb4\x04\x41\xf5\x2b\x81\x8b\x2a\x47\x02\x03\xc3\x15\x85\x0c\x57\xb8\xcc\x70\xb9\x24\x98\x03\xc3\x9c\xe6\x5b\xd5\xfb\x14\x2e\x5f\xc0\xf3\x17\xaf\xe1\xe2\xe9\xe3\xe7\xdf\x5f\xc1\xeb\xa7\xd7\x37\x70\x92\xd6\xf5\x6c\xbf\x07\x5c\x66\x50\xd7\xb3\x99\x0b\x11\xcd\x76\x77\x28\x27\x19\x12\x94\x49\x60\x66\x00\xfb\xfd\x29\x90\x15\x24\x4f\x11\x7f\x46\x33\x9c\x3f\xa1\xd9\xee\xa5\x04\x96\xeb\xf6\x34\x05\xf3\x09\x06\xf9\x3d\xd0\xdb\x7f\xe1\xa5\x50\x68\x64\x38\xc7\x6b\xd9\x60\x7a\x18\x0c\x0a\x39\x8e\xe9\xa7\xc1\x01\x39\x05\x66\x0c\xce\xce\xd5\x20\xc9\xcf\x66\xc8\x88\xd1\xad\xc0\xc9\x77\x94\x15\x48\xf0\xf8\x6b\xd5\xe9\xaf\xe7\x50\x92\x1c\xf6\x33\x00\x90\xe8\xc2\x39\xa0\x4a\x52\x20\x62\x98\x2f\x64\x97\x78\x06\x50\xcf\xf4\xb0\x39\x2e\xe5\xfb\x18\xce\xcf\xe1\x4b\xf3\xd1\x7e\x0f\xc9\x2b\xbc\xc4\xe4\x0e\xb3\xe7\xa8\xc0\x50\xd7\xc9\x7e\x0f\x15\xe2\x4b\x94\x93\xff\xc3\x90\x98\xb7\x70\x2e\xfb\x92\x15\xa0\x32\x83\xa8\xa4\x02\x92\x9b\xe5\x06\x17\x28\xb9\xe6\x4f\x10\xc7\xaf\x77\x15\x8e\x21\xb9\xe6\xcf\xb7\x79\x8e\x6e\x73\xf9\xcd\xa3\x86\xb8\x12\x15\x05\x89\x26\x23\xce\x39\xb6\x63\x49\x7a\xde\x90\xa2\xca\xb1\x43\x50\x8f\xc8\xd7\x02\x6b\x1a\x1b\x88\x15\x1b\x28\x6b\x00\x90\x03\xe4\x64\x89\x7f\x6e\x68\xcb\x5b\xe0\xe4\xb7\xb2\x87\xdb\xd8\x63\x17\x62\x0c\xed\x80\xae\x5c\xbe\xf1\x66\x36\xb3\x3e\x9c\xc9\xc7\x66\x36\x3d\x3f\x37\x69\xcd\x8c\xcd\x76\x98\x73\x09\x98\xda\x4c\xce\x42\x4e\x5c\x44\xec\xba\x77\x10\x53\x0c\x19\x23\x9d\x02\x2f\x72\x88\xaf\x7b\x5d\xf3\xeb\x52\x60\xb6\x42\x4b\xdc\x6b\xb9\x11\x0c\xa3\x22\x8e\xf5\xd4\x2b\xca\x14\x65\xae\xcb\x0c\x7f\xf8\x19\x31\x89\xff\xd9\x39\x30\x54\xae\xcd\xb6\xd9\x37\xd8\x78\xb4\xb6\xc3\x39\x44\x50\x1d\x89\xe6\xda\x9b\xce\xa0\x6f\xe5\x2a\x6f\x37\xc7\xe0\x80\xaf\xf0\xfb\x2d\x61\x38\x6b\xf9\x36\xb0\x93\x28\x6b\x3b\x47\x72\xb2\x8b\x0d\xc9\xb3\xe4\x25\x12\x1b\xa8\xeb\x85\x44\xaa\x62\xa4\x14\x2b\x98\x7f\xf1\x7e\x6e\x9b\x7f\xa4\x4b\xbd\xdb\xeb\x3a\x8e\x9b\x09\x6e\x19\x46\xef\x3c\xb8\xd4\x8e\x68\x40\x58\xd2\x52\x90\x72\x8b\xfd\x2e\xed\x32\xac\x67\xc1\xd7\xbe\xe8\xe8\x51\xe4\x08\x59\x32\x22\x4d\x7c\xf8\x6b\x2b\x5f\x1c\x02\xbb\x2b\x78\x70\x87\xfc\x6e\xe4\xd1\xc0\x2e\x6f\x19\x92\xa6\x50\x52\x57\x70\xcb\x25\x4c\x94\x4c\x22\x25\x88\x0d\xe1\xa0\xf6\xda\xec\xf3\x6f\x79\x7f\x17\x1f\x2f\x59\x9f\xa1\x6a\x4c\x02\x1c\xda\xfb\x8f\xb3\x4c\x29\x63\x94\xbf\x64\xb4\xc2\x4c\x10\x1c\x16\x05\x03\x1d\x7d\xc9\xe0\x0a\xe4\x02\x55\x01\x71\x6c\x65\xc7\x0f\x78\x77\x8c\xe4\x08\xce\xde\xdd\xf7\xed\xde\xb1\x40\x78\xbb\x5d\x0e\xe7\x6d\xf8\x9e\x04\x90\xdc\xd1\x6b\x66\x3e\x6f\x38\x35\x41\x2c\x2c\xcc\x4a\x50\x8b\xe0\x9a\x3f\x2e\x69\xb9\x2b\xe8\x96\x9b\x39\x0c\x0e\xdf\x53\xb9\x24\xa0\xae\x23\x6f\x15\xbc\xf1\xc8\xf1\x76\x70\xa4\xb8\xf9\x6a\x68\xb7\x8b\x2d\x2b\x65\xcb\x98\x7c\x09\x2e\x92\x01\xde\x36\x0b\x37\x32\xe0\x3c\x43\x95\x7c\xf7\xe2\x0e\x33\x46\x32\x1c\x87\xe4\x77\x8b\xc9\x41\xe9\x3d\x89\xa9\xd3\x85\xf9\xa0\x18\xff\x38\x01\xfe\x29\x22\xfc\x0e\xe5\xb0\x00\xfa\x0e\xce\xce\x03\x84\xf9\x5a\xb6\xf4\xa8\xa2\x18\x72\x04\xd1\x9d\xa9\xfe\x1a\x22\xb4\xc7\xf4\xce\xe6\x38\x4a\x8d\x4c\x50\x26\x21\x8a\xd6\x0f\x81\x60\x3d\x46\xfb\xdf\x97\x51\x3c\x49\xe7\x28\x55\x53\xa0\xea\xb7\x55\x34\xbe\x9e\x09\xea\x18\xdf\x58\x6e\x67\xbe\xe6\x8f\xa5\xa1\x1d\xbb\xdc\x8b\x87\xb4\x4d\x4f\x27\x90\x32\x97\xbe\x99\x81\xe9\xf3\x52\xa0\xdd\x00\xa1\x79\x2d\x88\xfe\xfc\xd7\x97\x50\xd7\x92\x2a\x9f\xe4\xba\x79\x6b\xc3\x62\x21\x75\xb0\x21\xe6\xa7\x90\xd1\x10\xa6\x19\xaa\xae\x95\x23\xd4\x6a\xb2\x02\x55\xad\x7f\xfc\x27\xa5\xf9\xc0\x86\x64\x56\xd5\xd0\xf2\x37\x5d\x99\xcd\xde\xf4\xb7\x66\x57\x63\x7b\x66\x59\x18\x23\x5a\xc2\x1a\x97\x98\x91\x25\x10\xdb\xf5\x37\x40\x27\x20\x6b\xf4\x83\x6b\xec\x3a\xa1\x9a\x90\x9f\xab\xe3\x3a\x26\x4a\xf0\x8c\x94\x3a\x82\x90\x3c\x43\x1f\x9a\x58\x82\x91\xf3\x4b\x54\x60\x0f\x8b\x1b\xf9\x70\x76\x2e\x49\xf0\xf7\xaf\x22\xa9\x1d\xba\x48\x75\xa4\xd8\x53\xc4\x2f\x09\x5f\x32\x52\x90\x52\x4e\xde\x4a\xb7\x86\xe0\xed\x2b\x63\xf1\x76\xa8\x70\xd2\x50\xa1\x9d\x4b\x1b\x8a\xc6\xe6\xde\x20\xfe\x92\xe1\x15\xf9\x00\x52\xf9\x6e\xf1\xd5\x87\x8a\x61\xce\x25\xd7\xe6\x34\x99\x2b\x0b\xba\x35\x48\xbb\x5d\xea\xfa\xa2\xdd\xd2\xb2\x5b\xbf\x43\x6b\x1e\xc6\x4e\x44\xcc\x88\x87\x86\x80\x75\x3d\x4b\x53\xed\xbd\xcb\xe7\x4b\x5c\x29\xa3\xa9\x30\xed\x67\xaa\xc9\xed\x1d\x30\xaa\x6d\xf3\x64\xab\x6b\x31\xc8\xa6\x45\x77\xbe\xfe\x36\x77\xec\xda\x3a\x80\x98\xb3\x1e\x82\x88\x99\x76\x83\x98\xd3\x3b\x84\x98\x69\x7e\x20\xc4\x9a\xf1\x8e\x47\xeb\xa7\x92\xbc\xdf\xe2\x51\xcc\xb6\x6d\x97\x33\x10\x6c\x8b\x43\x18\x39\xe3\x1c\x87\xd4\x6f\xbd\x5d\xe0\xc0\x7e\x81\x87\xdc\x30\x47\x32\xe7\xaa\xdc\x16\x43\x5c\x91\x6d\x7a\xad\xd9\x5e\x01\xae\xc8\xa6\x0b\xc4\x71\x64\xa4\xe3\x34\xb6\xcc\x0e\x9b\x63\x0f\xc8\x99\x53\x2f\x60\xae\x59\x73\xea\x5b\x85\x13\x19\xe5\x7d\x36\xc6\x2e\xab\xe5\x2e\xb6\x5c\xd0\x42\xeb\x7d\x81\xa5\xcb\x94\xdc\x08\x46\xca\x75\x14\x7b\xc0\x39\xba\xf2\xd4\x53\x96\xbd\xe1\x4f\x25\xb7\x1d\x4c\x8e\x9f\xc4\xa3\x88\x79\xb2\x1c\x69\x38\xf6\x1f\x77\x73\xc3\xf7\xd3\x76\x1b\x25\xd7\x5c\xf1\xfb\x1a\xea\x7a\x85\x72\x8e\xdb\x75\x29\x77\xed\xb4\x55\x28\x4d\x1a\x6f\x1d\xb6\x5a\xf4\xb4\x51\xa3\x4b\x89\xda\x50\xca\xe3\x82\x96\x77\x98\x69\x4c\x3d\x26\x62\x48\x6e\xee\xd1\x7a\x8d\x99\x26\x06\xcc\xe7\x8d\x75\xd1\x5b\xde\xba\xcb\x59\x6f\x9d\xfa\x23\xf4\x03\xd4\x4a\x51\xdf\x21\x56\x4a\x09\x19\xe0\xfe\xc2\xb5\x13\x5d\x50\xa3\xf1\xef\x7e\x8e\x5b\x2b\xb3\x63\x1a\x5a\xda\x51\xc6\x93\xeb\x52\x91\x45\x9a\x2f\x47\x88\xc1\xb9\xec\xda\x44\x6d\xe6\x8b\x03\x38\xc4\x43\xd9\x91\xfe\x62\x33\xa6\xd5\x3b\x52\xbd\x44\x8c\xe3\xd8\xa6\xca\x2a\xc4\x38\x29\xd7\x40\x38\xf0\x77\xa4\xaa\x70\xa6\xbc\x46\xae\xbc\x33\x6d\xef\xeb\xa8\xa5\x49\x34\x7d\x22\x8b\xee\x24\x0e\x0d\xe9\x57\xda\xd0\x4e\x14\x48\xd1\xa1\xcf\x17\xbf\x27\xc6\x28\x44\x5c\xc3\x7f\x0c\x34\x89\xeb\x49\xa4\x3e\x49\xa2\x93\xfd\xde\x8c\x64\x82\x43\x21\xee\x51\x49\xfe\x0f\x2f\x74\x8e\xaf\xef\x9d\x99\xf4\x60\x46\x96\x48\xe0\x0c\x04\x85\x12\x73\xf9\x4b\x31\xac\xd2\x0e\xb5\xe1\xd8\x61\xd0\x0e\xd0\xb5\xe7\x55\xf5\xfb\xb4\xd1\x1d\xc3\xd2\x18\x02\xce\x13\x59\xc1\x1d\x36\x41\x2a\xd9\x9a\x44\x27\x86\x29\x2d\x76\xb1\x17\xaa\x32\xac\xbb\xc3\xcd\x04\xd2\xe0\x71\xf9\x16\x3b\x71\xb1\x8e\xf4\xea\x3b\x36\x0f\x41\x8a\xae\x88\x69\x73\x67\x95\x0e\x29\xee\x82\xe9\x33\xdf\x0f\x69\x25\x68\x81\xaa\x6e\x7f\x57\x88\xb6\x56\xca\x70\x5c\xdd\xea\x17\x27\x82\x6e\x5f\xb9\xb1\x72\xcf\xa3\x57\x43\xc8\x8d\x6e\x23\x43\x2d\xa1\x74\xbf\x02\x55\x52\xcf\xfa\xde\x1e\x3f\x83\x25\xad\x76\x52\x5e\xa0\x3c\x07\x9c\xe3\x02\x97\x2a\xc8\x3e\x28\x78\x87\x94\xef\x2b\x49\xed\x02\xbd\xd3\xcc\x6c\x76\xd6\xc2\xf8\x4e\xc3\x4a\x5b\x6d\x99\x5e\x4c\x5f\x5b\xf5\x43\x1f\xfd\xdc\x86\xfc\x07\xbb\xd5\xf5\x45\x13\xc8\xf3\xf5\xb6\x0d\x73\x38\x19\x5a\xdd\x34\x08\x63\x77\x25\x0d\xc2\xd5\x71\xb7\x1b\x46\x0f\xe7\x87\x75\xe7\x43\x09\x5b\x6d\x3c\xd5\xa3\x11\xf9\xd0\x18\xb7\xa4\xcc\x70\x6f\x00\x2f\x74\x67\xa9\x62\xb2\x40\xbf\x22\x4d\x1c\x08\x03\xbb\x64\x00\xc4\x60\xd2\xc1\xc2\x1c\xc8\x02\x4f\x02\xa9\x97\x57\xf0\x19\x16\x48\x20\xf8\x3a\xe7\xd3\x32\xc0\xee\xa4\x87\x12\x06\xd3\xf2\x05\xdd\x05\xd0\x35\xe3\x02\xb4\x75\xf3\x9c\x13\x76\xf7\x9b\xfd\xde\x6c\x4e\x95\x96\x99\xf4\x51\x1b\x7c\xf1\x77\x9e\xbb\xe4\xea\xfa\xfa\xd5\x7e\x6f\x03\x57\xc3\x62\x75\x48\x16\x7b\x06\xea\x35\x7f\x69\xeb\x87\x1c\xe4\x5a\xb2\xb4\x82\xaf\xe9\xd7\x14\x43\xf4\xe2\x4d\x87\x4c\xaf\xd6\xfb\xb1\xcf\x4f\x10\xc7\x7f\xff\x2a\x1e\xf0\xd8\xf4\xf7\x2f\x56\xd1\x7e\xaf\x16\x8b\xb1\x42\x9a\x95\xa1\xac\x10\xf9\xc2\x33\x93\xac\xd1\xd8\x97\x6e\x8d\xaf\xb1\xb0\x66\xd7\x01\x27\x34\x8c\xa3\x23\x0f\x95\x11\xa2\x64\x87\xa7\x55\x8c\xd9\x71\x44\x61\x89\x27\x5f\x3c\xc9\x32\xaa\x09\x8e\x93\x28\x07\xe5\xc9\xf1\xa1\x43\x2b\x2d\xeb\x7a\x76\x87\xd8\x44\xb5\xe7\xa9\xbb\x99\x51\x64\xad\x2c\x79\x8e\x71\xc6\x55\xfd\x85\x89\x22\x38\xb5\x18\xad\x1b\xf7\x4f\x3f\x57\x3c\x41\x82\xb9\x0a\xf0\x60\x77\xad\x09\x87\xf4\xa0\xe2\x3b\xc3\xcb\x2d\xe3\x72\xe7\x38\x05\x73\x74\xa5\x8b\xa2\x54\x8d\x83\x6f\x85\xfa\x75\x1e\x7a\xc8\xc6\x1b\xb1\xec\x36\xce\x85\x99\xd0\x75\x31\x2e\x68\x9e\xe3\xa5\x9c\xc4\x71\x36\xac\xca\xe9\xb4\x0d\xcb\xad\x89\x3a\xaa\x45\xda\x85\x70\x12\x9d\x5b\x16\xf1\x7b\xb4\x4e\x6e\xaa\x9c\x88\x27\x3b\x0d\x57\x34\x69\x84\x21\x8d\x10\xc0\x32\x76\xd3\x2c\x47\x59\x11\x47\xdb\x10\x1e\x2d\x4d\x02\xb4\x8f\xce\xb0\xd1\x06\xdf\xba\x79\xd2\x63\x4c\x8f\x29\x7b\xaa\xc9\xc8\x4c\xea\x3e\x6d\xcb\x4c\xd1\x46\xe0\xa8\xa3\x90\x2f\xde\xe9\xab\x05\xa6\xf6\xac\xa5\x7d\x4d\x4a\xe3\x5b\x47\x15\xe5\x9c\x48\xc3\xe4\x9e\x88\x8d\xeb\xc4\xc5\xae\x34\x7d\x58\x13\xcb\x5f\x03\x13\x2c\xac\x3f\x12\x27\x1c\x5b\x49\x51\xbd\xa4\xe5\xa9\xae\xd7\x7c\xf4\x48\x3d\x48\xfa\x0b\x29\x84\x1b\x26\x74\x05\x55\xd8\x72\x9c\x50\x98\xf2\x67\x30\x29\x03\xb5\x6f\xd3\xcd\xc9\x3f\xdc\x42\xd2\x88\xba\x51\xd0\x34\x85\x0b\x9a\x61\x9d\xfb\x54\x31\x98\xdb\x1d\xac\xe9\x29\xd7\xc6\xd8\xd7\xb6\x72\xfc\xea\xf2\xfa\x75\x32\x9b\xd9\x38\xfe\x05\xad\x76\x8c\xac\x37\x02\x4e\xeb\x5a\x6b\xbb\x25\x2d\xa4\x13\xdd\x69\x73\xe2\xad\xb3\x0a\x2d\xdf\x21\xe3\xba\xbe\x34\xbf\x65\x43\x9a\xc2\xeb\x0d\xe1\xb0\x22\x52\x70\x20\xee\x03\x23\x36\x18\x0c\x34\x20\x28\xcd\x13\xd9\xff\x2a\x23\x42\x7a\xef\xa2\xf9\xae\x50\x33\x56\x8c\xde\x61\x58\x6d\x85\x1a\x6a\x83\x4b\xd8\xd1\x2d\x30\x7c\xca\xb6\xa5\x37\x92\x9d\x42\x81\x8d\xca\x6c\x36\x23\x45\x45\x99\x80\x68\x06\x30\x5f\x15\x62\x2e\xff\x25\x54\xfd\x53\x62\x91\x6e\x84\xa8\xe6\x33\xf9\xb4\x26\x62\xb3\xbd\x4d\x96\xb4\x48\xd7\xf4\x94\x56\xb8\x44\x15\x49\xf5\x72\x9e\x0f\x77\x60\xdb\x52\x90\x02\x1f\xee\x91\x72\x69\x92\x10\xb1\x9b\xd0\xb5\x20\x59\x96\xe3\x7b\xc4\xc6\xc6\xe5\x82\x59\x84\x06\x3a\xdc\xa3\xf5\x48\xb3\x35\xe5\xe7\xba\xcc\x12\x34\xa5\x38\x24\x97\x78\x85\xb6\xb9\xb8\x36\xcf\x36\x66\xd2\xb4\x3b\x0d\xb1\x62\xf3\x73\x7c\x1f\x4c\x9e\x9b\xca\x99\x25\xc3\x48\x60\x0e\x08\x4a\x7c\x0f\x63\x3d\x75\x55\xa4\x5d\x8d\xfa\xa5\xb2\x14\x68\x81\x0d\x54\x5c\x65\x1d\xd2\x54\xab\x22\xc9\xfb\x4c\x37\xe8\x90\xa7\x34\xed\x88\x20\x6a\xf4\x2c\xb1\xe6\x8e\xf9\xa4\xa4\xdd\xce\xda\x68\xce\xa4\x94\xe5\x15\x5e\x26\xae\x51\xbd\xda\x96\xcb\x03\xa8\x45\xf1\x28\x3a\xfb\x03\x98\x98\x90\x33\x53\xab\x33\x4d\x1d\xd0\xb5\x7a\xc5\x02\x33\xae\x11\xf5\xe1\xd6\x0c\xd1\x06\x73\xe2\xd7\x27\x81\xb5\x10\x9f\x22\x6e\x26\x72\x72\x41\x6d\xbb\xd1\x13\xdf\x91\x1c\xab\x01\x3a\x16\xe4\xf5\x65\x5d\xdb\xcf\xcf\xbd\x9a\xb6\xae\x3b\xd9\xfa\xa8\x43\xbe\x65\xc4\x95\x6b\x77\x41\x4b\x81\x48\xc9\x21\xf9\x5f\xcc\x28\xcc\xa3\x7f\xcc\x9d\xa4\xa5\x7a\x67\x6d\x10\xb5\xb2\xad\xfe\xb3\x34\x61\xb6\x3c\x85\xc3\x4f\x65\x81\x18\xdf\xa0\xfc\x35\xfe\x20\xa4\xcb\x88\x93\x75\x02\x97\x48\xe0\x85\xfa\xbf\xdc\x44\x0b\xb8\xdc\x32\xad\x51\x1a\xd5\xe9\xe3\xd0\x09\x6a\x8f\x23\x32\x86\x83\x82\xbb\x09\x9a\x47\xfd\x0c\x98\xa5\xa4\xb2\x89\x47\x10\x14\xe8\x1d\xe6\xa0\xa7\x3a\x1a\x6a\xab\xfa\x3b\xa0\xc7\x5a\x5e\x1f\x03\x1e\xc3\xeb\x6d\x8e\x18\xac\x29\x34\x27\x98\xfa\xc0\x1e\x80\xaf\x51\x5f\x26\xd5\x98\x9e\xc0\x25\x55\xcb\xce\x59\xe6\x2b\x46\x0b\x68\xac\xcb\xcc\x6e\x0c\x52\xda\x84\x81\xf1\xd7\x4e\xd2\xce\x22\x0e\xad\x45\xab\x26\x1d\x46\x0e\xe7\x36\xbb\x6b\xae\x01\x4a\x2f\x19\x2e\xa4\x1a\x59\xef\xf4\xee\xeb\xac\xb7\x10\xea\x3d\xf4\x7d\x1d\x7e\x7a\xe4\x8c\xff\xe2\xb4\x4c\x9a\x69\xa7\x4d\x39\x40\x84\xc8\x71\x69\x0f\xae\x0e\x39\x9e\xa2\xf9\xb5\xc3\x69\x67\x81\x4c\x5f\x1f\x67\xd6\x95\xd0\x21\x98\x03\x2b\xa7\x63\xed\xb9\x26\x62\x67\x89\xea\x13\x74\x79\x0e\x54\x6c\x30\x83\x25\xe2\x98\x43\xa4\x04\x00\x57\x95\x59\x31\xbc\xe1\x1b\xba\xcd\x33\xb5\xd8\xe8\x72\xb9\x65\x6f\x47\xa7\xf4\xcb\x82\x8f\x86\x45\x42\x60\xab\xc2\x06\x37\x45\x77\x8e\x60\xc4\x33\x54\x20\x06\x10\x2b\xf3\xac\x2b\xec\x83\x52\xde\xec\xb3\x25\x62\x6c\x07\xb4\xf4\xd7\xed\xe0\x82\xf3\x36\x97\x53\x7d\xf2\x89\xb2\xdd\x8a\xf6\x90\x4e\x49\xfc\x1d\xf5\xe6\xed\xed\x4e\xf4\x33\xac\x8e\x5c\x8a\x5b\xf0\x42\x02\xa6\xa7\xde\x28\x83\xe8\x68\xa9\x10\x07\x76\xad\x33\x72\x1b\x05\xed\xec\x4e\x03\xfe\x2f\xfb\x7d\x03\x3e\x9f\x43\x24\x7b\x35\x48\xc4\x75\xfd\x4b\xbc\x80\x47\x3e\x41\xa0\xa1\xc8\x58\xa5\x79\x9a\x42\x85\x4a\xb2\xe4\x12\x04\x69\xa7\x90\x15\x31\xce\x12\x91\xc2\x52\x59\x72\xde\x17\x05\x5f\xab\x04\x76\x21\x92\x1b\x0d\x53\x34\x37\xfd\x7c\x53\x42\xa5\xaa\x1a\x63\x03\x7a\xd0\x9d\xc1\x17\x77\xf3\x45\xaf\xb0\x5d\x81\x13\x15\x7c\xed\xbe\xee\x70\xc1\xad\x18\xe9\x67\xe0\x6c\xab\x17\xd0\x6c\x7b\x18\x6f\x72\xc4\xba\xda\x87\x4c\xa0\xfe\xae\x68\xbd\x3a\xaf\xa2\x56\x62\xf6\xeb\x17\x75\x3d\x72\x8b\xba\x82\x6c\x5f\xb4\xd4\x70\xbc\x2a\x90\xde\x9c\xa9\xa6\x1a\x36\xab\xed\x9e\x93\xc2\x50\x1a\xc2\xb7\x74\x5b\x66\x36\xae\xa9\x6b\xeb\x95\x57\xb6\xd9\x16\xa8\xf4\x8a\x5a\x69\x85\xb5\x69\x24\xe7\x10\xbb\x8a\x2c\x51\x9e\x2b\x9f\x8c\x63\x40\x0c\x03\xbd\x95\x43\xe3\x4c\x2b\x68\x04\xd2\x4d\x52\x3e\x3d\xe6\x62\x96\xa6\xf2\x33\xe3\x72\x9d\x39\x96\xaa\xd4\x70\x66\x8a\x99\x52\x07\x63\xe0\x73\xc1\xb6\x4b\x01\x7b\x93\xd0\x7d\xfa\xfa\xf5\x4b\x30\x33\x80\x2e\x2c\x98\x81\x7a\x6b\x5f\x9e\xb8\x40\xc0\x2f\x72\x77\x9d\xcd\x4f\xe7\xbf\xcc\xc2\xd6\x70\x7a\x62\x16\xc3\x25\x96\x4c\xac\x44\xe3\x64\xdf\xe6\x74\xf9\xae\xf1\x6b\x7b\xcd\x6e\x15\x5e\x27\xd0\x61\x9f\x74\x79\x61\xb7\xef\x33\xf4\x81\x14\xba\xc4\x0d\xc0\x3c\xd8\x55\x96\x5c\x7d\x58\xe6\x5b\x4e\xee\x70\xdb\xeb\x1b\x8f\xf3\xce\xe7\xbd\x81\x49\xe9\x0c\xac\x1f\x02\x03\x37\xbd\xbe\xed\x0c\xdc\x34\xf4\x06\xde\xe6\x82\x54\x39\x7e\xb1\x32\x63\x9b\x67\x78\xb1\x32\xe5\xa1\x6e\x87\x00\xbe\x3f\xe2\x72\xad\xa2\x3a\x1a\x63\xd0\xcf\x4d\x69\x69\xd3\x1c\xc0\xc8\xfb\x94\x94\xfe\xa7\x4e\x73\xf7\xd3\x97\x4a\x56\x97\xfa\x43\xf3\x70\x66\x82\x0f\xb6\x25\x00\xa9\x53\x23\x2d\x01\x0d\x97\xc0\x06\xc0\x74\xbf\x23\x25\x84\x6b\x82\xbb\xdf\x75\x8a\x55\x01\xf4\x8b\xf0\xb2\x71\x02\x5f\x33\x80\x6b\x83\x8c\xf3\xb6\xfb\x41\x38\xa1\xd0\xbe\x05\x2f\x07\xd1\xef\xdc\x1d\xaf\x2b\x2d\xcd\x83\x5f\x30\xd5\xb3\x7c\x5a\x59\x7d\x92\xce\x3c\xaf\xd2\x98\x42\xda\x72\xea\x1c\x63\xf8\xcc\x95\xb4\x8e\x5d\xeb\x9a\x73\xfd\x32\xff\x23\x8b\xfc\x3b\x9d\x2c\x20\x23\x93\xfb\xaa\xaf\xb6\x02\xfe\x09\x29\x33\x2b\xd2\x6e\xa9\xd8\xc0\x2d\x29\x33\xae\x00\xb1\xf1\x19\x0e\x48\x79\xbe\x98\x8b\x05\x10\x01\x88\xf3\x6d\x81\x39\x88\x0d\x12\xb0\xd4\x95\x58\x20\x36\xa4\x5c\x73\x50\x16\xb7\x92\x6b\x08\x4c\x66\x45\xc2\x1b\x69\x87\x33\x79\x85\xd7\x84\x0b\xb6\x8b\x75\x98\xd6\x39\x12\x91\xa6\x6e\x5d\x9d\x0d\xa5\x08\xb8\x27\x79\x0e\x5b\x8e\x95\xb5\xa8\x62\x71\x05\x16\x1b\x9a\x81\xd4\x18\x3c\x31\xba\xe0\x35\x05\x5c\xf2\x2d\xeb\x06\x63\x16\x3a\x38\xa7\x25\x7d\xb1\xe5\x02\x36\xe8\x0e\xc3\x2d\xc6\xa5\x1b\xa7\xd1\x2e\xcf\xc1\x50\xcb\x2d\x5e\x51\x86\x37\xa8\xcc\x12\x1d\x9c\x89\x02\xc7\x38\xe0\x64\x64\x90\xd8\xa5\x77\xc4\x7c\x95\xb2\x00\x75\xbc\x06\x4e\xda\xe8\x5b\xf2\x0c\x89\xe5\x06\x67\xaf\x64\x83\x25\xda\xde\x04\x6d\x18\xe6\xf0\xe6\xad\x7a\x37\x1b\x38\x52\xe2\xaa\xaf\x73\xb0\xdd\xcc\x9e\xfb\x9f\x2d\x66\xed\xe1\xb2\xf7\x5c\xe5\x40\x75\x00\x50\x07\x87\x79\xc4\x92\x9f\x5e\xfd\x98\xa8\x8e\x51\xec\xa4\xd3\xbc\x71\xe4\xbe\x6e\x86\x69\x6d\x54\xa6\xcb\x16\xb5\x04\x47\x4c\xc8\x6e\xd1\x7f\xff\x0d\xbe\xf9\x06\xfe\xf6\x65\xd7\xda\xfc\xcb\x5f\xda\xca\x44\x45\x93\x2b\xc6\x9e\x53\xd1\x7c\xdc\x3b\xfa\xe8\xc6\xfa\x9f\xe3\xfb\xe8\xab\x2f\xbf\x5c\xcc\x7b\xa6\x62\xdd\x18\xeb\x3e\x50\x0a\x96\xf1\xb3\x95\x13\x27\x98\xfd\xa5\xf6\x29\xa1\x28\xe7\x86\xc4\x56\x59\x98\xb2\xb2\x73\x1c\xb4\xbe\x06\x0c\x8a\x59\x27\x74\xe6\xba\x08\x4d\x61\x73\xcb\x54\xc9\xd3\xe0\x52\x5c\xc0\xfb\xcd\xbb\x81\x96\x7f\x4a\x50\xdf\xf3\xe4\x7b\x2c\x5e\xfc\xd0\xad\x23\x1c\x3f\x3b\x26\x05\x47\x40\xe0\x46\xc7\x03\xf1\x69\xa7\xcc\x7c\x0f\x4d\x82\x6f\xc9\xc1\x86\xe6\x1b\x27\x87\x06\xc7\x84\x50\x1f\x90\x30\xc7\x83\xf3\x90\x84\x79\x8a\x51\x86\x99\x25\xcd\x47\x62\x90\xe8\x51\xde\xa8\x2d\x7b\x81\x4a\x5a\x4a\x4b\x5e\xbf\xfc\x01\xef\x3c\x3a\xbd\x5d\x28\xeb\xe3\x61\xb1\x68\x64\x8f\x73\x36\xc0\xb4\xf8\x81\xe5\xde\x91\xa0\xf0\x41\x21\x0d\xfa\xa2\x15\x17\x72\x02\x39\xd4\x00\xcb\x2d\xdc\x76\xfb\xb9\xbe\xd7\xa3\xae\x40\x7b\x46\x38\x27\xe5\x5a\x0e\xd7\x6e\xfa\x61\x8c\x5d\xc9\x03\x73\x86\x51\x46\xca\xb5\xce\x4a\x7d\xf1\x1e\x56\x88\xe4\xd2\x11\x90\x22\xa9\x9b\xd1\x8c\x7c\xbc\x62\x5b\xce\xef\x9c\xb5\x0f\x41\xec\x4b\xcb\xf3\x20\xe0\x86\x45\x2a\x99\x71\x4a\x2b\x5d\x23\xab\x9b\xe1\x76\x2b\x80\x56\xfa\xfe\x00\x0d\x67\xe3\x9b\xcd\x3a\x7e\x79\x77\xb2\x23\x17\xde\xb1\x0c\x0d\xad\xb2\xbe\x83\x95\xa6\x7d\x07\xcb\x92\xf5\x1f\xa5\xbe\x3d\x4b\x03\x3f\xbc\x42\x35\x56\xc7\x9f\x78\x7f\x64\xf5\x83\x5a\x1d\x97\x48\xa0\xb3\x20\x3e\x0b\xd0\x18\x85\x5b\x75\x5b\xed\x96\xdd\x1b\xab\x73\x95\x0d\xc8\x94\x55\x36\x2e\xfd\x56\xd9\x83\x0a\xbd\x8f\x81\xe3\xd3\x4f\x1d\x37\x1a\x36\x3d\x51\x3f\x93\x56\x3a\xb4\xa7\x00\x3a\x7d\x94\x96\xd5\x3a\xd6\xe9\x33\x14\xfb\xfb\xb7\x02\xfe\xb7\x02\xfe\xd3\x29\x60\x13\xa5\x6f\x95\xf0\x9f\x59\xce\x04\xec\xf8\x4e\x36\xa3\xb9\xcc\xa3\x09\x51\x9c\xda\x7a\x41\x2b\xfd\x9f\x22\xae\x2f\x5a\x88\x3b\x87\x2d\x3a\xae\xbe\x5b\xb4\x39\x55\xc5\xb0\xe4\x89\x77\xbb\x81\x53\xd7\x94\xe1\x15\x66\xa6\x43\x72\x91\x53\x8e\xa3\x38\x70\x4e\xa0\x17\x94\x70\x5e\x5d\x7d\xa8\x28\x13\x6d\x45\xd6\x2d\xcd\x76\xee\x19\x42\x41\x4d\x6d\x8e\x02\x28\x51\xb7\x65\xf1\xb6\x5c\xa7\xcd\x66\xec\xf7\x90\x31\x5a\xd9\xa6\xb6\x18\xb8\x77\xaf\x86\xaa\xdf\x6c\xa8\x1d\x69\xf0\x2d\x1b\x2f\x68\xc9\xb7\x05\x36\xbe\x5a\xe8\xdc\x9b\x4b\xde\x7e\x3d\x59\x6b\x05\x11\x9a\x5c\xbd\xf8\xce\xf9\x48\xbd\xff\xf8\x0b\x8f\x66\xdd\x6c\x45\x27\x17\x37\x72\xaf\x4f\x97\x6f\x77\x88\x35\x77\x8e\x38\x55\xd3\xe0\x25\x8b\x7c\x7a\xd8\x1f\x0d\xb9\x1e\xc9\x01\x86\x7c\xe1\x8f\xa2\xcf\xa4\xcb\xa1\x0e\xda\xab\x53\xee\x8c\xf2\xcc\xad\x00\x29\xc7\x2d\x6b\x15\x0a\xb8\x92\x8f\x9f\x0a\xd0\x02\xe6\xf3\xbe\x85\x3d\x44\xba\x3e\xe7\x07\xd2\x54\x3d\x04\xfd\x3a\xc2\xce\x7d\xaa\xc9\x48\x09\xa2\x5f\x01\xed\x80\xe4\x8e\xff\x79\xf8\x16\x80\x6e\xf8\xc1\xbd\x4b\xaa\x2d\x91\xf6\xa3\x33\x17\xb4\xa8\x28\x27\xc2\x29\xe5\xd6\x4c\x65\x98\x27\x49\x62\xe7\x34\x1f\x95\x24\x9f\x99\xc3\x30\xff\xb9\xcc\x11\xe7\x4a\x1e\x9d\x9d\x43\xd4\x11\x9a\xb1\x49\x09\x06\xc3\x30\xf6\xac\x87\xeb\x65\xce\xd2\x14\x06\xd4\x91\x89\xaa\xfa\x3e\x91\xda\xb4\xaa\xb9\x09\x63\x6e\x30\xd0\x32\xdf\x01\xdf\x56\x46\x9a\xba\x27\x47\x68\xa9\x46\xe0\x2a\x43\xd6\xa6\x28\xa4\x68\x6e\x72\x1d\x07\x02\x93\x0e\xca\x6d\x4c\x72\x50\x87\xaa\x02\x48\x1b\x82\x53\x36\xf3\x02\x36\xca\xb6\x80\x13\xff\xbd\x71\xb3\x9c\x08\x25\xb8\x77\xca\xb6\xc0\x76\x93\x36\x00\x5c\xdd\xcd\xa1\x6d\x00\x92\xe3\xe4\x06\xe3\x77\xd1\x97\x0b\x29\x56\xe4\xcf\xab\x32\xd3\x7b\x2a\xd4\x78\x23\x10\x13\xf1\x50\xc5\xad\x97\xe8\x69\xc5\xa2\x9c\x10\xbe\x51\xb7\x81\xb4\x8d\x9e\xf8\x72\x57\xd7\xd5\x87\x25\xc6\x19\x37\x39\xac\xe3\xae\xec\xf0\xf2\x48\x0b\x50\x77\x0d\xc4\xe3\x55\xc2\x5e\x5e\xab\x03\xf3\xb7\xe6\x06\x93\xc9\x30\xeb\x84\xde\xb1\x30\x3b\x0c\x3a\x0c\xb3\xb7\xab\xfc\x72\x20\x7b\x8c\xab\xb1\x7a\x7c\x34\xf5\x75\x5b\xa3\x15\x1e\x53\xf6\x94\x9f\xa9\x08\x6c\x2e\x9d\x42\xee\xa4\xb4\x0e\x05\xf0\x8f\xda\x27\x0c\xdd\x4b\x87\x1d\xde\xbc\xd5\xc5\x28\x0b\xd8\x20\xfe\x03\xde\xc1\x2d\xa5\x79\x73\x9c\x0c\x06\x72\x20\xfb\xbe\xa1\x65\xf3\x4b\x8d\xef\x14\xf7\xd5\x08\x59\xc1\x5f\xcd\x34\x43\xcb\xe0\x23\x4d\x93\x01\x55\xa4\x52\x0e\xe8\xde\x54\x07\x5a\x18\x94\x74\xd6\xf8\xbb\x12\x5a\x01\x83\xee\xa5\xd5\xa9\x1b\xdf\xb8\x1d\x4f\xff\xeb\xad\x99\x49\xe7\xde\xdb\xa8\x8b\xe6\x47\x57\x61\x86\x9d\xeb\x34\x85\xc7\x79\x4e\xef\xaf\x8a\x4a\xec\x54\x58\x5d\x7f\xdf\x79\x19\x48\x38\xfa\x5e\x69\x9a\xc2\xcb\x66\xd9\x10\xae\x8a\xbd\x49\xa6\xab\xc4\x97\xb4\xd4\xd9\x23\x75\x5d\xa0\x5c\x48\x62\x83\xb5\x45\xe5\x1f\x2e\x82\x40\x62\x31\xc8\x3e\xdd\xd8\x81\x31\x6e\x3d\x81\xa1\xfb\x52\xcd\xf1\xc4\xe9\x7b\x99\xa1\xfb\xb0\x0f\xd3\xb9\x04\x00\xa0\xe7\xd3\x45\xd0\xc5\x00\x62\x75\x96\x5e\x03\x6f\x41\x8a\xc7\xf0\x50\xdc\x3f\x87\xf9\x1c\xf6\x92\xc5\xfa\xbe\x79\x93\xd7\xab\x10\xe7\x4e\x09\x9d\xa3\xe3\x06\xeb\x67\xd2\xd4\xa6\x85\xed\x20\x6d\x26\xaf\x62\xf8\x8e\xd0\x2d\xcf\x77\x5e\x52\xef\x76\x67\x52\x7a\x81\xcd\x1c\x75\xce\x86\x39\x62\xcc\xa7\x4a\x9f\xc3\xde\x65\x28\xfe\x7d\x1d\xbd\xab\x52\x24\x0f\x7e\xc5\x5b\x37\xba\x8d\xed\x35\x01\x60\x66\x36\x3e\x68\xe8\x36\x87\xf3\x70\xd4\xba\x41\x5a\xa1\x16\xac\x7a\xeb\x8b\xe9\x34\xf5\x72\xfe\x0f\x7d\xb1\x09\xfc\x11\xe8\xf8\x11\x25\x05\x8d\xf5\xd8\x2f\x29\xb0\xcf\x96\x35\x7e\x6e\xdf\xb9\x2b\xc5\x81\xd6\x29\x52\x94\xdc\x1a\xba\x65\xeb\x20\xe7\x19\xba\x1f\xdc\x01\x66\x77\xfa\xa7\x26\x3f\xfa\x52\xc9\x68\xf0\xa8\xf5\x88\xa0\xea\x94\xe8\x59\xd3\x63\xa0\xfa\xfb\x78\x1b\x42\x9f\x83\x9b\x6e\x49\x68\xdb\xfd\xb1\x2e\x14\x97\xa6\x79\x25\x57\x75\x06\x68\xb9\xa4\x4c\xe5\x6c\x04\x85\xfe\x01\xdd\xf9\x40\x75\xcc\x1c\xa2\xa6\x0e\x5d\x50\x98\x2f\xf9\xdd\x5c\x9f\x39\x52\x32\x34\xfe\x5d\x9a\x2e\x1d\xaf\xb2\x67\x9c\x3c\x80\x61\x32\x2e\x95\xf1\xfb\x00\x31\xe7\xca\x49\x99\x1b\xa5\xa4\xce\xa4\x05\x8f\x49\x07\xeb\x99\xa6\x1d\x04\x3f\x6b\x2c\x9c\x8e\xa8\x3c\x6d\x0e\xd7\xbc\xbf\x0b\x47\xe4\x1a\x43\x6a\xd8\x8c\x1a\xfa\x34\x6c\x56\xc1\x29\x28\xc3\xca\x9a\x55\xbf\x0a\xb6\x81\x73\xdb\x03\x50\x06\x0e\x5a\x1e\x3c\xa3\x6d\xd6\x92\x8a\x36\x1e\x32\x99\xc6\xcf\x5a\x07\x4f\x59\x3b\xd7\x51\x3f\xf0\x8a\xd4\x41\xb9\x8f\x81\x26\x70\x5e\xea\xb7\xb0\x79\xc2\x67\x02\xc6\x8f\xa2\x37\x4b\x67\x50\xcb\x1c\x3e\x9a\xea\x7b\x3f\xa3\x7f\xda\xe2\xb3\xe9\x18\xf7\xef\x3f\x04\xb4\x4c\xe7\xd6\x04\x53\xed\x1f\xd0\xff\x1d\x4d\x19\xeb\x4e\xed\xb9\xda\x00\xb2\xb1\x51\x58\x23\x08\xa9\xe3\x15\x04\xab\x78\x50\xf7\xba\xaa\x80\xd6\xfa\x18\x75\x31\x81\x9c\x63\x3a\x61\xfa\xb5\x5f\x07\xe8\xdb\xd1\xe8\x6e\x28\x41\xe5\x69\x9d\x8b\x96\x4d\x59\xa0\xfa\x33\x22\xce\x65\xdc\xdd\x73\x8c\x03\x37\xb1\xb4\x39\x8e\xd0\xd5\xeb\x8d\x1d\xe7\xff\xf5\xa4\x38\xd0\xa0\x6e\x0d\xf7\xa2\xb9\xfe\x5f\x36\xea\xac\xeb\x71\x4e\xcb\x01\x5d\xc3\xa4\x0c\xa3\xf6\xc0\x1c\x56\x09\xa8\x89\x9a\x1f\x02\xf7\xde\x78\xa7\x30\x0e\xec\x6a\xf7\xbf\x29\x97\x6e\x1c\x38\xc4\x04\xa1\x6b\x3e\x46\x26\x9d\xac\xf5\xc2\x32\xae\x3b\xd6\x04\x61\xe9\xf5\xff\x74\xb1\x39\x40\x85\xe1\x1b\x83\x7e\x75\xcc\x87\xaf\x0e\x7a\x70\xd4\x43\xb7\x33\x1c\x27\x7c\x02\x04\xec\xc9\xa3\x60\x98\x36\x7c\x95\xba\xfd\xf5\xff\x01\x00\x00\xff\xff\x2d\xca\x33\x0d\x86\x6f\x00\x00")
+var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x6f\xe3\x36\xb6\xf8\xdf\xeb\x4f\x71\xea\xdf\xaf\x03\x29\x70\xe4\xde\xbd\xc5\xfe\x91\x36\x05\xda\x24\xed\x04\xed\x3c\xee\x64\x3a\x17\xb8\xb3\x83\x2d\x63\xd1\x36\x77\x64\x51\x43\xca\x89\x7d\x05\x7d\xf7\x8b\x43\x91\x14\x29\x51\x7e\x64\xd2\x69\x8b\x3e\x16\x5b\x4b\x7c\x9d\x17\x0f\xcf\x4b\x4c\x55\x41\x4a\xe7\x2c\xa7\x30\xbe\x65\x79\x5a\x08\xb6\x62\x25\xbb\xa3\x05\x11\x64\x35\x86\xba\xae\xaa\xe9\x09\x90\x1c\xe8\xaa\x28\xb7\x50\x52\x59\x36\x03\x58\xc9\x78\x0e\x25\x6f\x5e\x95\x74\x55\x64\xa4\xa4\x20\x68\xc1\x21\xa5\x05\xcd\x53\x9a\xcf\x18\x95\x20\xa8\xe4\xd9\x5a\xf5\x3e\x85\xcb\x17\xf0\xfc\xc5\x6b\xb8\x78\xfa\xed\xf3\x1f\xae\xe0\xf5\xd3\xeb\x1b\x38\x99\xd6\xf5\xa8\xaa\x80\xe6\x29\xd4\xf5\x68\xe4\x42\xc4\xd3\xed\x1d\xc9\x58\x4a\x4a\x2e\x10\x98\x11\x40\x55\x9d\x02\x9b\x43\xf2\x94\xc8\x67\x3c\xa5\xd9\x77\x3c\xdd\xbe\x44\x60\x65\xd3\x3e\x9d\x82\x1e\x42\xe1\x96\xa7\x5b\xe0\xb7\xff\xa6\xb3\x52\xa1\x91\xd2\x8c\x2e\x10\x4a\xdd\x43\x63\xb0\xc2\x79\x74\xbf\x06\x1c\xc0\x25\xa8\x10\x70\x76\xae\x26\x49\xde\xe8\x29\x23\xc1\xd7\x25\x4d\xbe\xe7\x62\x45\x4a\x19\x7f\xa5\x3a\x7d\x76\x0e\x39\xcb\xa0\x1a\x01\x00\xa2\x0b\xe7\x40\x0a\xa4\x40\x24\xa8\x9c\x60\x97\x78\x04\x50\x8f\x9a\x69\x33\x9a\xe3\xfb\x18\xce\xcf\xe1\x0b\x3d\xa8\xaa\x20\x79\x45\x67\x94\xdd\x51\xf1\x9c\xac\x28\xd4\x75\x52\x55\x50\x10\x39\x23\x19\xfb\x5f\x0a\x89\x7e\x0b\xe7\x50\x55\x38\x0b\xc9\x53\x88\x72\x5e\x42\x72\x33\x5b\xd2\x15\x49\xae\xe5\x77\x44\xd2\xd7\xdb\x82\xc6\x90\x5c\xcb\xe7\xeb\x2c\x23\xb7\x19\xce\xf4\xc4\x12\x17\x51\x51\x90\x34\x64\xa4\x99\xa4\x66\x2e\xa4\xe7\x0d\x5b\x15\x19\x75\x08\xea\x11\xf9\xba\xa4\x0d\x8d\x35\xc4\x8a\x0d\x5c\x58\x00\x70\x82\x8c\xcd\xa8\x26\x15\xe3\xb9\x6c\x81\xc3\xb1\x38\x9b\xdb\xd8\x63\x17\x11\x82\x6c\x81\xcf\x5d\xbe\x49\xbb\x9a\x96\x0f\x67\xf1\x5d\x2b\xeb\x9e\x9f\x9a\xb4\x7a\x45\xbb\x1d\xc6\x12\x01\x53\x9b\x49\xa3\x89\x82\x9c\xb8\x88\x18\xb9\x77\x10\x43\x08\x76\x92\x4e\x81\x17\x39\xc4\x6f\x7a\x5d\xcb\xeb\xbc\xa4\x62\x4e\x66\xb4\xd7\x72\x53\x0a\x4a\x56\x71\xdc\x2c\x3d\xe7\x42\x51\xe6\x3a\x4f\xe9\xe6\x0d\x11\x88\xff\xd9\x39\x08\x92\x2f\xf4\xb6\xa9\x2c\x36\x1e\xad\xcd\x74\x0e\x11\x54\x47\xd6\x70\xed\x6d\x67\xd2\x77\x70\xee\x6e\x8e\xc1\x09\x5f\xd1\x0f\x6b\x26\xa8\xe5\xf0\xe0\x4e\xe2\xa2\xed\x1c\xe1\x62\x17\x4b\x96\xa5\xc9\x4b\x52\x2e\xa1\xae\x27\x88\x54\x21\x58\x5e\xce\x61\xfc\xf9\x87\xb1\x69\xfe\x89\xcf\x14\xe5\x54\x97\x20\x9c\x71\x6c\xd7\xbd\x15\x94\xbc\xb7\x4f\x76\xa3\x58\xc8\x66\x3c\x2f\x59\xbe\xa6\x7e\x97\x56\x3a\xeb\x51\xf0\xb5\xaf\x51\x7a\x00\x1c\xa1\x62\x76\x28\x19\x1f\xfe\xda\xa8\x1d\x03\x0e\x9b\x83\x2b\xd8\x83\x1b\xe7\x77\xa3\xa6\x02\x74\xf4\x19\x32\x9d\x42\xce\x5d\x7d\x8e\x92\xcd\x50\xa6\x80\xe5\x50\x2e\x99\x04\xb5\x05\x47\x9f\x5e\x13\x38\x70\x3f\x48\xe1\x3e\x23\xc5\x2e\xc5\xb0\x4f\x25\x7c\x9b\xa6\xea\x8c\x26\xd9\x4b\xc1\x0b\x2a\x4a\x46\xc3\x1a\x62\xa0\xa3\xaf\x30\x5c\x3d\xbd\x22\x45\x40\x4b\x1b\x95\xf2\x23\xdd\x1e\xa3\x50\x82\xab\x77\xd5\x41\xbb\x77\x0c\x10\x9e\x12\x60\xf3\x8e\x1e\xe8\x29\x06\xd4\xd3\x48\xfc\xba\x1e\x8f\x2d\xa7\x0e\xd2\x16\xcd\xf4\x48\xdc\xe4\x5a\x7e\x9b\xf3\x7c\xbb\xe2\x6b\xa9\xd7\xd0\x38\xfc\xc0\x51\x24\xa0\xae\x23\x4f\x0a\xde\x7a\xe4\x78\x37\x38\x53\x6c\x47\x0d\xed\xf6\x72\x2d\x72\x6c\xd9\xa5\x5f\x82\x42\x32\xc0\x5b\x2b\xb8\x91\x06\xe7\x19\x29\xf0\xdd\x8b\x3b\x2a\x04\x4b\x69\x1c\x52\xeb\x2d\x26\x7b\x95\xfa\x41\x4c\x3d\x5c\xc7\x0f\x6a\xf7\xb0\x5e\x6f\x01\x3d\x42\xab\x7f\x8c\x5e\xbf\x23\x19\x4c\x80\xbf\x87\xb3\xf3\x00\x10\x5f\x61\x4b\x8f\x54\x6a\x2b\x1f\xc1\x09\x67\xa9\x8e\x78\xf4\x20\xea\xed\x98\xa3\xce\x96\x03\x4e\x98\x10\x45\xeb\xc7\x40\xb0\x1e\x42\xa9\xfe\xdd\x19\xd0\x5d\x19\x0a\x1e\x44\xea\xfc\x59\x91\xe2\xb7\x3d\x7d\x1c\x50\xd9\x3c\x7c\xf0\x68\x32\x3b\xac\x33\xec\xfa\x16\x8d\xf2\x58\x03\xa2\x14\x45\x3c\x74\x04\xf5\x0e\x0a\x96\x67\xe8\x59\x6a\x98\x3e\x2d\x05\xda\x0d\x10\x5a\xd7\x80\xe8\xaf\x7f\x7d\x09\x75\x8d\xfe\xcf\xfe\x7d\x32\xb8\x3f\x3a\xb2\x61\xb0\xe0\xc2\x12\xf3\x63\xc8\xa8\x09\x63\xa7\xaa\x6b\xe5\x34\xb5\xc7\xdb\x8a\x14\x96\x12\x7f\x56\x9a\x0f\x6c\x48\x61\xce\x1f\x9e\xff\xa6\x92\xa9\x21\xcd\x53\x7f\x6b\xea\x2d\x68\x37\xa1\x67\xab\x85\x31\xe2\x39\x2c\x68\x4e\x05\x9b\x01\x33\x5d\x7f\x03\x74\x02\xba\xa6\x79\x70\x7e\xba\x61\x9d\x90\x4f\xdc\xc4\x80\x74\x44\xe1\x19\xcb\x95\x9f\x09\xc9\x33\xb2\xb1\x71\x07\xad\xe7\x67\x64\x45\x3d\x2c\x6e\xf0\xe1\xec\x1c\x49\xf0\x8f\x2f\x23\x3c\x1d\xba\x48\x75\xb4\xd8\x53\x22\x2f\x99\x9c\x61\xa8\x2b\xc7\xc8\x52\xab\xdd\x2c\xc1\xdb\x57\xda\x0c\xee\x50\xe1\xc4\x52\xa1\x5d\xab\xf1\x35\xb5\x21\xbe\x24\xf2\xa5\xa0\x73\xb6\x01\x3c\x7c\xd7\xf4\x6a\x53\x08\x2a\x25\x72\x6d\xcc\x93\x71\x1c\x7b\x56\x6a\xb7\x4b\x5d\x5f\xb4\x5b\xba\xaa\xfa\x73\xd4\x75\x6b\x33\xc6\x4e\xf4\x4c\xab\x07\x4b\xc0\xba\x1e\x4d\xa7\xc8\xe4\x44\x91\xf1\x92\x16\xca\x92\x5a\xe9\xf6\x33\xd5\xe4\xf6\x6e\xb7\xb0\xe6\x0d\xb5\xcd\x47\x98\x62\x43\x6c\x9a\x74\xd7\xeb\x6f\x73\xc7\xd8\xad\x03\x88\x91\xcd\x6e\xc4\xc8\xc6\x45\x8c\x6c\x76\x22\x46\x36\x8f\x89\x98\x9d\xef\x78\xb4\x7e\xce\xd9\x87\x35\xdd\x89\xd9\xba\xed\x72\x06\xa5\x58\xd3\x10\x46\xce\x3c\xc7\x21\xf5\x5b\x6f\x17\xd8\xb3\x5f\xe0\x31\x37\xcc\x91\xcc\xb9\xca\xd7\xab\x21\xae\x60\x5b\xb3\x89\x4c\xaf\x00\x57\xb0\xe9\x82\x48\x1a\x69\xed\x78\x18\x5b\x74\xe7\x4f\xc4\x99\x53\xab\xbb\xad\x33\x0d\xa7\x56\xe9\xda\xc8\xd1\x01\x8c\xf2\x86\xed\x62\x97\x39\xe5\x2e\xd6\xb2\xe4\xab\xc6\x27\x29\x29\xba\x4c\xc9\x4d\x29\x58\xbe\x88\x8c\x63\xec\xce\xa8\xac\x2a\xf3\x42\x2f\xd2\x9d\xfe\xb4\xae\x3d\x4c\x8e\x5f\xc4\x3e\x3b\x4f\x86\x23\x96\x63\xff\xef\x6e\xac\xf9\x7e\xda\x6e\xa3\xe4\x5a\xe2\xab\x8b\x6b\xa8\xeb\x39\xc9\x24\x6d\xe5\x12\x77\xed\x61\x52\x88\x2e\x86\xed\xe9\xfc\x1a\xe1\xfa\xe6\x18\x9d\x21\x69\x87\xd2\x23\x17\x3c\xbf\xa3\xa2\x21\xa7\x45\x0b\x99\x88\x71\x9f\x7b\xb2\x58\x50\xd1\x50\x1c\xc6\x7a\x64\x48\xbc\x9b\x2e\x67\x3d\x39\xf5\x67\xe8\x10\x4e\xc3\x01\x77\x44\xe4\xa8\x21\x03\xdc\x9f\x98\x3d\x52\x55\x3e\xa8\xd1\xee\x71\x6f\xe2\xd6\xca\x74\x28\xe7\xd2\x0e\x03\xc4\xd7\xb9\x22\x0b\x9a\x2f\x47\xa8\x41\x0c\x0c\xb5\xa1\x9c\xf1\x64\x0f\x0e\xc6\xf0\xb4\x42\x69\xf4\x5a\x5f\xd8\xb4\x69\xf5\x9e\x15\x2f\x89\x90\x2a\xba\xa2\xf2\x51\x05\x11\x92\xe5\x0b\xc0\x20\xe5\x7b\x56\x14\x34\x55\x5e\xa3\x54\xde\x99\x8a\x67\xe8\x50\xe6\xc9\xf4\x31\x58\x74\x87\xf4\xb4\xa4\x9f\xab\x81\x32\x51\x20\x45\xfb\x86\x4f\x7e\x4f\x8c\x51\x88\xb8\x86\xff\x2e\xd0\x50\xcc\x4e\x22\x35\x24\x89\x4e\xaa\x4a\xcf\xa4\x0c\xa7\x30\xf7\x38\x92\x7f\xf3\x42\x45\x36\xa1\xef\x9d\xe9\x54\x62\xca\x66\xa4\xa4\x29\xa6\x40\x73\x2a\xf1\x97\x62\x98\xca\xf5\x58\x8e\xed\x07\x6d\x77\x8f\x37\x3d\xaf\xaa\xdf\xa7\x8d\xee\x68\x96\xc6\xd0\xd3\x2d\x6a\x96\x3b\xaa\x83\x54\xd8\x9a\x44\x27\x9a\x29\x2d\x76\xb1\x17\xaa\xd2\xac\xbb\xa3\x76\x01\x34\xb8\x5d\xbe\xc5\x4e\x5c\xac\x65\x74\x77\x5f\x58\xf5\xf0\xf1\xa4\xe8\xaa\x98\x36\xcf\x56\x34\x31\xee\x6d\x30\xd5\xe6\x0c\xf3\x34\xe8\x8a\x14\xdd\xfe\x75\xed\x9f\xbb\xfa\x00\x19\x0c\xb6\x9b\xf3\xc5\x1e\xb8\xed\x2b\x7d\xe0\xc6\x3a\x88\xdf\x7a\xf4\x6a\x0a\xdc\xe8\x26\x32\xd4\x12\xaa\xd9\xe4\x18\x60\x5f\x12\xe9\x7b\x7b\xf2\x0c\x66\xbc\xd8\xa2\xbe\x20\x59\x06\x34\xa3\x2b\x9a\x97\x32\x40\x15\x4b\xc4\xa1\xc3\xf7\x15\x52\x7b\x45\xde\xd3\xc8\xdb\x59\x13\xed\x3b\x0d\x8e\xbb\x88\xe3\x50\xa0\x7f\x02\xbb\x06\xbd\x69\xf3\x00\x83\xdd\xea\xfa\x02\x2a\xf7\x98\xd2\xdd\xb4\xbd\x63\x44\x08\x9c\x19\x86\x96\xbb\xe8\x4a\xd2\x50\xc7\x37\x76\x4a\x77\xc5\x9d\xb9\xe4\xa6\xf3\xbe\xe4\xae\x9a\xc8\x05\xd9\xe1\xce\xae\x39\xb0\xfe\x82\xf6\x26\xb0\xd2\xe1\x52\x45\xa7\x86\xcc\x7c\xbf\x02\x4d\x1c\x08\x03\xbb\x64\x00\x44\x07\xa4\xee\x06\xf2\x8c\x4e\xa3\x90\x0e\x02\xa9\x97\x6c\xf0\x19\x16\xc8\x2a\xb4\xaa\xe8\x11\xb2\xc5\x07\xc1\x68\x03\xe3\x87\xe4\x15\x3a\x02\x51\x8f\x82\xaf\xbb\x72\xd2\xb5\xf6\x02\x2c\x68\x07\x1f\x02\xf4\xab\xb7\x55\xa5\xf7\xb0\x4a\xe9\x1c\x34\xa8\x8d\xd1\x18\xb6\xea\x90\xa6\x79\x7c\x46\x8a\xba\xbe\x7e\x55\x55\x26\xbe\x35\xac\x7d\x87\x54\xb6\xab\x82\x93\x6b\xf9\xd2\x94\x24\x39\xc8\xb5\x64\xd1\xe3\x18\xcf\x6d\x3f\x5b\x5f\xe1\x11\x45\x4f\xb8\xd3\x42\x6b\x9d\x24\xf3\x8c\x51\xb0\x7f\x7c\x19\x0f\x38\x76\x8d\x75\xf4\x62\x1e\x55\x95\x92\x29\x6d\xac\x58\x01\x52\xc6\x0a\xbe\xf0\xac\x29\x63\x5b\x76\xc9\xeb\xb8\x24\x13\x30\x47\xf9\x2e\x2f\x61\x08\x47\xcb\x15\x6d\xab\x28\x15\xe3\x9e\x24\xc6\x3a\xe9\xd0\x32\xac\xce\xda\xa9\xad\x1a\xf2\x14\x50\x55\x0d\x8b\xcb\x91\x8a\x67\xaf\xda\x71\x60\x31\xae\xd1\x9e\x08\xa3\x51\xaa\x75\x3d\xba\x23\xe2\x20\x58\x5e\x81\x77\x2a\x8e\xf4\x79\xd7\xaa\x9c\xe7\x94\xa6\x52\xd5\x6e\xe8\x60\x83\x53\xc7\xd1\x7a\x7b\xff\xb2\x6e\xdb\x81\x4a\xc4\x3b\x27\xf7\x76\x6f\x0e\x4c\xc3\x71\x7f\x37\x6a\xbe\x0b\x3a\x5b\x0b\xc9\xee\xa8\x5b\x83\xc7\xe7\xa0\x52\x06\xaa\x3e\xc2\x37\x56\xed\x74\x4a\xf8\x9b\x29\xad\xd3\x62\xd8\xad\x7d\x10\xbd\xa0\xeb\x89\x5c\xf0\x2c\xa3\x33\x54\x9c\x8e\x4f\xa2\x21\xeb\xb6\xb5\xc2\x63\xf9\xe7\xcb\xd2\x30\xda\x67\x26\x68\x1d\x80\xf0\x20\x3a\xb7\x2c\x92\xf7\x64\x91\xdc\x14\x19\x2b\xbf\xdb\x36\x70\x45\x07\xcd\x30\x74\x70\x04\xb0\x34\xd1\x05\x07\xdf\x03\x8d\x8d\x21\x33\xe1\xb0\x33\x40\xe7\x49\xfb\xe8\x0c\x21\x75\x11\xc3\x37\x6e\x3a\xf5\x18\x0b\x65\x3f\xc9\x5e\xb5\x89\x9b\x83\xba\x4f\x1e\xed\x34\x02\xe7\x38\x0a\xb9\xec\x9d\xbe\x6a\xe3\x68\x07\x1c\xcd\x70\x96\x6b\x17\x3c\x2a\xb8\x94\x0c\x73\x20\xf7\xac\x5c\xba\xbe\x5e\xec\x6a\x53\x3d\xdd\x3e\xf1\x3d\x58\x21\x9e\xee\xd1\x88\x7f\x60\x4e\x58\x2e\x68\xaa\xe7\x3c\x3f\x55\xaa\x09\x9e\x3c\x51\x0f\x48\xff\x12\x95\xb0\x65\x42\x57\x51\x85\x0d\xcc\x7d\x95\x06\x7f\x59\x9e\xae\xe5\xd9\x79\x79\x9c\xd5\xf9\x87\x93\xb7\x06\x7b\x37\xba\x3a\x9d\xc2\x05\x4f\x69\x93\x49\x55\x11\x9d\xdb\x2d\x2c\xf8\x29\x1e\x0e\x0b\x2a\xbe\x32\x35\xeb\x57\x97\xd7\xaf\x93\xd1\xc8\x64\x05\x2e\x78\xb1\x15\x6c\xb1\x2c\x31\x50\xdc\x1c\x8a\x33\xbe\x42\x97\xbc\xd3\xd6\xae\x34\x1a\x15\x64\xf6\x9e\x68\x47\xf8\xa5\xfe\x8d\xa6\xcb\x74\x0a\xaf\xb1\x54\x64\xce\x50\xbf\x10\xe9\x03\x53\x2e\x29\x68\x68\xa0\xe4\x3c\x4b\x30\x41\x74\x85\x15\x56\xf9\xa2\x29\x71\x54\xe3\x56\x0a\x9a\x42\xf0\x3b\x0a\xf3\x75\x89\xaf\xee\x97\x34\x87\x2d\x5f\x83\xa0\xa7\x62\x9d\x7b\x33\x99\x25\x14\xd8\x24\x4f\x47\x23\xb6\x2a\xb8\x28\x01\xd3\x14\x63\x74\x5a\xe8\xa6\x1c\xe3\xef\xf9\xaa\xf9\x2f\xe3\xea\x3f\x39\x2d\xa7\xcb\xb2\x2c\xc6\x18\xd5\x18\x2f\x58\xb9\x5c\xdf\x26\x33\xbe\x9a\x2e\xf8\x29\x2f\x68\x4e\x0a\x36\x6d\x42\x4b\xe3\xe1\x0e\x62\x9d\x97\x6c\x45\xf7\xf7\x98\x4a\xb4\x62\x58\xb9\x3d\xa0\xeb\x8a\xa5\x69\x46\xef\x89\xd8\x35\xaf\x2c\x85\x41\x68\xa0\xc3\x3d\x59\xec\x68\xd6\xbb\x81\x2a\xf4\x51\x18\x14\xd5\x24\x24\x97\x74\x4e\xd6\x59\x79\xad\x9f\xeb\xba\xd3\xee\x34\xc4\x8a\xe5\xcf\xe9\x7d\x30\x2d\xaf\xab\xef\x67\x82\x92\x92\x4a\x20\x90\xd3\x7b\xd8\xd5\xb3\x29\x95\x37\x92\xd9\xbc\xc4\xb8\xe5\x0d\x5f\x51\x0d\x95\x44\x31\xc5\x45\xd5\xe9\x85\x72\x90\x36\x0d\xe8\x17\xac\x29\x5a\x83\xac\x64\x6a\xf6\x34\x31\x16\x92\x1e\x92\xf3\x6e\xe7\xc6\x85\x4b\xd1\x84\x94\x05\x9d\x25\xae\x1d\x3e\x5f\xe7\xb3\x3d\xa8\x45\xf1\x4e\x74\xaa\x3d\x98\xe8\x60\xb6\x50\x92\x3a\x9d\x3a\xa0\x37\x27\x32\x2d\xa9\x90\x0d\xa2\x3e\xdc\x0d\x43\x1a\x1b\x5b\x4f\x6e\x54\x19\x18\xa3\x12\x73\x6a\x7a\x50\x9b\x65\x6a\xdb\xf5\xd1\xf2\x3d\xcb\xa8\x9a\xa0\x63\x74\x5e\x5f\xd6\xb5\x19\x7e\xee\x0c\xee\x9e\x59\xae\x5b\x3b\xe4\x8e\x46\x52\x79\x83\x17\x3c\x2f\x09\xc3\x8f\x29\xfe\x87\x0a\x0e\xe3\xe8\x9f\x63\x27\x1d\xaa\xde\x19\xb3\x45\x49\xb6\x39\x32\x0d\x4d\x84\x29\x7c\x91\xf0\x73\xbe\x22\x42\x2e\x49\xf6\x9a\x6e\xca\x28\x9e\x00\x4d\x16\x09\x5c\x92\x92\x4e\xd4\xff\xe3\x26\x9a\xc0\xe5\x5a\x28\x37\xb1\x75\x0b\xda\x7f\xec\x29\x73\x28\x22\xbb\x70\x50\xb4\xd3\x8e\x96\x4a\xfd\x74\x73\x6b\x86\x92\x75\x1d\xef\x44\xb0\x24\xef\xa9\xc4\x56\x0c\x8e\x1e\x0b\xb5\xb1\x16\x3a\xa0\xc7\x70\x7a\x2c\x78\x82\x2e\xd6\x19\x11\xb0\xe0\x60\xbf\xa3\xea\x03\xbb\x07\x3e\x7b\x94\xe9\x24\xe6\xf4\x04\x2e\xb9\xca\xbd\xb6\x93\xc0\x5c\xf0\x15\x58\x83\x54\x0b\x39\xee\x61\x93\x8a\xd0\x2e\xde\xc9\xd4\xa6\x35\x87\x65\xd1\x1c\x99\x0e\x23\xcd\xab\x2e\x3b\xfb\x32\x67\x81\x6a\x44\x46\x96\x78\xa4\x2c\xb6\xcd\xee\xeb\xc8\x5b\x08\xf5\x1e\xfa\x66\x69\x87\x08\x47\xac\xf8\x6f\xc9\xf3\xc4\x2e\x7b\xd8\x92\x03\x44\x88\x1c\x2f\x78\xaf\x74\xe0\x7c\x8a\xe6\xd7\x06\x3a\x2a\x5c\x01\x39\x5c\x3e\xce\x8c\xf7\xa1\xdc\x2e\xb7\x65\x2f\x2e\xb8\xcc\xa0\x88\x2a\x08\x30\x71\xc0\xcb\x25\x15\x30\x23\x92\x4a\x88\x94\x02\x90\xaa\xe6\x2b\x86\xb7\x72\xc9\xd7\x59\xaa\x84\x8d\xcf\x66\x6b\xf1\x6e\xe7\x92\xad\xcd\xf8\x20\x58\x10\x02\x53\x6f\x16\x5a\x27\xb8\x46\xef\xa5\xf7\xc2\x7b\x88\x47\xa3\x80\xb2\x0f\x6a\x79\xbd\xcf\x66\x44\x88\x2d\xf0\xdc\x97\xdb\x41\x81\xf3\x36\x97\x53\xd7\xe2\xed\xa4\xe3\x75\x7b\x1c\x0f\x9f\x29\xad\x68\x23\x64\xd1\xdb\x77\xb7\xdb\xb2\x9f\xbb\x35\x98\xe9\x14\xa7\x06\x2f\xa4\x60\x74\x6b\x8b\x00\x17\x10\x1d\xad\x15\xe2\xc0\xae\x75\x66\x6e\x03\xa7\x9d\xdd\xa9\xc1\xff\xa5\xaa\x2c\xf8\x72\x0c\x11\xf6\xb2\x48\xc4\x75\xfd\x4b\x3c\x81\x27\x3e\x41\xc0\x52\xa4\x5f\x46\xd1\xfe\x33\x9d\x42\x41\x72\x36\x93\x88\x19\xda\x29\x6c\xce\xb4\x7f\xc5\x50\x59\x2a\x4b\xce\x1b\xb1\x92\x0b\x84\x73\xbe\x2a\x93\x9b\x06\xa6\x68\xac\xfb\xf9\x26\x10\xc6\x67\x5b\x63\xc3\x73\x59\x54\x11\xf1\x19\x7c\x7e\x37\x9e\xe8\xf2\xd4\xf6\x5f\x05\x4e\xb4\x92\x0b\xf7\x75\x87\x0b\xba\xda\x24\x2c\xdf\xb6\xd5\x79\xaf\xdf\xd6\x75\x1b\x1d\xde\x61\x5d\x55\x70\xd0\xae\xd0\x00\xf8\x13\xa1\x75\x73\xf6\x09\xca\xc5\xda\x1a\xd3\x21\xb6\x4f\x5a\x6a\x38\x1e\x16\xa0\x67\xa7\xeb\xb4\x86\xf0\x57\x4e\xb1\xda\x73\xa8\x0c\xd1\x10\xbe\xe5\xeb\x3c\x35\xa1\x50\xe4\x2b\xbe\xac\x2a\x58\xae\x57\x24\x77\x27\x00\xcc\x57\x28\xf9\xc1\x35\xca\x6d\xc1\x66\x24\xcb\x94\x7f\x26\x29\x10\x41\x81\xdf\xa2\xa9\x86\x65\x1c\x78\x40\x13\x40\x37\x49\xc5\x09\xa8\x2c\x47\xd3\x29\x0e\xd3\xee\xd7\x99\x63\xa9\x56\x95\x5d\x62\xa4\x8e\x83\x5d\xe0\xcb\x52\xac\x67\x25\x54\x3a\x55\xfc\xf4\xf5\xeb\x97\xa0\x57\x80\xa6\x64\x61\x04\xea\xad\x79\x79\xe2\x02\x01\xbf\xe0\xee\x3a\x1b\x9f\x8e\x7f\xd1\xee\x4b\x57\x14\xa6\x27\x5a\x18\x2e\x29\x32\xb1\xd0\xe1\x88\xaa\x82\xdb\x8c\xcf\xde\x5b\x1f\xb7\xd7\x6c\x79\x81\x83\xfd\xd8\x88\x79\x6a\x0a\x17\xbb\x7d\x9f\x91\x0d\x5b\x35\xc5\x73\x00\xfa\xc1\x48\x59\x72\xb5\x99\x65\x6b\x8c\x61\xb7\xbd\xbe\xf6\x38\xef\x0c\xef\x4d\xcc\x72\xdd\x32\x02\x78\xc6\xf2\x81\x89\x6d\xaf\x6f\x3a\x13\xb3\x7c\x68\xe2\x75\x56\xb2\x22\xa3\x2f\xe6\x7a\x6e\xfd\x0c\x2f\xe6\xba\xf0\xd4\xed\xd0\x1b\x4d\x36\x3f\xd1\x7c\xa1\xaa\x77\x10\x30\xb2\x81\xe6\xd9\x16\xad\xda\xe6\xde\x50\x96\x7b\x43\x59\xee\x0f\x65\xf9\xe0\xd0\x97\xea\xf4\x41\x5e\x8d\x00\xf4\xc3\x99\x0e\x44\x98\x96\xde\x72\xba\xa0\xb5\x05\x34\x5c\x5c\xdb\x1b\xd7\x16\xf8\x6a\x28\xdd\x71\x2c\x1f\x1a\xd7\x29\x83\x05\x68\x5e\x84\xc5\xc6\x89\x95\x8d\x00\xae\x35\x32\xce\xdb\xee\x80\x70\x0e\xa2\x7d\x0b\x5e\xda\xa2\xdf\xb9\x3b\x5f\x57\x5b\xea\x87\x33\xd8\x69\xf9\xe8\x39\x46\x00\x27\xd3\x91\xe7\x55\x6a\x53\xa8\xae\xfd\xed\xaf\xd4\xde\x27\x50\xba\x6e\x8d\xae\x63\xd7\xba\xe6\x5c\x4f\x27\x1d\xfb\xf9\x40\xa7\x93\x01\x64\xc7\xe2\x86\x58\x0d\x70\xb5\x51\xf0\xdf\xb1\x3c\x35\x2a\xed\x96\x63\x3c\x9e\xe5\xa9\x54\x80\x98\xf8\x0c\x06\x4e\xd0\xf3\xa5\xb2\x9c\x00\x2b\x81\x48\xb9\x5e\x51\x09\xe5\x92\x94\x18\xf3\xc2\x1a\x2f\x8c\x9e\xe5\x0b\x89\x31\x9c\xa6\x9c\x06\x08\xe8\x64\x0c\x52\x05\x3d\x70\xb4\x05\x5e\xd1\x05\x93\xa5\xd8\xc6\x68\x6b\xe0\xf7\xc5\x86\xa6\x08\x8a\x53\xb1\x67\x42\x29\x25\xdc\xb3\x2c\x83\xb5\xa4\xca\x3d\x51\x71\xb9\x15\x2d\x97\x3c\x05\x3c\x31\x64\xa2\xcf\x82\xd7\x1c\x68\x2e\xd7\xa2\x1b\x8c\x99\xe0\x91\x62\x34\xfd\x6a\x2d\x4b\x58\x92\x3b\x0a\xb7\x94\xe6\x8e\x43\x90\x36\x4e\xd6\xde\x50\xcb\x2d\x9d\x73\x41\x97\x24\x4f\x93\x26\x38\x13\x05\x3e\x10\x81\x93\x1d\x93\xc4\x2e\xbd\x23\xe1\x1f\x29\x13\x50\x1f\x4b\xc1\x49\x1b\x7d\x4b\x9e\x91\x72\xb6\xa4\xe9\x2b\x6c\x30\x44\xab\x74\xd0\x06\xbf\xe2\x79\xfb\x4e\xbd\x1b\x0d\x7c\xac\xe2\x1e\x5f\xe7\x60\xba\xe9\x3d\xf7\x5f\x6b\x2a\xda\xcf\xd6\x3e\x48\x34\xd8\x74\x00\xb0\x89\x27\xcb\x48\x24\x3f\xbf\xfa\x29\x51\x1d\xa3\xd8\xc9\xc0\x79\xf3\xe0\x6e\xb7\xd3\xb4\x36\xaa\xc0\xa3\x50\xd2\x46\x83\x13\x51\x62\xb7\xe8\x3f\xff\x0e\x5f\x7f\x0d\x7f\xff\xa2\x6b\x6d\xfe\xed\x6f\x7a\xe0\x67\xe7\xcd\x59\x7f\x25\xc4\x73\x5e\xda\xc1\x1d\x83\xd4\x4f\x0f\x3c\xa7\xf7\xd1\x97\x5f\x7c\x31\x19\xf7\x4c\xc5\xda\xfa\xa6\x3e\x50\x0a\x96\x5d\x16\xef\xc1\x0b\x8c\xfe\xe6\x68\x31\x9c\x56\x51\xce\x92\x03\xab\xbc\xd2\x30\x65\xb1\x73\x6c\x37\xa4\xa7\xd2\x06\xc3\x6b\x5e\xe8\xcc\x75\x11\x34\x0c\xd7\x0e\x53\xa1\xae\x3f\x04\x45\x71\x02\x1f\x96\xef\x07\x5a\xfe\x85\xa0\x7e\x90\xc9\x0f\xb4\x7c\xf1\x63\xb7\x42\xb1\x25\x63\x48\xd6\x30\xa7\xe9\xcf\xaa\x14\x6e\x74\x3c\x10\x1f\xf7\xfd\x9a\xef\xa1\x61\x35\x89\x21\x87\x18\x5a\x6f\x37\x39\x1a\x70\xd4\x24\x8f\x4a\x98\xe3\xc1\x79\x4c\xc2\x3c\xa5\x24\xa5\xc2\x90\xe6\x81\x18\x24\xcd\x2c\x6f\xd5\x96\xbd\x20\x39\xcf\xd1\x92\x6f\x5e\xfe\x48\xb7\x1e\x9d\xde\x4d\x94\xf5\xf1\xb8\x58\x58\xdd\x63\x3d\xbd\xaa\x62\xf3\x40\x60\xb9\xf7\xb1\x51\xf8\x13\xa4\x06\x74\x5b\xda\xdd\xec\x52\x9c\x6a\x80\xe5\x06\x6e\xb3\xfd\x1c\x33\xe0\xc9\x93\xae\x42\x7b\xc6\x24\x56\xa9\xe3\x74\x76\xaf\xef\xc0\xd8\xd5\x3c\x30\x16\x94\xa4\x18\x95\x55\x19\xaa\xcf\x3f\xc0\x9c\xb0\x0c\x1d\x01\xd4\x79\xdd\x24\x68\xe4\xe3\x15\x9b\x0f\x05\x9c\xaf\xf8\x43\x10\xfb\xda\xf2\x3c\x08\xb8\x66\x91\x4a\x66\x9c\xf2\x42\xb9\xef\xab\x06\x2f\xb8\x5d\x97\xc0\x95\x23\x43\xb2\x06\x4e\xeb\x9b\xb9\xeb\x36\x4a\xae\xa7\x9a\x8f\x11\xbc\x63\x19\x1a\x92\xb2\xbe\x83\x35\x9d\xf6\x1d\x2c\x43\xd6\x7f\xe6\x58\xfa\xa4\x92\xee\x50\xd7\xc3\x12\xda\x60\x65\x57\xe8\x61\xe4\x63\xa3\xdf\xc2\x39\x3c\x31\xe7\x83\x92\x8e\x4b\x52\x92\xb3\x20\x3e\x13\x68\x30\x0a\xb7\x36\x6d\xb5\xde\x2a\xed\x66\xa9\xeb\x79\x87\x8e\x76\xba\x79\xba\x5b\xfb\xcd\xd3\x47\x55\x7a\x0f\x81\xe3\xe3\x14\x86\x77\xc2\x4e\x4f\xd4\xcf\xa4\xd5\x0e\x3a\x60\xda\xef\xa3\x4e\xd9\xe6\x8c\x75\xfa\x68\x99\xed\x1c\xbf\x7f\x1d\xc0\x7f\x1d\xc0\x7f\xc2\x03\x58\x47\xe9\xdb\x43\xf8\xcf\xac\x67\x1c\x0d\xa2\x7f\x8c\x3a\xd9\x0c\x7b\x4d\x88\xf1\xdc\xb5\xf6\x60\x73\xeb\x1d\x3c\x25\xaa\x57\x24\x62\xbd\xa4\xce\x2f\x76\x5d\x7d\x27\xac\xdc\x23\x49\x10\x3b\x40\x87\xec\x3b\xef\xde\x04\xa7\xc6\x29\xa5\x73\x2a\x74\x87\xe4\x22\xe3\x92\x46\xf1\xa8\x9f\xe0\xec\x05\x25\x9c\x57\x57\x1b\x2c\xca\x68\x8b\xb8\xb0\xae\xcf\xda\x51\x58\x8f\xcc\x75\x9d\x8e\x02\x28\x51\x97\x73\xc9\xb6\x74\xa7\xcd\x66\x60\xf5\xb1\xe0\x85\x69\xd2\x41\x8c\x36\x5a\x64\x34\x6f\x5d\xdf\x60\x62\xcf\x52\x3b\x6a\xc0\x37\x6c\xbc\xe0\x39\x86\x29\xb4\xaf\xc6\xe6\x7d\x6e\x3a\xd8\x75\xc2\xac\xce\x88\xf3\x73\x60\x3c\xb9\x7a\xf1\xbd\x33\x08\x91\x3a\x37\x16\x9a\x19\xe9\x8a\x6d\xbf\x2a\xcd\x89\xa6\x4d\x60\x3c\x8e\x47\xdd\x8c\x85\x97\x9e\xd8\x21\x69\x7d\xde\x61\x28\xc0\xdc\x68\xd2\x12\xcb\xc5\xfa\xec\xbc\x43\x13\xf3\xc3\x92\xec\x09\x4e\x10\x92\xf8\x07\xd3\x28\x88\x40\x97\x5e\x7b\x6d\xd6\x7d\x64\xb4\x74\x74\xcd\xae\x00\x39\x77\xc0\xf3\x9c\xde\xab\x38\xc5\x15\xb2\xf3\x31\x80\xea\x5b\xda\x43\xe4\xab\x7b\xe0\x1a\x6b\xa2\x87\x80\x67\x57\x76\x6b\x0b\x3b\xb7\xbb\xea\x0f\x0d\xcc\xfc\xde\x4c\xf5\x68\x00\x24\x77\xfe\x4f\xc7\xbb\x00\x84\xbb\x1f\xfc\x62\x26\xcc\xfa\xd2\x4d\xd9\xa9\xfa\xee\x7f\x22\x78\xbf\x64\xb3\xa5\xbe\x4c\x17\xd3\xd2\x18\x14\xd4\x75\x79\xba\x74\x07\xbd\xab\x17\x79\xb6\x05\xfd\x2d\x0d\xde\xb7\xab\xe2\x85\x68\x05\xde\x52\x90\x98\xaa\x61\x79\x1b\x09\xdd\x7b\x30\xf9\xb0\xd1\x48\xc3\x43\x93\xff\x66\xe5\xf2\x85\xc9\x7f\xe9\xe0\x5c\x24\x4c\xff\x28\x8e\x3f\xfe\x20\xea\x97\xaf\xab\x6b\xbe\xda\xb2\x74\x3f\xbc\x75\xc1\x57\x05\x97\xac\x74\xca\xe7\x9b\xdd\x20\xa8\x4c\x92\xc4\xcc\xab\x07\xe5\x2c\xd3\xb1\xe3\x0e\x8a\x86\xe4\x2a\x34\xec\xf8\x7e\x12\xef\x38\xdc\x9f\x0a\x9c\xc0\x2d\x91\xb4\xcb\x1f\xfc\xbd\x55\x29\xc1\x35\x36\xb2\xfc\xc1\x91\xd7\x0e\xb4\xd1\xac\xdc\x98\x45\x0c\xf1\xed\xd7\x42\x30\x10\xaf\xae\x8e\x10\xc3\x7e\x90\xd6\xc8\x7a\x20\xb0\x67\x9a\x74\x20\x31\x3c\xe3\x5e\x99\x9b\x79\xc3\x42\xf7\x43\x21\xd6\x3b\xbe\x89\xda\x23\x59\x00\x1d\xe9\xf2\x1e\x1f\x57\xda\xbc\x75\x7c\xd1\xc3\xa6\xff\x3f\xcb\x88\x94\x8a\xc5\x67\xe7\x10\x75\xd8\x1e\x9b\x4f\x9a\x02\xa4\xde\x4f\xe6\xdd\x12\xe6\xac\xdc\x0a\xd7\x61\x94\x7f\xa0\xbc\x39\xaa\xbe\x20\xdb\x8c\x93\x54\xcf\xa3\x37\x1c\xd6\x93\x47\x29\x9b\x95\x30\x6e\xcc\xb1\xb1\xb1\xcb\x60\xac\xc2\xd1\x63\x88\xd4\x89\xd5\x41\x68\x9c\x8c\xfb\x94\x8b\x61\x8c\x1e\xe7\x58\x1b\x33\xe3\x36\x42\x34\x86\xe8\x23\xf3\x53\xf6\x13\x6e\x8f\x9f\x1e\xaf\xbd\x9f\xe1\x10\xf8\x29\xf4\x23\x7c\x98\x04\x1a\x70\x05\x74\x46\xcb\x8f\x47\x21\x55\x1b\xbe\xd8\x14\xd2\x92\x02\xc7\x13\x40\xae\x0b\x6d\xc9\x6a\xf2\xaa\xed\x87\xf7\x10\xb2\x0c\x8b\x88\x05\x85\x36\x3d\x8c\x04\xb1\x79\xe6\x87\x08\xce\xa0\xff\x82\xe0\xae\x4c\xfa\x43\xc5\x2b\x26\xb0\x54\x7e\x1d\x9c\xf8\xef\x75\x88\xcb\x11\x19\x4b\x25\x2e\xdc\x5c\x76\x37\x61\x0e\x20\xd5\x8d\x4b\x8d\xff\xc5\x32\x9a\xdc\x50\xfa\x3e\xfa\x62\x82\x26\x2f\xfe\xbc\xca\x53\xdc\x8e\x10\x6c\xbc\x29\x89\x28\xe3\xd1\x80\x4e\xf0\x92\xec\xfa\x3d\x16\x0f\xe1\x86\xc0\xba\x03\xb7\x51\xc3\xdc\x57\x15\x57\x9b\x19\x7e\x21\xa8\x2b\x0e\x8e\xb0\xb0\xbb\x39\xfc\x09\xa8\x1b\x64\xdc\x6b\x16\x86\x60\x26\x9b\x20\xcc\xdf\x28\x98\xc9\xe6\x60\x98\xc9\xe6\x21\x30\x93\xcd\x11\x30\x87\x76\x91\xf6\xcc\x75\x8a\xa8\xf5\x38\x7d\x34\xb9\xd8\x5b\x5d\x77\xc8\x9e\xf2\xb3\xc4\x81\xcd\xd5\x94\xef\x74\xca\x09\x92\xc7\xdc\x27\x82\xdc\x63\xb0\x14\xde\xbe\x6b\x0a\x01\x27\x78\xaf\xd2\x8f\x74\x0b\xb7\x9c\x67\x07\xe9\x57\x87\x2c\xad\x7a\x73\xe2\x56\x71\xdf\x74\x67\x73\xf8\x4c\x2f\x33\x24\x06\xae\xa9\x7c\xa0\x00\x68\x54\x5c\x86\xf7\x58\xae\x4c\x0a\x72\xaf\x4b\xb4\x0d\x30\xea\xcc\xd5\xa3\x9d\x73\x17\xff\x27\xc8\x3d\xba\xfe\x4d\xe3\x5b\xb7\xe3\xe9\x7f\xbc\xd3\x2b\x35\x06\xb3\x81\xf8\x4c\x9b\x16\x3e\xca\x43\x11\xce\xe9\x14\xbe\xcd\x32\x7e\x7f\x85\x7f\xd5\x42\x1d\x36\xcd\xf8\xce\x4b\x37\x2c\x52\x55\x81\xd0\xe0\x74\x0a\x2f\xad\xfc\x30\x89\x76\xf8\x1d\x4b\x9b\xcf\x76\x66\x3c\x6f\x52\xf8\xe8\x64\x29\x89\x42\x6b\x52\x19\xc9\x76\x52\x0f\xc8\xbd\x7c\x6c\x1a\x3b\x30\xc6\xda\xfd\x6a\x6d\x2c\x23\xdb\x76\xa0\xfe\xac\xfc\x28\x9e\x86\xad\x2c\x23\x2b\x9e\x65\xe5\x05\xd6\x22\xe8\x62\x00\xb1\xba\xfe\xb4\x01\xde\x80\x14\xef\xc2\x43\x71\xff\x1c\xc6\x63\xa8\x90\xc5\xcd\x9f\x1e\xd1\xc5\x15\x05\x91\xd2\xa9\x63\xd6\xb8\xe2\x61\x37\x50\x96\xa3\xa4\xc4\xbc\xd1\x93\xb4\xe5\x14\x85\xa0\x77\x8c\xaf\x65\xb6\xf5\x2a\x2b\x6e\xb7\xba\xae\x22\xb0\xab\xa3\xd8\x67\x9f\xa6\x0a\x3a\x18\x3e\x55\xfa\x1c\xf6\xee\xba\xf2\xaf\x63\xea\xdd\x84\x85\x3c\x68\xd9\xfa\xe8\x97\x2a\x75\x1b\x6d\x08\xa6\xe1\xbe\x46\x25\x7c\xe3\x10\x9c\x07\x13\x71\x6d\xd9\xa6\x42\xad\x2f\x1f\x41\x7d\x3d\x9d\x7a\x85\x57\x8f\x7d\x6f\x15\xfc\x11\xe8\xf8\x80\xba\x2e\x6b\x46\xb6\xaf\x4c\x39\x95\x79\x36\xac\xf1\x0b\xac\x9c\xab\xb0\x1c\x68\x6d\xe4\xa7\xaa\x90\x5b\x75\xbd\x07\xe2\x21\xce\x0b\x72\x3f\xb8\x03\xf4\xee\xf4\xfd\x16\x36\x77\x77\xc0\x11\x77\x06\x47\x3b\xdd\x41\xc3\x58\x4d\xff\x2e\x40\x1d\x1b\xc4\xb7\x42\x9c\x58\xed\xb1\xc6\x84\xfa\x06\xe4\x08\x93\xa2\x31\xe2\x15\xcb\x71\xb0\x0a\x3f\x60\xc0\x80\xcc\x66\x5c\xa8\xc4\x79\xc9\x9d\x12\x45\xb3\x51\xc6\x03\x25\x8a\xe8\x50\x99\x8f\x81\x4a\x0e\xe3\x99\xbc\x1b\x37\x1f\x81\x2a\x1d\x1a\xff\x2e\x6d\x98\x4e\x48\xaf\x67\xa5\x3c\xa6\x85\x12\x92\x05\xfd\x8c\x82\xf8\x21\x40\xd5\xb1\x72\x5b\xc6\xfa\x74\x52\xf1\x23\xbf\xc7\x60\xc1\xe8\x81\xb7\x71\xa9\x3b\x01\x34\x8c\x0e\x78\xed\x3d\x97\x68\x3d\x7d\xb8\xf3\x59\x60\xb8\x66\x2d\xaa\x61\x7b\x6a\x68\x68\xd8\xbe\x82\x53\x50\x16\x56\x3d\xfa\x15\xb1\x0d\x5c\xbc\x31\x00\x65\x9f\xb5\x81\xa5\xe3\xa0\xae\xc1\x9d\xb9\xd7\x76\xda\x7d\x59\x46\x00\x7c\xef\xcf\x0e\x7c\xb4\x68\x1e\xb6\x64\x47\x70\x51\x32\xea\xfa\x21\x40\xc3\xef\xc3\x46\xea\x3b\x08\xfb\xaf\x1c\xb1\x12\x16\xc0\xef\xc0\xcb\xab\x5e\xf5\x23\x96\xe1\xeb\x57\x3e\xe5\x99\x64\xc9\x10\x3e\x95\x5c\x22\xb5\x9f\x68\x05\xec\x85\xce\xc9\x1a\x37\x9d\xec\x51\x16\x42\x36\xd6\x07\xdc\x0e\x84\xd4\x37\x71\x98\x55\xe0\x79\xef\xf6\xc2\xc0\x29\xf7\x90\xe3\xe5\x00\x72\x1e\x1e\x67\xdc\x75\x0b\xe4\x1e\xfa\x76\x2c\x00\x37\x06\xa1\xbe\x57\xd4\xd3\xe1\x9e\xd4\xb5\xdc\x58\x44\xe6\xfe\x6d\x86\xee\xc7\xe7\xed\x88\x81\x9b\x4b\x42\x7f\x89\xc3\xda\x7d\xfe\x1f\xde\x8b\x03\x0d\xea\x8f\x48\xd4\x75\x47\xaa\x75\xf4\xb2\x67\x68\xed\xe1\x34\x4e\x68\xdb\xd1\xac\x09\xa3\xf6\xc8\x1c\xc6\x55\x0f\xe1\xb2\x8f\xa2\x11\x6a\x8b\xfb\x41\xbb\xda\xfd\xd7\xcf\x44\xb6\xea\xa6\x2f\x33\x00\xbd\x9d\xda\x5f\xd3\x5e\xe7\xb4\x63\xd1\x83\x14\xb5\xd1\x39\xfd\xa6\xee\x5c\x07\x28\xcb\xf6\xdf\x47\x51\x9b\x03\x54\x18\xbe\x19\xee\x57\xc7\x7c\xf8\x8a\xb8\x47\x47\xdd\x62\xe9\x4d\x7f\x8c\xf2\x09\x10\xb0\xa7\x8f\x3a\xed\x9d\x47\xe7\xa1\x55\x5c\x3e\x08\x66\x9b\xd2\x7c\x8d\x17\xe7\xad\xa9\x1c\x43\x02\x75\x3d\xfa\xbf\x01\x00\xe4\x81\xad\xa7\xe8\x75\x00\x00")
 
 func templatesServerParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -722,12 +1037,12 @@ func templatesServerParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 28550, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0x62, 0xa2, 0x4e, 0xb0, 0x6f, 0xc3, 0x97, 0x31, 0x62, 0xe, 0xd5, 0x72, 0xab, 0xf9, 0x24, 0x8f, 0x4d, 0xdb, 0x33, 0x1c, 0xc5, 0x1b, 0xdf, 0x38, 0xcc, 0xf7, 0xa, 0x4f, 0x9c, 0x72, 0x9}}
+	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 30184, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1b, 0x6, 0x52, 0xc, 0x99, 0x7e, 0x4b, 0x29, 0x1a, 0xe4, 0xe0, 0xea, 0x8a, 0xcc, 0x72, 0xed, 0x2, 0x48, 0x56, 0x31, 0xb2, 0x89, 0x44, 0xd6, 0x34, 0xf1, 0x59, 0x1b, 0x70, 0x4d, 0xde, 0x31}}
 	return a, nil
 }
 
-var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdf\x73\xdb\x36\xf2\x7f\xe7\x5f\xb1\xd5\x37\xed\x88\x1e\x8b\xcc\xf7\xe1\x5e\xdc\xba\x33\xd7\x38\x77\x75\xe7\x9a\x78\x62\xf7\x6e\xe6\x72\x99\x06\x26\x57\x12\x5a\x12\x60\x00\x50\x8e\x4e\xc3\xff\xfd\x06\x3f\x48\x02\xfc\x21\xcb\x69\x9b\xa7\xf6\xa1\x31\x81\xc5\x62\x77\xf1\xd9\xc5\xee\x42\x87\x03\xe4\xb8\xa6\x0c\x61\x21\x51\xec\x50\x6c\x91\xe4\x28\xee\x6b\x5a\xe4\x28\x16\xd0\x34\xd1\xe1\x00\x74\x0d\x8c\x2b\x48\xae\xe5\x5f\x85\x20\x7b\x68\x9a\xc3\x01\x14\x96\x55\x41\x94\x5e\x49\xcb\xaa\xc0\xc9\xf5\x89\xa5\xc5\x42\xe2\x68\x55\x41\xb3\xe3\x8b\x58\x6e\xf7\x5f\xf5\x7f\xf6\xd2\xce\xef\xd9\xc9\x9c\x5c\xcb\x57\x75\x51\x90\xfb\x02\x61\xd5\x34\xd1\x8e\x08\x38\x1c\x60\x47\x04\x23\x25\x42\x72\x7d\x05\x4d\x03\x52\x09\xca\x36\x11\x5d\xeb\xb9\xe4\x0d\x66\x48\x77\x28\x5e\x69\x8a\xa6\x49\x0e\x07\xa8\x88\xcc\x48\x41\xff\xdb\xad\xf8\xe2\x12\x18\x2d\xe0\x10\xc1\x04\xbb\x4b\x70\x9b\xff\x8d\x8b\x92\x28\x85\xc2\x6a\x13\x7c\x2f\xcf\x4e\xdc\x2b\x0e\x8c\xd7\x9f\xc3\x8b\x5a\x2a\x5e\xfa\x2c\xcf\x3a\x8b\x9d\xc8\xba\xb3\xd1\x98\x57\x72\x6b\x6c\xb2\x8c\x0f\x07\x64\xb9\x26\x35\xff\x44\xc6\xb0\xbd\x38\x03\xcd\x2f\x4e\x53\xfd\x93\x34\xff\x83\x14\x72\x36\xd3\xe0\xb0\xe7\x3f\x50\xe9\x8b\x4b\x58\x2c\xcc\x41\x8b\x87\xe4\x7b\x03\xb3\x65\x9c\xdc\xa2\xd2\x5a\x54\x82\x32\xb5\x86\xc5\x97\x1f\x16\x90\x38\xb9\xce\xc7\x4c\xe2\xa8\xdb\x67\x00\x61\xed\x00\x54\x61\xf9\x49\x28\x4e\xfe\x49\x8a\x1a\x5f\x7e\xac\x04\x4a\x49\x39\x83\xa6\xb9\x1d\x60\x79\x4c\x31\x80\xee\x24\x8f\x27\x00\x78\xbc\xdc\x3b\xb5\x19\x8a\xdf\x04\xbb\x95\xb5\xca\xa4\xdc\x4f\x80\xdf\x51\xb9\x7f\x37\xb1\x47\xe0\x9a\x14\xbb\x87\xd8\x34\xc5\x1b\xb8\x04\x52\x55\xc8\xf2\x19\xd1\xdf\x9c\xcf\xf1\x1e\x22\x2f\x00\xde\x1c\xe8\x86\x41\xf2\xc5\x96\x16\xf9\xa4\x5c\x6f\xdf\x39\xb8\xad\xb9\x80\x9f\xcf\x4f\x5a\xa5\x4f\x49\x10\xb6\xc1\x39\x80\x5a\x43\xac\xba\x50\x67\x19\xcd\x5d\x3c\x47\x3d\xc8\xae\xfd\xa4\x0b\xc8\x5f\xe9\x8e\x30\xb8\x08\xad\x54\x37\x44\x20\x53\x2d\x28\xc7\xd1\x50\x3e\x90\x4d\xf2\x03\xa7\xec\xbb\xbd\xc5\xcb\xf2\x24\xc3\x1a\x4b\x06\xc1\xe5\x05\x2f\x0a\xcc\x14\xe5\xcc\xf2\x31\x51\x85\xae\xa1\x40\xb6\x1c\xc7\x1b\xf8\x16\x9e\x1b\x3b\x6e\x77\xce\x29\x42\x82\xb7\xcf\xdf\x45\xa0\x55\xd9\xee\x3c\xf4\x3d\x21\xc4\x6d\x77\x71\x04\xf0\x04\xbf\xfc\x5c\x86\x98\x74\x82\xce\x1c\x33\x04\xd2\x19\x69\x6a\xae\x33\xd5\xec\x5a\xdf\x80\xbf\xbb\x03\x4b\xdf\xce\x0e\x87\xe1\x9f\x61\xf2\x26\x50\x56\x9c\x49\xf4\xf3\x36\x6d\x60\x9e\x23\xac\xfe\x1f\x9a\x26\x4d\xe1\x70\xf0\xee\x4d\x7d\xa4\x4d\x63\xe6\xa9\x04\xb5\x45\xf8\xfe\xee\xee\x06\x32\x3d\x20\x50\xd5\x82\x61\x0e\xda\xbd\xd5\xbe\x32\x2e\x3b\x5a\x1b\x65\x9c\x49\x35\x39\x65\xd9\x32\x65\x6f\x14\x2b\x85\x9f\xd8\x45\xe9\x99\x8b\xaa\x57\x28\x33\x41\x2b\xd5\x85\xda\x01\x2f\x13\x18\x0e\x70\x5f\xf0\xec\xd7\x8c\x97\xa5\xf6\xba\xd1\x22\x0d\xc4\x23\x8b\xb7\x75\x49\x98\x3f\xd8\x86\xe9\x48\xa3\x73\x83\xe2\xa2\xb5\x9e\xa6\xce\x48\x89\x01\x8b\xe8\x2c\x8d\x66\x8c\xe0\x92\xc8\x3a\x53\x2d\xcc\xe8\x1a\xf0\x83\x6f\xf7\x08\xe0\x67\xa9\x88\xaa\x65\x6b\x14\x4b\xd8\x25\x6c\x36\x26\x3a\xff\x93\xfa\xa4\xce\x0e\x87\x49\xd3\x1c\x37\x42\x6b\xdb\x56\x8c\xe4\x47\xf2\x91\x96\x75\x69\xc7\xdc\xc7\x45\x3b\xf9\xf2\x63\x56\xd4\x92\xee\xb0\xa7\xfa\x26\x10\xcb\x5b\xee\x0f\x1b\xc6\x94\x79\x8c\xed\xc7\x04\xe3\x8e\xea\xdb\x01\xe3\x6e\x62\xc4\xb8\x2e\x14\xad\x0a\x7c\xbd\x76\xbc\xdd\x37\xbc\x5e\x1b\xfe\x21\xc1\x68\x35\xf9\xf8\x0f\x64\x1b\xb5\xed\x34\x06\xfb\xed\xd6\x7a\xd3\x13\x1a\x05\x4b\x29\x0b\x97\x7a\xd3\xc3\xa5\x37\x26\x17\x60\x76\xa1\xfb\xb0\xab\xfa\x99\x09\x49\xaf\xf5\xd5\xd5\x0b\x6a\x3e\x3b\x39\xdb\xc9\x09\x31\xfd\x75\x94\x05\xeb\xfa\xc9\xe1\xba\x9f\x18\xfd\x50\xa3\xb7\xd4\x0e\x5c\x80\x12\x35\x0e\x89\xbf\x27\xf2\x0a\xd7\xa4\x2e\x94\xa5\x75\x1f\x17\x41\x44\xfe\xbf\xdd\x42\x23\xb0\x25\xf3\xd1\x77\x96\x5a\x08\x4e\xfb\x62\xf2\x77\x7e\xa7\x7d\xa9\x69\xe0\xfd\x2f\x92\xb3\x8b\xc5\xe1\xe0\x82\x86\x77\xc9\xbe\xc1\x0f\x35\x15\xa8\x39\x9e\xf3\x52\x5f\xf3\x95\xda\x0f\x05\xbd\x96\x3f\xdc\xbe\x7e\x65\x33\x30\x4d\x68\x53\x92\x8e\x6a\xf1\xde\x77\xb4\xde\x2d\x6e\xb3\x2d\x96\xc4\xb2\x79\xa0\x6a\xeb\x8d\x44\x00\xbf\xcd\xf9\xfe\xf4\xbc\x3f\x3d\xef\x13\x3c\x2f\x02\xb8\x66\x17\xf0\x1d\xcf\xf7\xc6\x81\xfc\x89\x1b\xb2\x2f\x38\xc9\xdd\x21\x13\x96\xc3\xd2\xb8\x88\x05\x6d\x72\x2d\xbf\x23\x12\xb5\x4b\xc5\xde\xd8\x0b\x5e\x56\x05\x7e\x7c\x7d\xff\x0b\x66\x6a\xd4\x21\x70\x64\x23\x4f\xbc\xe7\xf9\xbe\x77\xb7\x81\xff\xe8\x4b\x3b\x85\x57\xf8\x30\xed\xda\x99\x40\xa2\x50\xce\x38\xbe\xf1\xb3\xdc\x85\x8b\xad\xbb\xe9\x76\x3a\xeb\x91\xd1\xba\x66\xd9\x2c\xdf\xe5\xd4\x95\x9a\xb9\x8b\xb4\x13\x2e\x86\xb3\x99\x80\x33\x7d\x25\xd3\xb5\xcd\x73\xbe\xb9\x74\xf9\x21\xd8\xef\x4b\xf8\xcb\xf3\xe7\x26\xef\x0a\xaa\x76\x13\x15\xad\xd0\x3a\x3a\xde\xf2\x12\x5d\xe8\x73\x87\xaa\x0b\xa7\xa5\x0e\x1e\x29\x50\x46\x15\xb5\x52\xb4\x7a\x06\xca\x3b\xa5\x8d\x65\x47\x37\xbf\x91\xa4\x0b\x54\x5e\x20\x5e\x75\x73\x6e\xde\x4b\xeb\x9b\xa6\xa5\xba\xf4\x68\x2c\xdd\xaa\xc5\x4c\x72\x2d\x6f\x04\x2d\xa9\xa2\x3b\x9c\x2a\x65\x0d\xa6\x96\x36\x82\xbe\xe0\x4c\x11\xca\x24\x24\xff\x46\xc1\x61\xb1\xfc\xcf\x62\x01\x71\xec\xc0\x63\xc6\xf4\x9f\xe9\x99\x4e\x7d\xd6\xa5\xb2\x19\x62\xaf\xb8\x00\x61\xc3\xb7\x84\x9f\x58\x49\x84\xdc\x92\xe2\x0e\x3f\xaa\x65\x7c\x0e\x98\x6c\x12\xb8\x22\x0a\xcf\xcd\xff\x15\x2d\xf5\x5f\xb5\x20\x26\x92\x9e\xa5\x81\x9e\xad\x0e\xa6\xce\x38\x55\x91\x63\x3a\x18\xb9\x1d\xee\x0d\xb6\x56\xb3\x37\x5a\x7c\x54\x41\x45\x7e\x45\xe9\x5a\x2e\x4f\x96\x7a\x39\xdd\xc8\x8b\xf5\x29\x3f\x4d\x3c\x81\x9b\xba\x20\x02\x36\x5c\x13\x3a\xf6\x23\x61\x1f\x91\xaf\xab\xb0\xcd\xf6\x2b\x48\xcf\xe0\x8a\x9b\x6b\xd8\xc3\xf2\x5a\xf0\x12\x2a\x2e\x25\xbd\x2f\xb0\x05\xb3\x04\xca\x80\xa1\x54\x98\x03\xd1\x2c\x24\x9c\xa5\x03\xac\x4e\x61\xb1\x2d\xec\xbd\x83\x6c\x87\xc6\x2d\x96\x21\xe6\x3a\xa1\x2c\x64\xa4\x12\x44\xe1\x66\x6f\xbd\x6c\x80\xb7\x29\xd5\x47\xea\x87\x6d\x86\xd5\x13\x77\xd4\x91\x33\xe9\xb6\x3d\x6d\xcb\x19\x23\x2c\x83\xae\xc7\x23\xe8\xd0\xfc\x8c\xcd\xaf\xbd\x93\x0e\x32\xb2\x53\xf1\x71\x01\xb6\xa1\x0e\xa6\x29\xf2\x18\x72\x02\x5d\x6c\xcd\x35\x9b\x13\xa6\x67\x40\x8a\x02\xb8\xda\xa2\x80\x8c\x48\x94\xb0\x34\x01\x40\x9a\x0b\x28\x86\xb7\x72\xcb\xeb\x22\x37\x60\xe3\x59\x56\x8b\x77\x47\xb7\x6c\xaf\xc4\x4f\x94\x45\x4b\xd0\x25\x7b\x73\x4e\xe1\xef\x11\x0c\x04\x1f\x71\x14\x4d\xc5\xef\xc9\xc0\xed\x7c\x2a\x23\x42\xec\x81\xb3\x10\xa3\xb3\xe0\x0a\x1c\xa9\xbf\xfb\x7f\x6b\x1c\x6f\xc3\xf8\xd4\xfd\x91\x84\xde\xf3\xf6\xdd\xfd\x5e\xe1\xa8\x15\xe4\xc5\xa0\xb8\x17\x6f\x2a\x98\x84\x37\x16\x5d\x03\x17\xb0\x7c\x72\x04\x88\x27\x3c\xd4\xe3\xac\x2f\x77\x21\xe0\xe2\x72\xe8\x89\x4e\xfc\xf7\x87\x43\x27\xbe\x5c\xc0\x52\x53\x75\x4a\xc4\x4d\xf3\x3e\x3e\x87\xaf\x46\x7d\xb2\x76\xfe\x6b\xc3\xdc\xeb\x9c\xf7\xff\xa5\x29\x54\x84\xd1\x4c\x6a\x11\x64\x85\x19\x5d\xd3\xcc\x1e\x22\xd5\x81\x71\x47\x0a\x9a\x07\x2b\x4a\xb9\xd1\x72\xae\x4b\x95\xdc\x5a\x99\x96\x0b\x47\x17\xa6\x07\xa6\xed\x62\xb3\x87\x71\x0b\xef\x02\xbe\xdc\x2d\xce\x51\x88\x38\x60\x6e\x64\x59\x96\x72\xe3\x0f\x0f\x8e\xa0\x6d\x1e\x1d\x47\x77\xf8\xe8\xd6\x51\xd8\x9e\x90\xb1\xd5\x38\xd1\xea\x9a\x60\xa3\x5c\xcb\xeb\x7d\x5c\x98\x24\xeb\xdc\x67\x3a\x9d\x06\xcd\x14\xa2\x53\x55\xa5\xb6\xc7\x30\x33\x76\x40\x89\xdd\xa7\x66\x43\x75\xb5\x56\x52\x46\x14\x17\x71\x47\x76\xcd\x14\x8a\x35\xc9\xb0\x1f\xba\x55\x02\x49\x19\x07\x2f\x2f\x4d\xf3\x95\x9f\x3e\x4f\x22\xe5\x3c\xf2\x6c\xdc\x56\x9b\xbd\xbd\x4d\xfa\x3c\x99\x8a\xa6\x29\xfc\x8b\xaa\xed\x6d\xdf\x21\x22\x79\x6e\xfb\x71\xd6\x72\xa0\xb8\xf9\x9a\xea\x63\x41\xdb\xb7\xb2\x49\xf4\xd4\xfb\xda\x4c\x66\x1c\x0f\x76\x5d\xb6\x39\xf5\x7c\x2a\xed\xda\xa8\xc3\xd7\x38\xbf\xb9\x75\x69\x4e\xb8\x07\xcb\x04\xbd\xab\x23\x6e\x51\x79\x2a\x4b\x54\x9f\x43\xe5\x60\x53\x4f\xe3\x27\xa8\xe6\xf9\xc4\x64\x02\xef\x8e\x73\xda\x84\xdd\xc9\x8e\xbb\x8c\x7a\x7a\x42\xeb\x67\x47\xd4\x7e\xf6\x88\xde\xcf\xc2\xb3\x9e\x2d\xaf\x3a\x3c\x77\x82\xf4\x6d\x9a\x71\x69\xf5\x6c\x08\x88\x91\x18\xc9\xb4\xf2\xe1\x6b\x44\xbb\xd7\x89\x58\x99\xe9\xbc\xb6\xb0\xf9\xdc\xf6\x9c\x93\xe8\x14\x73\xfe\x3e\x66\x0b\x71\x18\xb4\xb5\x5a\x0c\xb6\x8d\x83\x0e\x75\x95\x1b\xf8\x03\x03\x8a\xdb\x73\x59\x8d\x9a\x16\x73\xbd\x89\xd9\x66\xc6\x63\x4d\x8b\x27\x07\xaa\xd6\x1e\x97\xad\x21\x4e\xc4\x5e\xbb\xae\x43\xdb\x1f\x6c\xc7\x7e\xcb\xcf\x63\xc6\xd3\xed\xe5\x27\x04\x06\x65\x82\x2a\x7c\xd3\x3e\x9d\x38\x73\x64\x05\x45\xa6\x3e\x05\x3f\x3e\xb7\xa5\x78\x80\xad\x52\x55\xd2\x0e\x98\x59\x71\x0e\x95\xe0\x79\x9d\xa1\x00\x51\x33\x45\x4b\x4c\x6e\xdc\x40\xa7\xc8\x54\x57\x25\x4d\xbb\x13\xe9\x13\xab\xf6\x19\xcb\xdd\xdc\xde\xeb\xf0\xf4\x2f\x93\x56\xfe\xbd\xee\x75\x94\x3d\xd3\xbb\x88\xe6\xbd\xa6\x5e\x61\xb1\x6c\x45\xb5\x83\x3a\x2d\x47\xa6\xec\xf1\xa4\xe9\x1b\x2c\xf9\x0e\xc1\x8d\xae\xcc\xb1\x70\x06\xa6\x05\xd7\x09\x2d\x07\x1b\x8b\x87\xc4\x18\xc4\x6d\x33\x95\x59\x3c\x72\xa1\x85\x3f\x7a\x18\x3e\xd0\xc5\xfd\x53\x7c\xa0\xd9\x70\x74\xa2\xd5\xd8\x3f\x9a\xce\x01\x2a\x48\xa7\x07\x45\x57\x8b\x78\xf7\x2e\x3b\xc3\xc2\x97\x64\xa2\x23\xea\xe7\x75\x5c\x78\x13\xb6\x80\xe8\xbf\x7f\x24\x55\xdc\xee\x4c\xd7\xdd\xe6\x97\x61\xbe\x6f\xc0\x63\xe2\x84\x3d\x94\x20\xe0\x7a\xbf\x4f\x30\xe5\xb8\x5f\x0b\xf9\xfb\x40\xd3\x94\xa4\x0a\x73\xdf\x5e\xdd\x4b\x2f\x27\xb7\x85\x92\xc7\xbe\xa0\x44\xe2\xbc\xeb\x7b\xe5\xd0\xbc\x49\x4e\x69\x12\xb7\x59\xee\x64\xa4\x58\xe9\xc8\x1a\x94\x0e\x16\x3d\xc1\xde\xed\x1a\xd7\x3d\x99\x2a\x34\xda\xa2\x24\xb4\x44\x5f\xc5\xb5\xfe\xdd\xfa\xf5\x52\x3c\x9c\xb7\x66\x9a\x2e\xc7\x6c\xe5\xa3\x0b\x22\x7d\x52\x05\x2a\x13\x86\x04\x66\x7c\x87\x62\x0f\x25\xcd\xf3\x02\x1f\x88\x40\xc8\x91\x14\xb6\x6b\xa3\xb6\x54\x06\xc2\x3c\x0e\xea\x66\x0c\x57\xef\xc3\x0b\x8d\x69\x0a\xc6\x9d\x36\xc8\x50\x17\xf3\x39\xdc\xef\x61\xc3\x57\xee\xad\xf9\x6b\xb8\x7a\x0d\xaf\x5e\xdf\xc1\xcb\xab\xeb\xbb\x24\x6a\xcb\x82\xe4\x05\xaf\xf6\x82\x6e\xb6\xa6\x67\x60\x1e\xeb\xa1\x7b\x6d\x0a\xe6\xbc\x18\x1c\x55\x24\xfb\x95\xb8\x5f\xd4\xdc\xb8\xbf\x5d\x70\xbe\xdb\x52\x09\x6b\x5a\x20\x3c\x10\x19\x0a\x63\x32\x6d\x2b\x0d\x28\xce\x8b\x44\xd3\xbf\xcc\xa9\xa2\x6c\x63\x4c\x63\xd7\x95\x66\xc7\x4a\xe8\xf0\xb4\xae\x95\x61\xb5\x45\x06\x7b\x5e\x83\xc0\x95\xa8\x59\xc0\xa9\xdd\xc2\x88\x4d\x58\x1e\x45\x11\x2d\x2b\x2e\x94\x69\x8d\x2f\xd6\xa5\x5a\xe8\x7f\x19\xaa\x54\x47\xf6\x45\xa4\xbf\x36\x54\x6d\xeb\xfb\x24\xe3\x65\xba\xe1\x2b\x5e\x21\x23\x15\x4d\x51\x08\x2e\xe4\x62\x9e\xc0\x45\xd4\xc7\x29\x52\x89\x59\x2d\xa8\xda\x1f\x21\xd5\x0a\x1c\x99\x36\x05\x3a\x51\x68\x04\xd6\x87\x65\x94\x92\x5d\x13\xe1\xda\x7d\x77\x37\x42\x3b\xef\x4d\x04\xfd\xa2\xf6\x4a\x93\xae\xc4\x1e\x5e\x3c\xfd\x4f\x39\x92\xb0\x08\xef\x7e\x32\x61\xf6\xed\x2e\xde\x47\xb9\x4c\x2f\x70\x4c\xff\x17\x00\x00\xff\xff\xd9\x66\x33\x80\x07\x2c\x00\x00")
+var _templatesServerResponsesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x73\xdb\x36\x12\x7f\xe7\xa7\xd8\xf2\xda\x0e\xe9\x91\xa8\xdc\xc3\xbd\xb8\x75\x67\xae\x71\xee\xa2\xce\x35\xf1\xd8\x6e\x3b\x73\xb9\x4c\x03\x93\x2b\x09\x2d\x09\x30\x00\x28\x5b\xa7\xe1\x77\xbf\x01\x08\x90\xa0\x48\xca\xb2\x2f\xcd\x53\x27\x33\x31\x09\x60\xff\xe2\xb7\x8b\xc5\x52\xfb\x3d\x64\xb8\xa2\x0c\x21\x94\x28\xb6\x28\x36\x48\x32\x14\x77\x15\xcd\x33\x14\x21\xd4\x75\xb0\xdf\x03\x5d\x01\xe3\x0a\x92\xa5\xfc\xbb\x10\x64\x07\x75\xbd\xdf\x83\xc2\xa2\xcc\x89\xd2\x94\xb4\x28\x73\x1c\xa5\x4f\x9a\xb5\x98\x4b\x1c\x50\xe5\x34\x3d\x4e\xc4\xb2\x46\xfe\xbc\x7b\xec\xb4\x9d\x96\xd9\xea\x9c\x2c\xe5\x9b\x2a\xcf\xc9\x5d\x8e\x30\xaf\xeb\x60\x4b\x04\xec\xf7\xb0\x25\x82\x91\x02\x21\x59\x5e\x42\x5d\x83\x54\x82\xb2\x75\x40\x57\x7a\x2e\xb9\xc6\x14\xe9\x16\xc5\x1b\xbd\xa2\xae\x93\xfd\x1e\x4a\x22\x53\x92\xd3\xff\xb6\x14\x5f\x5c\x00\xa3\x39\xec\x03\x18\x61\x77\x01\x56\xf8\x3f\xb8\x28\x88\x52\x28\x1a\xc3\x7b\xef\xd1\xd9\x89\xb2\xe2\x9e\xf3\xba\x7d\x78\x59\x49\xc5\x0b\x9f\xe5\x59\xeb\xb1\x13\x59\xb7\x3e\x1a\xf2\x4a\x6e\x8c\x4f\xa2\x78\xbf\x47\x96\x69\x8e\xe6\x4f\x50\x07\x3d\x75\x0e\x2c\x3f\x3f\xcd\xf4\x67\x59\xfe\x07\x19\x64\x7d\xa6\xc1\x41\x57\x23\x9b\xf9\xc5\x05\x84\xa1\xd9\x68\x71\x9f\xbc\x36\x30\x8b\xe2\xe4\x06\x55\xa4\x35\x16\x94\xa9\x15\x84\x5f\x7d\x0c\x21\xb1\x7a\xcd\x86\x4c\x62\xeb\xb6\x21\x84\x75\x00\x50\x85\xc5\xb3\x50\x9c\xfc\x4c\xf2\x0a\x5f\x3d\x94\x02\xa5\xa4\x9c\x41\x5d\xdf\x1c\x60\x79\xb8\xe2\x00\xba\xa3\x3c\x9e\x00\xe0\x21\xb9\xb7\x6b\x13\x2b\x9e\xb1\x4b\x1d\xec\xb4\xfd\xe3\x6c\x6f\x9e\x02\xbf\xa3\x7a\x7f\x32\xb5\x07\xe0\x1a\x55\xbb\x83\xd8\xf8\x8a\x6b\xb8\x00\x52\x96\xc8\xb2\x09\xd5\xaf\x67\x53\xbc\x0f\x91\xd7\x03\xde\x14\xe8\x0e\x93\xe4\xcb\x0d\xcd\xb3\x31\xb1\xf0\xee\xbd\x85\xdb\x8a\x0b\xf8\x75\x76\x12\x95\xde\x25\x41\xd8\x1a\x27\x74\xb6\x8e\x98\xb7\x47\x4e\xc3\x68\xea\xe0\x39\x1a\x41\x0d\xed\xb3\x0e\x20\x9f\xd2\x6e\xa1\x0b\x47\x4f\xab\x2b\x22\x90\x29\x07\xca\x61\x36\x94\xf7\x64\x9d\xfc\xc0\x29\xfb\x7e\xd7\x40\x30\x3a\xc5\x45\xcd\x7e\xf6\x92\xcb\x4b\x9e\xe7\x98\x2a\xca\x59\xc3\x47\xa7\x47\x8d\xa9\x1c\x59\x8f\x65\x93\x38\xe1\x3b\x78\x61\xfc\xb8\xd9\xda\xa0\xe8\x2f\x78\xf7\xe2\x7d\x00\xda\x94\xcd\xd6\x43\xdf\x13\x52\xdc\x66\x1b\x07\x00\x4f\x88\xcb\xcf\xe5\x88\x31\xf9\x9d\x3b\x26\x16\x48\xeb\xa4\xb1\xb9\xd6\x55\x93\xb4\xbe\x03\x3f\x79\x00\x4b\xdf\xcf\x16\x87\xfd\xc7\x36\xa4\x0d\x8c\x05\xca\x92\x33\x89\xde\xe9\xc1\x34\xd2\x78\x86\x30\xff\x2b\xd4\xf5\x62\x01\xfb\xbd\x77\x6e\xea\x2d\xad\x6b\x33\x4f\x25\xa8\x0d\xc2\xeb\xdb\xdb\x2b\x48\xf5\x80\x40\x55\x09\x86\x19\xe8\xf0\x56\xbb\x12\xa1\x7f\xe6\x36\xb4\x41\xca\x99\x54\xa3\x53\x0d\x5b\xa6\xa0\x71\xaf\x79\xf5\x0b\xbb\x60\x71\x66\xb3\xea\x25\xca\x54\xd0\x52\xb5\xa9\xf6\x80\x97\x49\x0c\x7b\xb8\xcb\x79\xfa\x7b\xca\x8b\x42\x47\xdd\x80\x48\x87\xf8\x11\xe2\x4d\x55\x10\xe6\x0f\xba\x34\x1d\x68\x74\xae\x51\x9c\x3b\xef\x69\x6d\x53\x52\x60\x8f\x45\x70\xb6\x08\x26\x9c\x60\x8b\xc8\x2a\x55\x0e\x66\x74\x05\xf8\xd1\xf7\x7b\x00\xf0\xab\x54\x44\x55\xd2\x39\xa5\x59\xd8\x16\x6c\x4d\x4e\xb4\xf1\x27\xf5\x4e\x9d\xed\xf7\xa3\xae\x39\xee\x04\xe7\x5b\xa7\x46\xf2\x23\x79\xa0\x45\x55\x34\x63\xf6\xe5\xdc\x4d\xbe\x7a\x48\xf3\x4a\xd2\x2d\x76\xab\xbe\xed\xa9\xe5\x91\xfb\xc3\x9a\xf6\x47\xca\xec\x4c\x00\x60\x5f\x46\x18\xb7\xab\xbe\x3b\x60\x4c\xd9\x14\xe3\x2a\x57\xb4\xcc\xf1\xed\xca\xf2\xb6\xef\xf0\x76\x65\xf8\xf7\x17\x0c\xa8\xc9\xc3\xbf\x90\xad\xd5\xc6\x12\x93\x07\x68\xde\x2d\xad\x37\x3d\x20\xa5\xac\x47\x4a\x59\x9f\x94\xb2\x49\xd2\x2b\x53\x5e\xe8\x2d\x08\x00\xec\x4b\x23\xb0\x9b\x19\x88\x23\x0f\x4b\x5d\xfc\x75\x8a\x9a\xd7\x56\x4f\x37\x39\xa0\xa3\xcc\xa7\xa3\xac\x47\x47\xd9\x14\xdd\x4f\x8c\x7e\xac\xd0\x23\x6d\x06\xce\x41\x89\x0a\x0f\x17\xbf\x26\xf2\x12\x57\xa4\xca\x75\x8a\x0d\x00\xec\xcb\x79\x2f\x23\xff\x65\x1b\x42\xd2\x2d\x6b\x79\x04\x00\x67\x8b\x00\x26\x42\x45\xab\xf9\x4f\x7e\xab\x63\xa9\xae\xe1\xc3\x6f\x92\xb3\xf3\x70\xbf\xb7\x49\xc3\x3b\x64\xaf\xf1\x63\x45\x05\x6a\x37\xcf\x78\xa1\x8f\xf9\x52\xed\x0e\x15\x5d\xca\x1f\x6e\xde\xbe\x69\x2a\x30\xbd\xb0\x29\x49\xda\x55\xe1\x07\x3f\xd0\xba\xb0\xb8\x49\x37\x58\x90\x86\xcd\x3d\x55\x1b\x6f\x24\x00\xf8\xff\x82\xcf\x8a\xf8\x33\xf2\xfe\x8c\xbc\xa7\x44\x5e\x00\xb0\x64\xe7\xf0\x3d\xcf\x76\x26\x80\xfc\x89\x2b\xb2\xcb\x39\xc9\xec\x26\x13\x96\x41\x64\x42\xa4\x01\x6d\xb2\x94\xdf\x13\x89\x3a\xa4\x62\x6f\xec\x25\x2f\xca\x1c\x1f\xde\xde\xfd\x86\xa9\x1a\x74\x08\xec\xb2\x41\x24\xde\xf1\x6c\xd7\x85\xdb\x41\xfc\xe8\x43\x7b\x01\x6f\xf0\x7e\x3c\xb4\x53\x81\x44\xa1\x9c\x08\x7c\x13\x67\x99\x4d\x17\x1b\x7b\xd2\x6d\x75\x39\x24\x83\x55\xc5\xd2\x49\xbe\xd1\xd8\x91\x9a\xda\x83\xb4\x55\x2e\x86\xb3\x71\xb9\x7b\x18\xa3\xd7\xf5\xb3\xe1\xf1\xed\x85\xad\x0f\xa1\xa9\x7b\x2e\xe0\x6f\x2f\x5e\x98\xba\xab\xb3\xdb\x6e\xa4\x3d\x9e\x93\xd7\x44\xde\xf0\x02\x6d\xea\xb3\x9b\xaa\x2f\x4e\x91\x4e\x1e\x0b\xa0\x8c\x2a\xda\x68\xe1\xec\xec\x19\x6f\x8d\x06\x18\x3b\xf9\x8d\x26\x00\xc3\x44\x3c\x6f\xe7\xec\xbc\x57\xd6\xd7\xb5\x5b\x75\xe1\xad\x01\x68\x2f\x53\x1a\x33\xc9\x52\x5e\x09\x5a\x50\x45\xb7\x38\x76\x95\x35\x98\x8a\x9a\x0c\xfa\x92\x33\x45\x28\x93\x90\xfc\x1b\x05\x87\x30\xfa\x4f\x18\x42\x1c\x5b\xf0\x98\x31\xfd\xb8\x38\xd3\x3d\x87\x55\xa1\xc0\x14\x47\x9d\xe1\x02\x44\x93\xbe\x25\xfc\xc4\x0a\x22\xe4\x86\xe4\xb7\xf8\xa0\xa2\x78\x06\x98\xac\x13\xb8\x24\x0a\x67\xe6\x7f\x45\x0b\xfd\x54\x09\x62\x32\xe9\xd9\xa2\xae\x47\x6c\xd0\xe5\xdd\xc9\x86\x1c\xb3\xc1\x98\x60\x4f\x20\xd3\x0f\x98\x4f\x9e\x68\xf1\x51\x03\x15\xf9\x1d\xa5\x6d\xb9\x3c\x59\xeb\x68\xbc\x91\x17\xc3\xfc\xa9\xea\x09\x5c\x57\x39\x11\xb0\xe6\x50\xb6\xec\x07\xca\x3e\xa2\x5f\x7b\xc3\x36\xe2\xe7\xb0\x38\x83\x4b\x6e\x9a\x8d\x1d\x13\x58\x09\x5e\x40\xc9\xa5\xa4\xba\x17\x65\xc1\x2c\x81\x32\x60\x28\x15\x66\x40\x34\x0b\x09\x67\x8b\x03\xac\x8e\x61\xd1\x5d\xec\xbd\x8d\x74\x43\x87\xdb\x39\xc4\x5c\xab\x54\x03\x19\xa9\x04\x51\xb8\xde\x35\x51\x76\x80\xb7\xb1\xad\x19\x98\xef\x44\x7b\x4e\x78\x82\x44\x5d\xc3\x24\xad\xd8\xd3\x44\x4e\x38\x21\xea\x75\x3d\x1e\x41\x87\xe6\x67\x7c\xbe\x74\xda\xa1\xf0\x01\x72\x3a\x3e\xce\xa1\x69\xa8\x83\x69\x8a\xf8\x33\x8f\xda\xa2\xc5\x4c\x42\xd4\x68\x40\xf2\x1c\xb8\xda\xa0\x80\x94\x48\x94\x10\x99\x04\x20\xcd\x01\x14\xc3\x3b\xb9\xe1\x55\x9e\x19\xb0\xf1\x34\xad\xc4\xfb\xa3\x22\xdd\x91\xf8\x4c\x5d\xb4\x06\xee\xf0\x1b\x93\x33\x90\xd1\x1b\xe8\xbd\xc4\x41\x30\x96\xbf\x47\x13\xb7\x8d\xa9\x94\x08\xb1\x03\xce\xfa\x39\x71\x12\x5c\xbd\x40\xea\xce\xfe\x7e\xd4\x3c\x3d\x8f\xbb\x34\x3e\x76\x7e\x74\x30\xd6\x9a\x45\xef\xde\xdf\xed\x14\x0e\x5a\x41\xce\xb2\xba\x8e\xe3\x4e\xbd\xb1\x64\x62\x67\x3b\x03\xb8\x80\xe8\xc9\x19\x20\x1e\x89\x50\x8f\xb3\x3e\xdc\x85\xd0\x7d\x9c\x83\x48\xb4\xea\x7f\xd8\xef\x5b\xf5\x65\x08\x91\x5e\xd5\x1a\x11\xd7\xf5\x87\x78\x06\x5f\xf7\x1d\x02\xad\x47\xe2\x6f\x0c\x73\xaf\x73\xde\xfd\x5b\x2c\xa0\x24\x8c\xa6\x52\x5b\x26\x4b\x4c\xe9\x8a\xa6\xcd\x26\x52\x9d\x18\xb7\x24\xa7\x59\x8f\xa2\x90\x6b\xad\xe7\xaa\x50\xc9\x4d\xa3\x53\x14\xda\x75\xfd\xf2\xc0\xb4\x5d\x9a\xea\x61\xd8\xc2\x3b\x87\xaf\xb6\xe1\x0c\x85\x70\xde\x6f\xfe\x19\x5d\xa2\x42\xae\xfd\xe1\x83\x2d\x70\xcd\xa3\xe3\xe8\xee\x3d\xba\x27\xb0\x3d\x21\xf8\x7a\xb4\xd0\x6a\x9b\x60\x83\x5a\xcb\xeb\x7d\x9c\x9b\x22\x6b\xe6\x33\x05\x38\x2d\x8c\xba\xc5\x07\xb2\xb5\x3f\x0e\x2b\x63\x0b\x94\xd8\xbe\xea\xfb\x2c\xd5\x97\xb9\x82\x32\xa2\xb8\x70\xe3\x4b\xb9\x64\x0a\xc5\x8a\xa4\xd8\x0d\xdd\x28\x81\xa4\x88\x7b\x5f\x5e\xea\xfa\xeb\x56\xe7\x29\xa4\xcc\x9c\x7e\xad\x69\xf6\xb9\xf1\xb7\x6e\xe4\x05\x63\xee\xd1\x35\xf5\x2f\x54\x6d\x6e\x5a\x2f\x01\xc9\xb2\xa6\x1f\xd7\x78\x0e\x14\x37\x6f\x63\x7d\x2c\x70\x7d\xab\xa6\x88\x1e\xfb\xbe\x36\x51\x19\xc7\x07\x52\x23\x57\x53\x4f\x97\xd2\x8d\x4d\x83\xaf\x71\x7e\x73\xeb\xc2\xec\x70\x07\x96\x91\xf5\xda\x11\x8b\x05\xdc\xa0\xf2\x4c\x96\xa8\x3e\x87\xc9\x3d\xa1\x9e\xc5\x4f\x30\xcd\x8b\x89\x31\xe4\xba\xed\x1c\x77\x61\xbb\xb3\xc3\x2e\xa3\x9e\x1e\xb1\xfa\xcb\x23\x66\x7f\xf9\x88\xdd\x2d\x6d\x3c\xad\x52\xaf\x19\xdf\x2a\xd2\xb5\x69\xb4\xd7\x26\xb8\x3a\x40\x7c\xf9\xc8\xf7\x59\xb7\xfc\x02\xc6\x64\x9d\x88\x95\x71\x96\x2d\x6c\x3e\xb7\x3f\xa7\x34\x3a\xc5\x9d\x9f\xc6\x6d\x7d\x1c\xf6\xda\x5a\x0e\x83\xae\x71\xd0\xa2\xae\xb4\x03\x23\x7e\x39\xe2\x96\x47\xbc\xe2\x28\x63\x5f\x66\xe4\x44\x79\x85\xcb\x44\x6f\x62\xb2\x99\xf1\x58\xd3\xe2\xc9\x89\xca\xf9\xe3\x02\xac\x76\x27\x62\xcf\xd1\xb5\x68\xfb\x83\xfd\xd8\x89\xfc\x3c\x6e\x3c\xdd\x5f\x1e\xe8\x4c\x12\xff\x45\x50\x85\xd7\xd6\x52\xe7\x8e\x34\xa7\xc8\xd4\x73\xf0\xe3\x73\x8b\xc4\x3d\x6c\x94\x2a\x13\x37\x60\x64\x89\x19\x94\x82\x67\x55\x8a\x02\x44\xc5\x74\xf7\x20\xb9\xb2\x03\xad\x21\xc3\xa4\x6c\x8a\x35\xb7\x23\xb6\x2d\x03\x6d\xdb\xd9\x9d\xdc\xde\xd7\xe1\xd1\x0f\xc3\xb6\x74\xea\x5c\xe0\x0a\x1e\xcf\xf5\x36\xa3\x79\x5f\x53\x2f\x31\x8f\x9c\xaa\xcd\xa0\x2e\xcb\x91\xa9\x66\x7b\x16\x8b\x6b\x2c\xf8\x16\xc1\x8e\xce\xf5\xb0\xbe\x25\x98\x8e\x77\x0b\x23\x79\x20\x58\xdc\x27\xc6\x21\x56\xcc\x58\x65\xf1\xc8\x81\xe6\x6a\xf6\xba\x1e\xfb\x40\x17\xdb\x92\xb0\x9f\x58\x00\x0e\x47\x07\x38\xac\xeb\xee\xa3\xe9\x14\xa0\x7a\xe5\xb4\x57\x6d\x6a\xf6\x0e\xf1\xe7\x17\xc7\x58\xf8\x9a\x8c\x74\x44\xfd\xba\x8e\x0b\x6f\xc2\xd4\x85\xde\xfb\x8f\xa4\x8c\x9d\x64\xba\x6a\x85\x5f\xf4\xeb\x7d\x03\x1e\x53\xfc\x36\x9b\xd2\x4b\xb8\x2d\xd7\xba\x36\xd7\x71\xff\x2e\xe4\xcb\x81\xba\x2e\x48\xe9\xef\xa1\x6f\xae\xeb\xdc\x59\x9b\xfa\x4a\xe7\x94\x48\x9c\x0e\xfd\x96\xd9\x31\x97\x74\x09\x61\x9a\x8d\xab\x72\x2d\x26\xfa\x99\x62\xae\x33\x6b\x1d\x0c\x2e\xdd\x3d\xd9\x8e\xb7\xed\x9e\xf8\xab\x3b\xab\xdb\x52\xde\x1b\xeb\x6e\x71\x2e\xbe\x5d\x5c\x47\xe2\x7e\xe6\xdc\x34\x7e\x1d\x6b\x6e\x3e\xfa\x42\xa4\xc3\x3c\x47\x65\xb2\xb2\xc0\x94\x6f\x51\xec\xa0\xa0\x59\x96\xe3\x3d\x11\xba\x6d\x45\xf2\xa6\x4f\xa4\x36\x54\xf6\x94\x79\x1c\xd4\x75\x30\xb0\xc5\x7b\x69\x4e\x0b\x13\xc4\x0f\xea\x67\x7d\xf5\x23\x0a\xf5\x6d\xce\x3c\xf4\xcf\x0d\xbe\x7a\xfc\xdc\x98\xc1\x9d\xd9\x74\xce\xcc\xda\xb4\x61\x0c\x54\x01\x95\x50\xe9\x19\xca\x9e\x91\x63\x0f\x14\x8c\x52\xf5\xe0\x78\x27\x76\x6e\xa6\x6f\x9f\x05\x51\xd2\x36\x3f\x93\x6b\x5c\x53\xa9\xc4\x2e\xd6\xce\xe7\xc2\xa6\xd9\x16\x68\xd6\x69\xad\xf3\x5e\x13\xd9\x97\x42\x39\xb3\x59\xb8\x97\x64\xad\x37\xac\x74\xeb\x29\x2e\x42\x88\x32\x9a\x2a\x08\x1b\x76\xa1\xe3\x0b\xa1\xf9\x91\x44\x08\x91\xb9\x3a\x1f\x98\x1c\xba\xcc\x10\xc6\x10\x5e\x11\xb5\x09\xe1\x83\xf9\x9c\x11\x7e\x80\xb0\xbb\xca\x85\x10\x3d\x27\x36\xe2\x78\xb0\xdf\x6d\xe9\xc0\x68\xee\x9f\x8b\x06\x04\x19\xc2\x1a\x19\xea\x4e\x4e\x06\x77\x3b\x58\xf3\xb9\xfd\xa1\xc1\x37\x70\xf9\x16\xde\xbc\xbd\x85\x57\x97\xcb\xdb\x24\x70\x77\xc2\xe4\x25\x2f\x77\x82\xae\x37\xa6\x61\x64\x7e\xa9\x01\xed\xa7\xc6\xde\x5c\x17\x36\x41\x50\x92\xf4\x77\x62\x7f\x4e\x75\x65\x9f\xed\xc9\x7c\xbb\xa1\x12\x56\x34\x47\xb8\x27\xb2\xaf\x8c\x86\x93\xd5\x06\x14\xe7\x79\xa2\x81\xfb\x2a\xa3\x4a\xb7\xb2\x55\x4b\x57\x18\x6d\x4a\xa1\xcf\xa6\x55\xa5\xf4\xd0\xfd\x06\x19\xec\x78\x05\x02\xe7\xa2\x62\x3d\x4e\x4e\x84\x51\x9b\xb0\x2c\x08\x02\x5a\x94\x5c\x28\xf3\x5d\x24\xb4\xbb\x1c\xea\xe7\x55\xd1\xfc\x65\xa8\x16\xfa\x88\x0f\x03\xfd\xb6\xa6\x6a\x53\xdd\x25\x29\x2f\x16\x6b\x3e\xe7\x25\x32\x52\xd2\x85\x41\x9c\x0c\xa7\x17\xd8\xa3\xf5\xf1\x15\x0b\x89\x69\x25\xa8\xda\x1d\x59\xda\x00\xfe\xd8\x82\x7b\xb2\x3e\x32\xed\xc2\xdd\x58\xa4\x77\xd6\x78\x40\xb6\xed\xa6\xa5\x7d\xaf\xeb\x83\x79\x6f\xa2\xd7\x59\x74\xc5\x8f\xb4\xcd\x98\xc3\x12\xc5\x65\x0b\x5d\x9d\xf4\xda\x35\x16\x56\x56\xae\x63\xf3\x38\x97\x71\x02\x64\x19\xd4\x75\xf0\xbf\x01\x00\xb9\x97\xa0\xc3\x31\x2e\x00\x00")
 
 func templatesServerResponsesGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -742,8 +1057,8 @@ func templatesServerResponsesGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 11271, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5e, 0xb2, 0xbe, 0x31, 0xe8, 0x22, 0x82, 0x99, 0x19, 0x7e, 0xbc, 0x9c, 0xba, 0xda, 0x16, 0xc0, 0xfa, 0x17, 0xe, 0xe1, 0x2c, 0x44, 0xfb, 0x72, 0xe0, 0xce, 0xf3, 0x91, 0xc6, 0x46, 0x7d, 0x4}}
+	info := bindataFileInfo{name: "templates/server/responses.gotmpl", size: 11825, mode: os.FileMode(0644), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9f, 0x86, 0xd3, 0x91, 0xdf, 0xff, 0x6e, 0x24, 0x46, 0x46, 0x3e, 0xf3, 0x52, 0x59, 0xd1, 0xc0, 0x47, 0x65, 0xbc, 0x34, 0xcc, 0x37, 0x19, 0x88, 0x54, 0x2f, 0x21, 0xd7, 0x9e, 0x12, 0x88, 0x42}}
 	return a, nil
 }
 
@@ -978,8 +1293,14 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/cli/cli.gotmpl":                                    templatesCliCliGotmpl,
+	"templates/cli/completion.gotmpl":                             templatesCliCompletionGotmpl,
+	"templates/cli/main.gotmpl":                                   templatesCliMainGotmpl,
+	"templates/cli/models.gotmpl":                                 templatesCliModelsGotmpl,
+	"templates/cli/operation.gotmpl":                              templatesCliOperationGotmpl,
 	"templates/client/client.gotmpl":                              templatesClientClientGotmpl,
 	"templates/client/facade.gotmpl":                              templatesClientFacadeGotmpl,
+	"templates/client/mock.gotmpl":                                templatesClientMockGotmpl,
 	"templates/client/parameter.gotmpl":                           templatesClientParameterGotmpl,
 	"templates/client/response.gotmpl":                            templatesClientResponseGotmpl,
 	"templates/contrib/stratoscale/client/client.gotmpl":          templatesContribStratoscaleClientClientGotmpl,
@@ -987,18 +1308,27 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/contrib/stratoscale/server/configureapi.gotmpl":    templatesContribStratoscaleServerConfigureapiGotmpl,
 	"templates/contrib/stratoscale/server/server.gotmpl":          templatesContribStratoscaleServerServerGotmpl,
 	"templates/docstring.gotmpl":                                  templatesDocstringGotmpl,
+	"templates/easyjsonsupport.gotmpl": templatesEasyjsonsupportGotmpl,
+	"templates/easyjsontest.gotmpl": templatesEasyjsontestGotmpl,
 	"templates/header.gotmpl":                                     templatesHeaderGotmpl,
+	"templates/markdown/index.gotmpl":                             templatesMarkdownIndexGotmpl,
+	"templates/markdown/models.gotmpl":                            templatesMarkdownModelsGotmpl,
+	"templates/markdown/operations.gotmpl":                        templatesMarkdownOperationsGotmpl,
+	"templates/markdown/shared.gotmpl":                            templatesMarkdownSharedGotmpl,
 	"templates/model.gotmpl":                                      templatesModelGotmpl,
 	"templates/modelvalidator.gotmpl":                             templatesModelvalidatorGotmpl,
 	"templates/schema.gotmpl":                                     templatesSchemaGotmpl,
 	"templates/schemabody.gotmpl":                                 templatesSchemabodyGotmpl,
 	"templates/schemapolymorphic.gotmpl":                          templatesSchemapolymorphicGotmpl,
+	"templates/schemadeepcopy.gotmpl": templatesSchemadeepcopyGotmpl,
 	"templates/schematype.gotmpl":                                 templatesSchematypeGotmpl,
 	"templates/schemavalidator.gotmpl":                            templatesSchemavalidatorGotmpl,
 	"templates/serializers/additionalpropertiesserializer.gotmpl": templatesSerializersAdditionalpropertiesserializerGotmpl,
 	"templates/serializers/aliasedserializer.gotmpl":              templatesSerializersAliasedserializerGotmpl,
 	"templates/serializers/allofserializer.gotmpl":                templatesSerializersAllofserializerGotmpl,
 	"templates/serializers/basetypeserializer.gotmpl":             templatesSerializersBasetypeserializerGotmpl,
+	"templates/serializers/defaultsserializer.gotmpl":             templatesSerializersDefaultsserializerGotmpl,
+	"templates/serializers/easyjsonserializer.gotmpl": templatesSerializersEasyjsonserializerGotmpl,
 	"templates/serializers/marshalbinaryserializer.gotmpl":        templatesSerializersMarshalbinaryserializerGotmpl,
 	"templates/serializers/schemaserializer.gotmpl":               templatesSerializersSchemaserializerGotmpl,
 	"templates/serializers/subtypeserializer.gotmpl":              templatesSerializersSubtypeserializerGotmpl,
@@ -1061,9 +1391,17 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
+		"cli": &bintree{nil, map[string]*bintree{
+			"cli.gotmpl":        &bintree{templatesCliCliGotmpl, map[string]*bintree{}},
+			"completion.gotmpl": &bintree{templatesCliCompletionGotmpl, map[string]*bintree{}},
+			"main.gotmpl":       &bintree{templatesCliMainGotmpl, map[string]*bintree{}},
+			"models.gotmpl":     &bintree{templatesCliModelsGotmpl, map[string]*bintree{}},
+			"operation.gotmpl":  &bintree{templatesCliOperationGotmpl, map[string]*bintree{}},
+		}},
 		"client": &bintree{nil, map[string]*bintree{
 			"client.gotmpl":    &bintree{templatesClientClientGotmpl, map[string]*bintree{}},
 			"facade.gotmpl":    &bintree{templatesClientFacadeGotmpl, map[string]*bintree{}},
+			"mock.gotmpl":      &bintree{templatesClientMockGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesClientParameterGotmpl, map[string]*bintree{}},
 			"response.gotmpl":  &bintree{templatesClientResponseGotmpl, map[string]*bintree{}},
 		}},
@@ -1079,13 +1417,22 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				}},
 			}},
 		}},
-		"docstring.gotmpl":         &bintree{templatesDocstringGotmpl, map[string]*bintree{}},
-		"header.gotmpl":            &bintree{templatesHeaderGotmpl, map[string]*bintree{}},
+		"docstring.gotmpl": &bintree{templatesDocstringGotmpl, map[string]*bintree{}},
+		"easyjsonsupport.gotmpl": &bintree{templatesEasyjsonsupportGotmpl, map[string]*bintree{}},
+		"easyjsontest.gotmpl": &bintree{templatesEasyjsontestGotmpl, map[string]*bintree{}},
+		"header.gotmpl":    &bintree{templatesHeaderGotmpl, map[string]*bintree{}},
+		"markdown": &bintree{nil, map[string]*bintree{
+			"index.gotmpl":      &bintree{templatesMarkdownIndexGotmpl, map[string]*bintree{}},
+			"models.gotmpl":     &bintree{templatesMarkdownModelsGotmpl, map[string]*bintree{}},
+			"operations.gotmpl": &bintree{templatesMarkdownOperationsGotmpl, map[string]*bintree{}},
+			"shared.gotmpl":     &bintree{templatesMarkdownSharedGotmpl, map[string]*bintree{}},
+		}},
 		"model.gotmpl":             &bintree{templatesModelGotmpl, map[string]*bintree{}},
 		"modelvalidator.gotmpl":    &bintree{templatesModelvalidatorGotmpl, map[string]*bintree{}},
 		"schema.gotmpl":            &bintree{templatesSchemaGotmpl, map[string]*bintree{}},
 		"schemabody.gotmpl":        &bintree{templatesSchemabodyGotmpl, map[string]*bintree{}},
 		"schemapolymorphic.gotmpl": &bintree{templatesSchemapolymorphicGotmpl, map[string]*bintree{}},
+		"schemadeepcopy.gotmpl": &bintree{templatesSchemadeepcopyGotmpl, map[string]*bintree{}},
 		"schematype.gotmpl":        &bintree{templatesSchematypeGotmpl, map[string]*bintree{}},
 		"schemavalidator.gotmpl":   &bintree{templatesSchemavalidatorGotmpl, map[string]*bintree{}},
 		"serializers": &bintree{nil, map[string]*bintree{
@@ -1093,6 +1440,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"aliasedserializer.gotmpl":              &bintree{templatesSerializersAliasedserializerGotmpl, map[string]*bintree{}},
 			"allofserializer.gotmpl":                &bintree{templatesSerializersAllofserializerGotmpl, map[string]*bintree{}},
 			"basetypeserializer.gotmpl":             &bintree{templatesSerializersBasetypeserializerGotmpl, map[string]*bintree{}},
+			"defaultsserializer.gotmpl":             &bintree{templatesSerializersDefaultsserializerGotmpl, map[string]*bintree{}},
+			"easyjsonserializer.gotmpl": &bintree{templatesSerializersEasyjsonserializerGotmpl, map[string]*bintree{}},
 			"marshalbinaryserializer.gotmpl":        &bintree{templatesSerializersMarshalbinaryserializerGotmpl, map[string]*bintree{}},
 			"schemaserializer.gotmpl":               &bintree{templatesSerializersSchemaserializerGotmpl, map[string]*bintree{}},
 			"subtypeserializer.gotmpl":              &bintree{templatesSerializersSubtypeserializerGotmpl, map[string]*bintree{}},
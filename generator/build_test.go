@@ -77,6 +77,41 @@ func TestGenerateAndBuild(t *testing.T) {
 	}
 }
 
+func TestGenerateAndBuild_OpenAPI3(t *testing.T) {
+	defer func() {
+		log.SetOutput(os.Stdout)
+	}()
+	log.SetOutput(ioutil.Discard)
+
+	spec := filepath.Join("..", "fixtures", "oas3", "petstore.yaml")
+	generated, err := ioutil.TempDir(filepath.Dir(spec), "generated")
+	if err != nil {
+		t.Fatalf("TempDir()=%s", generated)
+	}
+	defer func() { _ = os.RemoveAll(generated) }()
+
+	// the client shares the models of the server
+	for _, cmd := range []struct {
+		opts interface{ Execute([]string) error }
+		args []string
+	}{
+		{opts: &generate.Server{}, args: []string{"--spec", spec, "--target", generated}},
+		{opts: &generate.Client{}, args: []string{"--spec", spec, "--target", generated, "--skip-models"}},
+	} {
+		if _, err = flags.ParseArgs(cmd.opts, cmd.args); err != nil {
+			t.Fatalf("ParseArgs()=%s", err)
+		}
+		if err = cmd.opts.Execute(nil); err != nil {
+			t.Fatalf("Execute()=%s", err)
+		}
+	}
+
+	packages := filepath.Join(generated, "...")
+	if p, err := exec.Command("go", "build", packages).CombinedOutput(); err != nil {
+		t.Fatalf("go build %s: %s\n%s", packages, err, p)
+	}
+}
+
 func newTestClient(input, output string) *generate.Client {
 	c := &generate.Client{}
 	c.DefaultScheme = "http"
@@ -0,0 +1,154 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// cliFlagKinds maps go types to the pflag function registering a flag of this type
+var cliFlagKinds = map[string]string{
+	"string":    "String",
+	"bool":      "Bool",
+	"int8":      "Int8",
+	"int16":     "Int16",
+	"int32":     "Int32",
+	"int64":     "Int64",
+	"uint8":     "Uint8",
+	"uint16":    "Uint16",
+	"uint32":    "Uint32",
+	"uint64":    "Uint64",
+	"float32":   "Float32",
+	"float64":   "Float64",
+	"[]string":  "StringSlice",
+	"[]bool":    "BoolSlice",
+	"[]int32":   "Int32Slice",
+	"[]int64":   "Int64Slice",
+	"[]float32": "Float32Slice",
+	"[]float64": "Float64Slice",
+}
+
+// cliFlagKind returns the pflag type of the flag used to set a value of some go type
+// on the command line, or an empty string when no such flag exists.
+func cliFlagKind(goType string) string {
+	return cliFlagKinds[goType]
+}
+
+// cliFlagDefault renders the default value of a flag of some pflag type.
+//
+// The default value from the spec is only rendered for scalar flags:
+// slices default to nil.
+func cliFlagDefault(kind string, value interface{}) string {
+	switch {
+	case strings.HasSuffix(kind, "Slice"):
+		return "nil"
+	case kind == "String":
+		if value == nil {
+			return `""`
+		}
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	case kind == "Bool":
+		if b, ok := value.(bool); ok && b {
+			return "true"
+		}
+		return "false"
+	default:
+		if value == nil {
+			return "0"
+		}
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// cliGoType qualifies a go type declared in some operation package, so it may be used
+// from the package of the command line client.
+//
+// Builtin types and types which are already qualified are left unchanged.
+func cliGoType(goType, pkg string) string {
+	switch {
+	case strings.HasPrefix(goType, "[]"):
+		return "[]" + cliGoType(goType[2:], pkg)
+	case strings.HasPrefix(goType, "*"):
+		return "*" + cliGoType(goType[1:], pkg)
+	case strings.HasPrefix(goType, "map[string]"):
+		return "map[string]" + cliGoType(goType[len("map[string]"):], pkg)
+	case goType == "" || pkg == "" || strings.ContainsAny(goType, ".{ "):
+		return goType
+	case types.Universe.Lookup(goType) != nil:
+		return goType
+	default:
+		return pkg + "." + goType
+	}
+}
+
+// cliParamFlagKind returns the pflag type of the flag used to set a parameter on the command line,
+// or an empty string when the parameter can't be set with a flag.
+//
+// Values with a custom format, e.g. date-time, are set with a string flag and parsed as text.
+func cliParamFlagKind(param GenParameter) string {
+	switch {
+	case param.IsBodyParam():
+		return ""
+	case param.IsFileParam():
+		return "String"
+	case cliFlagKind(param.GoType) != "":
+		return cliFlagKind(param.GoType)
+	case param.IsArray:
+		if param.Child != nil && !param.Child.IsArray && param.Child.IsCustomFormatter {
+			return "StringSlice"
+		}
+		return ""
+	case param.IsCustomFormatter:
+		return "String"
+	default:
+		return ""
+	}
+}
+
+// cliModelsAlias returns the alias of the imported package declaring a go type,
+// or an empty string when the type is not declared in one of these imports.
+func cliModelsAlias(goType string, imports map[string]string) string {
+	for alias := range imports {
+		if strings.HasPrefix(goType, alias+".") {
+			return alias
+		}
+	}
+	return ""
+}
+
+// cliModelTypes returns the go types of the models which properties may be set with flags,
+// i.e. structs which are neither base types, tuples nor aliases of other models.
+func cliModelTypes(models []GenDefinition) []string {
+	var types []string
+	seen := make(map[string]bool, len(models))
+	for _, model := range models {
+		if seen[model.GoType] {
+			continue
+		}
+		if model.IsComplexObject && !model.IsBaseType && !model.HasDiscriminator && !model.IsTuple && !model.IsAliased {
+			types = append(types, model.GoType)
+			seen[model.GoType] = true
+		}
+	}
+	return types
+}
+
+// cliUsage renders a help text of the command line client as a go string literal,
+// without the leading and trailing spaces of descriptions written in yaml blocks.
+func cliUsage(text string) string {
+	return fmt.Sprintf("%q", strings.TrimSpace(text))
+}
@@ -0,0 +1,143 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCLIGenOpts(spec, target string) *GenOpts {
+	g := testClientGenOpts()
+	g.Spec = spec
+	g.Target = target
+	g.IncludeCLI = true
+	g.CLIPackage = defaultCLITarget
+	g.Sections = SectionOpts{}
+	DefaultSectionOpts(g)
+	return g
+}
+
+func TestGenerateCLI(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	cwd, _ := os.Getwd()
+	target, err := ioutil.TempDir(cwd, "cli")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(target)
+	}()
+
+	opts := testCLIGenOpts("../fixtures/codegen/tasklist.basic.yml", target)
+	require.NoError(t, GenerateClient("", nil, nil, opts))
+	assert.Equal(t, "issue-tracker-cli", opts.CLIAppName)
+
+	main, err := ioutil.ReadFile(filepath.Join(target, "cmd", "issue-tracker-cli", "main.go"))
+	require.NoError(t, err)
+	assertInCode(t, `cli.MakeRootCmd().Execute()`, string(main))
+
+	root, err := ioutil.ReadFile(filepath.Join(target, "cli", "cli.go"))
+	require.NoError(t, err)
+	assertInCode(t, `Use:          "issue-tracker-cli"`, string(root))
+	assertInCode(t, `rootCmd.AddCommand(makeGroupTasksCmd())`, string(root))
+	assertInCode(t, `cmd.AddCommand(makeOperationListTasksCmd())`, string(root))
+	assertInCode(t, `cmd.PersistentFlags().String("token-header", "", "the API key sent in the X-Token header (token_header)")`, string(root))
+	assertInCode(t, `httptransport.APIKeyAuth("X-Token", "header", key.Value.String())`, string(root))
+
+	list, err := ioutil.ReadFile(filepath.Join(target, "cli", "list_tasks_operation.go"))
+	require.NoError(t, err)
+	assertInCode(t, `Use:   "list-tasks"`, string(list))
+	assertInCode(t, `cmd.Flags().Int32("page-size", 20, "Amount of items to return in a single page")`, string(list))
+	assertInCode(t, `cmd.Flags().StringSlice("tags", nil, "the tags to filter by")`, string(list))
+	assertInCode(t, `params.SetPageSize(&value)`, string(list))
+	assertInCode(t, `response0, err := appCli.Tasks.ListTasks(params)`, string(list))
+	assertInCode(t, `case *tasks.ListTasksUnprocessableEntity:`, string(list))
+	assertInCode(t, `return printResult(cmd, response0.Payload)`, string(list))
+
+	update, err := ioutil.ReadFile(filepath.Join(target, "cli", "update_task_operation.go"))
+	require.NoError(t, err)
+	assertInCode(t, `registerModelFlags(cmd, "body", new(models.Task))`, string(update))
+	assertInCode(t, `if changed, err := retrieveModelFlags(cmd, "body", body); err != nil {`, string(update))
+	assertInCode(t, `_ = cmd.MarkFlagRequired("id")`, string(update))
+
+	upload, err := ioutil.ReadFile(filepath.Join(target, "cli", "upload_task_file_operation.go"))
+	require.NoError(t, err)
+	assertInCode(t, `file, err := os.Open(path)`, string(upload))
+	// no payload to print on success
+	assertInCode(t, `_, err = appCli.Tasks.UploadTaskFile(params, nil)`, string(upload))
+
+	models, err := ioutil.ReadFile(filepath.Join(target, "cli", "models.go"))
+	require.NoError(t, err)
+	assertInCode(t, `case *models.Task:`, string(models))
+	// properties of composed models are set with the flags of their parts
+	assertInCode(t, `registerTaskCardModelFlags(cmd, prefix)`, string(models))
+	assertInCode(t, `retrieveTaskCardModelFlags(cmd, prefix, &m.TaskCard)`, string(models))
+	assertInCode(t, `var value strfmt.Date`, string(models))
+	// read-only properties have no flag
+	assertNotInCode(t, `prefix+".id"`, string(models))
+
+	completion, err := ioutil.ReadFile(filepath.Join(target, "cli", "completion.go"))
+	require.NoError(t, err)
+	assertInCode(t, `cmd.Root().GenZshCompletion(cmd.OutOrStdout())`, string(completion))
+}
+
+func TestCLIGoType(t *testing.T) {
+	assert.Equal(t, "string", cliGoType("string", "tasks"))
+	assert.Equal(t, "models.Task", cliGoType("models.Task", "tasks"))
+	assert.Equal(t, "tasks.AddCommentToTaskBody", cliGoType("AddCommentToTaskBody", "tasks"))
+	assert.Equal(t, "[]*tasks.Item", cliGoType("[]*Item", "tasks"))
+	assert.Equal(t, "map[string]tasks.Item", cliGoType("map[string]Item", "tasks"))
+	assert.Equal(t, "interface{}", cliGoType("interface{}", "tasks"))
+}
+
+func TestCLIFlagDefault(t *testing.T) {
+	assert.Equal(t, `""`, cliFlagDefault("String", nil))
+	assert.Equal(t, `"open"`, cliFlagDefault("String", "open"))
+	assert.Equal(t, "true", cliFlagDefault("Bool", true))
+	assert.Equal(t, "false", cliFlagDefault("Bool", nil))
+	assert.Equal(t, "20", cliFlagDefault("Int32", float64(20)))
+	assert.Equal(t, "0", cliFlagDefault("Int64", nil))
+	assert.Equal(t, "nil", cliFlagDefault("StringSlice", []interface{}{"a"}))
+}
+
+func TestCLIParamFlagKind(t *testing.T) {
+	assert.Equal(t, "Int64", cliParamFlagKind(GenParameter{resolvedType: resolvedType{GoType: "int64"}, Location: "query"}))
+	assert.Equal(t, "", cliParamFlagKind(GenParameter{resolvedType: resolvedType{GoType: "models.Task"}, Location: "body"}))
+	assert.Equal(t, "String", cliParamFlagKind(GenParameter{resolvedType: resolvedType{GoType: "io.ReadCloser", SwaggerType: "file"}, Location: "formData"}))
+	assert.Equal(t, "String", cliParamFlagKind(GenParameter{resolvedType: resolvedType{GoType: "strfmt.DateTime", IsCustomFormatter: true}, Location: "query"}))
+	assert.Equal(t, "StringSlice", cliParamFlagKind(GenParameter{
+		resolvedType: resolvedType{GoType: "[]strfmt.UUID", IsArray: true},
+		Child:        &GenItems{resolvedType: resolvedType{GoType: "strfmt.UUID", IsCustomFormatter: true}},
+		Location:     "query",
+	}))
+}
+
+func TestCLIModelTypes(t *testing.T) {
+	models := []GenDefinition{
+		{GenSchema: GenSchema{resolvedType: resolvedType{GoType: "Task", IsComplexObject: true}}},
+		{GenSchema: GenSchema{resolvedType: resolvedType{GoType: "Pet", IsComplexObject: true, HasDiscriminator: true}}},
+		{GenSchema: GenSchema{resolvedType: resolvedType{GoType: "Tuple", IsComplexObject: true, IsTuple: true}}},
+		{GenSchema: GenSchema{resolvedType: resolvedType{GoType: "Currency", IsAliased: true}}},
+		{GenSchema: GenSchema{resolvedType: resolvedType{GoType: "Task", IsComplexObject: true}}},
+	}
+	assert.Equal(t, []string{"Task"}, cliModelTypes(models))
+}
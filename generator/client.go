@@ -83,6 +83,11 @@ func (c *clientGenerator) Generate() error {
 		return dumpData(swag.ToDynamicJSON(app))
 	}
 
+	if c.GenOpts.IncludeCLI && c.GenOpts.CLIAppName == "" {
+		// the command line client is named after the application, e.g. todo-list-cli
+		c.GenOpts.CLIAppName = swag.ToCommandName(app.Name) + "-cli"
+	}
+
 	if c.GenOpts.IncludeModel {
 		for _, mod := range app.Models {
 			if mod.IsStream {
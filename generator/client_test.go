@@ -367,3 +367,64 @@ func TestGenClient_1518(t *testing.T) {
 		}
 	}
 }
+
+func TestGenClient_Mocks(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer func() {
+		log.SetOutput(os.Stdout)
+	}()
+
+	fixtureConfig := map[string]map[string][]string{
+		filepath.Join("..", "fixtures", "codegen", "tasklist.basic.yml"): {
+			"client/tasks/tasks_client_mock.go": {
+				`func NewMockClientService() *MockClientService {`,
+				`var _ ClientService = &MockClientService{}`,
+				`listTasksFunc  func(params *ListTasksParams) (*ListTasksOK, error)`,
+				`listTasksCalls []MockListTasksCall`,
+				`type MockUpdateTaskCall struct {`,
+				`AuthInfo runtime.ClientAuthInfoWriter`,
+				`func (m *MockClientService) UpdateTask(params *UpdateTaskParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateTaskOK, error) {`,
+				`return nil, fmt.Errorf("unexpected call to UpdateTask: no result has been programmed with OnUpdateTask or ReturnUpdateTask")`,
+				`return fn(params, authInfo)`,
+				`func (m *MockClientService) OnListTasks(fn func(params *ListTasksParams) (*ListTasksOK, error)) *MockClientService {`,
+				`func (m *MockClientService) ReturnListTasks(response0 *ListTasksOK, err error) *MockClientService {`,
+				`func (m *MockClientService) ListTasksCalls() []MockListTasksCall {`,
+				`func (m *MockClientService) AssertListTasksCalled(t MockTestingT, times int) bool {`,
+				`func (m *MockClientService) SetTransport(transport runtime.ClientTransport) {`,
+			},
+		},
+		filepath.Join("..", "fixtures", "bugs", "1518", "fixture-1518.yaml"): {
+			"client/operations/operations_client_mock.go": {
+				// several success responses
+				`func (m *MockClientService) ReturnGetRecords3(response0 *GetRecords3OK, response1 *GetRecords3Created, err error) *MockClientService {`,
+				`return response0, response1, err`,
+				`return nil, nil, fmt.Errorf("unexpected call to GetRecords3:`,
+			},
+		},
+	}
+
+	for spec, files := range fixtureConfig {
+		opts := testClientGenOpts()
+		opts.Spec = spec
+		opts.IncludeMocks = true
+		opts.Sections = SectionOpts{}
+		DefaultSectionOpts(opts)
+
+		cwd, _ := os.Getwd()
+		tft, _ := ioutil.TempDir(cwd, "generated")
+		opts.Target = tft
+
+		err := GenerateClient("client", []string{}, []string{}, opts)
+		if assert.NoError(t, err) {
+			for fileToInspect, expectedCode := range files {
+				code, err := ioutil.ReadFile(filepath.Join(opts.Target, filepath.FromSlash(fileToInspect)))
+				if assert.NoError(t, err) {
+					for _, codeLine := range expectedCode {
+						assertInCode(t, strings.TrimSpace(codeLine), string(code))
+					}
+				}
+			}
+		}
+		_ = os.RemoveAll(opts.Target)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genContextValidationModel(t testing.TB, name string) string {
+	_, res := genModelWith(t, "../fixtures/codegen/context-validation.yml", name, nil)
+	return res
+}
+
+func TestContextValidation_Model(t *testing.T) {
+	res := genContextValidationModel(t, "Task")
+
+	assertInCode(t, "func (m *Task) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+
+	// readOnly properties
+	assertInCode(t, "if err := m.contextValidateID(ctx, formats); err != nil {", res)
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "id", "body", m.ID); err != nil {`, res)
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "createdAt", "body", m.CreatedAt); err != nil {`, res)
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "tags", "body", m.Tags); err != nil {`, res)
+
+	// delegation to other models
+	assertInCode(t, "if err := m.Milestone.ContextValidate(ctx, formats); err != nil {", res)
+	assertInCode(t, "if err := m.Milestones[i].ContextValidate(ctx, formats); err != nil {", res)
+	assertInCode(t, `return ve.ValidateName("milestones" + "." + strconv.Itoa(i))`, res)
+	assertInCode(t, "val := m.MilestonesByName[k]", res)
+	assertInCode(t, `return ve.ValidateName("milestonesByName" + "." + k)`, res)
+	assertInCode(t, "if err := m.Owner.ContextValidate(ctx, formats); err != nil {", res)
+	assertInCode(t, "if err := m.Plain.ContextValidate(ctx, formats); err != nil {", res)
+
+	// anonymous schemas rendered as extra types get their own method
+	assertInCode(t, "func (m *TaskOwner) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "owner"+"."+"joined", "body", m.Joined); err != nil {`, res)
+	assertInCode(t, "func (m *TaskAttachmentsAnon) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+
+	// types imported with x-go-type are validated only if they support it
+	assertInCode(t, "interface{}(&m.Custom).(interface {", res)
+	assertInCode(t, "ContextValidate(context.Context, strfmt.Registry) error", res)
+
+	// no validation depending on the context
+	assertNotInCode(t, "contextValidateTitle", res)
+}
+
+func TestContextValidation_AllOf(t *testing.T) {
+	res := genContextValidationModel(t, "TaskWithParent")
+
+	assertInCode(t, "func (m *TaskWithParent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+	assertInCode(t, "if err := m.Task.ContextValidate(ctx, formats); err != nil {", res)
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "parentId", "body", m.ParentID); err != nil {`, res)
+}
+
+func TestContextValidation_BaseType(t *testing.T) {
+	res := genContextValidationModel(t, "Dog")
+
+	// properties inherited from the base type are validated according to their own type
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "id", "body", m.ID()); err != nil {`, res)
+	assertInCode(t, `if err := validate.ReadOnly(ctx, "tags", "body", m.Tags()); err != nil {`, res)
+	assertInCode(t, "if err := m.Milestone().ContextValidate(ctx, formats); err != nil {", res)
+	assertNotInCode(t, "m.ID().ContextValidate", res)
+	assertNotInCode(t, "m.Tags().ContextValidate", res)
+
+	// polymorphic values delegate to their concrete type
+	res = genContextValidationModel(t, "Kennel")
+	assertInCode(t, "if m.Favorite() != nil {", res)
+	assertInCode(t, "if err := m.Favorite().ContextValidate(ctx, formats); err != nil {", res)
+	assertInCode(t, "if err := m.petsField[i].ContextValidate(ctx, formats); err != nil {", res)
+}
+
+func TestContextValidation_Aliased(t *testing.T) {
+	res := genContextValidationModel(t, "Milestones")
+	assertInCode(t, "func (m Milestones) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+	assertInCode(t, "for i := 0; i < len(m); i++ {", res)
+	assertInCode(t, "if err := m[i].ContextValidate(ctx, formats); err != nil {", res)
+
+	res = genContextValidationModel(t, "MilestoneMap")
+	assertInCode(t, "func (m MilestoneMap) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+	assertInCode(t, "val := m[k]", res)
+	assertInCode(t, "return ve.ValidateName(k)", res)
+
+	res = genContextValidationModel(t, "Plain")
+	assertInCode(t, "func (m *Plain) ContextValidate(ctx context.Context, formats strfmt.Registry) error {\n\treturn nil\n}", res)
+}
+
+func TestContextValidation_Parameters(t *testing.T) {
+	for _, fixture := range []struct {
+		Operation string
+		Expected  []string
+	}{
+		{
+			Operation: "createTask",
+			Expected: []string{
+				"if err := o.contextValidateBody(ctx, formats); err != nil {",
+				"if err := o.Body.ContextValidate(ctx, formats); err != nil {",
+			},
+		},
+		{
+			Operation: "updateTasks",
+			Expected: []string{
+				"if err := o.contextValidateTasks(ctx, formats); err != nil {",
+				"if err := o.Tasks[i].ContextValidate(ctx, formats); err != nil {",
+				`return ve.ValidateName("tasks" + "." + strconv.Itoa(i))`,
+			},
+		},
+		{
+			Operation: "patchTasks",
+			Expected: []string{
+				"if err := o.contextValidateByName(ctx, formats); err != nil {",
+				"val := o.ByName[k]",
+				`return ve.ValidateName("byName" + "." + k)`,
+			},
+		},
+	} {
+		b, err := opBuilder(fixture.Operation, "../fixtures/codegen/context-validation.yml")
+		require.NoError(t, err)
+		op, err := b.MakeOperation()
+		require.NoError(t, err)
+
+		opts := opts()
+		for _, tpl := range []string{"serverParameter", "clientParameter"} {
+			buf := bytes.NewBuffer(nil)
+			require.NoError(t, templates.MustGet(tpl).Execute(buf, op))
+			ff, err := opts.LanguageOpts.FormatContent(swag.ToFileName(fixture.Operation)+"_parameters.go", buf.Bytes())
+			if err != nil {
+				fmt.Println(buf.String())
+			}
+			require.NoError(t, err)
+
+			res := string(ff)
+			assertInCode(t, "Params) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+			for _, expected := range fixture.Expected {
+				assertInCode(t, expected, res)
+			}
+			if tpl == "serverParameter" {
+				assertInCode(t, "if err := o.ContextValidate(validate.WithOperationRequest(r.Context()), route.Formats); err != nil {", res)
+			}
+		}
+	}
+}
+
+func TestContextValidation_Responses(t *testing.T) {
+	b, err := opBuilder("patchTasks", "../fixtures/codegen/context-validation.yml")
+	require.NoError(t, err)
+	op, err := b.MakeOperation()
+	require.NoError(t, err)
+
+	opts := opts()
+	for _, tpl := range []string{"serverResponses", "clientResponse"} {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, templates.MustGet(tpl).Execute(buf, op))
+		ff, err := opts.LanguageOpts.FormatContent("patch_tasks_responses.go", buf.Bytes())
+		if err != nil {
+			fmt.Println(buf.String())
+		}
+		require.NoError(t, err)
+
+		res := string(ff)
+		assertInCode(t, "func (o *PatchTasksOK) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+		assertInCode(t, "if err := o.Payload.ContextValidate(ctx, formats); err != nil {", res)
+		if tpl == "clientResponse" {
+			// the inline response schema is rendered alongside the response
+			assertInCode(t, "func (o *PatchTasksOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {", res)
+			assertInCode(t, `if err := validate.ReadOnly(ctx, "patchTasksOK"+"."+"id", "body", o.ID); err != nil {`, res)
+		}
+	}
+}
+
+func TestContextValidation_Polymorphic(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/todolist.discriminators.yml")
+	require.NoError(t, err)
+
+	opts := opts()
+	for _, name := range []string{"Pet", "WithPet"} {
+		genModel, err := makeGenDefinition(name, "models", specDoc.Spec().Definitions[name], specDoc, opts)
+		require.NoError(t, err)
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, templates.MustGet("model").Execute(buf, genModel))
+		ff, err := opts.LanguageOpts.FormatContent(swag.ToFileName(name)+".go", buf.Bytes())
+		require.NoError(t, err)
+
+		res := string(ff)
+		if name == "Pet" {
+			// the interface of a base type supports context validation
+			assertInCode(t, "ContextValidate(context.Context, strfmt.Registry) error", res)
+			continue
+		}
+		assert.Contains(t, res, "if m.Pet() != nil {\n\t\tif err := m.Pet().ContextValidate(ctx, formats); err != nil {")
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+// deepCopiesUntyped tells if the DeepCopy methods of a named schema copy some untyped values,
+// i.e. values which may hold JSON objects and arrays.
+//
+// Named types nested in the schema are skipped: they are copied by their own methods.
+func deepCopiesUntyped(data interface{}) bool {
+	var s GenSchema
+	switch v := data.(type) {
+	case GenSchema:
+		s = v
+	case *GenSchema:
+		s = *v
+	case GenDefinition:
+		s = v.GenSchema
+	case *GenDefinition:
+		s = v.GenSchema
+	default:
+		return false
+	}
+
+	children := make(GenSchemaList, 0, len(s.Properties)+len(s.AllOf)+3)
+	children = append(children, s.Properties...)
+	children = append(children, s.AllOf...)
+	for _, nested := range []*GenSchema{s.Items, s.AdditionalProperties, s.AdditionalItems} {
+		if nested != nil {
+			children = append(children, *nested)
+		}
+	}
+
+	for _, child := range children {
+		switch {
+		case child.IsInterface:
+			return true
+		case child.IsStream || child.IsExternal || child.HasDiscriminator:
+			continue
+		case (child.IsComplexObject || child.IsTuple) && !child.IsAnonymous:
+			continue
+		case deepCopiesUntyped(child):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func genDeepCopyModel(t testing.TB, name string, includeDeepCopy bool) (*GenDefinition, string) {
+	return genModelWith(t, "../fixtures/codegen/deepcopy.yml", name, func(opts *GenOpts) {
+		opts.IncludeDeepCopy = includeDeepCopy
+	})
+}
+
+func TestDeepCopy_Disabled(t *testing.T) {
+	for _, name := range []string{"Kennel", "Pet", "Dog", "Labels", "When"} {
+		genModel, res := genDeepCopyModel(t, name, false)
+		assert.False(t, genModel.WantsDeepCopy)
+		assertNotInCode(t, "DeepCopy", res)
+		assertNotInCode(t, "Equal(", res)
+	}
+}
+
+func TestDeepCopy_Struct(t *testing.T) {
+	genModel, res := genDeepCopyModel(t, "Kennel", true)
+	assert.True(t, genModel.WantsDeepCopy)
+	assertInCode(t, "func (m *Kennel) DeepCopyInto(out *Kennel) {", res)
+	assertInCode(t, "func (m *Kennel) DeepCopy() *Kennel {", res)
+	assertInCode(t, "func (m *Kennel) Equal(other *Kennel) bool {", res)
+	assertInCode(t, "*out = *m", res)
+
+	// pointers
+	assertInCode(t, "out.Owner = m.Owner.DeepCopy()", res)
+	assertInCode(t, "c := *m.ID", res)
+	assertInCode(t, "if *m.ID != *other.ID {", res)
+	// polymorphic values
+	assertInCode(t, "out.starField = m.starField.DeepCopyPet()", res)
+	assertInCode(t, "out.petsField[i] = m.petsField[i].DeepCopyPet()", res)
+	assertInCode(t, "!m.petsField[i].EqualPet(other.petsField[i])", res)
+	// slices and maps
+	assertInCode(t, "out.Picture = append(m.Picture[:0:0], m.Picture...)", res)
+	assertInCode(t, "out.Rooms[i][ii] = m.Rooms[i][ii].DeepCopy()", res)
+	assertInCode(t, "out.Ratings = make(map[string]*int64, len(m.Ratings))", res)
+	assertInCode(t, "c := append(v[:0:0], v...)", res)
+	// tuples are values
+	assertInCode(t, "m.Position.DeepCopyInto(&out.Position)", res)
+	assertInCode(t, "!m.Position.Equal(&other.Position)", res)
+	// formatted and untyped values
+	assertInCode(t, "!bytes.Equal(m.Picture, other.Picture)", res)
+	assertInCode(t, "!time.Time(m.Opened).Equal(time.Time(other.Opened))", res)
+	assertInCode(t, "!reflect.DeepEqual(m.Notes, other.Notes)", res)
+}
+
+func TestDeepCopy_Polymorphic(t *testing.T) {
+	_, res := genDeepCopyModel(t, "Pet", true)
+	assertInCode(t, "DeepCopyPet() Pet", res)
+	assertInCode(t, "EqualPet(Pet) bool", res)
+	assertInCode(t, "func (m *pet) DeepCopy() *pet {", res)
+	assertInCode(t, "func (m *pet) EqualPet(other Pet) bool {", res)
+
+	_, res = genDeepCopyModel(t, "Dog", true)
+	assertInCode(t, "func (m *Dog) DeepCopyPet() Pet {", res)
+	assertInCode(t, "func (m *Dog) EqualPet(other Pet) bool {", res)
+	assertInCode(t, "o, ok := other.(*Dog)", res)
+	assertInCode(t, "out.ownerField = m.ownerField.DeepCopy()", res)
+	assertInCode(t, "if m.PackSize != other.PackSize {", res)
+}
+
+func TestDeepCopy_NonStruct(t *testing.T) {
+	_, res := genDeepCopyModel(t, "Labels", true)
+	assertInCode(t, "func (m Labels) DeepCopy() Labels {", res)
+	assertInCode(t, "func (m Labels) DeepCopyInto(out *Labels) {", res)
+	assertInCode(t, "func (m Labels) Equal(other Labels) bool {", res)
+	assertInCode(t, "out = make(Labels, len(m))", res)
+
+	_, res = genDeepCopyModel(t, "Tags", true)
+	assertInCode(t, "func (m Tags) DeepCopy() Tags {", res)
+
+	_, res = genDeepCopyModel(t, "When", true)
+	assertInCode(t, "return time.Time(m).Equal(time.Time(other))", res)
+
+	_, res = genDeepCopyModel(t, "Blob", true)
+	assertInCode(t, "return append(m[:0:0], m...)", res)
+	assertInCode(t, "return bytes.Equal(m, other)", res)
+
+	// untyped definitions have nothing to copy
+	genModel, res := genDeepCopyModel(t, "Anything", true)
+	assert.False(t, genModel.WantsDeepCopy)
+	assertNotInCode(t, "DeepCopy", res)
+}
+
+func TestDeepCopy_Untyped(t *testing.T) {
+	_, res := genDeepCopyModel(t, "Kennel", true)
+	assertInCode(t, "var deepCopyJSONValue func(interface{}) interface{}", res)
+	assertInCode(t, "out.Notes = deepCopyJSONValue(m.Notes)", res)
+	assertInCode(t, "out.Extra = deepCopyJSONValue(m.Extra)", res)
+
+	_, res = genDeepCopyModel(t, "Notebook", true)
+	assertInCode(t, "out.Pages[i] = deepCopyJSONValue(m.Pages[i])", res)
+	assertInCode(t, "[k] = deepCopyJSONValue(v)", res)
+
+	// no untyped values
+	_, res = genDeepCopyModel(t, "Owner", true)
+	assertNotInCode(t, "deepCopyJSONValue", res)
+}
+
+func TestDeepCopy_Ref(t *testing.T) {
+	// a definition which is only a $ref embeds the referred type, and gets its own methods
+	genModel, res := genDeepCopyModel(t, "KennelRef", true)
+	assert.True(t, genModel.WantsDeepCopy)
+	assertInCode(t, "func (m *KennelRef) DeepCopy() *KennelRef {", res)
+	assertInCode(t, "m.Kennel.DeepCopyInto(&out.Kennel)", res)
+	assertInCode(t, "func (m *KennelRef) Equal(other *KennelRef) bool {", res)
+	assertInCode(t, "!m.Kennel.Equal(&other.Kennel)", res)
+}
+
+// deepCopyMutateTest checks that the copies of models do not share values with the originals
+const deepCopyMutateTest = `package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func notes() interface{} {
+	return map[string]interface{}{
+		"nested": map[string]interface{}{"key": "value"},
+		"list":   []interface{}{"value", map[string]interface{}{"key": "value"}},
+	}
+}
+
+func mutate(t *testing.T, value interface{}) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		t.Fatalf("unexpected copy: %v", value)
+	}
+	object["nested"].(map[string]interface{})["key"] = "changed"
+	list := object["list"].([]interface{})
+	list[0] = "changed"
+	list[1].(map[string]interface{})["key"] = "changed"
+}
+
+func TestDeepCopyUntyped(t *testing.T) {
+	kennel := &Kennel{Notes: notes(), Extra: notes()}
+	copied := kennel.DeepCopy()
+	mutate(t, copied.Notes)
+	mutate(t, copied.Extra)
+	if !reflect.DeepEqual(notes(), kennel.Notes) || !reflect.DeepEqual(notes(), kennel.Extra) {
+		t.Errorf("the copy of a kennel shares its untyped values: %v, %v", kennel.Notes, kennel.Extra)
+	}
+
+	notebook := &Notebook{Pages: []interface{}{notes()}, Drafts: map[string]interface{}{"draft": notes()}}
+	copiedNotebook := notebook.DeepCopy()
+	mutate(t, copiedNotebook.Pages[0])
+	mutate(t, copiedNotebook.Drafts["draft"])
+	if !reflect.DeepEqual(notes(), notebook.Pages[0]) || !reflect.DeepEqual(notes(), notebook.Drafts["draft"]) {
+		t.Errorf("the copy of a notebook shares its untyped values: %v", notebook)
+	}
+}
+
+func TestDeepCopyRef(t *testing.T) {
+	ref := &KennelRef{Kennel: Kennel{Notes: notes()}}
+	var copied *KennelRef = ref.DeepCopy()
+	if !copied.Equal(ref) {
+		t.Errorf("expected the copy to be equal to the original")
+	}
+	mutate(t, copied.Notes)
+	if !reflect.DeepEqual(notes(), ref.Notes) {
+		t.Errorf("the copy of a kennel ref shares its untyped values: %v", ref.Notes)
+	}
+	if copied.Equal(ref) {
+		t.Errorf("expected the mutated copy to differ from the original")
+	}
+}
+`
+
+func TestDeepCopy_Mutate(t *testing.T) {
+	testGeneratedModels(t, filepath.Join("..", "fixtures", "codegen", "deepcopy.yml"), func(opts *GenOpts) {
+		opts.IncludeDeepCopy = true
+	}, "deepcopy_mutate_test.go", deepCopyMutateTest)
+}
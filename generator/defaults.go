@@ -0,0 +1,154 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+// buildDefaults determines the default values applied by the constructor of a model.
+//
+// Only objects get a constructor: polymorphic types are left out by templates.
+func (sg *schemaGenContext) buildDefaults() {
+	if !sg.GenSchema.IsComplexObject || sg.GenSchema.IsTuple || sg.Schema.Discriminator != "" {
+		return
+	}
+
+	values, ok := schemaDefaults(&sg.Schema, sg.TypeResolver.Doc.Spec(), false, make(map[string]bool)).(map[string]interface{})
+	if !ok || len(values) == 0 {
+		return
+	}
+	sg.GenSchema.DefaultValues = values
+
+	// required properties are left out when unmarshalling, so that validation reports them when they are absent
+	if optional, ok := schemaDefaults(&sg.Schema, sg.TypeResolver.Doc.Spec(), true, make(map[string]bool)).(map[string]interface{}); ok && len(optional) > 0 {
+		sg.GenSchema.UnmarshalDefaultValues = optional
+	}
+
+	name := "New" + pascalize(sg.GenSchema.Name)
+	if sg.clashesWithDefinition(name) {
+		name += "Model"
+	}
+	sg.GenSchema.ConstructorName = name
+}
+
+// schemaDefaults returns the default values of a schema, completed with the default values of
+// nested objects and arrays.
+//
+// The default of an object holds the defaults of its properties, and those of its required properties
+// which are objects with some defaults. The defaults of some explicit value are merged into this value,
+// e.g. the default items of an array are completed with the defaults of the items schema.
+//
+// With optionalOnly, the required properties of objects are left out.
+//
+// Polymorphic types are not explored, and nil is returned when a schema has no default.
+func schemaDefaults(schema *spec.Schema, root *spec.Swagger, optionalOnly bool, visited map[string]bool) interface{} {
+	if ref := schema.Ref.String(); ref != "" {
+		if visited[ref] {
+			return nil
+		}
+		resolved, err := spec.ResolveRef(root, &schema.Ref)
+		if err != nil || resolved == nil {
+			return nil
+		}
+		visited[ref] = true
+		defer delete(visited, ref)
+		return schemaDefaults(resolved, root, optionalOnly, visited)
+	}
+	if schema.Discriminator != "" {
+		return schema.Default
+	}
+
+	var nested interface{}
+	switch {
+	case len(schema.Properties) > 0 || len(schema.AllOf) > 0:
+		values := make(map[string]interface{})
+		for i := range schema.AllOf {
+			if member, ok := schemaDefaults(&schema.AllOf[i], root, optionalOnly, visited).(map[string]interface{}); ok {
+				values = mergeDefaults(values, member).(map[string]interface{})
+			}
+		}
+		for name, property := range schema.Properties {
+			if optionalOnly && swag.ContainsStrings(schema.Required, name) {
+				continue
+			}
+			value := schemaDefaults(&property, root, optionalOnly, visited)
+			if value == nil {
+				continue
+			}
+			if _, isObject := value.(map[string]interface{}); isObject && !hasExplicitDefault(&property, root) && !swag.ContainsStrings(schema.Required, name) {
+				// optional objects are not created for the sake of their defaults
+				continue
+			}
+			values[name] = value
+		}
+		if len(values) > 0 {
+			nested = values
+		}
+	case schema.Items != nil && schema.Items.Schema != nil:
+		if items, ok := schema.Default.([]interface{}); ok {
+			completed := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				completed = append(completed, mergeDefaults(item, schemaDefaults(schema.Items.Schema, root, optionalOnly, visited)))
+			}
+			return completed
+		}
+	case schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+		if entries, ok := schema.Default.(map[string]interface{}); ok {
+			completed := make(map[string]interface{}, len(entries))
+			for key, entry := range entries {
+				completed[key] = mergeDefaults(entry, schemaDefaults(schema.AdditionalProperties.Schema, root, optionalOnly, visited))
+			}
+			return completed
+		}
+	}
+
+	return mergeDefaults(schema.Default, nested)
+}
+
+// hasExplicitDefault tells if a schema, or the schema it refers to, has a default value
+func hasExplicitDefault(schema *spec.Schema, root *spec.Swagger) bool {
+	if schema.Default != nil {
+		return true
+	}
+	if schema.Ref.String() == "" {
+		return false
+	}
+	resolved, err := spec.ResolveRef(root, &schema.Ref)
+	return err == nil && resolved != nil && resolved.Default != nil
+}
+
+// mergeDefaults completes the value with the defaults of its schema: the value takes precedence,
+// and objects are merged key by key.
+func mergeDefaults(value, defaults interface{}) interface{} {
+	if value == nil {
+		return defaults
+	}
+	object, isObject := value.(map[string]interface{})
+	defaultObject, hasDefaultObject := defaults.(map[string]interface{})
+	if !isObject || !hasDefaultObject {
+		return value
+	}
+
+	merged := make(map[string]interface{}, len(object)+len(defaultObject))
+	for k, v := range defaultObject {
+		merged[k] = v
+	}
+	for k, v := range object {
+		merged[k] = mergeDefaults(v, defaultObject[k])
+	}
+	return merged
+}
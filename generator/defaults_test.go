@@ -0,0 +1,164 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func genDefaultsModel(t testing.TB, name string, unmarshalDefaults bool) (*GenDefinition, string) {
+	return genModelWith(t, "../fixtures/codegen/model-defaults.yml", name, func(opts *GenOpts) {
+		opts.UnmarshalDefaults = unmarshalDefaults
+	})
+}
+
+func TestDefaults_Values(t *testing.T) {
+	genModel, _ := genDefaultsModel(t, "Settings", false)
+
+	expected := map[string]interface{}{
+		"theme":    "dark",
+		"pageSize": float64(20),
+		"notify":   true,
+		"since":    "2020-01-01T00:00:00.000Z",
+		"tags":     []interface{}{"news", "sports"},
+		// required objects are created with their defaults, optional ones are not
+		"layout": map[string]interface{}{"columns": float64(2)},
+		"owner":  map[string]interface{}{"name": "anonymous", "role": "viewer"},
+		// the default of a referenced type
+		"color": "blue",
+		// explicit values are completed with the defaults of items and additional properties
+		"rules": []interface{}{
+			map[string]interface{}{"name": "first", "severity": "warning"},
+			map[string]interface{}{"name": "second", "severity": "error"},
+		},
+		"limits": map[string]interface{}{"cpu": map[string]interface{}{"value": float64(1)}},
+	}
+	assert.Equal(t, expected, genModel.DefaultValues)
+	assert.Equal(t, "NewSettings", genModel.ConstructorName)
+
+	// required properties get no default when unmarshalling
+	delete(expected, "layout")
+	delete(expected, "owner")
+	assert.Equal(t, expected, genModel.UnmarshalDefaultValues)
+
+	var found bool
+	for _, extra := range genModel.ExtraSchemas {
+		if extra.Name == "SettingsSidebar" {
+			found = true
+			assert.Equal(t, map[string]interface{}{"width": float64(200)}, extra.DefaultValues)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestDefaults_Constructors(t *testing.T) {
+	_, res := genDefaultsModel(t, "Settings", false)
+	assertInCode(t, "// NewSettings creates a settings, with the default values of its properties", res)
+	assertInCode(t, "func NewSettings() *Settings {", res)
+	assertInCode(t, `if err := json.Unmarshal([]byte("{\"color\":\"blue\",\"layout\":{\"columns\":2},`, res)
+	assertInCode(t, `msg := fmt.Sprintf("invalid default values for settings: %v", err)`, res)
+	assertInCode(t, "func NewSettingsLayout() *SettingsLayout {", res)
+	assertInCode(t, "func NewSettingsSidebar() *SettingsSidebar {", res)
+	assertNotInCode(t, "UnmarshalJSON", res)
+
+	// the constructor does not clash with the NewUser definition
+	_, res = genDefaultsModel(t, "User", false)
+	assertInCode(t, "func NewUserModel() *User {", res)
+
+	_, res = genDefaultsModel(t, "Admin", false)
+	assertInCode(t, "func NewAdmin() *Admin {", res)
+	assertInCode(t, `\"name\":\"anonymous\",\"role\":\"viewer\",\"superUser\":true}`, res)
+
+	// no constructor without defaults, nor for polymorphic types
+	for _, name := range []string{"Plain", "Pet", "Dog"} {
+		_, res = genDefaultsModel(t, name, false)
+		assertNotInCode(t, "func New", res)
+	}
+}
+
+func TestDefaults_Unmarshal(t *testing.T) {
+	_, res := genDefaultsModel(t, "User", true)
+	assertInCode(t, "func NewUserModel() *User {", res)
+	assertInCode(t, "func (m *User) UnmarshalJSON(raw []byte) error {", res)
+	assertInCode(t, "type userAlias User", res)
+	assertInCode(t, `if err := json.Unmarshal([]byte("{\"name\":\"anonymous\",\"role\":\"viewer\"}"), &data); err != nil {`, res)
+	assertInCode(t, "*m = User(data)", res)
+
+	// composed types apply the defaults of their inline members
+	_, res = genDefaultsModel(t, "Admin", true)
+	assertInCode(t, `if err := swag.ReadJSON([]byte("{\"name\":\"anonymous\",\"role\":\"viewer\",\"superUser\":true}"), &dataAO1); err != nil {`, res)
+
+	_, res = genDefaultsModel(t, "Plain", true)
+	assertNotInCode(t, "UnmarshalJSON", res)
+
+	_, res = genDefaultsModel(t, "Settings", true)
+	assertInCode(t, "func (m *Settings) UnmarshalJSON(raw []byte) error {", res)
+	assertNotInCode(t, `\"layout\":{\"columns\":2}`, res[strings.Index(res, "UnmarshalJSON"):])
+}
+
+// defaultsRequiredTest checks that the defaults applied when unmarshalling do not hide absent required properties
+const defaultsRequiredTest = `package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestSettingsRequired(t *testing.T) {
+	var settings Settings
+	if err := json.Unmarshal([]byte("{}"), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if err := settings.Validate(strfmt.Default); err == nil {
+		t.Error("expected absent required properties to fail validation")
+	}
+
+	settings = Settings{}
+	if err := json.Unmarshal([]byte(` + "`" + `{"layout":{},"owner":{}}` + "`" + `), &settings); err != nil {
+		t.Fatal(err)
+	}
+	if err := settings.Validate(strfmt.Default); err != nil {
+		t.Error(err)
+	}
+	if settings.Theme == nil || *settings.Theme != "dark" {
+		t.Errorf("expected the default theme, got %v", settings.Theme)
+	}
+}
+`
+
+func TestDefaults_UnmarshalRequired(t *testing.T) {
+	testGeneratedModels(t, filepath.Join("..", "fixtures", "codegen", "model-defaults.yml"), func(opts *GenOpts) {
+		opts.UnmarshalDefaults = true
+	}, "settings_required_test.go", defaultsRequiredTest)
+}
+
+func TestDefaults_MergeDefaults(t *testing.T) {
+	assert.Nil(t, mergeDefaults(nil, nil))
+	assert.Equal(t, "a", mergeDefaults("a", map[string]interface{}{"b": 1}))
+	assert.Equal(t, map[string]interface{}{"b": 1}, mergeDefaults(nil, map[string]interface{}{"b": 1}))
+	assert.Equal(t,
+		map[string]interface{}{"a": map[string]interface{}{"x": 1, "y": 3}, "b": 2},
+		mergeDefaults(
+			map[string]interface{}{"a": map[string]interface{}{"x": 1}},
+			map[string]interface{}{"a": map[string]interface{}{"x": 2, "y": 3}, "b": 2},
+		),
+	)
+}
@@ -0,0 +1,764 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-openapi/swag"
+)
+
+// easyJSONPrimitives maps go types to the methods of the JSON lexer reading a value of this type
+var easyJSONPrimitives = map[string]string{
+	"string":  "String",
+	"bool":    "Bool",
+	"int":     "Int",
+	"int8":    "Int8",
+	"int16":   "Int16",
+	"int32":   "Int32",
+	"int64":   "Int64",
+	"uint":    "Uint",
+	"uint8":   "Uint8",
+	"uint16":  "Uint16",
+	"uint32":  "Uint32",
+	"uint64":  "Uint64",
+	"float32": "Float32",
+	"float64": "Float64",
+}
+
+// easyJSONSchema returns the schema of a model, as passed to the templates.
+//
+// The "schema" template renders either a GenDefinition or some GenSchema.
+func easyJSONSchema(data interface{}) (GenSchema, error) {
+	switch s := data.(type) {
+	case GenSchema:
+		return s, nil
+	case *GenSchema:
+		return *s, nil
+	case GenDefinition:
+		return s.GenSchema, nil
+	case *GenDefinition:
+		return s.GenSchema, nil
+	default:
+		return GenSchema{}, fmt.Errorf("expected a schema, but got %T", data)
+	}
+}
+
+// easyJSONPath tells which JSON serializer the templates would render for a named schema,
+// and hence which behavior the marshallers generated without reflection must reproduce.
+//
+// An empty string means that no marshaller is generated for this schema.
+func easyJSONPath(s GenSchema) string {
+	switch {
+	case s.IsInterface || s.IsStream || s.IsSuperAlias || s.IsExternal:
+		return ""
+	case s.IsBaseType && s.IsExported:
+		return ""
+	case s.IsComplexObject || s.IsTuple || s.IsAdditionalProperties:
+		switch {
+		case s.IsSubType && !s.HasBaseType:
+			return "discriminated"
+		case s.IsTuple:
+			return "tuple"
+		case s.HasBaseType:
+			return "discriminated"
+		case s.IsAdditionalProperties:
+			return "additionalProperties"
+		case len(s.AllOf) > 0 && !s.IsSubType:
+			return "allOf"
+		case s.IsComplexObject && s.StrictAdditionalProperties:
+			return "strict"
+		default:
+			return "object"
+		}
+	case s.IsArray:
+		return "array"
+	case s.IsMap:
+		return "map"
+	case s.IsPrimitive && s.IsAliased && s.IsCustomFormatter && !strings.Contains(s.Zero(), `("`):
+		return "formatted"
+	case s.IsPrimitive:
+		return "primitive"
+	default:
+		return ""
+	}
+}
+
+// easyJSONKind tells how a value is written and read by the marshallers generated without reflection:
+//
+//   - json: values of external types, handled by the standard library
+//   - interface: untyped values, handled by the standard library
+//   - polymorphic: values of a base type, which concrete type is found from the discriminator
+//   - model: named types generated in the same package, which have their own marshallers
+//   - formatter: values with a format, which implement their own JSON marshallers
+//   - slice, map: anonymous containers
+//   - primitive: builtin go types
+//
+// An empty string means that the value cannot be handled without reflection.
+func easyJSONKind(s GenSchema) string {
+	switch {
+	case s.IsStream || s.IsJSONString:
+		return ""
+	case s.IsExternal:
+		return "json"
+	case s.IsInterface:
+		return "interface"
+	case s.HasDiscriminator && !s.IsArray && !s.IsMap:
+		return "polymorphic"
+	case strings.HasPrefix(s.GoType, "[]"):
+		if s.Items == nil {
+			return ""
+		}
+		if s.Items.GoType == "uint8" || s.Items.GoType == "byte" {
+			// []byte is rendered as base64
+			return "json"
+		}
+		return "slice"
+	case strings.HasPrefix(s.GoType, "map[string]"):
+		if s.AdditionalProperties == nil {
+			return "json"
+		}
+		return "map"
+	case strings.HasPrefix(s.GoType, "strfmt."):
+		return "formatter"
+	case easyJSONPrimitives[s.GoType] != "":
+		return "primitive"
+	case s.GoType == "" || strings.ContainsAny(s.GoType, ".{}[]* "):
+		return ""
+	default:
+		return "model"
+	}
+}
+
+// easyJSONFormattedString tells if a formatted value is a string, written like a string of its underlying type
+func easyJSONFormattedString(s GenSchema) bool {
+	return strings.Contains(s.Zero(), `("")`)
+}
+
+// easyJSONElemPtr tells if the elements of a slice or map are rendered as pointers
+func easyJSONElemPtr(s *GenSchema) bool {
+	return s != nil && s.IsNullable && !s.IsMapNullOverride && !s.IsMap
+}
+
+// easyJSONPtr tells if a property is rendered as a pointer
+func easyJSONPtr(s GenSchema) bool {
+	return s.IsNullable && !s.IsMap && !s.IsSuperAlias
+}
+
+// easyJSONType returns the go type of a value.
+//
+// The type of anonymous containers is built from their elements, as the pointers to their elements
+// may be dropped after their go type is resolved.
+func easyJSONType(s GenSchema) string {
+	switch easyJSONKind(s) {
+	case "slice":
+		return "[]" + easyJSONElemType(s.Items)
+	case "map":
+		return "map[string]" + easyJSONElemType(s.AdditionalProperties)
+	default:
+		return s.GoType
+	}
+}
+
+// easyJSONElemType returns the go type of the elements of a slice or map
+func easyJSONElemType(s *GenSchema) string {
+	if easyJSONElemPtr(s) {
+		return "*" + easyJSONType(*s)
+	}
+	return easyJSONType(*s)
+}
+
+// easyJSONNotEmpty returns the condition for a value to be rendered with the omitempty option,
+// i.e. the go expression which is false when encoding/json considers the value as empty.
+//
+// It returns an empty string when the value is never empty, like structs, and "?" when
+// this can't be known from the schema.
+func easyJSONNotEmpty(s GenSchema, ptr bool, x string) string {
+	kind := easyJSONKind(s)
+	switch {
+	case ptr || kind == "interface" || kind == "polymorphic":
+		return x + " != nil"
+	case s.IsExternal:
+		// the flags of external types do not tell how they are rendered
+		return "?"
+	case s.IsArray || s.IsMap || s.IsBase64 || kind == "slice" || kind == "map":
+		return "len(" + x + ") != 0"
+	case s.IsComplexObject || s.IsTuple || s.IsAdditionalProperties:
+		return ""
+	case kind == "json":
+		return "?"
+	}
+
+	zero := s.Zero()
+	switch {
+	case strings.Contains(zero, "{}"):
+		return ""
+	case zero == `""` || strings.HasSuffix(zero, `("")`):
+		return x + ` != ""`
+	case zero == "0" || strings.HasSuffix(zero, "(0)"):
+		return x + " != 0"
+	case zero == "false" || strings.HasSuffix(zero, "(false)"):
+		return x
+	default:
+		return "?"
+	}
+}
+
+// easyJSONOmitted tells if a property is rendered with the omitempty option
+func easyJSONOmitted(s GenSchema) bool {
+	return !s.Required && s.IsEmptyOmitted
+}
+
+// easyJSONKey renders the JSON key of an object member, preceded by a separator, as a go string literal
+func easyJSONKey(name string) string {
+	key, _ := json.Marshal(name)
+	return strconv.Quote("," + string(key) + ":")
+}
+
+// easyJSONNames renders the JSON keys matched when reading an object, as a list of go string literals
+func easyJSONNames(s GenSchema) string {
+	var names []string
+	switch easyJSONPath(s) {
+	case "discriminated":
+		for _, field := range easyJSONDiscriminatedFields(s, false) {
+			names = append(names, field.Key)
+		}
+	default:
+		for _, member := range s.AllOf {
+			if member.IsAnonymous {
+				for _, prop := range member.Properties {
+					names = append(names, prop.OriginalName)
+				}
+			}
+		}
+		for _, prop := range s.Properties {
+			names = append(names, prop.OriginalName)
+		}
+	}
+
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, strconv.Quote(name))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// easyJSONValidName tells if a property name is rendered by encoding/json as a key,
+// in the same way as the json struct tag of a field.
+//
+// Properties with other names fall back to the name of the go field.
+func easyJSONValidName(name string) bool {
+	if name == "" || name == "-" {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+// easyJSONFallbacks lists the named schemas of a definition which want JSON marshallers without reflection,
+// but get marshallers delegating to encoding/json.
+//
+// Base types are left out: their values are marshalled by their concrete types.
+func easyJSONFallbacks(def *GenDefinition) []string {
+	var names []string
+	for _, s := range append(GenSchemaList{def.GenSchema}, def.ExtraSchemas...) {
+		if s.WantsEasyJSON && s.IsExported && !s.IsBaseType && !easyJSONSupported(s) {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// easyJSONSupported tells if the JSON marshallers of a named schema may be generated without reflection.
+//
+// Other schemas get marshallers which delegate to encoding/json, so they may be used
+// from the marshallers of the models which refer to them.
+func easyJSONSupported(s GenSchema) bool {
+	if !s.WantsEasyJSON || !s.IsExported {
+		return false
+	}
+	if len(s.StructTags) > 0 && !swag.ContainsStrings(s.StructTags, "json") {
+		return false
+	}
+
+	switch easyJSONPath(s) {
+	case "object", "strict":
+		return len(s.AllOf) == 0 && s.AdditionalProperties == nil && s.AdditionalItems == nil &&
+			easyJSONPropertiesSupported(s.Properties, false, true)
+
+	case "additionalProperties":
+		if len(s.AllOf) > 0 || s.AdditionalItems != nil || s.AdditionalProperties == nil ||
+			!easyJSONPropertiesSupported(s.Properties, false, true) {
+			return false
+		}
+		for _, prop := range s.Properties {
+			// additional properties are the members which do not match exactly the name of a property
+			if prop.Name != prop.OriginalName {
+				return false
+			}
+		}
+		return easyJSONValueSupported(*s.AdditionalProperties)
+
+	case "allOf":
+		if s.AdditionalProperties != nil || s.AdditionalItems != nil || !easyJSONPropertiesSupported(s.Properties, false, true) {
+			return false
+		}
+		// the anonymous members and the properties are read in a single pass
+		keys := make([]string, 0, len(s.Properties))
+		for _, prop := range s.Properties {
+			keys = append(keys, prop.OriginalName)
+		}
+		for _, member := range s.AllOf {
+			switch {
+			case member.IsBaseType || member.HasBaseType || member.IsSubType:
+				return false
+			case member.IsAnonymous:
+				if len(member.Properties) == 0 || member.AdditionalProperties != nil || member.AdditionalItems != nil ||
+					!easyJSONPropertiesSupported(member.Properties, false, true) {
+					return false
+				}
+				for _, prop := range member.Properties {
+					keys = append(keys, prop.OriginalName)
+				}
+			case !member.IsComplexObject || member.IsTuple || easyJSONKind(member) != "model":
+				return false
+			}
+		}
+		return easyJSONDistinctKeys(keys)
+
+	case "discriminated":
+		if s.IsAdditionalProperties || s.AdditionalProperties != nil || s.AdditionalItems != nil ||
+			!easyJSONPropertiesSupported(s.Properties, true, false) {
+			return false
+		}
+		for _, member := range s.AllOf {
+			switch {
+			case member.IsAnonymous:
+				if member.IsBaseType || member.AdditionalProperties != nil || member.AdditionalItems != nil ||
+					!easyJSONPropertiesSupported(member.Properties, true, true) {
+					return false
+				}
+				for _, prop := range member.Properties {
+					if prop.IsTuple {
+						// tuples are not rendered as members of these objects
+						return false
+					}
+				}
+			case member.IsBaseType && member.IsExported:
+				// all the properties of the base type are flagged as such, but are read like any other value
+				props := make(GenSchemaList, 0, len(member.Properties))
+				for _, prop := range member.Properties {
+					if prop.Name == s.DiscriminatorField && (prop.GoType != "string" || easyJSONPtr(prop)) {
+						return false
+					}
+					prop.IsBaseType = false
+					props = append(props, prop)
+				}
+				if !easyJSONPropertiesSupported(props, false, true) {
+					return false
+				}
+			default:
+				// embedded types would hijack the marshallers of the struct used by encoding/json
+				return false
+			}
+		}
+		// all members are read in a single pass
+		fields := easyJSONDiscriminatedFields(s, false)
+		keys := make([]string, 0, len(fields))
+		for _, field := range fields {
+			keys = append(keys, field.Key)
+		}
+		return easyJSONDistinctKeys(keys)
+
+	case "tuple":
+		if len(s.Properties) == 0 || len(s.AllOf) > 0 {
+			return false
+		}
+		for _, prop := range s.Properties {
+			if !prop.IsExported || !easyJSONValueSupported(prop) {
+				return false
+			}
+		}
+		return s.AdditionalItems == nil || easyJSONValueSupported(*s.AdditionalItems)
+
+	case "array":
+		return s.Items != nil && easyJSONValueSupported(*s.Items)
+
+	case "map":
+		return s.AdditionalProperties != nil && easyJSONValueSupported(*s.AdditionalProperties)
+
+	case "primitive":
+		if !s.IsAliased {
+			return false
+		}
+		if easyJSONPrimitives[s.AliasedType] != "" {
+			return true
+		}
+		// formatted strings are rendered as plain strings
+		return strings.HasPrefix(s.AliasedType, "strfmt.") && strings.HasSuffix(s.Zero(), `(""))`)
+
+	case "formatted":
+		return strings.HasPrefix(s.AliasedType, "strfmt.")
+
+	default:
+		return false
+	}
+}
+
+// easyJSONPropertiesSupported checks the properties of an object.
+//
+// Polymorphic properties may only be found in types with a discriminated serializer.
+func easyJSONPropertiesSupported(props GenSchemaList, allowPolymorphic, originalNames bool) bool {
+	for _, prop := range props {
+		name := prop.Name
+		if originalNames {
+			name = prop.OriginalName
+		}
+		if !prop.IsExported || !easyJSONValidName(name) || !easyJSONValidName(prop.Name) {
+			return false
+		}
+		if easyJSONOmitted(prop) && easyJSONNotEmpty(prop, easyJSONPtr(prop), "x") == "?" {
+			return false
+		}
+		if allowPolymorphic && prop.IsBaseType {
+			if prop.IsArray && prop.Items != nil && easyJSONKind(*prop.Items) == "polymorphic" {
+				continue
+			}
+			if easyJSONKind(prop) == "polymorphic" {
+				continue
+			}
+			return false
+		}
+		if prop.IsBaseType || !easyJSONValueSupported(prop) {
+			return false
+		}
+	}
+	return true
+}
+
+// easyJSONDistinctKeys checks that the keys of the properties read in a single pass do not collide,
+// as encoding/json matches keys case-insensitively
+func easyJSONDistinctKeys(keys []string) bool {
+	for i := range keys {
+		for j := i + 1; j < len(keys); j++ {
+			if strings.EqualFold(keys[i], keys[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// easyJSONField is a member of the JSON object of a type with a discriminated serializer
+type easyJSONField struct {
+	Schema        GenSchema
+	Key           string // the JSON key of this member
+	Value         string // the go expression of the value, from the receiver when writing or the result when reading
+	Polymorphic   bool   // the value is a base type, resolved from its discriminator
+	Discriminator bool   // the value is the discriminator of a subtype, checked against the type being read
+}
+
+// easyJSONDiscriminatedFields lists the members of the JSON object of a type with a discriminated serializer,
+// in the order they are written.
+//
+// Like encoding/json with the structs used by the templates, properties of anonymous members are matched with their original name,
+// other properties of the type with their go name.
+func easyJSONDiscriminatedFields(s GenSchema, write bool) []easyJSONField {
+	rcv := "result"
+	if write {
+		rcv = s.ReceiverName
+	}
+	fields := make([]easyJSONField, 0, len(s.Properties))
+	member := func(prop GenSchema, key, value string) {
+		fields = append(fields, easyJSONField{Schema: prop, Key: key, Value: rcv + "." + value, Polymorphic: !write && prop.IsBaseType})
+	}
+
+	for _, part := range s.AllOf {
+		switch {
+		case part.IsAnonymous:
+			for _, prop := range part.Properties {
+				switch {
+				case !prop.IsBaseType:
+					member(prop, prop.OriginalName, pascalize(prop.Name))
+				case !write:
+					member(prop, prop.Name, swag.ToJSONName(prop.Name)+"Field")
+				}
+			}
+		case !write:
+			// properties of the base type
+			for _, prop := range part.Properties {
+				if prop.Name == s.DiscriminatorField {
+					fields = append(fields, easyJSONField{Schema: prop, Key: prop.OriginalName, Value: "discriminator", Discriminator: true})
+					continue
+				}
+				fields = append(fields, easyJSONField{Schema: prop, Key: prop.OriginalName, Value: rcv + "." + swag.ToJSONName(prop.Name) + "Field"})
+			}
+		}
+	}
+	for _, prop := range s.Properties {
+		switch {
+		case !prop.IsBaseType:
+			member(prop, prop.Name, pascalize(prop.Name))
+		case !write:
+			member(prop, prop.Name, swag.ToJSONName(prop.Name)+"Field")
+		}
+	}
+	if !write {
+		return fields
+	}
+
+	// properties of base types come last, with their accessors
+	for _, part := range s.AllOf {
+		for _, prop := range part.Properties {
+			switch {
+			case !prop.IsBaseType:
+			case prop.IsSubType && prop.Name != s.DiscriminatorField:
+				member(prop, prop.Name, swag.ToJSONName(prop.Name)+"Field")
+			default:
+				member(prop, prop.Name, pascalize(prop.Name)+"()")
+			}
+		}
+	}
+	for _, prop := range s.Properties {
+		if prop.IsBaseType {
+			member(prop, prop.Name, swag.ToJSONName(prop.Name)+"Field")
+		}
+	}
+	return fields
+}
+
+// easyJSONConcreteTypes lists the types which may be created from the discriminator of a base type
+func easyJSONConcreteTypes(s GenSchema) []string {
+	seen := make(map[string]bool, len(s.Discriminates))
+	types := make([]string, 0, len(s.Discriminates))
+	for _, goType := range s.Discriminates {
+		if strings.EqualFold(goType, pascalize(s.Name)) {
+			// the base type itself is implemented by an unexported struct
+			goType = swag.ToJSONName(s.Name)
+		}
+		if !seen[goType] {
+			seen[goType] = true
+			types = append(types, goType)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// easyJSONBasicType returns the builtin go type of a named primitive type
+func easyJSONBasicType(s GenSchema) string {
+	if easyJSONPrimitives[s.AliasedType] != "" {
+		return s.AliasedType
+	}
+	// formatted strings are rendered as plain strings
+	return "string"
+}
+
+// easyJSONValueSupported checks that a value and its elements can be written and read without reflection
+func easyJSONValueSupported(s GenSchema) bool {
+	switch easyJSONKind(s) {
+	case "", "polymorphic":
+		return false
+	case "slice":
+		return easyJSONValueSupported(*s.Items)
+	case "map":
+		return easyJSONValueSupported(*s.AdditionalProperties)
+	case "model":
+		// inline structs are not rendered as named types
+		return !(s.IsAnonymous && (s.IsComplexObject || len(s.AllOf) > 0))
+	default:
+		return true
+	}
+}
+
+// easyJSONTested tells if the marshallers of a named schema are checked by the generated tests.
+//
+// Required polymorphic properties are left out of the samples, but may not be read back as null.
+func easyJSONTested(s GenSchema) bool {
+	if !easyJSONSupported(s) || s.IsBaseType {
+		return false
+	}
+	props := append(GenSchemaList{}, s.Properties...)
+	for _, member := range s.AllOf {
+		props = append(props, member.Properties...)
+	}
+	for _, prop := range props {
+		if prop.Required && easyJSONKind(prop) == "polymorphic" {
+			return false
+		}
+	}
+	return true
+}
+
+// easyJSONSample builds a sample JSON document for a named schema, used by the generated tests.
+//
+// Polymorphic values and references to other objects are left out, so the sample
+// may be unmarshalled without knowing the definition of these types.
+func easyJSONSample(s GenSchema) string {
+	var sample interface{}
+	switch easyJSONPath(s) {
+	case "tuple":
+		items := make([]interface{}, 0, len(s.Properties)+1)
+		for _, prop := range s.Properties {
+			items = append(items, easyJSONSampleValue(prop))
+		}
+		if s.AdditionalItems != nil {
+			items = append(items, easyJSONSampleValue(*s.AdditionalItems))
+		}
+		sample = items
+	case "array", "map", "primitive", "formatted":
+		sample = easyJSONSampleValue(s)
+	default:
+		object := make(map[string]interface{})
+		for _, member := range s.AllOf {
+			easyJSONSampleProperties(object, member.Properties, false)
+			if member.IsBaseType && s.DiscriminatorField != "" {
+				object[s.DiscriminatorField] = s.DiscriminatorValue
+			}
+		}
+		// discriminated types match their own properties with their go name
+		easyJSONSampleProperties(object, s.Properties, easyJSONPath(s) == "discriminated")
+		if s.IsAdditionalProperties && s.AdditionalProperties != nil && !easyJSONSampleSkipped(*s.AdditionalProperties) {
+			object["additionalProp"] = easyJSONSampleValue(*s.AdditionalProperties)
+		}
+		sample = object
+	}
+
+	buf, err := json.Marshal(sample)
+	if err != nil {
+		return "null"
+	}
+	return string(buf)
+}
+
+func easyJSONSampleProperties(object map[string]interface{}, props GenSchemaList, goNames bool) {
+	for _, prop := range props {
+		if easyJSONSampleSkipped(prop) {
+			continue
+		}
+		name := prop.OriginalName
+		if goNames {
+			name = prop.Name
+		}
+		object[name] = easyJSONSampleValue(prop)
+	}
+}
+
+// easyJSONSampleSkipped tells if a value is left out of a sample
+func easyJSONSampleSkipped(s GenSchema) bool {
+	switch easyJSONKind(s) {
+	case "polymorphic":
+		return true
+	case "model":
+		return s.IsComplexObject || s.IsTuple || s.IsAdditionalProperties
+	case "slice":
+		return s.IsBaseType
+	default:
+		return false
+	}
+}
+
+// easyJSONSampleFormats are sample values for the string formats which are checked when unmarshalling
+var easyJSONSampleFormats = map[string]string{
+	"date-time":    "1970-01-01T00:00:00.000Z",
+	"datetime":     "1970-01-01T00:00:00.000Z",
+	"date":         "1970-01-01",
+	"duration":     "1s",
+	"byte":         "c2FtcGxl",
+	"bsonobjectid": "507f1f77bcf86cd799439011",
+}
+
+func easyJSONSampleValue(s GenSchema) interface{} {
+	if len(s.Enum) > 0 {
+		return s.Enum[0]
+	}
+	if s.Default != nil {
+		return s.Default
+	}
+
+	switch {
+	case s.IsInterface:
+		return "value"
+	case s.IsArray:
+		if s.Items == nil || easyJSONSampleSkipped(*s.Items) {
+			return []interface{}{}
+		}
+		return []interface{}{easyJSONSampleValue(*s.Items)}
+	case s.IsMap || s.IsAdditionalProperties:
+		if s.AdditionalProperties == nil || easyJSONSampleSkipped(*s.AdditionalProperties) {
+			return map[string]interface{}{}
+		}
+		return map[string]interface{}{"key": easyJSONSampleValue(*s.AdditionalProperties)}
+	case s.IsTuple || s.IsComplexObject:
+		return nil
+	}
+
+	if sample, ok := easyJSONSampleFormats[s.SwaggerFormat]; ok {
+		return sample
+	}
+	switch s.SwaggerType {
+	case "boolean":
+		return true
+	case "integer":
+		return 1
+	case "number":
+		goType := s.GoType
+		if s.IsAliased {
+			goType = s.AliasedType
+		}
+		if strings.Contains(goType, "int") {
+			// numbers with an integer format
+			return 1
+		}
+		return 1.5
+	case "string":
+		return "sample"
+	default:
+		return nil
+	}
+}
+
+// easyJSONWriter renders the statement writing a builtin value
+func easyJSONWriter(goType, x string) string {
+	switch goType {
+	case "string":
+		return fmt.Sprintf("jsonWriteString(w, %s)", x)
+	case "float32":
+		return fmt.Sprintf("jsonWriteFloat(w, float64(%s), 32)", x)
+	case "float64":
+		return fmt.Sprintf("jsonWriteFloat(w, %s, 64)", x)
+	default:
+		return fmt.Sprintf("w.%s(%s)", easyJSONPrimitives[goType], x)
+	}
+}
+
+// easyJSONReader renders the expression reading a builtin value
+func easyJSONReader(goType string) string {
+	if goType == "string" {
+		return "jsonReadString(l)"
+	}
+	return fmt.Sprintf("l.%s()", easyJSONPrimitives[goType])
+}
@@ -0,0 +1,367 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"testing"
+	"text/template"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genEasyJSONModel(t testing.TB, name string, includeEasyJSON bool) (*GenDefinition, string) {
+	return genModelWith(t, "../fixtures/codegen/easyjson.yml", name, func(opts *GenOpts) {
+		opts.IncludeEasyJSON = includeEasyJSON
+	})
+}
+
+func TestEasyJSON_Disabled(t *testing.T) {
+	for _, name := range []string{"Sample", "Pet", "Dog", "Staff", "Tags", "When"} {
+		genModel, res := genEasyJSONModel(t, name, false)
+		assert.False(t, genModel.WantsEasyJSON)
+		assertNotInCode(t, "EasyJSON", res)
+		assertNotInCode(t, "jwriter", res)
+	}
+}
+
+func TestEasyJSON_Struct(t *testing.T) {
+	genModel, res := genEasyJSONModel(t, "Sample", true)
+	assert.True(t, genModel.WantsEasyJSON)
+	assert.True(t, easyJSONSupported(genModel.GenSchema))
+	assertInCode(t, "func (m Sample) MarshalEasyJSON(w *jwriter.Writer) {", res)
+	assertInCode(t, "func (m Sample) MarshalJSON() ([]byte, error) {", res)
+	assertInCode(t, "func (m *Sample) UnmarshalEasyJSON(l *jlexer.Lexer) {", res)
+	assertInCode(t, "func (m *Sample) UnmarshalJSON(data []byte) error {", res)
+	assertInCode(t, "func (m *Sample) unmarshalEasyJSON(l *jlexer.Lexer, useNumber bool) {", res)
+
+	// omitempty properties, with their keys escaped like encoding/json does
+	assertInCode(t, "if m.Ratio != 0 {", res)
+	assertInCode(t, "jsonWriteFloat(w, float64(m.Ratio), 32)", res)
+	assertInCode(t, "if len(m.Picture) != 0 {", res)
+	assertInCode(t, `jsonWriteKey(w, &first, ",\"odd \\u003cname\\u003e \\u0026 more\":")`, res)
+	// formatted and untyped values
+	assertInCode(t, "w.Raw(m.Timeout.MarshalJSON())", res)
+	assertInCode(t, "jsonReadUnmarshaler(l, &m.Timeout)", res)
+	assertInCode(t, "w.Raw(json.Marshal(m.Notes))", res)
+	assertInCode(t, "m.Notes = jsonReadInterface(l, useNumber)", res)
+	// keys are matched like encoding/json does
+	assertInCode(t, `key := jsonFieldName(l.UnsafeString(), "address", "bag", "closed"`, res)
+	assertInCode(t, "m.Small = l.Int8()", res)
+	// nested containers
+	assertInCode(t, "for i, v := range m.Rooms {", res)
+	assertInCode(t, "for ii, vv := range v {", res)
+
+	// inline structs get their own marshallers
+	assertInCode(t, "func (m SampleAddress) MarshalEasyJSON(w *jwriter.Writer) {", res)
+}
+
+func TestEasyJSON_Polymorphic(t *testing.T) {
+	_, res := genEasyJSONModel(t, "Pet", true)
+	assertInCode(t, "func marshalPetJSON(w *jwriter.Writer, value Pet) {", res)
+	assertInCode(t, "func unmarshalPetJSON(data []byte) (Pet, error) {", res)
+	assertInCode(t, `discriminator, err := jsonDiscriminator(data, "petType")`, res)
+	assertInCode(t, "case *Dog:", res)
+	assertInCode(t, `return nil, errors.New(422, "invalid petType value: %q", discriminator)`, res)
+
+	genModel, res := genEasyJSONModel(t, "Dog", true)
+	assert.Equal(t, "discriminated", easyJSONPath(genModel.GenSchema))
+	assertInCode(t, "func (m Dog) MarshalEasyJSON(w *jwriter.Writer) {", res)
+	assertInCode(t, `jsonWriteKey(w, &first, ",\"petType\":")`, res)
+	assertInCode(t, "jsonWriteString(w, m.PetType())", res)
+	assertInCode(t, "marshalPetJSON(w, v)", res)
+	assertInCode(t, "value, err := unmarshalPetJSON(data)", res)
+	assertInCode(t, "if discriminator != result.PetType() {", res)
+
+	_, res = genEasyJSONModel(t, "Kennel", true)
+	assertInCode(t, "marshalPetJSON(w, m.starField)", res)
+	assertInCode(t, "result.starField = value", res)
+}
+
+func TestEasyJSON_AllOf(t *testing.T) {
+	genModel, res := genEasyJSONModel(t, "Staff", true)
+	assert.Equal(t, "allOf", easyJSONPath(genModel.GenSchema))
+	assertInCode(t, "jsonWriteEmbedded(w, &first, m.Owner)", res)
+	assertInCode(t, "result.Owner.unmarshalEasyJSON(l, false)", res)
+	assertInCode(t, `key := jsonFieldName(l.UnsafeString(), "level", "role", "since", "badge")`, res)
+}
+
+func TestEasyJSON_AdditionalProperties(t *testing.T) {
+	genModel, res := genEasyJSONModel(t, "Inventory", true)
+	assert.Equal(t, "additionalProperties", easyJSONPath(genModel.GenSchema))
+	assertInCode(t, "jsonWriteMember(w, &first, k)", res)
+	assertInCode(t, `switch key := jsonFieldName(name, "label", "total"); key {`, res)
+	assertInCode(t, "result.Inventory = make(map[string]*Owner)", res)
+
+	_, res = genEasyJSONModel(t, "Bag", true)
+	assertInCode(t, "w.Raw(json.Marshal(m.BagAdditionalProperties[k]))", res)
+	assertInCode(t, "value = jsonReadInterface(l, false)", res)
+}
+
+func TestEasyJSON_Tuple(t *testing.T) {
+	genModel, res := genEasyJSONModel(t, "Position", true)
+	assert.Equal(t, "tuple", easyJSONPath(genModel.GenSchema))
+	assertInCode(t, "jsonWriteFloat(w, *m.P0, 64)", res)
+	assertInCode(t, "m.P1 = &value", res)
+	assertInCode(t, "m.PositionItems = append(m.PositionItems, item)", res)
+}
+
+func TestEasyJSON_NonStruct(t *testing.T) {
+	_, res := genEasyJSONModel(t, "Tags", true)
+	assertInCode(t, "func (m Tags) MarshalEasyJSON(w *jwriter.Writer) {", res)
+	assertInCode(t, "c := make([]string, 0)", res)
+
+	_, res = genEasyJSONModel(t, "Labels", true)
+	assertInCode(t, "sort.Strings(ks)", res)
+	assertInCode(t, "(*m) = make(Labels)", res)
+
+	_, res = genEasyJSONModel(t, "When", true)
+	assertInCode(t, "w.Raw(strfmt.DateTime(m).MarshalJSON())", res)
+	assertInCode(t, "jsonReadUnmarshaler(l, (*strfmt.DateTime)(m))", res)
+	assertNotInCode(t, "func (m *When) UnmarshalJSON(b []byte) error {", res)
+
+	_, res = genEasyJSONModel(t, "Color", true)
+	assertInCode(t, "jsonWriteString(w, string(m))", res)
+	assertInCode(t, "*m = Color(jsonReadString(l))", res)
+
+	// untyped definitions are left to encoding/json
+	genModel, res := genEasyJSONModel(t, "Anything", true)
+	assert.False(t, genModel.WantsEasyJSON)
+	assertNotInCode(t, "EasyJSON", res)
+}
+
+func TestEasyJSON_Bridge(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/easyjson.yml")
+	require.NoError(t, err)
+
+	// without json tags, the marshallers delegate to encoding/json
+	opts := opts()
+	opts.IncludeEasyJSON = true
+	opts.StructTags = []string{"yaml"}
+	genModel, err := makeGenDefinition("Owner", "models", specDoc.Spec().Definitions["Owner"], specDoc, opts)
+	require.NoError(t, err)
+	assert.True(t, genModel.WantsEasyJSON)
+	assert.False(t, easyJSONSupported(genModel.GenSchema))
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("model").Execute(buf, genModel))
+	res := buf.String()
+	assertInCode(t, "w.Raw(json.Marshal(m))", res)
+	assertInCode(t, "jsonReadValue(l, m, useNumber)", res)
+	assertNotInCode(t, "func (m Owner) MarshalJSON()", res)
+}
+
+func TestEasyJSON_Sample(t *testing.T) {
+	for _, name := range []string{"Sample", "Dog", "Staff", "Inventory", "Position", "Tags", "When"} {
+		genModel, _ := genEasyJSONModel(t, name, true)
+		sample := easyJSONSample(genModel.GenSchema)
+		assert.Truef(t, json.Valid([]byte(sample)), "invalid sample for %s: %s", name, sample)
+		assert.True(t, easyJSONTested(genModel.GenSchema))
+	}
+
+	genModel, _ := genEasyJSONModel(t, "Dog", true)
+	assert.JSONEq(t, `{"name":"sample","packSize":1,"petType":"Dog"}`, easyJSONSample(genModel.GenSchema))
+
+	genModel, _ = genEasyJSONModel(t, "Position", true)
+	assert.JSONEq(t, `[1.5,"sample",true]`, easyJSONSample(genModel.GenSchema))
+
+	genModel, _ = genEasyJSONModel(t, "Pet", true)
+	assert.False(t, easyJSONTested(genModel.GenSchema))
+}
+
+func TestEasyJSON_Sections(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/easyjson.yml")
+	require.NoError(t, err)
+
+	opts := opts()
+	opts.IncludeEasyJSON = true
+	opts.Sections = SectionOpts{}
+	DefaultSectionOpts(opts)
+	require.Len(t, opts.Sections.Models, 3)
+	support, test := opts.Sections.Models[1], opts.Sections.Models[2]
+	assert.Equal(t, "easyjson_helpers.go", support.FileName)
+
+	pet, err := makeGenDefinition("Pet", "models", specDoc.Spec().Definitions["Pet"], specDoc, opts)
+	require.NoError(t, err)
+	assert.True(t, opts.shouldRenderDefinition(&support, pet))
+	assert.False(t, opts.shouldRenderDefinition(&test, pet))
+
+	anything, err := makeGenDefinition("Anything", "models", specDoc.Spec().Definitions["Anything"], specDoc, opts)
+	require.NoError(t, err)
+	assert.False(t, opts.shouldRenderDefinition(&support, anything))
+	assert.False(t, opts.shouldRenderDefinition(&test, anything))
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("easyjsonsupport").Execute(buf, pet))
+	_, err = opts.LanguageOpts.FormatContent("easyjson_helpers.go", buf.Bytes())
+	require.NoError(t, err)
+	assertInCode(t, "func jsonWriteString(w *jwriter.Writer, s string) {", buf.String())
+
+	sample, err := makeGenDefinition("Sample", "models", specDoc.Spec().Definitions["Sample"], specDoc, opts)
+	require.NoError(t, err)
+	assert.True(t, opts.shouldRenderDefinition(&test, sample))
+	buf.Reset()
+	require.NoError(t, templates.MustGet("easyjsontest").Execute(buf, sample))
+	ff, err := opts.LanguageOpts.FormatContent("sample_easyjson_test.go", buf.Bytes())
+	require.NoError(t, err)
+	res := string(ff)
+	assertInCode(t, "func TestSampleEasyJSON(t *testing.T) {", res)
+	assertInCode(t, "func BenchmarkSampleMarshalJSON(b *testing.B) {", res)
+	assertInCode(t, "func BenchmarkSampleUnmarshalJSON(b *testing.B) {", res)
+	assertInCode(t, "func TestSampleAddressEasyJSON(t *testing.T) {", res)
+}
+
+func TestEasyJSON_Fallbacks(t *testing.T) {
+	var captureLog bytes.Buffer
+	log.SetOutput(&captureLog)
+	defer func() {
+		log.SetOutput(os.Stdout)
+	}()
+
+	// allOf compositions with additionalProperties delegate to encoding/json
+	for _, name := range []string{"Comment", "WithAllOf"} {
+		genModel, res := genModelWith(t, "../fixtures/codegen/todolist.models.yml", name, func(opts *GenOpts) {
+			opts.IncludeEasyJSON = true
+		})
+		assert.Equal(t, []string{name}, easyJSONFallbacks(genModel))
+		assertInCode(t, "w.Raw(json.Marshal(m))", res)
+		assert.Contains(t, captureLog.String(), "warning: the JSON marshallers of model "+name+" are not supported without reflection")
+	}
+
+	// base types are marshalled by their concrete types
+	for _, name := range []string{"Sample", "Pet", "Dog"} {
+		genModel, _ := genEasyJSONModel(t, name, true)
+		assert.Empty(t, easyJSONFallbacks(genModel))
+	}
+}
+
+// easyJSONCompareTest checks that the same samples are marshalled identically
+// by the models generated with and without easyjson.
+const easyJSONCompareTest = `package compare
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	easy "{{ .Path }}/easy"
+	std "{{ .Path }}/std"
+)
+
+func TestSameJSON(t *testing.T) {
+	for _, fixture := range []struct {
+		Name   string
+		Sample string
+		Std    interface{}
+		Easy   interface{}
+	}{
+	{{- range .Models }}
+		{Name: {{ printf "%q" .Name }}, Sample: {{ printf "%q" .Sample }}, Std: new(std.{{ .Name }}), Easy: new(easy.{{ .Name }})},
+	{{- end }}
+	} {
+		errStd := json.Unmarshal([]byte(fixture.Sample), fixture.Std)
+		errEasy := json.Unmarshal([]byte(fixture.Sample), fixture.Easy)
+		if (errStd == nil) != (errEasy == nil) {
+			t.Errorf("%s: unmarshalled with different errors: %v, %v", fixture.Name, errStd, errEasy)
+			continue
+		}
+
+		expected, errStd := json.Marshal(fixture.Std)
+		actual, errEasy := json.Marshal(fixture.Easy)
+		if errStd != nil || errEasy != nil {
+			t.Errorf("%s: marshalled with errors: %v, %v", fixture.Name, errStd, errEasy)
+			continue
+		}
+		if !bytes.Equal(expected, actual) {
+			t.Errorf("%s: marshalled differently:\n%s\n%s", fixture.Name, expected, actual)
+		}
+	}
+}
+`
+
+func TestEasyJSON_SameOutput(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer func() {
+		log.SetOutput(os.Stdout)
+	}()
+
+	fixture := filepath.Join("..", "fixtures", "codegen", "easyjson.yml")
+	generated, err := ioutil.TempDir(filepath.Dir(fixture), "generated")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(generated)
+	}()
+
+	// generate the same models with the standard serializers and with easyjson
+	for pkg, includeEasyJSON := range map[string]bool{"std": false, "easy": true} {
+		opts := &GenOpts{}
+		opts.IncludeModel = true
+		opts.IncludeValidator = true
+		opts.IncludeEasyJSON = includeEasyJSON
+		opts.Spec = fixture
+		opts.ModelPackage = pkg
+		opts.Target = generated
+		require.NoError(t, opts.EnsureDefaults())
+		require.NoError(t, GenerateDefinition(nil, opts))
+	}
+
+	// samples are those of the tests generated with easyjson
+	specDoc, err := loads.Spec(fixture)
+	require.NoError(t, err)
+	type compared struct {
+		Name   string
+		Sample string
+	}
+	models := make([]compared, 0, len(specDoc.Spec().Definitions))
+	for name := range specDoc.Spec().Definitions {
+		genModel, _ := genEasyJSONModel(t, name, true)
+		if !easyJSONTested(genModel.GenSchema) {
+			continue
+		}
+		models = append(models, compared{Name: genModel.Name, Sample: easyJSONSample(genModel.GenSchema)})
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	require.NotEmpty(t, models)
+
+	rel, err := filepath.Rel("..", generated)
+	require.NoError(t, err)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, template.Must(template.New("compare").Parse(easyJSONCompareTest)).Execute(buf, map[string]interface{}{
+		"Path":   path.Join("github.com/go-swagger/go-swagger", filepath.ToSlash(rel)),
+		"Models": models,
+	}))
+	compare := filepath.Join(generated, "compare")
+	require.NoError(t, os.MkdirAll(compare, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(compare, "compare_test.go"), buf.Bytes(), 0644))
+
+	packages := "./" + filepath.ToSlash(filepath.Join(generated, "..."))
+	if p, err := exec.Command("go", "get", packages).CombinedOutput(); err != nil {
+		t.Fatalf("go get %s: %s\n%s", packages, err, p)
+	}
+
+	if p, err := exec.Command("go", "test", "./"+filepath.ToSlash(compare)).CombinedOutput(); err != nil {
+		t.Fatalf("go test %s: %s\n%s", compare, err, p)
+	}
+}
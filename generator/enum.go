@@ -0,0 +1,149 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// enumValues returns the go constants capturing the values of the enum of a schema or a parameter,
+// named after some prefix.
+//
+// Numbers are named after their go literal, e.g. 1.5 is named Nr1Dot5.
+// The name of the value in the name of a constant may be overridden with the x-go-enum-names extension,
+// which lists names in the same order as the values of the enum.
+//
+// Only the values of strings and numbers get a constant: no constant is returned for other kinds of enums,
+// nor for formats which are not rendered as a go string, e.g. date-time.
+func (l *LanguageOpts) enumValues(prefix string, data interface{}) []GenEnumValue {
+	var (
+		enum          []interface{}
+		swaggerType   string
+		swaggerFormat string
+		extensions    map[string]interface{}
+	)
+	switch v := data.(type) {
+	case GenDefinition:
+		enum, swaggerType, swaggerFormat, extensions = v.Enum, v.SwaggerType, v.SwaggerFormat, v.Extensions
+	case *GenDefinition:
+		enum, swaggerType, swaggerFormat, extensions = v.Enum, v.SwaggerType, v.SwaggerFormat, v.Extensions
+	case GenSchema:
+		enum, swaggerType, swaggerFormat, extensions = v.Enum, v.SwaggerType, v.SwaggerFormat, v.Extensions
+	case *GenSchema:
+		enum, swaggerType, swaggerFormat, extensions = v.Enum, v.SwaggerType, v.SwaggerFormat, v.Extensions
+	case GenParameter:
+		enum, swaggerType, swaggerFormat, extensions = v.Enum, v.SwaggerType, v.SwaggerFormat, v.Extensions
+	case *GenParameter:
+		enum, swaggerType, swaggerFormat, extensions = v.Enum, v.SwaggerType, v.SwaggerFormat, v.Extensions
+	default:
+		return nil
+	}
+	if len(enum) == 0 || !isConstantType(swaggerType, swaggerFormat) {
+		return nil
+	}
+
+	names, _ := extensions[xGoEnumNames].([]interface{})
+
+	values := make([]GenEnumValue, 0, len(enum))
+	seen := make(map[string]bool, len(enum))
+	for i, value := range enum {
+		literal, ok := enumLiteral(swaggerType, value)
+		if !ok {
+			return nil
+		}
+
+		text := fmt.Sprint(value)
+		if swaggerType != str {
+			// the decimal point is spelled out, so that 1.5 and 15 get different names
+			text = strings.Replace(literal, ".", "Dot", 1)
+		}
+		name := pascalize(text)
+		if i < len(names) {
+			if override, isString := names[i].(string); isString && override != "" {
+				name = pascalize(override)
+			}
+		}
+		name = l.MangleName(prefix+name, "enum")
+		for unique, j := name, 2; ; j++ {
+			if !seen[unique] {
+				name = unique
+				break
+			}
+			unique = name + strconv.Itoa(j)
+		}
+		seen[name] = true
+
+		values = append(values, GenEnumValue{Name: name, Value: literal})
+	}
+	return values
+}
+
+// isConstantType tells if the values of some swagger type and format are rendered as a go type
+// which may hold a constant, i.e. numbers and types which underlying type is string
+func isConstantType(swaggerType, swaggerFormat string) bool {
+	switch swaggerType {
+	case integer, number:
+		return true
+	case str:
+		goType, ok := formatMapping[str][swaggerFormat]
+		if !ok || goType == "string" {
+			return true
+		}
+		return strings.HasPrefix(zeroes[goType], goType+`("`)
+	default:
+		return false
+	}
+}
+
+// enumLiteral renders the value of an enum as a go literal,
+// and tells if the value is a valid constant of some swagger type
+func enumLiteral(swaggerType string, value interface{}) (string, bool) {
+	if swaggerType == str {
+		text, ok := value.(string)
+		if !ok {
+			return "", false
+		}
+		return strconv.Quote(text), true
+	}
+
+	var literal string
+	switch v := value.(type) {
+	case float64:
+		if swaggerType == integer && v != math.Trunc(v) {
+			return "", false
+		}
+		format := byte('g')
+		if swaggerType == integer {
+			format = 'f'
+		}
+		literal = strconv.FormatFloat(v, format, -1, 64)
+	case float32:
+		return enumLiteral(swaggerType, float64(v))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		literal = fmt.Sprint(v)
+	case json.Number:
+		if _, err := v.Int64(); err != nil && swaggerType == integer {
+			return "", false
+		}
+		literal = v.String()
+	default:
+		return "", false
+	}
+	return literal, true
+}
@@ -529,3 +529,158 @@ func TestEnum_Issue352(t *testing.T) {
 		}
 	}
 }
+
+func TestEnum_Constants(t *testing.T) {
+	specDoc, err := loads.Spec("../fixtures/codegen/enum-constants.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	definitions := specDoc.Spec().Definitions
+	opts := opts()
+
+	expected := map[string][]string{
+		"Color": {
+			`ColorRouge Color = "red"`,
+			`ColorGreen Color = "green"`,
+			`ColorBleu Color = "blue"`,
+			`func AllColorValues() []Color {`,
+			`func (m Color) IsValid() bool {`,
+			`for _, value := range AllColorValues() {`,
+			`if value == m {`,
+		},
+		"Weight": {
+			`WeightNr0Dot5 Weight = 0.5`,
+			`WeightNr1 Weight = 1`,
+			`WeightNr1Dot5 Weight = 1.5`,
+			`WeightNr15 Weight = 15`,
+			`func AllWeightValues() []Weight {`,
+			`func (m Weight) IsValid() bool {`,
+		},
+		"Task": {
+			`TaskStateTodo string = "todo"`,
+			`TaskStateDone string = "done"`,
+			`func AllTaskStateValues() []string {`,
+			`func IsValidTaskState(value string) bool {`,
+			`if strings.EqualFold(string(enumValue), string(value)) {`,
+			`TaskRankNr1 int64 = 1`,
+			`func AllTaskRankValues() []int64 {`,
+			`func IsValidTaskRank(value int64) bool {`,
+			`if enumValue == value {`,
+		},
+		"Soda": {
+			// the helpers of the property do not clash with the SodaBrand definition
+			`SodaBrandYUMFOODS string = "YUM_FOODS"`,
+			`func AllSodaBrandPropertyValues() []string {`,
+			`func IsValidSodaBrandProperty(value string) bool {`,
+			`for _, enumValue := range AllSodaBrandPropertyValues() {`,
+		},
+		"SodaBrand": {
+			`SodaBrandPEPSI SodaBrand = "PEPSI"`,
+			`func AllSodaBrandValues() []SodaBrand {`,
+		},
+	}
+
+	for k, lines := range expected {
+		genModel, err := makeGenDefinition(k, "models", definitions[k], specDoc, opts)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, templates.MustGet("model").Execute(buf, genModel)) {
+			continue
+		}
+		ff, err := opts.LanguageOpts.FormatContent(strings.ToLower(k)+".go", buf.Bytes())
+		if !assert.NoError(t, err) {
+			fmt.Println(buf.String())
+			continue
+		}
+		res := string(ff)
+		for _, line := range lines {
+			assertInCode(t, line, res)
+		}
+		if k == "Task" {
+			// no constant may hold a date-time
+			assertNotInCode(t, "TaskDue", res)
+		}
+	}
+}
+
+func TestEnum_ParameterConstants(t *testing.T) {
+	b, err := opBuilder("listTasks", "../fixtures/codegen/enum-constants.yml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	op, err := b.MakeOperation()
+	if !assert.NoError(t, err) {
+		return
+	}
+	opts := opts()
+	for _, tpl := range []string{"serverParameter", "clientParameter"} {
+		buf := bytes.NewBuffer(nil)
+		if !assert.NoError(t, templates.MustGet(tpl).Execute(buf, op)) {
+			continue
+		}
+		ff, err := opts.LanguageOpts.FormatContent("list_tasks_parameters.go", buf.Bytes())
+		if !assert.NoError(t, err) {
+			fmt.Println(buf.String())
+			continue
+		}
+		res := string(ff)
+		assertInCode(t, `ListTasksParamsKindBug string = "bug"`, res)
+		assertInCode(t, `func AllListTasksParamsKindValues() []string {`, res)
+		assertInCode(t, `func IsValidListTasksParamsKind(value string) bool {`, res)
+		assertInCode(t, `ListTasksParamsStatusOpened string = "open"`, res)
+		assertInCode(t, `ListTasksParamsStatusOngoing string = "in-progress"`, res)
+		assertInCode(t, `ListTasksParamsStatusClosed string = "closed"`, res)
+		assertInCode(t, `ListTasksParamsPriorityNr2 int32 = 2`, res)
+		assertInCode(t, `func IsValidListTasksParamsPriority(value int32) bool {`, res)
+		assertNotInCode(t, `ListTasksParamsTask`, res)
+	}
+}
+
+func TestEnum_EnumValues(t *testing.T) {
+	lang := GoLangOpts()
+
+	values := lang.enumValues("Kind", GenSchema{
+		resolvedType: resolvedType{
+			SwaggerType: "string",
+			Extensions:  map[string]interface{}{xGoEnumNames: []interface{}{"", "Other"}},
+		},
+		sharedValidations: sharedValidations{Enum: []interface{}{"a-b", "a_b", "a b"}},
+	})
+	assert.Equal(t, []GenEnumValue{
+		{Name: "KindAb", Value: `"a-b"`},
+		{Name: "KindOther", Value: `"a_b"`},
+		{Name: "KindAb2", Value: `"a b"`},
+	}, values)
+
+	values = lang.enumValues("Size", &GenParameter{
+		resolvedType:      resolvedType{SwaggerType: "integer"},
+		sharedValidations: sharedValidations{Enum: []interface{}{float64(1), 2}},
+	})
+	assert.Equal(t, []GenEnumValue{
+		{Name: "SizeNr1", Value: "1"},
+		{Name: "SizeNr2", Value: "2"},
+	}, values)
+
+	// values which are not valid constants
+	assert.Empty(t, lang.enumValues("Size", GenSchema{
+		resolvedType:      resolvedType{SwaggerType: "integer"},
+		sharedValidations: sharedValidations{Enum: []interface{}{float64(1), 1.5}},
+	}))
+	assert.Empty(t, lang.enumValues("Date", GenSchema{
+		resolvedType:      resolvedType{SwaggerType: "string", SwaggerFormat: "date"},
+		sharedValidations: sharedValidations{Enum: []interface{}{"2020-01-01"}},
+	}))
+	assert.Empty(t, lang.enumValues("List", GenSchema{
+		resolvedType:      resolvedType{SwaggerType: "array"},
+		sharedValidations: sharedValidations{Enum: []interface{}{[]interface{}{"a"}}},
+	}))
+	assert.Empty(t, lang.enumValues("Nothing", "not a schema"))
+
+	// string formats held by a go string
+	assert.Len(t, lang.enumValues("ID", GenSchema{
+		resolvedType:      resolvedType{SwaggerType: "string", SwaggerFormat: "uuid"},
+		sharedValidations: sharedValidations{Enum: []interface{}{"a7c7e1ab-5b22-4b4e-9d5a-6a7c2a1b9e57"}},
+	}), 1)
+}
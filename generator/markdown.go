@@ -0,0 +1,241 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+)
+
+// GenerateMarkdown generates the markdown reference documentation of an API:
+// an index, a page per tag with the operations and a reference of the models.
+func GenerateMarkdown(name string, modelNames, operationIDs []string, opts *GenOpts) error {
+	if opts != nil {
+		opts.LanguageOpts = markdownLanguageOpts(opts.LanguageOpts)
+	}
+	generator, err := newAppGenerator(name, modelNames, operationIDs, opts)
+	if err != nil {
+		return err
+	}
+	return (&markdownGenerator{*generator}).Generate()
+}
+
+type markdownGenerator struct {
+	appGenerator
+}
+
+func (m *markdownGenerator) Generate() error {
+	app, err := m.makeCodegenApp()
+	if err != nil {
+		return err
+	}
+
+	if m.DumpData {
+		return dumpData(app)
+	}
+
+	// models templates are only rendered when a layout specifies some
+	for _, mod := range app.Models {
+		if err := m.GenOpts.renderDefinition(&mod); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("rendering %d operation groups (tags)", app.OperationGroups.Len())
+	for _, opg := range app.OperationGroups {
+		for _, op := range opg.Operations {
+			if err := m.GenOpts.renderOperation(&op); err != nil {
+				return err
+			}
+		}
+		if err := m.GenOpts.renderOperationGroup(&opg); err != nil {
+			return fmt.Errorf("error while rendering operation group: %v", err)
+		}
+	}
+
+	return m.GenOpts.renderApplication(&app)
+}
+
+// markdownLanguageOpts keeps the naming rules of a language, without formatting the generated files nor
+// resolving the import path of the target: the documentation may be generated outside of a go package
+func markdownLanguageOpts(lang *LanguageOpts) *LanguageOpts {
+	if lang == nil {
+		lang = DefaultLanguageFunc()
+	}
+	opts := *lang
+	opts.formatFunc = nil
+	opts.BaseImportFunc = func(string) string { return "" }
+	return &opts
+}
+
+// markdownSectionOpts sets the default templates of the markdown documentation
+func markdownSectionOpts(gen *GenOpts) {
+	sec := gen.Sections
+	if len(sec.OperationGroups) == 0 {
+		sec.OperationGroups = []TemplateOpts{
+			{
+				Name:     "operations",
+				Source:   "asset:markdownOperations",
+				Target:   "{{ .Target }}",
+				FileName: "{{ snakize (pascalize .Name) }}_operations.md",
+			},
+		}
+	}
+
+	if len(sec.Application) == 0 {
+		sec.Application = []TemplateOpts{
+			{
+				Name:     "index",
+				Source:   "asset:markdownIndex",
+				Target:   "{{ .Target }}",
+				FileName: "index.md",
+			},
+			{
+				Name:     "models",
+				Source:   "asset:markdownModels",
+				Target:   "{{ .Target }}",
+				FileName: "models.md",
+			},
+		}
+	}
+	gen.Sections = sec
+}
+
+// markdownCell escapes a text for a cell of a markdown table
+func markdownCell(text string) string {
+	text = strings.Replace(strings.TrimSpace(text), "|", "\\|", -1)
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	return strings.Replace(text, "\n", "<br>", -1)
+}
+
+// markdownAnchor returns the anchor of a markdown heading, as rendered by github
+func markdownAnchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(heading)) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// markdownValidations describes the validations of a schema, a parameter, a header or items,
+// e.g. max length: 50, pattern: `^[a-z]+$`. The validations of the items of arrays are nested, e.g. items: (min length: 1)
+func markdownValidations(data interface{}) string {
+	return markdownCell(validationRules(data))
+}
+
+func validationRules(data interface{}) string {
+	var v sharedValidations
+	var items interface{}
+	switch d := data.(type) {
+	case GenSchema:
+		return validationRules(&d)
+	case *GenSchema:
+		v = d.sharedValidations
+		if d.Items != nil {
+			items = d.Items
+		}
+	case GenParameter:
+		return validationRules(&d)
+	case *GenParameter:
+		v = d.sharedValidations
+		if d.Child != nil {
+			items = d.Child
+		}
+	case GenHeader:
+		return validationRules(&d)
+	case *GenHeader:
+		v = d.sharedValidations
+		if d.Child != nil {
+			items = d.Child
+		}
+	case GenItems:
+		return validationRules(&d)
+	case *GenItems:
+		v = d.sharedValidations
+		if d.Child != nil {
+			items = d.Child
+		}
+	default:
+		return ""
+	}
+
+	var rules []string
+	if v.Minimum != nil {
+		rules = append(rules, fmt.Sprintf("minimum: %v%s", *v.Minimum, exclusive(v.ExclusiveMinimum)))
+	}
+	if v.Maximum != nil {
+		rules = append(rules, fmt.Sprintf("maximum: %v%s", *v.Maximum, exclusive(v.ExclusiveMaximum)))
+	}
+	if v.MultipleOf != nil {
+		rules = append(rules, fmt.Sprintf("multiple of: %v", *v.MultipleOf))
+	}
+	if v.MinLength != nil {
+		rules = append(rules, fmt.Sprintf("min length: %d", *v.MinLength))
+	}
+	if v.MaxLength != nil {
+		rules = append(rules, fmt.Sprintf("max length: %d", *v.MaxLength))
+	}
+	if v.Pattern != "" {
+		rules = append(rules, fmt.Sprintf("pattern: `%s`", strings.Replace(v.Pattern, "`", "'", -1)))
+	}
+	if v.MinItems != nil {
+		rules = append(rules, fmt.Sprintf("min items: %d", *v.MinItems))
+	}
+	if v.MaxItems != nil {
+		rules = append(rules, fmt.Sprintf("max items: %d", *v.MaxItems))
+	}
+	if v.UniqueItems {
+		rules = append(rules, "unique items")
+	}
+	if len(v.Enum) > 0 {
+		rules = append(rules, "enum: "+markdownValues(v.Enum))
+	}
+	if len(v.ItemsEnum) > 0 {
+		rules = append(rules, "items enum: "+markdownValues(v.ItemsEnum))
+	}
+	if items != nil {
+		if itemsRules := validationRules(items); itemsRules != "" {
+			rules = append(rules, "items: ("+itemsRules+")")
+		}
+	}
+	return strings.Join(rules, ", ")
+}
+
+func exclusive(isExclusive bool) string {
+	if isExclusive {
+		return " (exclusive)"
+	}
+	return ""
+}
+
+func markdownValues(values []interface{}) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		b, err := json.Marshal(value)
+		if err != nil {
+			b = []byte(fmt.Sprintf("%v", value))
+		}
+		quoted = append(quoted, "`"+strings.Replace(string(b), "`", "'", -1)+"`")
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,143 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMarkdownGenOpts(spec, target string) *GenOpts {
+	g := &GenOpts{}
+	g.Spec = spec
+	g.Target = target
+	g.IncludeModel = true
+	g.IncludeHandler = true
+	g.IncludeSupport = true
+	g.IsMarkdown = true
+	if err := g.EnsureDefaults(); err != nil {
+		panic(err)
+	}
+	return g
+}
+
+func TestGenerateMarkdown(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	target, err := ioutil.TempDir("", "markdown")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(target)
+	}()
+
+	opts := testMarkdownGenOpts("../fixtures/codegen/tasklist.basic.yml", target)
+	require.NoError(t, GenerateMarkdown("", nil, nil, opts))
+
+	index, err := ioutil.ReadFile(filepath.Join(target, "index.md"))
+	require.NoError(t, err)
+	assertInCode(t, `# Issue Tracker API`, string(index))
+	assertInCode(t, "| [listTasks](tasks_operations.md#listtasks) | GET | `/tasks` | Lists the tasks |", string(index))
+	assertInCode(t, "| <a name=\"token_header\"></a>token_header | apikey | header: `X-Token` |", string(index))
+
+	operations, err := ioutil.ReadFile(filepath.Join(target, "tasks_operations.md"))
+	require.NoError(t, err)
+	assertInCode(t, "## getTaskComments", string(operations))
+	assertInCode(t, "| pageSize | query | integer (int32) |  | Amount of items to return in a single page<br>Default: `20` |  |", string(operations))
+	assertInCode(t, "| status | query | []string (pipes) |  | the status to filter by | unique items, items: (enum: `\"open\"`, `\"closed\"`, `\"ignored\"`, `\"rejected\"`) |", string(operations))
+	assertInCode(t, "| 200 | Successful response | [][TaskCard](models.md#taskcard) | `X-Last-Task-Id`: integer (int64) |", string(operations))
+	assertInCode(t, "Security: [api_key](index.md#api_key) or [token_header](index.md#token_header)", string(operations))
+
+	models, err := ioutil.ReadFile(filepath.Join(target, "models.md"))
+	require.NoError(t, err)
+	assertInCode(t, "Composes: [TaskCard](models.md#taskcard)", string(models))
+	assertInCode(t, "| title | string | yes | The title of the task.<br>", string(models))
+	assertInCode(t, "| min length: 5, max length: 150 |", string(models))
+	assertInCode(t, "| tags | []string |  | task tags.<br>a task can be tagged with text blurbs. | max items: 5, unique items, items: (min length: 3, pattern: `\\w[\\w- ]+`) |", string(models))
+	// inline schemas are documented with the models
+	assertInCode(t, "| stats | [MilestoneStats](models.md#milestonestats) |", string(models))
+	assertInCode(t, "## MilestoneStats", string(models))
+	assertInCode(t, "## AddCommentToTaskBody", string(models))
+}
+
+func TestGenerateMarkdown_NestedProperties(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	target, err := ioutil.TempDir("", "markdown")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(target)
+	}()
+
+	opts := testMarkdownGenOpts("../fixtures/codegen/todolist.models.yml", target)
+	require.NoError(t, GenerateMarkdown("", nil, nil, opts))
+
+	models, err := ioutil.ReadFile(filepath.Join(target, "models.md"))
+	require.NoError(t, err)
+	assertInCode(t, "| content | object |  |  |  |", string(models))
+	assertInCode(t, "| content.body | string |  |  | min length: 5, max length: 2048 |", string(models))
+	assertInCode(t, "| content.scores | [Scores](models.md#scores) |  |  |  |", string(models))
+	assertInCode(t, "Type: map of [Notable](models.md#notable)", string(models))
+	// the anchors follow the names of the types
+	assertInCode(t, "## <a name=\"flagslist\"></a>flags_list", string(models))
+}
+
+func TestGenerateMarkdown_TemplateDir(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	target, err := ioutil.TempDir("", "markdown")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(target)
+	}()
+	templateDir := filepath.Join(target, "templates")
+	require.NoError(t, os.MkdirAll(filepath.Join(templateDir, "markdown"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(templateDir, "markdown", "models.gotmpl"),
+		[]byte(`{{ range .Models }}- {{ .Name }}{{ "\n" }}{{ end }}`), 0644))
+	defer func() {
+		// restores the default templates
+		templates.LoadDefaults()
+	}()
+
+	opts := testMarkdownGenOpts("../fixtures/codegen/tasklist.basic.yml", filepath.Join(target, "docs"))
+	opts.TemplateDir = templateDir
+	require.NoError(t, GenerateMarkdown("", nil, nil, opts))
+
+	models, err := ioutil.ReadFile(filepath.Join(target, "docs", "models.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "- Comment\n- Currency\n- Error\n- Milestone\n- Price\n- Task\n- TaskCard\n- UserCard\n- ValidationError\n", string(models))
+
+	index, err := ioutil.ReadFile(filepath.Join(target, "docs", "index.md"))
+	require.NoError(t, err)
+	assertInCode(t, `# Issue Tracker API`, string(index))
+}
+
+func TestMarkdownHelpers(t *testing.T) {
+	assert.Equal(t, "a \\| b<br>c", markdownCell(" a | b\r\nc\n"))
+	assert.Equal(t, "get-pet_by-id", markdownAnchor("Get pet_by-Id!"))
+
+	minimum, maxLength := float64(1), int64(10)
+	schema := GenSchema{sharedValidations: sharedValidations{Minimum: &minimum, ExclusiveMinimum: true, MaxLength: &maxLength, Pattern: "a|b"}}
+	assert.Equal(t, "minimum: 1 (exclusive), max length: 10, pattern: `a\\|b`", markdownValidations(schema))
+	assert.Equal(t, "", markdownValidations("not validated"))
+}
@@ -142,6 +142,10 @@ func makeGenDefinition(name, pkg string, schema spec.Schema, specDoc *loads.Docu
 		// We do this at the top level because of the possibility of aliased types which always bubble up validation to types which
 		// are referring to them. This results in correct but inelegant code with empty validations.
 		gd.GenSchema.HasValidations = shallowValidationLookup(gd.GenSchema)
+
+		for _, fallback := range easyJSONFallbacks(gd) {
+			log.Printf("warning: the JSON marshallers of model %s are not supported without reflection and delegate to encoding/json", fallback)
+		}
 	}
 	return gd, err
 }
@@ -227,6 +231,9 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 		IncludeValidator:           opts.IncludeValidator,
 		IncludeModel:               opts.IncludeModel,
 		StrictAdditionalProperties: opts.StrictAdditionalProperties,
+		UnmarshalDefaults:          opts.UnmarshalDefaults,
+		IncludeDeepCopy:            opts.IncludeDeepCopy,
+		IncludeEasyJSON:            opts.IncludeEasyJSON,
 		WithXML:                    opts.WithXML,
 		StructTags:                 opts.StructTags,
 	}
@@ -324,12 +331,19 @@ func makeGenDefinitionHierarchy(name, pkg, container string, schema spec.Schema,
 
 	}
 
+	// a definition does not delegate its context validation to itself
+	pg.GenSchema.HasContextValidations = hasChildContextValidations(&pg.GenSchema) || pg.GenSchema.IsExternal
+
 	defaultImports := map[string]string{
 		"errors":   "github.com/go-openapi/errors",
 		"runtime":  "github.com/go-openapi/runtime",
 		"swag":     "github.com/go-openapi/swag",
 		"validate": "github.com/go-openapi/validate",
 	}
+	if opts.IncludeEasyJSON {
+		defaultImports["jlexer"] = "github.com/mailru/easyjson/jlexer"
+		defaultImports["jwriter"] = "github.com/mailru/easyjson/jwriter"
+	}
 
 	return &GenDefinition{
 		GenCommon: GenCommon{
@@ -406,6 +420,9 @@ type schemaGenContext struct {
 	IncludeValidator           bool
 	IncludeModel               bool
 	StrictAdditionalProperties bool
+	UnmarshalDefaults          bool
+	IncludeDeepCopy            bool
+	IncludeEasyJSON            bool
 	WithXML                    bool
 	Index                      int
 
@@ -546,6 +563,9 @@ func (sg *schemaGenContext) shallowClone() *schemaGenContext {
 	pg.IncludeValidator = sg.IncludeValidator
 	pg.IncludeModel = sg.IncludeModel
 	pg.StrictAdditionalProperties = sg.StrictAdditionalProperties
+	pg.UnmarshalDefaults = sg.UnmarshalDefaults
+	pg.IncludeDeepCopy = sg.IncludeDeepCopy
+	pg.IncludeEasyJSON = sg.IncludeEasyJSON
 	return pg
 }
 
@@ -692,6 +712,32 @@ func (sg *schemaGenContext) MergeResult(other *schemaGenContext, liftsRequired b
 	}
 }
 
+// enumName returns the name of the helpers of the enum of a property, after the model and the property.
+//
+// Since these helpers live in the same package as the models, the name is suffixed when it clashes with a definition,
+// e.g. property "brand" of model "Soda" and definition "SodaBrand".
+func (sg *schemaGenContext) enumName(property string) string {
+	name := pascalize(sg.Name) + pascalize(property)
+	if sg.clashesWithDefinition(name) {
+		return name + "Property"
+	}
+	return name
+}
+
+// clashesWithDefinition tells if a go name is already used by the type of some definition
+func (sg *schemaGenContext) clashesWithDefinition(name string) bool {
+	for definition, schema := range sg.TypeResolver.Doc.Spec().Definitions {
+		goName := swag.ToGoName(definition)
+		if override, ok := schema.Extensions.GetString(xGoName); ok {
+			goName = override
+		}
+		if goName == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (sg *schemaGenContext) buildProperties() error {
 	debugLog("building properties %s (parent: %s)", sg.Name, sg.Container)
 
@@ -743,6 +789,9 @@ func (sg *schemaGenContext) buildProperties() error {
 		if err := emprop.makeGenSchema(); err != nil {
 			return err
 		}
+		if len(emprop.GenSchema.Enum) > 0 {
+			emprop.GenSchema.EnumName = sg.enumName(k)
+		}
 
 		// whatever the validations says, if we have an interface{}, do not validate
 		// NOTE: this may be the case when the type is left empty and we get a Enum validation.
@@ -1356,6 +1405,9 @@ func (sg *schemaGenContext) makeNewStruct(name string, schema spec.Schema) *sche
 		IncludeValidator:           sg.IncludeValidator,
 		IncludeModel:               sg.IncludeModel,
 		StrictAdditionalProperties: sg.StrictAdditionalProperties,
+		UnmarshalDefaults:          sg.UnmarshalDefaults,
+		IncludeDeepCopy:            sg.IncludeDeepCopy,
+		IncludeEasyJSON:            sg.IncludeEasyJSON,
 		StructTags:                 sg.StructTags,
 	}
 	if schema.Ref.String() == "" {
@@ -1855,6 +1907,7 @@ func (sg *schemaGenContext) makeGenSchema() error {
 	sg.GenSchema.IncludeValidator = sg.IncludeValidator
 	sg.GenSchema.IncludeModel = sg.IncludeModel
 	sg.GenSchema.StrictAdditionalProperties = sg.StrictAdditionalProperties
+	sg.GenSchema.UnmarshalDefaults = sg.UnmarshalDefaults
 	sg.GenSchema.Default = sg.Schema.Default
 	sg.GenSchema.StructTags = sg.StructTags
 
@@ -1864,6 +1917,9 @@ func (sg *schemaGenContext) makeGenSchema() error {
 		return err
 	}
 	if returns {
+		// a struct embedding the referred type gets its own DeepCopy, rather than the promoted one
+		sg.GenSchema.WantsDeepCopy = sg.wantsDeepCopy()
+		sg.buildContextValidations()
 		return nil
 	}
 	debugLogAsJSON("after short circuit named ref", sg.Schema)
@@ -1978,6 +2034,96 @@ func (sg *schemaGenContext) makeGenSchema() error {
 	sg.GenSchema.WantsMarshalBinary = !(gs.IsInterface || gs.IsStream || gs.IsBaseType) &&
 		(gs.IsTuple || gs.IsComplexObject || gs.IsAdditionalProperties || (gs.IsPrimitive && gs.IsAliased && gs.IsCustomFormatter && !strings.Contains(gs.Zero(), `("`)))
 
+	sg.GenSchema.WantsDeepCopy = sg.wantsDeepCopy()
+
+	// generate JSON marshallers without reflection for the same types as DeepCopy,
+	// and the factory of polymorphic types declared as type A = B
+	sg.GenSchema.WantsEasyJSON = sg.IncludeEasyJSON && !(gs.IsInterface || gs.IsStream || gs.IsExternal) &&
+		(!gs.IsSuperAlias || gs.IsBaseType) &&
+		(gs.IsTuple || gs.IsComplexObject || gs.IsAdditionalProperties || gs.IsArray || gs.IsMap || gs.IsPrimitive)
+
+	sg.buildContextValidations()
+	if sg.Named {
+		sg.buildDefaults()
+	}
+
 	debugLog("finished gen schema for %q", sg.Name)
 	return nil
 }
+
+// wantsDeepCopy tells if DeepCopy and Equal methods are generated for the schema.
+//
+// They are generated for all named types, including polymorphic types, but not for:
+// - interface{}
+// - io.Reader
+// - aliases declared as type A = B
+func (sg *schemaGenContext) wantsDeepCopy() bool {
+	gs := sg.GenSchema
+	return sg.IncludeDeepCopy && !(gs.IsInterface || gs.IsStream || gs.IsSuperAlias || gs.IsExternal) &&
+		(gs.IsTuple || gs.IsComplexObject || gs.IsAdditionalProperties || gs.IsArray || gs.IsMap || gs.IsPrimitive)
+}
+
+// buildContextValidations determines which schemas need some validation depending on the context,
+// such as readOnly properties, which are rejected when validating a request.
+func (sg *schemaGenContext) buildContextValidations() {
+	markContextValidations(&sg.GenSchema)
+}
+
+func markContextValidations(s *GenSchema) bool {
+	for i := range s.Properties {
+		markContextValidations(&s.Properties[i])
+	}
+	for i := range s.AllOf {
+		markContextValidations(&s.AllOf[i])
+	}
+	for _, child := range []*GenSchema{s.Items, s.AdditionalProperties, s.AdditionalItems} {
+		if child != nil {
+			markContextValidations(child)
+		}
+	}
+	s.HasContextValidations = hasChildContextValidations(s) || s.DelegatesContextValidation()
+	return s.HasContextValidations
+}
+
+// hasChildContextValidations tells if a schema needs some validation depending on the context,
+// without regard to its own type. Children are expected to be marked already.
+func hasChildContextValidations(s *GenSchema) bool {
+	if s.ReadOnly {
+		return true
+	}
+	for _, p := range s.Properties {
+		if p.HasContextValidations && p.Name != s.DiscriminatorField {
+			return true
+		}
+	}
+	for _, a := range s.AllOf {
+		if a.HasContextValidations {
+			return true
+		}
+	}
+	for _, child := range []*GenSchema{s.Items, s.AdditionalProperties, s.AdditionalItems} {
+		if child != nil && child.HasContextValidations {
+			return true
+		}
+	}
+	return false
+}
+
+// DelegatesContextValidation tells if the context validation of this schema is carried out
+// by the ContextValidate method of its type, rather than inline.
+//
+// Types imported with x-go-type may or may not support context validation: this is checked at runtime.
+func (g GenSchema) DelegatesContextValidation() bool {
+	if g.IsStream {
+		return false
+	}
+	if g.IsExternal {
+		return true
+	}
+	if g.IsInterface || g.IsAnonymous {
+		return false
+	}
+	// properties inherited from a base type are flagged as such, whatever their type: only the polymorphic type itself
+	// delegates its validation
+	return g.IsComplexObject || g.IsTuple || g.IsAdditionalProperties || (g.IsBaseType && g.HasDiscriminator) || (g.IsAliased && !g.IsPrimitive)
+}
@@ -501,6 +501,7 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		ExtraSchemes:         extraSchemes,
 		TimeoutName:          timeoutName,
 		Extensions:           operation.Extensions,
+		GenOpts:              b.GenOpts,
 	}, nil
 }
 
@@ -1068,6 +1069,8 @@ func (b *codeGenOpBuilder) buildOperationSchema(schemaPath, containerName, schem
 		IncludeModel:               true,
 		IncludeValidator:           true,
 		StrictAdditionalProperties: b.GenOpts.StrictAdditionalProperties,
+		UnmarshalDefaults:          b.GenOpts.UnmarshalDefaults,
+		IncludeDeepCopy:            b.GenOpts.IncludeDeepCopy,
 		ExtraSchemas:               make(map[string]GenSchema),
 		StructTags:                 b.GenOpts.StructTags,
 	}
@@ -1124,6 +1127,7 @@ func (b *codeGenOpBuilder) buildOperationSchema(schemaPath, containerName, schem
 			schema.IsComplexObject = true
 			schema.SwaggerType = schemaName
 			schema.HasValidations = hasValidations
+			schema.HasContextValidations = true
 			schema.GoType = schemaName
 		} else if isInterface {
 			schema = GenSchema{}
@@ -1287,7 +1287,7 @@ func TestGenParameter_Issue909(t *testing.T) {
 				`if err := validate.FormatOf(fmt.Sprintf("%s.%v", "isAnOption2", i), "query", "uuid", isAnOption2I.String(), formats); err != nil {`,
 				`isAnOption2IR = append(isAnOption2IR, isAnOption2I)`,
 				`o.IsAnOption2 = isAnOption2IR`,
-				`return errors.Required("notAnOption1", "query")`,
+				`return errors.Required("notAnOption1", "query", rawData)`,
 				`notAnOption1IC := swag.SplitByFormat(qvNotAnOption1, "csv")`,
 				`var notAnOption1IR []strfmt.DateTime`,
 				`for i, notAnOption1IV := range notAnOption1IC {`,
@@ -1425,7 +1425,7 @@ func TestGenParameter_Issue909(t *testing.T) {
 				`if err := o.validateIsAnOption4(formats); err != nil {`,
 				`isAnOption4Size := int64(len(o.IsAnOption4))`,
 				`if err := validate.MaxItems("isAnOption4", "query", isAnOption4Size, 4); err != nil {`,
-				`return errors.Required("notAnOption1", "query")`,
+				`return errors.Required("notAnOption1", "query", rawData)`,
 				`notAnOption1IC := swag.SplitByFormat(qvNotAnOption1, "")`,
 				`var notAnOption1IR [][]strfmt.DateTime`,
 				`for i, notAnOption1IV := range notAnOption1IC {`,
@@ -1541,7 +1541,7 @@ func TestGenParameter_Issue1237(t *testing.T) {
 				`var body models.Sg`,
 				`if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`if err == io.EOF {`,
-				`res = append(res, errors.Required("body", "body"))`,
+				`res = append(res, errors.Required("body", "body", ""))`,
 				`} else {`,
 				`res = append(res, errors.NewParseError("body", "body", "", err))`,
 				`if err := body.Validate(route.Formats); err != nil {`,
@@ -1990,14 +1990,14 @@ func TestGenParameter_Issue1536(t *testing.T) {
 				`		var body []interface{`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("maxRecords", "body")`,
+				`				res = append(res, errors.Required("maxRecords", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("maxRecords", "body", "", err)`,
 				`		} else {`,
 				`			o.MaxRecords = body`,
 				`			if err := o.validateMaxRecordsBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("maxRecords", "body")`,
+				`		res = append(res, errors.Required("maxRecords", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetRecordsMaxParams) validateMaxRecordsBody(formats strfmt.Registry) error {`,
 				`	maxRecordsSize := int64(len(o.MaxRecords)`,
@@ -2021,14 +2021,14 @@ func TestGenParameter_Issue1536(t *testing.T) {
 				`		var body []interface{`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("records", "body")`,
+				`				res = append(res, errors.Required("records", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("records", "body", "", err)`,
 				`		} else {`,
 				`			o.Records = body`,
 				// fixed: no validation has to be carried on
 				`	} else {`,
-				`		res = append(res, errors.Required("records", "body")`,
+				`		res = append(res, errors.Required("records", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2379,13 +2379,13 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]models.ModelInterface`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfInterface", "body")`,
+				`				res = append(res, errors.Required("mapOfInterface", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfInterface", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfInterface = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfInterface", "body")`,
+				`		res = append(res, errors.Required("mapOfInterface", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2406,13 +2406,13 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body []interface{`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("arrayOfInterface", "body")`,
+				`				res = append(res, errors.Required("arrayOfInterface", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("arrayOfInterface", "body", "", err)`,
 				`		} else {`,
 				`			o.ArrayOfInterface = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("arrayOfInterface", "body")`,
+				`		res = append(res, errors.Required("arrayOfInterface", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2433,7 +2433,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]models.ModelArrayWithMax`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfArrayWithMax", "body")`,
+				`				res = append(res, errors.Required("mapOfArrayWithMax", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfArrayWithMax", "body", "", err)`,
 				`		} else {`,
@@ -2444,7 +2444,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`			if len(res) == 0 {`,
 				`				o.MapOfArrayWithMax = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfArrayWithMax", "body")`,
+				`		res = append(res, errors.Required("mapOfArrayWithMax", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2465,14 +2465,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body [][]string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("arrayOfarraySimple", "body")`,
+				`				res = append(res, errors.Required("arrayOfarraySimple", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("arrayOfarraySimple", "body", "", err)`,
 				`		} else {`,
 				`			o.ArrayOfarraySimple = body`,
 				`			if err := o.validateArrayOfarraySimpleBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("arrayOfarraySimple", "body")`,
+				`		res = append(res, errors.Required("arrayOfarraySimple", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetArrayNestedSimpleParams) validateArrayOfarraySimpleBody(formats strfmt.Registry) error {`,
 				`	arrayOfarraySimpleIC := o.ArrayOfarraySimple`,
@@ -2506,14 +2506,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]strfmt.UUID`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfFormat", "body")`,
+				`				res = append(res, errors.Required("mapOfFormat", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfFormat", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfFormat = body`,
 				`			if err := o.validateMapOfFormatBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfFormat", "body")`,
+				`		res = append(res, errors.Required("mapOfFormat", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfFormatParams) validateMapOfFormatBody(formats strfmt.Registry) error {`,
 				`	mapOfFormatIC := o.MapOfFormat`,
@@ -2542,14 +2542,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body []map[string][]int32`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("arrayOfMap", "body")`,
+				`				res = append(res, errors.Required("arrayOfMap", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("arrayOfMap", "body", "", err)`,
 				`		} else {`,
 				`			o.ArrayOfMap = body`,
 				`			if err := o.validateArrayOfMapBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("arrayOfMap", "body")`,
+				`		res = append(res, errors.Required("arrayOfMap", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetArrayOfMapParams) validateArrayOfMapBody(formats strfmt.Registry) error {`,
 				`	arrayOfMapSize := int64(len(o.ArrayOfMap)`,
@@ -2588,13 +2588,13 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string][]*int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfAnonArrayWithXNullable", "body")`,
+				`				res = append(res, errors.Required("mapOfAnonArrayWithXNullable", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfAnonArrayWithXNullable", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfAnonArrayWithXNullable = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfAnonArrayWithXNullable", "body")`,
+				`		res = append(res, errors.Required("mapOfAnonArrayWithXNullable", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2615,14 +2615,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body [][]*models.ModelObject`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("arrayOfarray", "body")`,
+				`				res = append(res, errors.Required("arrayOfarray", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("arrayOfarray", "body", "", err)`,
 				`		} else {`,
 				`			o.ArrayOfarray = body`,
 				`			if err := o.validateArrayOfarrayBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("arrayOfarray", "body")`,
+				`		res = append(res, errors.Required("arrayOfarray", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetArrayNestedParams) validateArrayOfarrayBody(formats strfmt.Registry) error {`,
 				`	arrayOfarrayIC := o.ArrayOfarray`,
@@ -2661,7 +2661,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]models.ModelArray`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfArray", "body")`,
+				`				res = append(res, errors.Required("mapOfArray", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfArray", "body", "", err)`,
 				`		} else {`,
@@ -2673,7 +2673,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`			if len(res) == 0 {`,
 				`				o.MapOfArray = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfArray", "body")`,
+				`		res = append(res, errors.Required("mapOfArray", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2694,14 +2694,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string][]*int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfAnonArrayWithNullable", "body")`,
+				`				res = append(res, errors.Required("mapOfAnonArrayWithNullable", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfAnonArrayWithNullable", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfAnonArrayWithNullable = body`,
 				`			if err := o.validateMapOfAnonArrayWithNullableBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfAnonArrayWithNullable", "body")`,
+				`		res = append(res, errors.Required("mapOfAnonArrayWithNullable", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapAnonArrayWithNullableParams) validateMapOfAnonArrayWithNullableBody(formats strfmt.Registry) error {`,
 				`	mapOfAnonArrayWithNullableIC := o.MapOfAnonArrayWithNullable`,
@@ -2734,14 +2734,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string][]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfAnonArray", "body")`,
+				`				res = append(res, errors.Required("mapOfAnonArray", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfAnonArray", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfAnonArray = body`,
 				`			if err := o.validateMapOfAnonArrayBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfAnonArray", "body")`,
+				`		res = append(res, errors.Required("mapOfAnonArray", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfAnonArrayParams) validateMapOfAnonArrayBody(formats strfmt.Registry) error {`,
 				`	mapOfAnonArrayIC := o.MapOfAnonArray`,
@@ -2774,14 +2774,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]map[string][]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfAnonMap", "body")`,
+				`				res = append(res, errors.Required("mapOfAnonMap", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfAnonMap", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfAnonMap = body`,
 				`			if err := o.validateMapOfAnonMapBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfAnonMap", "body")`,
+				`		res = append(res, errors.Required("mapOfAnonMap", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfAnonMapParams) validateMapOfAnonMapBody(formats strfmt.Registry) error {`,
 				`	mapOfAnonMapIC := o.MapOfAnonMap`,
@@ -2818,14 +2818,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string][]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfAnonArray", "body")`,
+				`				res = append(res, errors.Required("mapOfAnonArray", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfAnonArray", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfAnonArray = body`,
 				`			if err := o.validateMapOfAnonArrayBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfAnonArray", "body")`,
+				`		res = append(res, errors.Required("mapOfAnonArray", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapAnonArrayParams) validateMapOfAnonArrayBody(formats strfmt.Registry) error {`,
 				`	mapOfAnonArrayIC := o.MapOfAnonArray`,
@@ -2858,14 +2858,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfPrimitive", "body")`,
+				`				res = append(res, errors.Required("mapOfPrimitive", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfPrimitive", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfPrimitive = body`,
 				`			if err := o.validateMapOfPrimitiveBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfPrimitive", "body")`,
+				`		res = append(res, errors.Required("mapOfPrimitive", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfPrimitiveParams) validateMapOfPrimitiveBody(formats strfmt.Registry) error {`,
 				`	mapOfPrimitiveIC := o.MapOfPrimitive`,
@@ -2894,7 +2894,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body []*models.ModelObject`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("arrayOfObject", "body")`,
+				`				res = append(res, errors.Required("arrayOfObject", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("arrayOfObject", "body", "", err)`,
 				`		} else {`,
@@ -2905,7 +2905,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`			if len(res) == 0 {`,
 				`				o.ArrayOfObject = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("arrayOfObject", "body")`,
+				`		res = append(res, errors.Required("arrayOfObject", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2929,7 +2929,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]models.ModelObject`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfObject", "body")`,
+				`				res = append(res, errors.Required("mapOfObject", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfObject", "body", "", err)`,
 				`		} else {`,
@@ -2941,7 +2941,7 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`			if len(res) == 0 {`,
 				`				o.MapOfObject = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfObject", "body")`,
+				`		res = append(res, errors.Required("mapOfObject", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -2962,14 +2962,14 @@ func TestGenParameter_Issue1536_Maps(t *testing.T) {
 				`		var body map[string]map[string]models.ModelArrayWithMax`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfMap", "body")`,
+				`				res = append(res, errors.Required("mapOfMap", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfMap", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfMap = body`,
 				`			if err := o.validateMapOfMapBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfMap", "body")`,
+				`		res = append(res, errors.Required("mapOfMap", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfMapParams) validateMapOfMapBody(formats strfmt.Registry) error {`,
 				`	mapOfMapIC := o.MapOfMap`,
@@ -3014,14 +3014,14 @@ func TestGenParameter_Issue1536_MapsWithExpand(t *testing.T) {
 				`		var body map[string][]map[string]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfArrayOfMap", "body")`,
+				`				res = append(res, errors.Required("mapOfArrayOfMap", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfArrayOfMap", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfArrayOfMap = body`,
 				`			if err := o.validateMapOfArrayOfMapBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfArrayOfMap", "body")`,
+				`		res = append(res, errors.Required("mapOfArrayOfMap", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfArrayOfMapParams) validateMapOfArrayOfMapBody(formats strfmt.Registry) error {`,
 				`	mapOfArrayOfMapIC := o.MapOfArrayOfMap`,
@@ -3059,14 +3059,14 @@ func TestGenParameter_Issue1536_MapsWithExpand(t *testing.T) {
 				`		var body map[string][]GetMapOfArrayOfNullableMapParamsBodyItems0`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfArrayOfNullableMap", "body")`,
+				`				res = append(res, errors.Required("mapOfArrayOfNullableMap", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfArrayOfNullableMap", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfArrayOfNullableMap = body`,
 				`			if err := o.validateMapOfArrayOfNullableMapBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfArrayOfNullableMap", "body")`,
+				`		res = append(res, errors.Required("mapOfArrayOfNullableMap", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapOfArrayOfNullableMapParams) validateMapOfArrayOfNullableMapBody(formats strfmt.Registry) error {`,
 				`	mapOfArrayOfNullableMapIC := o.MapOfArrayOfNullableMap`,
@@ -3103,14 +3103,14 @@ func TestGenParameter_Issue1536_MapsWithExpand(t *testing.T) {
 				`		var body map[string][][]GetMapArrayOfArrayParamsBodyItems0`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfArrayOfArray", "body")`,
+				`				res = append(res, errors.Required("mapOfArrayOfArray", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfArrayOfArray", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfArrayOfArray = body`,
 				`			if err := o.validateMapOfArrayOfArrayBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfArrayOfArray", "body")`,
+				`		res = append(res, errors.Required("mapOfArrayOfArray", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapArrayOfArrayParams) validateMapOfArrayOfArrayBody(formats strfmt.Registry) error {`,
 				`	mapOfArrayOfArrayIC := o.MapOfArrayOfArray`,
@@ -3152,14 +3152,14 @@ func TestGenParameter_Issue1536_MapsWithExpand(t *testing.T) {
 				`		var body map[string][][]*int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfAnonArrayWithNestedNullable", "body")`,
+				`				res = append(res, errors.Required("mapOfAnonArrayWithNestedNullable", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfAnonArrayWithNestedNullable", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfAnonArrayWithNestedNullable = body`,
 				`			if err := o.validateMapOfAnonArrayWithNestedNullableBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfAnonArrayWithNestedNullable", "body")`,
+				`		res = append(res, errors.Required("mapOfAnonArrayWithNestedNullable", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetMapAnonArrayWithNestedNullableParams) validateMapOfAnonArrayWithNestedNullableBody(formats strfmt.Registry) error {`,
 				`	mapOfAnonArrayWithNestedNullableIC := o.MapOfAnonArrayWithNestedNullable`,
@@ -3197,13 +3197,13 @@ func TestGenParameter_Issue1536_MapsWithExpand(t *testing.T) {
 				`		var body map[string]map[string]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfModelMap", "body")`,
+				`				res = append(res, errors.Required("mapOfModelMap", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfModelMap", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfModelMap = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfModelMap", "body")`,
+				`		res = append(res, errors.Required("mapOfModelMap", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -3224,13 +3224,13 @@ func TestGenParameter_Issue1536_MapsWithExpand(t *testing.T) {
 				`		var body map[string]map[string]*int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("mapOfModelMapNullable", "body")`,
+				`				res = append(res, errors.Required("mapOfModelMapNullable", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("mapOfModelMapNullable", "body", "", err)`,
 				`		} else {`,
 				`			o.MapOfModelMapNullable = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("mapOfModelMapNullable", "body")`,
+				`		res = append(res, errors.Required("mapOfModelMapNullable", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -3264,13 +3264,13 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string]map[string]map[string]*bool`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMap04", "body")`,
+				`				res = append(res, errors.Required("nestedMap04", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMap04", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMap04 = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMap04", "body")`,
+				`		res = append(res, errors.Required("nestedMap04", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -3291,14 +3291,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body []map[string][]map[string]strfmt.Date`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedSliceAndMap01", "body")`,
+				`				res = append(res, errors.Required("nestedSliceAndMap01", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedSliceAndMap01", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedSliceAndMap01 = body`,
 				`			if err := o.validateNestedSliceAndMap01Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedSliceAndMap01", "body")`,
+				`		res = append(res, errors.Required("nestedSliceAndMap01", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedSliceAndMap01Params) validateNestedSliceAndMap01Body(formats strfmt.Registry) error {`,
 				`	if err := validate.UniqueItems("nestedSliceAndMap01", "body", o.NestedSliceAndMap01); err != nil {`,
@@ -3341,14 +3341,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string][]map[string][]map[string]*int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMapAndSlice02", "body")`,
+				`				res = append(res, errors.Required("nestedMapAndSlice02", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMapAndSlice02", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMapAndSlice02 = body`,
 				`			if err := o.validateNestedMapAndSlice02Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMapAndSlice02", "body")`,
+				`		res = append(res, errors.Required("nestedMapAndSlice02", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedMapAndSlice02Params) validateNestedMapAndSlice02Body(formats strfmt.Registry) error {`,
 				`	nestedMapAndSlice02IC := o.NestedMapAndSlice02`,
@@ -3396,14 +3396,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body []map[string][]map[string]string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedSliceAndMap03", "body")`,
+				`				res = append(res, errors.Required("nestedSliceAndMap03", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedSliceAndMap03", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedSliceAndMap03 = body`,
 				`			if err := o.validateNestedSliceAndMap03Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedSliceAndMap03", "body")`,
+				`		res = append(res, errors.Required("nestedSliceAndMap03", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedSliceAndMap03Params) validateNestedSliceAndMap03Body(formats strfmt.Registry) error {`,
 				`	if err := validate.UniqueItems("nestedSliceAndMap03", "body", o.NestedSliceAndMap03); err != nil {`,
@@ -3445,13 +3445,13 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body [][][]string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedArray03", "body")`,
+				`				res = append(res, errors.Required("nestedArray03", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedArray03", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedArray03 = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedArray03", "body")`,
+				`		res = append(res, errors.Required("nestedArray03", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -3472,14 +3472,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body [][][]string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedArray03", "body")`,
+				`				res = append(res, errors.Required("nestedArray03", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedArray03", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedArray03 = body`,
 				`			if err := o.validateNestedArray03Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedArray03", "body")`,
+				`		res = append(res, errors.Required("nestedArray03", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedArray04Params) validateNestedArray03Body(formats strfmt.Registry) error {`,
 				`	if err := validate.UniqueItems("nestedArray03", "body", o.NestedArray03); err != nil {`,
@@ -3520,14 +3520,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string][]map[string][]map[string]strfmt.Date`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMapAndSlice01", "body")`,
+				`				res = append(res, errors.Required("nestedMapAndSlice01", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMapAndSlice01", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMapAndSlice01 = body`,
 				`			if err := o.validateNestedMapAndSlice01Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMapAndSlice01", "body")`,
+				`		res = append(res, errors.Required("nestedMapAndSlice01", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedMapAndSlice01Params) validateNestedMapAndSlice01Body(formats strfmt.Registry) error {`,
 				`	nestedMapAndSlice01IC := o.NestedMapAndSlice01`,
@@ -3598,14 +3598,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string]map[string]map[string]*string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMap02", "body")`,
+				`				res = append(res, errors.Required("nestedMap02", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMap02", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMap02 = body`,
 				`			if err := o.validateNestedMap02Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMap02", "body")`,
+				`		res = append(res, errors.Required("nestedMap02", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedMap02Params) validateNestedMap02Body(formats strfmt.Registry) error {`,
 				`	nestedMap02IC := o.NestedMap02`,
@@ -3643,14 +3643,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string][]map[string][]map[string]int64`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMapAndSlice03", "body")`,
+				`				res = append(res, errors.Required("nestedMapAndSlice03", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMapAndSlice03", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMapAndSlice03 = body`,
 				`			if err := o.validateNestedMapAndSlice03Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMapAndSlice03", "body")`,
+				`		res = append(res, errors.Required("nestedMapAndSlice03", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedMapAndSlice03Params) validateNestedMapAndSlice03Body(formats strfmt.Registry) error {`,
 				`	nestedMapAndSlice03IC := o.NestedMapAndSlice03`,
@@ -3696,14 +3696,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body []map[string][]map[string]*string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedSliceAndMap02", "body")`,
+				`				res = append(res, errors.Required("nestedSliceAndMap02", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedSliceAndMap02", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedSliceAndMap02 = body`,
 				`			if err := o.validateNestedSliceAndMap02Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedSliceAndMap02", "body")`,
+				`		res = append(res, errors.Required("nestedSliceAndMap02", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedSliceAndMap02Params) validateNestedSliceAndMap02Body(formats strfmt.Registry) error {`,
 				`	if err := validate.UniqueItems("nestedSliceAndMap02", "body", o.NestedSliceAndMap02); err != nil {`,
@@ -3747,14 +3747,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string]map[string]map[string]strfmt.Date`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMap01", "body")`,
+				`				res = append(res, errors.Required("nestedMap01", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMap01", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMap01 = body`,
 				`			if err := o.validateNestedMap01Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMap01", "body")`,
+				`		res = append(res, errors.Required("nestedMap01", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedMap01Params) validateNestedMap01Body(formats strfmt.Registry) error {`,
 				`	nestedMap01IC := o.NestedMap01`,
@@ -3791,13 +3791,13 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body map[string]map[string]map[string]string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedMap03", "body")`,
+				`				res = append(res, errors.Required("nestedMap03", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedMap03", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedMap03 = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedMap03", "body")`,
+				`		res = append(res, errors.Required("nestedMap03", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -3818,14 +3818,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body [][][]strfmt.Date`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedArray01", "body")`,
+				`				res = append(res, errors.Required("nestedArray01", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedArray01", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedArray01 = body`,
 				`			if err := o.validateNestedArray01Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedArray01", "body")`,
+				`		res = append(res, errors.Required("nestedArray01", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedArray01Params) validateNestedArray01Body(formats strfmt.Registry) error {`,
 				`	nestedArray01Size := int64(len(o.NestedArray01)`,
@@ -3870,14 +3870,14 @@ func TestGenParameter_Issue1536_MoreMaps(t *testing.T) {
 				`		var body [][][]*string`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nestedArray01", "body")`,
+				`				res = append(res, errors.Required("nestedArray01", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nestedArray01", "body", "", err)`,
 				`		} else {`,
 				`			o.NestedArray01 = body`,
 				`			if err := o.validateNestedArray01Body(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nestedArray01", "body")`,
+				`		res = append(res, errors.Required("nestedArray01", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetNestedArray02Params) validateNestedArray01Body(formats strfmt.Registry) error {`,
 				`	nestedArray01Size := int64(len(o.NestedArray01)`,
@@ -4155,13 +4155,13 @@ func TestGenParameter_1572(t *testing.T) {
 				`		var body interface{`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("interfaceBody", "body")`,
+				`				res = append(res, errors.Required("interfaceBody", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("interfaceBody", "body", "", err)`,
 				`		} else {`,
 				`			o.InterfaceBody = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("interfaceBody", "body")`,
+				`		res = append(res, errors.Required("interfaceBody", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -4182,13 +4182,13 @@ func TestGenParameter_1572(t *testing.T) {
 				`		var body interface{`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("nullBody", "body")`,
+				`				res = append(res, errors.Required("nullBody", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("nullBody", "body", "", err)`,
 				`		} else {`,
 				`			o.NullBody = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("nullBody", "body")`,
+				`		res = append(res, errors.Required("nullBody", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
@@ -4209,14 +4209,14 @@ func TestGenParameter_1572(t *testing.T) {
 				`		var body uint32`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("primitiveBody", "body")`,
+				`				res = append(res, errors.Required("primitiveBody", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("primitiveBody", "body", "", err)`,
 				`		} else {`,
 				`			o.PrimitiveBody = body`,
 				`			if err := o.validatePrimitiveBodyBody(route.Formats); err != nil {`,
 				`	} else {`,
-				`		res = append(res, errors.Required("primitiveBody", "body")`,
+				`		res = append(res, errors.Required("primitiveBody", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 				`func (o *GetPrimitiveParams) validatePrimitiveBodyBody(formats strfmt.Registry) error {`,
 				`	if err := validate.MaximumInt("primitiveBody", "body", int64(o.PrimitiveBody), 100, false); err != nil {`,
@@ -4239,13 +4239,13 @@ func TestGenParameter_1572(t *testing.T) {
 				`		var body map[string]models.ModelInterface`,
 				`		if err := route.Consumer.Consume(r.Body, &body); err != nil {`,
 				`			if err == io.EOF {`,
-				`				res = append(res, errors.Required("interfaceBody", "body")`,
+				`				res = append(res, errors.Required("interfaceBody", "body", ""`,
 				`			} else {`,
 				`				res = append(res, errors.NewParseError("interfaceBody", "body", "", err)`,
 				`		} else {`,
 				`			o.InterfaceBody = body`,
 				`	} else {`,
-				`		res = append(res, errors.Required("interfaceBody", "body")`,
+				`		res = append(res, errors.Required("interfaceBody", "body", ""`,
 				`		return errors.CompositeValidationError(res...`,
 			},
 		},
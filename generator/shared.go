@@ -44,6 +44,7 @@ const (
 	defaultServerTarget     = "restapi"
 	defaultClientTarget     = "client"
 	defaultOperationsTarget = "operations"
+	defaultCLITarget        = "cli"
 	defaultClientName       = "rest"
 	defaultServerName       = "swagger"
 	defaultScheme           = "http"
@@ -60,6 +61,11 @@ func init() {
 // DefaultSectionOpts for a given opts, this is used when no config file is passed
 // and uses the embedded templates when no local override can be found
 func DefaultSectionOpts(gen *GenOpts) {
+	if gen.IsMarkdown {
+		markdownSectionOpts(gen)
+		return
+	}
+
 	sec := gen.Sections
 	if len(sec.Models) == 0 {
 		sec.Models = []TemplateOpts{
@@ -70,6 +76,22 @@ func DefaultSectionOpts(gen *GenOpts) {
 				FileName: "{{ (snakize (pascalize .Name)) }}.go",
 			},
 		}
+		if gen.IncludeEasyJSON {
+			sec.Models = append(sec.Models,
+				TemplateOpts{
+					Name:     "easyjsonsupport",
+					Source:   "asset:easyjsonsupport",
+					Target:   "{{ joinFilePath .Target (toPackagePath .ModelPackage) }}",
+					FileName: "easyjson_helpers.go",
+				},
+				TemplateOpts{
+					Name:     "easyjsontest",
+					Source:   "asset:easyjsontest",
+					Target:   "{{ joinFilePath .Target (toPackagePath .ModelPackage) }}",
+					FileName: "{{ (snakize (pascalize .Name)) }}_easyjson_test.go",
+				},
+			)
+		}
 	}
 
 	if len(sec.Operations) == 0 {
@@ -88,6 +110,14 @@ func DefaultSectionOpts(gen *GenOpts) {
 					FileName: "{{ (snakize (pascalize .Name)) }}_responses.go",
 				},
 			}
+			if gen.IncludeCLI {
+				sec.Operations = append(sec.Operations, TemplateOpts{
+					Name:     "cli",
+					Source:   "asset:cliOperation",
+					Target:   "{{ joinFilePath .Target (toPackagePath .CLIPackage) }}",
+					FileName: "{{ (snakize (pascalize .Name)) }}_operation.go",
+				})
+			}
 		} else {
 			ops := []TemplateOpts{}
 			if gen.IncludeParameters {
@@ -136,6 +166,14 @@ func DefaultSectionOpts(gen *GenOpts) {
 					FileName: "{{ (snakize (pascalize .Name)) }}_client.go",
 				},
 			}
+			if gen.IncludeMocks {
+				sec.OperationGroups = append(sec.OperationGroups, TemplateOpts{
+					Name:     "mock",
+					Source:   "asset:clientMock",
+					Target:   "{{ joinFilePath .Target (toPackagePath .ClientPackage) (toPackagePath .Name)}}",
+					FileName: "{{ (snakize (pascalize .Name)) }}_client_mock.go",
+				})
+			}
 		} else {
 			sec.OperationGroups = []TemplateOpts{}
 		}
@@ -151,6 +189,34 @@ func DefaultSectionOpts(gen *GenOpts) {
 					FileName: "{{ snakize .Name }}Client.go",
 				},
 			}
+			if gen.IncludeCLI {
+				sec.Application = append(sec.Application,
+					TemplateOpts{
+						Name:     "cli",
+						Source:   "asset:cliCli",
+						Target:   "{{ joinFilePath .Target (toPackagePath .CLIPackage) }}",
+						FileName: "cli.go",
+					},
+					TemplateOpts{
+						Name:     "cliModels",
+						Source:   "asset:cliModels",
+						Target:   "{{ joinFilePath .Target (toPackagePath .CLIPackage) }}",
+						FileName: "models.go",
+					},
+					TemplateOpts{
+						Name:     "cliCompletion",
+						Source:   "asset:cliCompletion",
+						Target:   "{{ joinFilePath .Target (toPackagePath .CLIPackage) }}",
+						FileName: "completion.go",
+					},
+					TemplateOpts{
+						Name:     "cliMain",
+						Source:   "asset:cliMain",
+						Target:   "{{ joinFilePath .Target \"cmd\" .CLIAppName }}",
+						FileName: "main.go",
+					},
+				)
+			}
 		} else {
 			sec.Application = []TemplateOpts{
 				{
@@ -225,14 +291,20 @@ type GenOpts struct {
 	IncludeURLBuilder          bool
 	IncludeMain                bool
 	IncludeSupport             bool
+	IncludeCLI                 bool
+	IncludeMocks               bool
 	ExcludeSpec                bool
 	DumpData                   bool
 	ValidateSpec               bool
 	FlattenOpts                *analysis.FlattenOpts
 	IsClient                   bool
+	IsMarkdown                 bool
 	defaultsEnsured            bool
 	PropertiesSpecOrder        bool
 	StrictAdditionalProperties bool
+	UnmarshalDefaults          bool
+	IncludeDeepCopy            bool
+	IncludeEasyJSON            bool
 	AllowTemplateOverride      bool
 
 	Spec                   string
@@ -240,6 +312,8 @@ type GenOpts struct {
 	ModelPackage           string
 	ServerPackage          string
 	ClientPackage          string
+	CLIPackage             string
+	CLIAppName             string
 	Principal              string
 	Target                 string
 	Sections               SectionOpts
@@ -397,6 +471,10 @@ func (g *GenOpts) EnsureDefaults() error {
 	// always include validator with models
 	g.IncludeValidator = g.IncludeModel
 
+	if g.IncludeCLI && g.CLIPackage == "" {
+		g.CLIPackage = defaultCLITarget
+	}
+
 	g.defaultsEnsured = true
 	return nil
 }
@@ -443,6 +521,7 @@ func (g *GenOpts) location(t *TemplateOpts, data interface{}) (string, string, e
 
 	d := struct {
 		Name, Package, APIPackage, ServerPackage, ClientPackage, ModelPackage, MainPackage, Target string
+		CLIPackage, CLIAppName                                                                     string
 		Tags                                                                                       []string
 		UseTags                                                                                    bool
 		Context                                                                                    interface{}
@@ -454,6 +533,8 @@ func (g *GenOpts) location(t *TemplateOpts, data interface{}) (string, string, e
 		ClientPackage: g.ClientPackage,
 		ModelPackage:  g.ModelPackage,
 		MainPackage:   g.MainPackage,
+		CLIPackage:    g.CLIPackage,
+		CLIAppName:    g.CLIAppName,
 		Target:        g.Target,
 		Tags:          tags,
 		UseTags:       useTags,
@@ -644,10 +725,38 @@ func (g *GenOpts) renderOperation(gg *GenOperation) error {
 	return nil
 }
 
+func (g *GenOpts) shouldRenderDefinition(t *TemplateOpts, def *GenDefinition) bool {
+	switch swag.ToFileName(swag.ToGoName(t.Name)) {
+	case "easyjsonsupport":
+		// the helpers are needed as soon as some model has JSON marshallers without reflection
+		if def.WantsEasyJSON {
+			return true
+		}
+		for _, extra := range def.ExtraSchemas {
+			if extra.WantsEasyJSON {
+				return true
+			}
+		}
+		return false
+	case "easyjsontest":
+		if easyJSONTested(def.GenSchema) {
+			return true
+		}
+		for _, extra := range def.ExtraSchemas {
+			if easyJSONTested(extra) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
 func (g *GenOpts) renderDefinition(gg *GenDefinition) error {
 	log.Printf("rendering %d templates for model %s", len(g.Sections.Models), gg.Name)
 	for _, templ := range g.Sections.Models {
-		if !g.IncludeModel {
+		if !g.IncludeModel || !g.shouldRenderDefinition(&templ, gg) {
 			continue
 		}
 
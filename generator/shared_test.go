@@ -1,16 +1,19 @@
 package generator
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +45,63 @@ func opts() *GenOpts {
 	return &opts
 }
 
+// genModelWith renders the model of a definition of a fixture, with the generation options set by a function
+func genModelWith(t testing.TB, fixture, name string, withOpts func(*GenOpts)) (*GenDefinition, string) {
+	specDoc, err := loads.Spec(fixture)
+	require.NoError(t, err)
+
+	opts := opts()
+	if withOpts != nil {
+		withOpts(opts)
+	}
+	genModel, err := makeGenDefinition(name, "models", specDoc.Spec().Definitions[name], specDoc, opts)
+	require.NoError(t, err)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, templates.MustGet("model").Execute(buf, genModel))
+
+	ff, err := opts.LanguageOpts.FormatContent(swag.ToFileName(name)+".go", buf.Bytes())
+	if err != nil {
+		fmt.Println(buf.String())
+	}
+	require.NoError(t, err)
+	return genModel, string(ff)
+}
+
+// testGeneratedModels generates the models of a fixture in a temporary package of this repository,
+// with the generation options set by a function, and runs a test file against them
+func testGeneratedModels(t testing.TB, fixture string, withOpts func(*GenOpts), testFile, test string) {
+	log.SetOutput(ioutil.Discard)
+	defer func() {
+		log.SetOutput(os.Stdout)
+	}()
+
+	generated, err := ioutil.TempDir(filepath.Dir(fixture), "generated")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(generated)
+	}()
+
+	opts := &GenOpts{}
+	opts.IncludeModel = true
+	opts.IncludeValidator = true
+	opts.Spec = fixture
+	opts.ModelPackage = "models"
+	opts.Target = generated
+	if withOpts != nil {
+		withOpts(opts)
+	}
+	require.NoError(t, opts.EnsureDefaults())
+	require.NoError(t, GenerateDefinition(nil, opts))
+
+	models := filepath.Join(generated, "models")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(models, testFile), []byte(test), 0644))
+
+	if p, err := exec.Command("go", "test", "./"+filepath.ToSlash(models)).CombinedOutput(); err != nil {
+		t.Fatalf("go test %s: %s\n%s", models, err, p)
+	}
+}
+
 func testGenOpts() *GenOpts {
 	g := &GenOpts{}
 	g.Target = "."
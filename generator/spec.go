@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -14,12 +15,13 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
+	"github.com/go-swagger/go-swagger/oas3"
 	"gopkg.in/yaml.v2"
 )
 
 func (g *GenOpts) validateAndFlattenSpec() (*loads.Document, error) {
 	// Load spec document
-	specDoc, err := loads.Spec(g.Spec)
+	specDoc, err := loadSpec(g.Spec)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +40,7 @@ func (g *GenOpts) validateAndFlattenSpec() (*loads.Document, error) {
 		}
 		// TODO(fredbi): due to uncontrolled $ref state in spec, we need to reload the spec atm, or flatten won't
 		// work properly (validate expansion alters the $ref cache in go-openapi/spec)
-		specDoc, _ = loads.Spec(g.Spec)
+		specDoc, _ = loadSpec(g.Spec)
 	}
 
 	// Flatten spec
@@ -66,6 +68,10 @@ func (g *GenOpts) validateAndFlattenSpec() (*loads.Document, error) {
 	// The right place to fix these shortcomings is go-openapi/analysis.
 
 	g.FlattenOpts.BasePath = specDoc.SpecFilePath()
+	if g.FlattenOpts.BasePath == "" {
+		// documents converted from OpenAPI 3.0 resolve their relative $ref's from the original location
+		g.FlattenOpts.BasePath = g.Spec
+	}
 	g.FlattenOpts.Spec = analysis.New(specDoc.Spec())
 
 	g.printFlattenOpts()
@@ -78,6 +84,36 @@ func (g *GenOpts) validateAndFlattenSpec() (*loads.Document, error) {
 	return specDoc, nil
 }
 
+// loadSpec loads a swagger 2.0 spec document.
+//
+// OpenAPI 3.0 documents are converted to swagger 2.0: constructs which can only be approximated are
+// logged, whereas constructs which cannot be represented in swagger 2.0 make the load fail.
+func loadSpec(specPath string) (*loads.Document, error) {
+	raw, err := oas3.ReadRaw(specPath)
+	if err != nil || !oas3.IsOpenAPI3(raw) {
+		return loads.Spec(specPath)
+	}
+
+	doc, err := oas3.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("converting OpenAPI %s spec %v to swagger 2.0", doc.OpenAPI, specPath)
+	converted, losses, err := oas3.ToSwagger(doc)
+	if err != nil {
+		return nil, err
+	}
+	for _, loss := range losses {
+		log.Printf("warning: %v", loss)
+	}
+
+	b, err := json.Marshal(converted)
+	if err != nil {
+		return nil, err
+	}
+	return loads.Analyzed(b, "2.0")
+}
+
 func (g *GenOpts) analyzeSpec() (*loads.Document, *analysis.Spec, error) {
 	// spec preprocessing option
 	if g.PropertiesSpecOrder {
@@ -197,6 +233,15 @@ func WithAutoXOrder(specPath string) string {
 		}
 	}
 
+	// OpenAPI 3.0 documents
+	if components, ok := lookFor(yamlDoc, "components"); ok {
+		if schemas, ok := lookFor(components, "schemas"); ok {
+			for _, def := range schemas {
+				addXOrder(def.Value)
+			}
+		}
+	}
+
 	addXOrder(yamlDoc)
 
 	out, err := yaml.Marshal(yamlDoc)
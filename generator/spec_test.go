@@ -85,3 +85,46 @@ func TestShared_Issue1614(t *testing.T) {
 	_, err = opts.validateAndFlattenSpec()
 	assert.NoError(t, err)
 }
+
+func TestSpec_OpenAPI3(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	opts := testGenOpts()
+	opts.Spec = filepath.Join("..", "fixtures", "oas3", "petstore.yaml")
+	opts.ValidateSpec = true
+
+	appGen, err := newAppGenerator("petstore", nil, nil, opts)
+	require.NoError(t, err)
+	app, err := appGen.makeCodegenApp()
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/v1", app.BasePath)
+	ops := make(map[string]GenOperation)
+	for _, group := range app.OperationGroups {
+		for _, op := range group.Operations {
+			ops[op.Name] = op
+		}
+	}
+	require.Contains(t, ops, "createPet")
+	assert.True(t, ops["createPet"].HasBodyParams)
+	for _, param := range ops["createPet"].Params {
+		if param.IsBodyParam() {
+			assert.Equal(t, "pet", param.Name)
+		}
+	}
+	require.Contains(t, ops, "uploadPhoto")
+	assert.True(t, ops["uploadPhoto"].HasFileParams)
+
+	models := make(map[string]GenDefinition)
+	for _, model := range app.Models {
+		models[model.Name] = model
+	}
+	require.Contains(t, models, "Pet")
+	assert.True(t, models["Pet"].IsBaseType)
+
+	// constructs which cannot be represented in swagger 2.0 are reported
+	opts.Spec = filepath.Join("..", "fixtures", "oas3", "unsupported.yaml")
+	_, err = newAppGenerator("unsupported", nil, nil, opts)
+	assert.Error(t, err)
+}
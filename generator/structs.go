@@ -79,6 +79,7 @@ type GenSchema struct {
 	AdditionalProperties       *GenSchema
 	StrictAdditionalProperties bool
 	ReadOnly                   bool
+	HasContextValidations      bool
 	IsVirtual                  bool
 	IsBaseType                 bool
 	HasBaseType                bool
@@ -92,7 +93,14 @@ type GenSchema struct {
 	IncludeModel               bool
 	Default                    interface{}
 	WantsMarshalBinary         bool // do we generate MarshalBinary interface?
+	WantsDeepCopy              bool // do we generate DeepCopy and Equal methods?
+	WantsEasyJSON              bool // do we generate JSON marshallers without reflection?
 	StructTags                 []string
+	EnumName                   string      // name of the helpers of the enum of a property, e.g. All<EnumName>Values()
+	DefaultValues              interface{} // default values applied by the constructor of a model
+	UnmarshalDefaultValues     interface{} // default values of the optional properties, applied when unmarshalling
+	ConstructorName            string      // name of the constructor of a model with default values, e.g. New<Model>()
+	UnmarshalDefaults          bool        // when unmarshalling, absent properties get their default values
 }
 
 func (g GenSchemaList) Len() int      { return len(g) }
@@ -120,6 +128,14 @@ func (g GenSchemaList) Less(i, j int) bool {
 	return g[i].Name < g[j].Name
 }
 
+// GenEnumValue represents a value of an enum, captured by a go constant
+type GenEnumValue struct {
+	// Name of the go constant
+	Name string
+	// Value is the go literal of the value
+	Value string
+}
+
 type sharedValidations struct {
 	HasValidations bool
 	Required       bool
@@ -319,6 +335,12 @@ func (g *GenParameter) ItemsDepth() string {
 	return ""
 }
 
+// HasContextValidations returns true when this parameter needs some validation depending on the context,
+// e.g. a body parameter with readOnly properties
+func (g *GenParameter) HasContextValidations() bool {
+	return g.IsBodyParam() && g.Schema != nil && g.Schema.HasContextValidations
+}
+
 // GenParameters represents a sorted parameter collection
 type GenParameters []GenParameter
 
@@ -337,6 +359,16 @@ func (g GenParameters) HasSomeDefaults() bool {
 	return false
 }
 
+// HasContextValidations returns true if at least one parameter needs some validation depending on the context
+func (g GenParameters) HasContextValidations() bool {
+	for i := range g {
+		if g[i].HasContextValidations() {
+			return true
+		}
+	}
+	return false
+}
+
 // GenItems represents the collection items for a collection parameter
 type GenItems struct {
 	sharedValidations
@@ -490,6 +522,8 @@ type GenOperation struct {
 	TimeoutName        string
 
 	Extensions map[string]interface{}
+
+	GenOpts *GenOpts
 }
 
 // GenOperations represents a list of operations to generate
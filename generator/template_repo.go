@@ -85,6 +85,46 @@ func DefaultFuncMap(lang *LanguageOpts) template.FuncMap {
 		"stringContains":   strings.Contains,
 		"imports":          lang.imports,
 		"dict":             dict,
+		"enumValues":       lang.enumValues,
+
+		// markdown documentation
+		"markdownCell":        markdownCell,
+		"markdownAnchor":      markdownAnchor,
+		"markdownValidations": markdownValidations,
+
+		// command line client
+		"cliFlagKind":      cliFlagKind,
+		"cliFlagDefault":   cliFlagDefault,
+		"cliGoType":        cliGoType,
+		"cliParamFlagKind": cliParamFlagKind,
+		"cliModelsAlias":   cliModelsAlias,
+		"cliModelTypes":    cliModelTypes,
+		"cliUsage":         cliUsage,
+
+		// deep copies
+		"deepCopiesUntyped": deepCopiesUntyped,
+
+		// JSON marshallers without reflection
+		"easyJSONSchema":              easyJSONSchema,
+		"easyJSONSupported":           easyJSONSupported,
+		"easyJSONPath":                easyJSONPath,
+		"easyJSONKind":                easyJSONKind,
+		"easyJSONPtr":                 easyJSONPtr,
+		"easyJSONFormattedString":     easyJSONFormattedString,
+		"easyJSONElemPtr":             easyJSONElemPtr,
+		"easyJSONType":                easyJSONType,
+		"easyJSONElemType":            easyJSONElemType,
+		"easyJSONBasicType":           easyJSONBasicType,
+		"easyJSONNotEmpty":            easyJSONNotEmpty,
+		"easyJSONOmitted":             easyJSONOmitted,
+		"easyJSONKey":                 easyJSONKey,
+		"easyJSONNames":               easyJSONNames,
+		"easyJSONDiscriminatedFields": easyJSONDiscriminatedFields,
+		"easyJSONConcreteTypes":       easyJSONConcreteTypes,
+		"easyJSONWriter":              easyJSONWriter,
+		"easyJSONReader":              easyJSONReader,
+		"easyJSONTested":              easyJSONTested,
+		"easyJSONSample":              easyJSONSample,
 	})
 }
 
@@ -98,12 +138,15 @@ func defaultAssets() map[string][]byte {
 		"structfield.gotmpl":             MustAsset("templates/structfield.gotmpl"),
 		"schemavalidator.gotmpl":         MustAsset("templates/schemavalidator.gotmpl"),
 		"schemapolymorphic.gotmpl":       MustAsset("templates/schemapolymorphic.gotmpl"),
+		"schemadeepcopy.gotmpl":          MustAsset("templates/schemadeepcopy.gotmpl"),
 
 		// schema serialization templates
 		"additionalpropertiesserializer.gotmpl": MustAsset("templates/serializers/additionalpropertiesserializer.gotmpl"),
 		"aliasedserializer.gotmpl":              MustAsset("templates/serializers/aliasedserializer.gotmpl"),
 		"allofserializer.gotmpl":                MustAsset("templates/serializers/allofserializer.gotmpl"),
 		"basetypeserializer.gotmpl":             MustAsset("templates/serializers/basetypeserializer.gotmpl"),
+		"defaultsserializer.gotmpl":             MustAsset("templates/serializers/defaultsserializer.gotmpl"),
+		"easyjsonserializer.gotmpl":             MustAsset("templates/serializers/easyjsonserializer.gotmpl"),
 		"marshalbinaryserializer.gotmpl":        MustAsset("templates/serializers/marshalbinaryserializer.gotmpl"),
 		"schemaserializer.gotmpl":               MustAsset("templates/serializers/schemaserializer.gotmpl"),
 		"subtypeserializer.gotmpl":              MustAsset("templates/serializers/subtypeserializer.gotmpl"),
@@ -117,6 +160,10 @@ func defaultAssets() map[string][]byte {
 		"model.gotmpl":      MustAsset("templates/model.gotmpl"),
 		"header.gotmpl":     MustAsset("templates/header.gotmpl"),
 
+		// JSON marshallers without reflection
+		"easyjsonsupport.gotmpl": MustAsset("templates/easyjsonsupport.gotmpl"),
+		"easyjsontest.gotmpl":    MustAsset("templates/easyjsontest.gotmpl"),
+
 		"swagger_json_embed.gotmpl": MustAsset("templates/swagger_json_embed.gotmpl"),
 
 		// server templates
@@ -135,6 +182,20 @@ func defaultAssets() map[string][]byte {
 		"client/response.gotmpl":  MustAsset("templates/client/response.gotmpl"),
 		"client/client.gotmpl":    MustAsset("templates/client/client.gotmpl"),
 		"client/facade.gotmpl":    MustAsset("templates/client/facade.gotmpl"),
+		"client/mock.gotmpl":      MustAsset("templates/client/mock.gotmpl"),
+
+		// markdown documentation
+		"markdown/shared.gotmpl":     MustAsset("templates/markdown/shared.gotmpl"),
+		"markdown/index.gotmpl":      MustAsset("templates/markdown/index.gotmpl"),
+		"markdown/operations.gotmpl": MustAsset("templates/markdown/operations.gotmpl"),
+		"markdown/models.gotmpl":     MustAsset("templates/markdown/models.gotmpl"),
+
+		// command line client
+		"cli/cli.gotmpl":        MustAsset("templates/cli/cli.gotmpl"),
+		"cli/operation.gotmpl":  MustAsset("templates/cli/operation.gotmpl"),
+		"cli/models.gotmpl":     MustAsset("templates/cli/models.gotmpl"),
+		"cli/completion.gotmpl": MustAsset("templates/cli/completion.gotmpl"),
+		"cli/main.gotmpl":       MustAsset("templates/cli/main.gotmpl"),
 	}
 }
 
@@ -53,7 +53,8 @@ const (
 	xSchemes      = "x-schemes" // additional schemes supported for operations (server generation)
 	xOrder        = "x-order"   // sort order for properties (or any schema)
 	xGoJSONString = "x-go-json-string"
-	xGoEnumCI     = "x-go-enum-ci" // make string enumeration case-insensitive
+	xGoEnumCI     = "x-go-enum-ci"    // make string enumeration case-insensitive
+	xGoEnumNames  = "x-go-enum-names" // names of the go constants capturing the values of an enum
 
 	xGoOperationTag = "x-go-operation-tag" // additional tag to override generation in operation groups
 )
@@ -253,6 +254,7 @@ func (t *typeResolver) resolveSchemaRef(schema *spec.Schema, isRequired bool) (r
 		result.Pkg = pkg
 		result.PkgAlias = alias
 	}
+	_, result.IsExternal = ref.Extensions[xGoType]
 	result.HasDiscriminator = res.HasDiscriminator
 	result.IsBaseType = result.HasDiscriminator
 	result.IsNullable = t.IsNullable(ref)
@@ -426,6 +428,7 @@ func (t *typeResolver) resolveObject(schema *spec.Schema, isAnonymous bool) (res
 		result.GoType = tpe
 		result.Pkg = pkg
 		result.PkgAlias = alias
+		_, result.IsExternal = schema.Extensions[xGoType]
 	}
 	if len(schema.AllOf) > 0 {
 		result.GoType = t.goTypeName(t.ModelName)
@@ -776,6 +779,10 @@ type resolvedType struct {
 	// IsSuperAlias indicates that the aliased type is really the same type,
 	// e.g. in golang, this translates to: type A = B
 	IsSuperAlias bool
+
+	// IsExternal indicates that the type is provided by an external package
+	// with x-go-type, and is not generated
+	IsExternal bool
 }
 
 func (rt *resolvedType) Zero() string {
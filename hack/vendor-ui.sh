@@ -0,0 +1,16 @@
+#!/bin/bash
+# Vendors the Swagger UI and Redoc bundles served by `swagger serve`, then embeds them in the binary.
+set -euo pipefail
+
+SWAGGER_UI_VERSION=${SWAGGER_UI_VERSION:-3.25.0}
+REDOC_VERSION=${REDOC_VERSION:-2.0.0-rc.30}
+
+assets=cmd/swagger/commands/ui/assets
+cdn=https://cdn.jsdelivr.net/npm
+
+for file in swagger-ui-bundle.js swagger-ui-standalone-preset.js swagger-ui.css; do
+  curl -sSfL -o "${assets}/swagger/${file}" "${cdn}/swagger-ui-dist@${SWAGGER_UI_VERSION}/${file}"
+done
+curl -sSfL -o "${assets}/redoc/redoc.standalone.js" "${cdn}/redoc@${REDOC_VERSION}/bundles/redoc.standalone.js"
+
+(cd cmd/swagger/commands/ui && go generate)
@@ -0,0 +1,80 @@
+package oas3
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+)
+
+// Loss describes a construct of the source document which could only be approximated
+// or had to be dropped by a conversion
+type Loss struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (l Loss) String() string {
+	return fmt.Sprintf("%s: %s", l.Pointer, l.Message)
+}
+
+// ConversionError reports all the constructs of a source document which cannot be converted
+type ConversionError struct {
+	Target   string
+	Failures []Loss
+}
+
+func (e *ConversionError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "the document cannot be converted to %s:", e.Target)
+	for _, f := range e.Failures {
+		fmt.Fprintf(&b, "\n- %s", f)
+	}
+	return b.String()
+}
+
+// converter collects losses and failures during a conversion
+type converter struct {
+	target   string
+	losses   []Loss
+	failures []Loss
+}
+
+func (c *converter) lossy(ptr, format string, args ...interface{}) {
+	c.losses = append(c.losses, Loss{Pointer: ptr, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *converter) fail(ptr, format string, args ...interface{}) {
+	c.failures = append(c.failures, Loss{Pointer: ptr, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *converter) err() error {
+	if len(c.failures) == 0 {
+		return nil
+	}
+	return &ConversionError{Target: c.target, Failures: c.failures}
+}
+
+// pointer builds a JSON pointer from its unescaped tokens
+func pointer(base string, tokens ...string) string {
+	var b strings.Builder
+	b.WriteString(base)
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(jsonpointer.Escape(token))
+	}
+	return b.String()
+}
+
+// sortedKeys returns the keys of a map indexed by strings, in lexicographic order
+func sortedKeys(m interface{}) []string {
+	v := reflect.ValueOf(m)
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,20 @@
+/*
+Package oas3 reads OpenAPI 3.0 documents and converts them to and from Swagger 2.0.
+
+The go-swagger toolchain works on the Swagger 2.0 model defined in github.com/go-openapi/spec.
+This package provides a model for OpenAPI 3.0 documents and the conversion of such documents
+to the Swagger 2.0 model, so that OpenAPI 3.0 documents may be fed to the generators.
+
+Schemas are held in the Swagger 2.0 dialect: OpenAPI 3.0 specific schema keywords are translated
+when schemas are unmarshalled and translated back when they are marshalled:
+
+  - nullable: true is held as the x-nullable extension
+  - discriminator objects are held as a discriminator property name, with their mapping held as
+    the x-discriminator-mapping extension
+  - $ref's to #/components/schemas are held as $ref's to #/definitions
+
+Conversions report every construct that could only be approximated as a Loss.
+Constructs which cannot be represented at all make the conversion fail, with an error listing
+all such constructs and their location in the source document.
+*/
+package oas3
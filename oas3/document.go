@@ -0,0 +1,338 @@
+package oas3
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+)
+
+// Document is an OpenAPI 3.0 document
+type Document struct {
+	OpenAPI      string                      `json:"openapi"`
+	Info         *spec.Info                  `json:"info,omitempty"`
+	Servers      []Server                    `json:"servers,omitempty"`
+	Paths        map[string]*PathItem        `json:"paths"`
+	Components   *Components                 `json:"components,omitempty"`
+	Security     []map[string][]string       `json:"security,omitempty"`
+	Tags         []spec.Tag                  `json:"tags,omitempty"`
+	ExternalDocs *spec.ExternalDocumentation `json:"externalDocs,omitempty"`
+	Extensions   spec.Extensions             `json:"-"`
+}
+
+// MarshalJSON marshals this document with its vendor extensions
+func (d Document) MarshalJSON() ([]byte, error) {
+	type alias Document
+	return marshalWithExtensions(alias(d), d.Extensions)
+}
+
+// UnmarshalJSON unmarshals this document and its vendor extensions
+func (d *Document) UnmarshalJSON(data []byte) error {
+	type alias Document
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*d = Document(a)
+	return nil
+}
+
+// Server describes a server hosting the API
+type Server struct {
+	URL         string                    `json:"url"`
+	Description string                    `json:"description,omitempty"`
+	Variables   map[string]ServerVariable `json:"variables,omitempty"`
+}
+
+// ServerVariable describes a variable substituted in a server URL template
+type ServerVariable struct {
+	Enum        []string `json:"enum,omitempty"`
+	Default     string   `json:"default"`
+	Description string   `json:"description,omitempty"`
+}
+
+// Components holds the reusable objects of a document
+type Components struct {
+	Schemas         map[string]Schema          `json:"schemas,omitempty"`
+	Responses       map[string]*Response       `json:"responses,omitempty"`
+	Parameters      map[string]*Parameter      `json:"parameters,omitempty"`
+	Examples        map[string]interface{}     `json:"examples,omitempty"`
+	RequestBodies   map[string]*RequestBody    `json:"requestBodies,omitempty"`
+	Headers         map[string]*Header         `json:"headers,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+	Links           map[string]interface{}     `json:"links,omitempty"`
+	Callbacks       map[string]interface{}     `json:"callbacks,omitempty"`
+}
+
+// PathItem describes the operations available on a single path
+type PathItem struct {
+	Ref         string          `json:"$ref,omitempty"`
+	Summary     string          `json:"summary,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Get         *Operation      `json:"get,omitempty"`
+	Put         *Operation      `json:"put,omitempty"`
+	Post        *Operation      `json:"post,omitempty"`
+	Delete      *Operation      `json:"delete,omitempty"`
+	Options     *Operation      `json:"options,omitempty"`
+	Head        *Operation      `json:"head,omitempty"`
+	Patch       *Operation      `json:"patch,omitempty"`
+	Trace       *Operation      `json:"trace,omitempty"`
+	Servers     []Server        `json:"servers,omitempty"`
+	Parameters  []*Parameter    `json:"parameters,omitempty"`
+	Extensions  spec.Extensions `json:"-"`
+}
+
+// MarshalJSON marshals this path item with its vendor extensions
+func (p PathItem) MarshalJSON() ([]byte, error) {
+	type alias PathItem
+	return marshalWithExtensions(alias(p), p.Extensions)
+}
+
+// UnmarshalJSON unmarshals this path item and its vendor extensions
+func (p *PathItem) UnmarshalJSON(data []byte) error {
+	type alias PathItem
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*p = PathItem(a)
+	return nil
+}
+
+// Operations returns the operations of this path item, indexed by upper case http method
+func (p *PathItem) Operations() map[string]*Operation {
+	ops := make(map[string]*Operation, 8)
+	for method, op := range map[string]*Operation{
+		"GET":     p.Get,
+		"PUT":     p.Put,
+		"POST":    p.Post,
+		"DELETE":  p.Delete,
+		"OPTIONS": p.Options,
+		"HEAD":    p.Head,
+		"PATCH":   p.Patch,
+		"TRACE":   p.Trace,
+	} {
+		if op != nil {
+			ops[method] = op
+		}
+	}
+	return ops
+}
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	Tags         []string                    `json:"tags,omitempty"`
+	Summary      string                      `json:"summary,omitempty"`
+	Description  string                      `json:"description,omitempty"`
+	ExternalDocs *spec.ExternalDocumentation `json:"externalDocs,omitempty"`
+	OperationID  string                      `json:"operationId,omitempty"`
+	Parameters   []*Parameter                `json:"parameters,omitempty"`
+	RequestBody  *RequestBody                `json:"requestBody,omitempty"`
+	Responses    map[string]*Response        `json:"responses"`
+	Callbacks    map[string]interface{}      `json:"callbacks,omitempty"`
+	Deprecated   bool                        `json:"deprecated,omitempty"`
+	Security     []map[string][]string       `json:"security,omitempty"`
+	Servers      []Server                    `json:"servers,omitempty"`
+	Extensions   spec.Extensions             `json:"-"`
+}
+
+// MarshalJSON marshals this operation with its vendor extensions
+func (o Operation) MarshalJSON() ([]byte, error) {
+	type alias Operation
+	return marshalWithExtensions(alias(o), o.Extensions)
+}
+
+// UnmarshalJSON unmarshals this operation and its vendor extensions
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	type alias Operation
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*o = Operation(a)
+	return nil
+}
+
+// Parameter describes a single operation parameter, or a reference to a reusable one
+type Parameter struct {
+	Ref             string                 `json:"$ref,omitempty"`
+	Name            string                 `json:"name,omitempty"`
+	In              string                 `json:"in,omitempty"`
+	Description     string                 `json:"description,omitempty"`
+	Required        bool                   `json:"required,omitempty"`
+	Deprecated      bool                   `json:"deprecated,omitempty"`
+	AllowEmptyValue bool                   `json:"allowEmptyValue,omitempty"`
+	Style           string                 `json:"style,omitempty"`
+	Explode         *bool                  `json:"explode,omitempty"`
+	AllowReserved   bool                   `json:"allowReserved,omitempty"`
+	Schema          *Schema                `json:"schema,omitempty"`
+	Example         interface{}            `json:"example,omitempty"`
+	Examples        map[string]interface{} `json:"examples,omitempty"`
+	Content         map[string]*MediaType  `json:"content,omitempty"`
+	Extensions      spec.Extensions        `json:"-"`
+}
+
+// MarshalJSON marshals this parameter with its vendor extensions
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	type alias Parameter
+	return marshalWithExtensions(alias(p), p.Extensions)
+}
+
+// UnmarshalJSON unmarshals this parameter and its vendor extensions
+func (p *Parameter) UnmarshalJSON(data []byte) error {
+	type alias Parameter
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*p = Parameter(a)
+	return nil
+}
+
+// RequestBody describes the body of a request, or a reference to a reusable one
+type RequestBody struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Extensions  spec.Extensions       `json:"-"`
+}
+
+// MarshalJSON marshals this request body with its vendor extensions
+func (r RequestBody) MarshalJSON() ([]byte, error) {
+	type alias RequestBody
+	return marshalWithExtensions(alias(r), r.Extensions)
+}
+
+// UnmarshalJSON unmarshals this request body and its vendor extensions
+func (r *RequestBody) UnmarshalJSON(data []byte) error {
+	type alias RequestBody
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*r = RequestBody(a)
+	return nil
+}
+
+// MediaType describes the schema and examples for a given media type
+type MediaType struct {
+	Schema   *Schema                `json:"schema,omitempty"`
+	Example  interface{}            `json:"example,omitempty"`
+	Examples map[string]interface{} `json:"examples,omitempty"`
+	Encoding map[string]interface{} `json:"encoding,omitempty"`
+}
+
+// Response describes a single response from an operation, or a reference to a reusable one
+type Response struct {
+	Ref         string                 `json:"$ref,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Headers     map[string]*Header     `json:"headers,omitempty"`
+	Content     map[string]*MediaType  `json:"content,omitempty"`
+	Links       map[string]interface{} `json:"links,omitempty"`
+	Extensions  spec.Extensions        `json:"-"`
+}
+
+// MarshalJSON marshals this response with its vendor extensions
+func (r Response) MarshalJSON() ([]byte, error) {
+	type alias Response
+	return marshalWithExtensions(alias(r), r.Extensions)
+}
+
+// UnmarshalJSON unmarshals this response and its vendor extensions
+func (r *Response) UnmarshalJSON(data []byte) error {
+	type alias Response
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*r = Response(a)
+	return nil
+}
+
+// Header describes a response header, or a reference to a reusable one
+type Header struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+	Style       string                `json:"style,omitempty"`
+	Explode     *bool                 `json:"explode,omitempty"`
+	Schema      *Schema               `json:"schema,omitempty"`
+	Example     interface{}           `json:"example,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// SecurityScheme describes a security scheme, or a reference to a reusable one
+type SecurityScheme struct {
+	Ref              string          `json:"$ref,omitempty"`
+	Type             string          `json:"type,omitempty"`
+	Description      string          `json:"description,omitempty"`
+	Name             string          `json:"name,omitempty"`
+	In               string          `json:"in,omitempty"`
+	Scheme           string          `json:"scheme,omitempty"`
+	BearerFormat     string          `json:"bearerFormat,omitempty"`
+	Flows            *OAuthFlows     `json:"flows,omitempty"`
+	OpenIDConnectURL string          `json:"openIdConnectUrl,omitempty"`
+	Extensions       spec.Extensions `json:"-"`
+}
+
+// MarshalJSON marshals this security scheme with its vendor extensions
+func (s SecurityScheme) MarshalJSON() ([]byte, error) {
+	type alias SecurityScheme
+	return marshalWithExtensions(alias(s), s.Extensions)
+}
+
+// UnmarshalJSON unmarshals this security scheme and its vendor extensions
+func (s *SecurityScheme) UnmarshalJSON(data []byte) error {
+	type alias SecurityScheme
+	var a alias
+	if err := unmarshalWithExtensions(data, &a, &a.Extensions); err != nil {
+		return err
+	}
+	*s = SecurityScheme(a)
+	return nil
+}
+
+// OAuthFlows holds the configuration of the supported OAuth flows
+type OAuthFlows struct {
+	Implicit          *OAuthFlow `json:"implicit,omitempty"`
+	Password          *OAuthFlow `json:"password,omitempty"`
+	ClientCredentials *OAuthFlow `json:"clientCredentials,omitempty"`
+	AuthorizationCode *OAuthFlow `json:"authorizationCode,omitempty"`
+}
+
+// OAuthFlow holds the configuration of a single OAuth flow
+type OAuthFlow struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	RefreshURL       string            `json:"refreshUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes"`
+}
+
+func marshalWithExtensions(v interface{}, ext spec.Extensions) ([]byte, error) {
+	b1, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(ext) == 0 {
+		return b1, nil
+	}
+	b2, err := json.Marshal(spec.VendorExtensible{Extensions: ext})
+	if err != nil {
+		return nil, err
+	}
+	return swag.ConcatJSON(b1, b2), nil
+}
+
+func unmarshalWithExtensions(data []byte, v interface{}, ext *spec.Extensions) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	var x spec.VendorExtensible
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	*ext = x.Extensions
+	return nil
+}
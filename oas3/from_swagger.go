@@ -0,0 +1,582 @@
+package oas3
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+const (
+	// Version is the OpenAPI version of converted documents
+	Version = "3.0.3"
+
+	oas3Target       = "OpenAPI 3.0"
+	jsonMediaType    = "application/json"
+	componentsPrefix = "#/components/"
+	parametersPrefix = "#/parameters/"
+	responsesPrefix  = "#/responses/"
+)
+
+// FromSwagger converts a Swagger 2.0 spec to OpenAPI 3.0.
+//
+// Constructs which could only be approximated are reported as losses.
+func FromSwagger(sw *spec.Swagger) (*Document, []Loss, error) {
+	f := &fromSwagger{
+		converter: converter{target: oas3Target},
+		sw:        sw,
+	}
+
+	doc := f.convert()
+	if err := f.err(); err != nil {
+		return nil, f.losses, err
+	}
+	return doc, f.losses, nil
+}
+
+type fromSwagger struct {
+	converter
+	sw *spec.Swagger
+}
+
+func (f *fromSwagger) convert() *Document {
+	doc := &Document{
+		OpenAPI:      Version,
+		Info:         f.sw.Info,
+		Servers:      f.servers(),
+		Paths:        make(map[string]*PathItem),
+		Security:     f.sw.Security,
+		Tags:         f.sw.Tags,
+		ExternalDocs: f.sw.ExternalDocs,
+		Extensions:   f.sw.Extensions,
+	}
+	components := &Components{}
+
+	definitions := make(spec.Definitions, len(f.sw.Definitions))
+	for _, name := range sortedKeys(f.sw.Definitions) {
+		sch := cloneSchema(f.sw.Definitions[name])
+		f.schema(pointer("#/definitions", name), &sch)
+		definitions[name] = sch
+	}
+	f.discriminators(definitions)
+	for name, sch := range definitions {
+		if components.Schemas == nil {
+			components.Schemas = make(map[string]Schema, len(definitions))
+		}
+		components.Schemas[name] = Schema{Schema: sch}
+	}
+
+	for _, name := range sortedKeys(f.sw.Parameters) {
+		ptr := pointer("#/parameters", name)
+		param := f.sw.Parameters[name]
+		switch param.In {
+		case "body":
+			if components.RequestBodies == nil {
+				components.RequestBodies = make(map[string]*RequestBody)
+			}
+			components.RequestBodies[name] = f.requestBody(ptr, &param, f.consumes(nil))
+		case "formData":
+			// form parameters are inlined in the request bodies of the operations which use them
+		default:
+			if components.Parameters == nil {
+				components.Parameters = make(map[string]*Parameter)
+			}
+			components.Parameters[name] = f.parameter(ptr, &param)
+		}
+	}
+
+	for _, name := range sortedKeys(f.sw.Responses) {
+		if components.Responses == nil {
+			components.Responses = make(map[string]*Response, len(f.sw.Responses))
+		}
+		resp := f.sw.Responses[name]
+		components.Responses[name] = f.response(pointer("#/responses", name), &resp, f.produces(nil))
+	}
+
+	for _, name := range sortedKeys(f.sw.SecurityDefinitions) {
+		if components.SecuritySchemes == nil {
+			components.SecuritySchemes = make(map[string]*SecurityScheme, len(f.sw.SecurityDefinitions))
+		}
+		components.SecuritySchemes[name] = f.securityScheme(f.sw.SecurityDefinitions[name])
+	}
+
+	if f.sw.Paths != nil {
+		for _, path := range sortedKeys(f.sw.Paths.Paths) {
+			item := f.sw.Paths.Paths[path]
+			doc.Paths[path] = f.pathItem(pointer("#/paths", path), &item)
+		}
+	}
+
+	if components.Schemas != nil || components.Parameters != nil || components.RequestBodies != nil ||
+		components.Responses != nil || components.SecuritySchemes != nil {
+		doc.Components = components
+	}
+	return doc
+}
+
+// servers builds one server per scheme from the host and base path
+func (f *fromSwagger) servers() []Server {
+	if f.sw.Host == "" && f.sw.BasePath == "" {
+		return nil
+	}
+	if f.sw.Host == "" {
+		return []Server{{URL: f.sw.BasePath}}
+	}
+	if len(f.sw.Schemes) == 0 {
+		// a network-path reference keeps the scheme used to access the API
+		return []Server{{URL: "//" + f.sw.Host + f.sw.BasePath}}
+	}
+
+	servers := make([]Server, 0, len(f.sw.Schemes))
+	for _, scheme := range f.sw.Schemes {
+		servers = append(servers, Server{URL: scheme + "://" + f.sw.Host + f.sw.BasePath})
+	}
+	return servers
+}
+
+func (f *fromSwagger) pathItem(ptr string, item *spec.PathItem) *PathItem {
+	result := &PathItem{
+		Extensions: item.Extensions,
+	}
+	if ref := item.Ref.String(); ref != "" {
+		f.lossy(ptr, "path item $ref %q is kept as is", ref)
+		result.Ref = ref
+		return result
+	}
+
+	// body and form parameters declared on the path item go to the request body of each operation
+	var shared []spec.Parameter
+	for i, p := range item.Parameters {
+		paramPtr := pointer(ptr, "parameters", strconv.Itoa(i))
+		if in := f.resolveParameter(&p).In; in == "body" || in == "formData" {
+			shared = append(shared, p)
+			continue
+		}
+		result.Parameters = append(result.Parameters, f.parameterOrRef(paramPtr, &p))
+	}
+
+	for _, op := range []struct {
+		method string
+		from   *spec.Operation
+		to     **Operation
+	}{
+		{method: "get", from: item.Get, to: &result.Get},
+		{method: "put", from: item.Put, to: &result.Put},
+		{method: "post", from: item.Post, to: &result.Post},
+		{method: "delete", from: item.Delete, to: &result.Delete},
+		{method: "options", from: item.Options, to: &result.Options},
+		{method: "head", from: item.Head, to: &result.Head},
+		{method: "patch", from: item.Patch, to: &result.Patch},
+	} {
+		if op.from != nil {
+			*op.to = f.operation(pointer(ptr, op.method), op.from, shared)
+		}
+	}
+	return result
+}
+
+func (f *fromSwagger) operation(ptr string, op *spec.Operation, shared []spec.Parameter) *Operation {
+	result := &Operation{
+		Tags:         op.Tags,
+		Summary:      op.Summary,
+		Description:  op.Description,
+		ExternalDocs: op.ExternalDocs,
+		OperationID:  op.ID,
+		Deprecated:   op.Deprecated,
+		Security:     op.Security,
+		Extensions:   op.Extensions,
+		Responses:    make(map[string]*Response),
+	}
+
+	var (
+		body     *RequestBody
+		form     []spec.Parameter
+		override = make(map[string]bool, len(op.Parameters))
+	)
+	consumes := f.consumes(op.Consumes)
+	params := append([]spec.Parameter{}, op.Parameters...)
+	for _, p := range op.Parameters {
+		resolved := f.resolveParameter(&p)
+		override[resolved.In+"/"+resolved.Name] = true
+	}
+	for _, p := range shared {
+		resolved := f.resolveParameter(&p)
+		if !override[resolved.In+"/"+resolved.Name] {
+			params = append(params, p)
+		}
+	}
+
+	for i, p := range params {
+		paramPtr := pointer(ptr, "parameters", strconv.Itoa(i))
+		resolved := f.resolveParameter(&p)
+		switch resolved.In {
+		case "body":
+			if ref := p.Ref.String(); strings.HasPrefix(ref, parametersPrefix) {
+				body = &RequestBody{Ref: componentsPrefix + "requestBodies/" + strings.TrimPrefix(ref, parametersPrefix)}
+				continue
+			}
+			body = f.requestBody(paramPtr, resolved, consumes)
+		case "formData":
+			form = append(form, *resolved)
+		default:
+			result.Parameters = append(result.Parameters, f.parameterOrRef(paramPtr, &p))
+		}
+	}
+	if len(form) > 0 {
+		body = f.formBody(ptr, form, consumes)
+	}
+	result.RequestBody = body
+
+	produces := f.produces(op.Produces)
+	if op.Responses != nil {
+		if op.Responses.Default != nil {
+			result.Responses["default"] = f.response(pointer(ptr, "responses", "default"), op.Responses.Default, produces)
+		}
+		for code, resp := range op.Responses.StatusCodeResponses {
+			resp := resp
+			result.Responses[strconv.Itoa(code)] = f.response(pointer(ptr, "responses", strconv.Itoa(code)), &resp, produces)
+		}
+	}
+	return result
+}
+
+// resolveParameter follows a local $ref to a parameter
+func (f *fromSwagger) resolveParameter(p *spec.Parameter) *spec.Parameter {
+	ref := p.Ref.String()
+	if !strings.HasPrefix(ref, parametersPrefix) {
+		return p
+	}
+	if resolved, ok := f.sw.Parameters[strings.TrimPrefix(ref, parametersPrefix)]; ok {
+		return &resolved
+	}
+	return p
+}
+
+func (f *fromSwagger) parameterOrRef(ptr string, p *spec.Parameter) *Parameter {
+	if ref := p.Ref.String(); ref != "" {
+		if !strings.HasPrefix(ref, parametersPrefix) {
+			f.lossy(ptr, "parameter $ref %q is kept as is", ref)
+		}
+		return &Parameter{Ref: translateRef(ref, false)}
+	}
+	return f.parameter(ptr, p)
+}
+
+// parameter converts a path, query or header parameter
+func (f *fromSwagger) parameter(ptr string, p *spec.Parameter) *Parameter {
+	result := &Parameter{
+		Name:            p.Name,
+		In:              p.In,
+		Description:     p.Description,
+		Required:        p.Required,
+		AllowEmptyValue: p.AllowEmptyValue,
+		Schema:          &Schema{Schema: *f.simpleSchema(ptr, &p.SimpleSchema, &p.CommonValidations)},
+	}
+	for k, v := range p.Extensions {
+		if strings.ToLower(k) == xNullable {
+			result.Schema.AddExtension(xNullable, v)
+			continue
+		}
+		if result.Extensions == nil {
+			result.Extensions = make(spec.Extensions, len(p.Extensions))
+		}
+		result.Extensions[k] = v
+	}
+
+	if p.Type != "array" {
+		return result
+	}
+	explode := false
+	switch p.CollectionFormat {
+	case "", "csv":
+		if p.In == "query" {
+			result.Style = "form"
+			result.Explode = &explode
+		}
+	case "multi":
+		// default style for query parameters
+	case "ssv", "pipes":
+		if p.In != "query" {
+			f.lossy(pointer(ptr, "collectionFormat"), "collection format %q is only supported for query parameters", p.CollectionFormat)
+			break
+		}
+		result.Style = "spaceDelimited"
+		if p.CollectionFormat == "pipes" {
+			result.Style = "pipeDelimited"
+		}
+		result.Explode = &explode
+	default:
+		f.lossy(pointer(ptr, "collectionFormat"), "collection format %q is represented as csv", p.CollectionFormat)
+		if p.In == "query" {
+			result.Style = "form"
+			result.Explode = &explode
+		}
+	}
+	return result
+}
+
+// simpleSchema converts the simple schema of a non-body parameter, items or header
+func (f *fromSwagger) simpleSchema(ptr string, simple *spec.SimpleSchema, validations *spec.CommonValidations) *spec.Schema {
+	sch := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Format:           simple.Format,
+			Default:          simple.Default,
+			Maximum:          validations.Maximum,
+			ExclusiveMaximum: validations.ExclusiveMaximum,
+			Minimum:          validations.Minimum,
+			ExclusiveMinimum: validations.ExclusiveMinimum,
+			MaxLength:        validations.MaxLength,
+			MinLength:        validations.MinLength,
+			Pattern:          validations.Pattern,
+			MaxItems:         validations.MaxItems,
+			MinItems:         validations.MinItems,
+			UniqueItems:      validations.UniqueItems,
+			MultipleOf:       validations.MultipleOf,
+			Enum:             validations.Enum,
+		},
+		SwaggerSchemaProps: spec.SwaggerSchemaProps{
+			Example: simple.Example,
+		},
+	}
+	switch simple.Type {
+	case "":
+	case "file":
+		sch.Type = spec.StringOrArray{"string"}
+		sch.Format = "binary"
+	default:
+		sch.Type = spec.StringOrArray{simple.Type}
+	}
+
+	if simple.Items != nil {
+		items := simple.Items
+		if items.Type == "array" && items.CollectionFormat != "" && items.CollectionFormat != "csv" {
+			f.lossy(pointer(ptr, "items", "collectionFormat"), "collection format %q of nested arrays is not supported", items.CollectionFormat)
+		}
+		sch.Items = &spec.SchemaOrArray{Schema: f.simpleSchema(pointer(ptr, "items"), &items.SimpleSchema, &items.CommonValidations)}
+	}
+	return sch
+}
+
+func (f *fromSwagger) requestBody(ptr string, p *spec.Parameter, consumes []string) *RequestBody {
+	result := &RequestBody{
+		Description: p.Description,
+		Required:    p.Required,
+		Content:     make(map[string]*MediaType, len(consumes)),
+		Extensions:  p.Extensions,
+	}
+	if p.Name != defaultBodyArg {
+		result.Extensions = make(spec.Extensions, len(p.Extensions)+1)
+		for k, v := range p.Extensions {
+			result.Extensions[k] = v
+		}
+		result.Extensions[xRequestBodyName] = p.Name
+	}
+
+	var sch spec.Schema
+	if p.Schema != nil {
+		sch = cloneSchema(*p.Schema)
+		f.schema(pointer(ptr, "schema"), &sch)
+	}
+	for _, mediaType := range consumes {
+		if isForm(mediaType) {
+			continue
+		}
+		result.Content[mediaType] = &MediaType{Schema: &Schema{Schema: sch}}
+	}
+	if len(result.Content) == 0 {
+		result.Content[jsonMediaType] = &MediaType{Schema: &Schema{Schema: sch}}
+	}
+	return result
+}
+
+// formBody converts form data parameters to the properties of an object schema
+func (f *fromSwagger) formBody(ptr string, params []spec.Parameter, consumes []string) *RequestBody {
+	sch := &spec.Schema{SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"object"}}}
+	hasFile := false
+	for i, p := range params {
+		paramPtr := pointer(ptr, "parameters", strconv.Itoa(i))
+		prop := f.simpleSchema(paramPtr, &p.SimpleSchema, &p.CommonValidations)
+		prop.Description = p.Description
+		prop.Extensions = p.Extensions
+		if p.Type == "file" {
+			hasFile = true
+		}
+		if p.Required {
+			sch.Required = append(sch.Required, p.Name)
+		}
+		sch.SetProperty(p.Name, *prop)
+	}
+
+	var mediaTypes []string
+	for _, mediaType := range consumes {
+		if isForm(mediaType) {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+	if len(mediaTypes) == 0 {
+		mediaTypes = []string{formURLEncoded}
+		if hasFile {
+			mediaTypes = []string{multipartFormData}
+		}
+	}
+
+	result := &RequestBody{
+		Required: len(sch.Required) > 0,
+		Content:  make(map[string]*MediaType, len(mediaTypes)),
+	}
+	for _, mediaType := range mediaTypes {
+		result.Content[mediaType] = &MediaType{Schema: &Schema{Schema: *sch}}
+	}
+	return result
+}
+
+func (f *fromSwagger) response(ptr string, resp *spec.Response, produces []string) *Response {
+	if ref := resp.Ref.String(); ref != "" {
+		if !strings.HasPrefix(ref, responsesPrefix) {
+			f.lossy(ptr, "response $ref %q is kept as is", ref)
+		}
+		return &Response{Ref: translateRef(ref, false)}
+	}
+
+	result := &Response{
+		Description: resp.Description,
+		Extensions:  resp.Extensions,
+	}
+	if resp.Schema != nil {
+		sch := cloneSchema(*resp.Schema)
+		f.schema(pointer(ptr, "schema"), &sch)
+		result.Content = make(map[string]*MediaType, len(produces))
+		for _, mediaType := range produces {
+			result.Content[mediaType] = &MediaType{Schema: &Schema{Schema: sch}}
+		}
+	}
+	for _, mediaType := range sortedKeys(resp.Examples) {
+		if result.Content == nil {
+			result.Content = make(map[string]*MediaType, len(resp.Examples))
+		}
+		if result.Content[mediaType] == nil {
+			result.Content[mediaType] = &MediaType{}
+		}
+		result.Content[mediaType].Example = resp.Examples[mediaType]
+	}
+
+	for _, name := range sortedKeys(resp.Headers) {
+		if result.Headers == nil {
+			result.Headers = make(map[string]*Header, len(resp.Headers))
+		}
+		hdr := resp.Headers[name]
+		result.Headers[name] = &Header{
+			Description: hdr.Description,
+			Schema:      &Schema{Schema: *f.simpleSchema(pointer(ptr, "headers", name), &hdr.SimpleSchema, &hdr.CommonValidations)},
+		}
+	}
+	return result
+}
+
+// schema converts Swagger 2.0 schema constructs which are not supported by OpenAPI 3.0
+func (f *fromSwagger) schema(ptr string, sch *spec.Schema) {
+	walkSchema(ptr, sch, func(_ string, sch *spec.Schema) {
+		if sch.Type.Contains("file") {
+			sch.Type = spec.StringOrArray{"string"}
+			sch.Format = "binary"
+		}
+	})
+}
+
+// discriminators converts the x-class extensions of subtypes to a discriminator mapping
+func (f *fromSwagger) discriminators(definitions spec.Definitions) {
+	for _, name := range sortedKeys(definitions) {
+		sub := definitions[name]
+		value, ok := sub.Extensions.GetString(xClass)
+		if !ok {
+			continue
+		}
+		for _, parent := range sub.AllOf {
+			ref := parent.Ref.String()
+			base, isDefinition := definitions[strings.TrimPrefix(ref, "#/definitions/")]
+			if !strings.HasPrefix(ref, "#/definitions/") || !isDefinition || base.Discriminator == "" {
+				continue
+			}
+			mapping, _ := base.Extensions[xDiscriminatorMapping].(map[string]interface{})
+			if mapping == nil {
+				mapping = make(map[string]interface{})
+			}
+			mapping[value] = "#/definitions/" + name
+			base.AddExtension(xDiscriminatorMapping, mapping)
+			definitions[strings.TrimPrefix(ref, "#/definitions/")] = base
+
+			for k := range sub.Extensions {
+				if strings.ToLower(k) == xClass {
+					delete(sub.Extensions, k)
+				}
+			}
+			definitions[name] = sub
+		}
+	}
+}
+
+func (f *fromSwagger) securityScheme(scheme *spec.SecurityScheme) *SecurityScheme {
+	result := &SecurityScheme{
+		Description: scheme.Description,
+		Extensions:  scheme.Extensions,
+	}
+
+	switch scheme.Type {
+	case "basic":
+		result.Type = "http"
+		result.Scheme = "basic"
+	case "apiKey":
+		result.Type = "apiKey"
+		result.Name = scheme.Name
+		result.In = scheme.In
+	case "oauth2":
+		result.Type = "oauth2"
+		flow := &OAuthFlow{
+			AuthorizationURL: scheme.AuthorizationURL,
+			TokenURL:         scheme.TokenURL,
+			Scopes:           scheme.Scopes,
+		}
+		if flow.Scopes == nil {
+			flow.Scopes = map[string]string{}
+		}
+		result.Flows = &OAuthFlows{}
+		switch scheme.Flow {
+		case "implicit":
+			flow.TokenURL = ""
+			result.Flows.Implicit = flow
+		case "password":
+			flow.AuthorizationURL = ""
+			result.Flows.Password = flow
+		case "application":
+			flow.AuthorizationURL = ""
+			result.Flows.ClientCredentials = flow
+		case "accessCode":
+			result.Flows.AuthorizationCode = flow
+		}
+	default:
+		result.Type = scheme.Type
+	}
+	return result
+}
+
+// consumes returns the media types consumed by an operation
+func (f *fromSwagger) consumes(mediaTypes []string) []string {
+	if len(mediaTypes) > 0 {
+		return mediaTypes
+	}
+	if len(f.sw.Consumes) > 0 {
+		return f.sw.Consumes
+	}
+	return []string{jsonMediaType}
+}
+
+// produces returns the media types produced by an operation
+func (f *fromSwagger) produces(mediaTypes []string) []string {
+	if len(mediaTypes) > 0 {
+		return mediaTypes
+	}
+	if len(f.sw.Produces) > 0 {
+		return f.sw.Produces
+	}
+	return []string{jsonMediaType}
+}
@@ -0,0 +1,128 @@
+package oas3
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSwagger_Petstore(t *testing.T) {
+	specDoc, err := loads.Spec(filepath.Join("..", "fixtures", "petstores", "petstore.json"))
+	require.NoError(t, err)
+
+	doc, losses, err := FromSwagger(specDoc.Spec())
+	require.NoError(t, err)
+	assert.Empty(t, losses)
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	require.Len(t, doc.Servers, 1)
+	assert.Equal(t, "http://petstore.swagger.wordnik.com/api", doc.Servers[0].URL)
+	require.Contains(t, doc.Components.Schemas, "Pet")
+
+	listPets := doc.Paths["/pets"].Get
+	require.NotNil(t, listPets)
+	ok := listPets.Responses["200"]
+	require.Contains(t, ok.Content, "application/json")
+	assert.Equal(t, "array", ok.Content["application/json"].Schema.Type[0])
+	assert.Contains(t, ok.Headers, "x-expires")
+
+	b, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"$ref":"#/components/schemas/Pet"`)
+	assert.NotContains(t, string(b), "#/definitions/")
+}
+
+func TestFromSwagger_Parameters(t *testing.T) {
+	specDoc, err := loads.Spec(filepath.Join("..", "fixtures", "codegen", "todolist.simpleform.yml"))
+	require.NoError(t, err)
+
+	doc, _, err := FromSwagger(specDoc.Spec())
+	require.NoError(t, err)
+
+	for _, item := range doc.Paths {
+		for _, op := range item.Operations() {
+			for _, param := range op.Parameters {
+				assert.NotEqual(t, "formData", param.In)
+				assert.NotEqual(t, "body", param.In)
+			}
+		}
+	}
+}
+
+func TestFromSwagger_Discriminators(t *testing.T) {
+	specDoc, err := loads.Analyzed(json.RawMessage(`{
+  "swagger": "2.0",
+  "info": {"title": "discriminators", "version": "1.0.0"},
+  "paths": {},
+  "definitions": {
+    "Pet": {
+      "type": "object",
+      "required": ["petType"],
+      "discriminator": "petType",
+      "properties": {"petType": {"type": "string"}}
+    },
+    "Dog": {
+      "x-class": "dog",
+      "allOf": [{"$ref": "#/definitions/Pet"}, {"type": "object", "properties": {"barks": {"type": "boolean"}}}]
+    }
+  }
+}`), "2.0")
+	require.NoError(t, err)
+
+	doc, _, err := FromSwagger(specDoc.Spec())
+	require.NoError(t, err)
+
+	b, err := json.Marshal(doc.Components.Schemas["Pet"])
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"discriminator":{"propertyName":"petType","mapping":{"dog":"#/components/schemas/Dog"}}`)
+	assert.NotContains(t, string(b), xDiscriminatorMapping)
+
+	// back to swagger 2.0
+	sw, _, err := ToSwagger(doc)
+	require.NoError(t, err)
+	value, _ := sw.Definitions["Dog"].Extensions.GetString(xClass)
+	assert.Equal(t, "dog", value)
+}
+
+func TestFromSwagger_RoundTrip(t *testing.T) {
+	for _, fixture := range []string{
+		filepath.Join("petstores", "petstore.json"),
+		filepath.Join("codegen", "todolist.allparams.yml"),
+		filepath.Join("codegen", "todolist.arrayform.yml"),
+		filepath.Join("codegen", "todolist.arrayquery.yml"),
+		filepath.Join("codegen", "todolist.bodyparams.yml"),
+		filepath.Join("codegen", "todolist.discriminators.yml"),
+		filepath.Join("codegen", "todolist.simpleform.yml"),
+		filepath.Join("codegen", "tasklist.basic.yml"),
+	} {
+		fixture := fixture
+		t.Run(fixture, func(t *testing.T) {
+			specDoc, err := loads.Spec(filepath.Join("..", "fixtures", fixture))
+			require.NoError(t, err)
+
+			doc, _, err := FromSwagger(specDoc.Spec())
+			require.NoError(t, err)
+			b, err := json.Marshal(doc)
+			require.NoError(t, err)
+
+			parsed, err := Parse(b)
+			require.NoError(t, err)
+			sw, _, err := ToSwagger(parsed)
+			require.NoError(t, err)
+			b, err = json.Marshal(sw)
+			require.NoError(t, err)
+
+			converted, err := loads.Analyzed(b, "2.0")
+			require.NoError(t, err)
+			assert.NoError(t, validate.Spec(converted, strfmt.Default))
+			assert.Equal(t, len(specDoc.Analyzer.OperationIDs()), len(converted.Analyzer.OperationIDs()))
+			assert.Equal(t, len(specDoc.Spec().Definitions), len(converted.Spec().Definitions))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package oas3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// ReadRaw reads a JSON or YAML document from a local file or URL and returns it as JSON
+func ReadRaw(path string) (json.RawMessage, error) {
+	b, err := swag.LoadFromFileOrHTTP(path)
+	if err != nil {
+		return nil, err
+	}
+	return toJSON(b)
+}
+
+// IsOpenAPI3 tells if a raw JSON document declares an OpenAPI 3.x version
+func IsOpenAPI3(raw json.RawMessage) bool {
+	var header struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return false
+	}
+	return strings.HasPrefix(header.OpenAPI, "3.")
+}
+
+// Load reads an OpenAPI 3.0 document from a local file or URL
+func Load(path string) (*Document, error) {
+	raw, err := ReadRaw(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not load %s: %v", path, err)
+	}
+	return doc, nil
+}
+
+// Parse builds an OpenAPI 3.0 document from raw JSON or YAML
+func Parse(raw []byte) (*Document, error) {
+	b, err := toJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !IsOpenAPI3(b) {
+		return nil, fmt.Errorf("not an OpenAPI 3.x document")
+	}
+	doc := new(Document)
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func toJSON(b []byte) (json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) > 0 && trimmed[0] != '{' && trimmed[0] != '[' {
+		yml, err := swag.BytesToYAMLDoc(trimmed)
+		if err != nil {
+			return nil, err
+		}
+		return swag.YAMLToJSON(yml)
+	}
+	return trimmed, nil
+}
@@ -0,0 +1,202 @@
+package oas3
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	xNullable             = "x-nullable"
+	xIsNullable           = "x-isnullable"
+	xDiscriminatorMapping = "x-discriminator-mapping"
+
+	// xClass is the discriminator value of a subtype
+	xClass = "x-class"
+)
+
+// local $ref prefixes in OpenAPI 3.0 documents and their Swagger 2.0 counterpart
+var refPrefixes = []struct{ v3, v2 string }{
+	{v3: "#/components/schemas/", v2: "#/definitions/"},
+	{v3: "#/components/parameters/", v2: "#/parameters/"},
+	{v3: "#/components/responses/", v2: "#/responses/"},
+}
+
+// Schema is an OpenAPI 3.0 schema object.
+//
+// The schema is held in the Swagger 2.0 dialect, so it may be used wherever a spec.Schema is expected.
+type Schema struct {
+	spec.Schema
+}
+
+// MarshalJSON marshals this schema in the OpenAPI 3.0 dialect
+func (s Schema) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(s.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return translateSchemaJSON(b, false)
+}
+
+// UnmarshalJSON unmarshals an OpenAPI 3.0 schema
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	b, err := translateSchemaJSON(data, true)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, &s.Schema)
+}
+
+// translateSchemaJSON translates a JSON schema between the OpenAPI 3.0 and the Swagger 2.0 dialects,
+// retaining the order of keys.
+func translateSchemaJSON(data []byte, toV2 bool) ([]byte, error) {
+	doc, err := swag.BytesToYAMLDoc(data)
+	if err != nil {
+		return nil, err
+	}
+	if node, ok := doc.(yaml.MapSlice); ok {
+		doc = translateSchema(node, toV2)
+	}
+	return swag.YAMLToJSON(doc)
+}
+
+func translateSchema(node yaml.MapSlice, toV2 bool) yaml.MapSlice {
+	translated := make(yaml.MapSlice, 0, len(node))
+	var mapping interface{}
+
+	for _, item := range node {
+		key, _ := item.Key.(string)
+		switch key {
+		case "$ref":
+			if ref, ok := item.Value.(string); ok {
+				item.Value = translateRef(ref, toV2)
+			}
+		case "nullable":
+			if toV2 {
+				item.Key = xNullable
+			}
+		case xNullable, xIsNullable:
+			if !toV2 {
+				item.Key = "nullable"
+			}
+		case "discriminator":
+			if obj, ok := item.Value.(yaml.MapSlice); ok && toV2 {
+				item.Value = nil
+				for _, d := range obj {
+					switch d.Key {
+					case "propertyName":
+						item.Value = d.Value
+					case "mapping":
+						mapping = translateMapping(d.Value, toV2)
+					}
+				}
+			}
+			if name, ok := item.Value.(string); ok && !toV2 {
+				obj := yaml.MapSlice{{Key: "propertyName", Value: name}}
+				for _, d := range node {
+					if d.Key == xDiscriminatorMapping {
+						obj = append(obj, yaml.MapItem{Key: "mapping", Value: translateMapping(d.Value, toV2)})
+					}
+				}
+				item.Value = obj
+			}
+		case xDiscriminatorMapping:
+			if !toV2 {
+				// folded in the discriminator object
+				continue
+			}
+		case "properties", "patternProperties", "definitions":
+			if obj, ok := item.Value.(yaml.MapSlice); ok {
+				schemas := make(yaml.MapSlice, 0, len(obj))
+				for _, prop := range obj {
+					schemas = append(schemas, yaml.MapItem{Key: prop.Key, Value: translateValue(prop.Value, toV2)})
+				}
+				item.Value = schemas
+			}
+		case "items", "allOf", "oneOf", "anyOf", "not", "additionalProperties", "additionalItems":
+			item.Value = translateValue(item.Value, toV2)
+		}
+		translated = append(translated, item)
+	}
+
+	if mapping != nil {
+		translated = append(translated, yaml.MapItem{Key: xDiscriminatorMapping, Value: mapping})
+	}
+	return translated
+}
+
+// translateValue translates a schema or an array of schemas
+func translateValue(value interface{}, toV2 bool) interface{} {
+	switch v := value.(type) {
+	case yaml.MapSlice:
+		return translateSchema(v, toV2)
+	case []interface{}:
+		schemas := make([]interface{}, 0, len(v))
+		for _, elem := range v {
+			schemas = append(schemas, translateValue(elem, toV2))
+		}
+		return schemas
+	default:
+		return value
+	}
+}
+
+func translateMapping(value interface{}, toV2 bool) interface{} {
+	obj, ok := value.(yaml.MapSlice)
+	if !ok {
+		return value
+	}
+	mapping := make(yaml.MapSlice, 0, len(obj))
+	for _, m := range obj {
+		if ref, ok := m.Value.(string); ok {
+			m.Value = translateRef(ref, toV2)
+		}
+		mapping = append(mapping, m)
+	}
+	return mapping
+}
+
+// translateRef translates a local $ref between the OpenAPI 3.0 and Swagger 2.0 document layouts.
+//
+// Remote $ref's are left unchanged.
+func translateRef(ref string, toV2 bool) string {
+	for _, prefix := range refPrefixes {
+		from, to := prefix.v2, prefix.v3
+		if toV2 {
+			from, to = prefix.v3, prefix.v2
+		}
+		if strings.HasPrefix(ref, from) {
+			return to + strings.TrimPrefix(ref, from)
+		}
+	}
+	return ref
+}
+
+// walkSchema calls fn on a schema, then on each of its subschemas
+func walkSchema(ptr string, sch *spec.Schema, fn func(string, *spec.Schema)) {
+	fn(ptr, sch)
+
+	for i := range sch.AllOf {
+		walkSchema(pointer(ptr, "allOf", strconv.Itoa(i)), &sch.AllOf[i], fn)
+	}
+	for _, name := range sortedKeys(sch.Properties) {
+		prop := sch.Properties[name]
+		walkSchema(pointer(ptr, "properties", name), &prop, fn)
+		sch.Properties[name] = prop
+	}
+	if sch.Items != nil {
+		if sch.Items.Schema != nil {
+			walkSchema(pointer(ptr, "items"), sch.Items.Schema, fn)
+		}
+		for i := range sch.Items.Schemas {
+			walkSchema(pointer(ptr, "items", strconv.Itoa(i)), &sch.Items.Schemas[i], fn)
+		}
+	}
+	if sch.AdditionalProperties != nil && sch.AdditionalProperties.Schema != nil {
+		walkSchema(pointer(ptr, "additionalProperties"), sch.AdditionalProperties.Schema, fn)
+	}
+}
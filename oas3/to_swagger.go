@@ -0,0 +1,867 @@
+package oas3
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+const (
+	formURLEncoded    = "application/x-www-form-urlencoded"
+	multipartFormData = "multipart/form-data"
+
+	// xRequestBodyName names the body parameter a request body is converted to
+	xRequestBodyName = "x-codegen-request-body-name"
+
+	componentsRequestBodies = "#/components/requestBodies/"
+	componentsHeaders       = "#/components/headers/"
+	definitionsPrefix       = "#/definitions/"
+
+	swaggerTarget  = "swagger 2.0"
+	defaultBodyArg = "body"
+)
+
+// ToSwagger converts an OpenAPI 3.0 document to Swagger 2.0.
+//
+// Constructs which could only be approximated are reported as losses.
+// The conversion fails with a *ConversionError when some constructs cannot be represented in Swagger 2.0.
+func ToSwagger(doc *Document) (*spec.Swagger, []Loss, error) {
+	t := &toSwagger{
+		converter:  converter{target: swaggerTarget},
+		doc:        doc,
+		components: doc.Components,
+	}
+	if t.components == nil {
+		t.components = &Components{}
+	}
+
+	sw := t.convert()
+	if err := t.err(); err != nil {
+		return nil, t.losses, err
+	}
+	return sw, t.losses, nil
+}
+
+type toSwagger struct {
+	converter
+	doc        *Document
+	components *Components
+}
+
+func (t *toSwagger) convert() *spec.Swagger {
+	sw := &spec.Swagger{
+		VendorExtensible: spec.VendorExtensible{Extensions: t.doc.Extensions},
+		SwaggerProps: spec.SwaggerProps{
+			Swagger:      "2.0",
+			Info:         t.doc.Info,
+			Security:     t.doc.Security,
+			Tags:         t.doc.Tags,
+			ExternalDocs: t.doc.ExternalDocs,
+		},
+	}
+	t.servers(sw, t.doc.Servers)
+
+	for _, name := range sortedKeys(t.components.Schemas) {
+		if sw.Definitions == nil {
+			sw.Definitions = make(spec.Definitions, len(t.components.Schemas))
+		}
+		sch := cloneSchema(t.components.Schemas[name].Schema)
+		t.schema(pointer("#/components/schemas", name), &sch)
+		sw.Definitions[name] = sch
+	}
+	t.discriminators(sw.Definitions)
+
+	for _, name := range sortedKeys(t.components.Parameters) {
+		if sw.Parameters == nil {
+			sw.Parameters = make(map[string]spec.Parameter, len(t.components.Parameters))
+		}
+		if param, ok := t.parameter(pointer("#/components/parameters", name), t.components.Parameters[name]); ok {
+			sw.Parameters[name] = param
+		}
+	}
+
+	for _, name := range sortedKeys(t.components.Responses) {
+		if sw.Responses == nil {
+			sw.Responses = make(map[string]spec.Response, len(t.components.Responses))
+		}
+		sw.Responses[name] = t.response(pointer("#/components/responses", name), t.components.Responses[name], map[string]bool{})
+	}
+
+	for _, name := range sortedKeys(t.components.SecuritySchemes) {
+		if sw.SecurityDefinitions == nil {
+			sw.SecurityDefinitions = make(spec.SecurityDefinitions, len(t.components.SecuritySchemes))
+		}
+		if scheme := t.securityScheme(pointer("#/components/securitySchemes", name), t.components.SecuritySchemes[name]); scheme != nil {
+			sw.SecurityDefinitions[name] = scheme
+		}
+	}
+
+	for _, unsupported := range []struct {
+		name   string
+		values map[string]interface{}
+	}{
+		{name: "examples", values: t.components.Examples},
+		{name: "links", values: t.components.Links},
+		{name: "callbacks", values: t.components.Callbacks},
+	} {
+		if len(unsupported.values) > 0 {
+			t.lossy(pointer("#/components", unsupported.name), "%s are not supported and have been dropped", unsupported.name)
+		}
+	}
+
+	sw.Paths = &spec.Paths{Paths: make(map[string]spec.PathItem, len(t.doc.Paths))}
+	for _, path := range sortedKeys(t.doc.Paths) {
+		if item, ok := t.pathItem(pointer("#/paths", path), t.doc.Paths[path]); ok {
+			sw.Paths.Paths[path] = item
+		}
+	}
+	return sw
+}
+
+// servers sets the host, base path and schemes from the first declared server
+func (t *toSwagger) servers(sw *spec.Swagger, servers []Server) {
+	if len(servers) == 0 {
+		return
+	}
+
+	first, err := url.Parse(serverURL(servers[0]))
+	if err != nil {
+		t.fail("#/servers/0/url", "invalid server url: %v", err)
+		return
+	}
+	sw.Host = first.Host
+	if first.Path != "" && first.Path != "/" {
+		sw.BasePath = strings.TrimSuffix(first.Path, "/")
+	}
+
+	dropped := false
+	for i, server := range servers {
+		u, err := url.Parse(serverURL(server))
+		if err != nil || u.Host != first.Host || u.Path != first.Path {
+			dropped = true
+			continue
+		}
+		if u.Scheme != "" && !containsString(sw.Schemes, u.Scheme) {
+			sw.Schemes = append(sw.Schemes, u.Scheme)
+		}
+		if len(server.Variables) > 0 {
+			t.lossy(pointer("#/servers", strconv.Itoa(i), "variables"), "server variables are replaced by their default value")
+		}
+	}
+	if dropped {
+		t.lossy("#/servers", "only the servers with the same host and base path as the first one are retained")
+	}
+}
+
+// serverURL resolves the url template of a server with the default value of its variables
+func serverURL(server Server) string {
+	u := server.URL
+	for name, variable := range server.Variables {
+		u = strings.Replace(u, "{"+name+"}", variable.Default, -1)
+	}
+	return u
+}
+
+func (t *toSwagger) pathItem(ptr string, item *PathItem) (spec.PathItem, bool) {
+	if item.Ref != "" {
+		t.fail(ptr, "path item $ref %q is not supported", item.Ref)
+		return spec.PathItem{}, false
+	}
+
+	result := spec.PathItem{
+		VendorExtensible: spec.VendorExtensible{Extensions: item.Extensions},
+	}
+	if item.Summary != "" || item.Description != "" {
+		t.lossy(ptr, "the summary and description of a path item have been dropped")
+	}
+	if len(item.Servers) > 0 {
+		t.lossy(pointer(ptr, "servers"), "servers declared on a path item have been dropped")
+	}
+	for i, p := range item.Parameters {
+		if param, ok := t.parameter(pointer(ptr, "parameters", strconv.Itoa(i)), p); ok {
+			result.Parameters = append(result.Parameters, param)
+		}
+	}
+
+	ops := item.Operations()
+	for _, method := range sortedKeys(ops) {
+		opPtr := pointer(ptr, strings.ToLower(method))
+		if method == "TRACE" {
+			t.lossy(opPtr, "trace operations are not supported and have been dropped")
+			continue
+		}
+		op := t.operation(opPtr, ops[method])
+		switch method {
+		case "GET":
+			result.Get = op
+		case "PUT":
+			result.Put = op
+		case "POST":
+			result.Post = op
+		case "DELETE":
+			result.Delete = op
+		case "OPTIONS":
+			result.Options = op
+		case "HEAD":
+			result.Head = op
+		case "PATCH":
+			result.Patch = op
+		}
+	}
+	return result, true
+}
+
+func (t *toSwagger) operation(ptr string, op *Operation) *spec.Operation {
+	result := &spec.Operation{
+		VendorExtensible: spec.VendorExtensible{Extensions: op.Extensions},
+		OperationProps: spec.OperationProps{
+			Description:  op.Description,
+			Tags:         op.Tags,
+			Summary:      op.Summary,
+			ExternalDocs: op.ExternalDocs,
+			ID:           op.OperationID,
+			Deprecated:   op.Deprecated,
+			Security:     op.Security,
+		},
+	}
+	if len(op.Callbacks) > 0 {
+		t.lossy(pointer(ptr, "callbacks"), "callbacks are not supported and have been dropped")
+	}
+	if len(op.Servers) > 0 {
+		t.lossy(pointer(ptr, "servers"), "servers declared on an operation have been dropped")
+	}
+
+	for i, p := range op.Parameters {
+		if param, ok := t.parameter(pointer(ptr, "parameters", strconv.Itoa(i)), p); ok {
+			result.Parameters = append(result.Parameters, param)
+		}
+	}
+
+	if op.RequestBody != nil {
+		params, consumes := t.requestBody(pointer(ptr, "requestBody"), op.RequestBody)
+		result.Parameters = append(result.Parameters, params...)
+		result.Consumes = consumes
+	}
+
+	produces := make(map[string]bool)
+	result.Responses = &spec.Responses{}
+	for _, code := range sortedKeys(op.Responses) {
+		respPtr := pointer(ptr, "responses", code)
+		resp := t.response(respPtr, op.Responses[code], produces)
+		if code == "default" {
+			result.Responses.Default = &resp
+			continue
+		}
+		status, err := strconv.Atoi(code)
+		if err != nil {
+			t.fail(respPtr, "response code %q cannot be represented: only explicit status codes are supported", code)
+			continue
+		}
+		if result.Responses.StatusCodeResponses == nil {
+			result.Responses.StatusCodeResponses = make(map[int]spec.Response, len(op.Responses))
+		}
+		result.Responses.StatusCodeResponses[status] = resp
+	}
+	result.Produces = sortedKeys(produces)
+	if len(result.Produces) == 0 {
+		result.Produces = nil
+	}
+	return result
+}
+
+// parameter converts a path, query or header parameter
+func (t *toSwagger) parameter(ptr string, p *Parameter) (spec.Parameter, bool) {
+	if p.Ref != "" {
+		if !strings.HasPrefix(p.Ref, "#/components/parameters/") {
+			t.fail(ptr, "parameter $ref %q is not supported: only local references to components are supported", p.Ref)
+			return spec.Parameter{}, false
+		}
+		return *spec.ParamRef(translateRef(p.Ref, true)), true
+	}
+
+	switch p.In {
+	case "path", "query", "header":
+	default:
+		t.fail(ptr, "%s parameter %q cannot be represented", p.In, p.Name)
+		return spec.Parameter{}, false
+	}
+	if len(p.Content) > 0 || p.Schema == nil {
+		t.fail(ptr, "parameter %q must be described by a schema, not a content map", p.Name)
+		return spec.Parameter{}, false
+	}
+
+	param := spec.Parameter{
+		VendorExtensible: spec.VendorExtensible{Extensions: p.Extensions},
+		ParamProps: spec.ParamProps{
+			Description:     p.Description,
+			Name:            p.Name,
+			In:              p.In,
+			Required:        p.Required,
+			AllowEmptyValue: p.AllowEmptyValue,
+		},
+	}
+	if p.Deprecated {
+		t.lossy(pointer(ptr, "deprecated"), "parameters cannot be deprecated")
+	}
+	if p.Example != nil || len(p.Examples) > 0 {
+		t.lossy(ptr, "parameter examples have been dropped")
+	}
+	if nullable(&p.Schema.Schema) {
+		param.AddExtension(xNullable, true)
+	}
+
+	collectionFormat, ok := t.collectionFormat(ptr, p.In, p.Style, p.Explode)
+	if !ok {
+		return spec.Parameter{}, false
+	}
+	if !t.simpleSchema(pointer(ptr, "schema"), &p.Schema.Schema, &param.SimpleSchema, &param.CommonValidations) {
+		return spec.Parameter{}, false
+	}
+	if param.Type == "array" {
+		param.CollectionFormat = collectionFormat
+	}
+	return param, true
+}
+
+// collectionFormat maps a serialization style to a collection format
+func (t *toSwagger) collectionFormat(ptr, in, style string, explode *bool) (string, bool) {
+	if style == "" {
+		style = "simple"
+		if in == "query" {
+			style = "form"
+		}
+	}
+	exploded := style == "form"
+	if explode != nil {
+		exploded = *explode
+	}
+
+	switch style {
+	case "form":
+		if exploded {
+			return "multi", true
+		}
+		return "csv", true
+	case "simple":
+		return "csv", true
+	case "spaceDelimited":
+		return "ssv", true
+	case "pipeDelimited":
+		return "pipes", true
+	default:
+		t.fail(pointer(ptr, "style"), "serialization style %q cannot be represented", style)
+		return "", false
+	}
+}
+
+// requestBody converts a request body to a body parameter or to form data parameters
+func (t *toSwagger) requestBody(ptr string, body *RequestBody) ([]spec.Parameter, []string) {
+	body, ptr = t.resolveRequestBody(ptr, body)
+	if body == nil {
+		return nil, nil
+	}
+
+	consumes := sortedKeys(body.Content)
+	forms := 0
+	for _, mediaType := range consumes {
+		if isForm(mediaType) {
+			forms++
+		}
+	}
+
+	switch {
+	case forms == 0:
+		sch, ok := t.contentSchema(pointer(ptr, "content"), body.Content)
+		if !ok {
+			return nil, consumes
+		}
+		if sch == nil {
+			sch = spec.StringProperty()
+			sch.Format = "binary"
+		}
+		param := spec.Parameter{
+			ParamProps: spec.ParamProps{
+				Description: body.Description,
+				Name:        defaultBodyArg,
+				In:          "body",
+				Required:    body.Required,
+				Schema:      sch,
+			},
+		}
+		for k, v := range body.Extensions {
+			if strings.ToLower(k) == xRequestBodyName {
+				param.Name, _ = v.(string)
+				continue
+			}
+			param.AddExtension(k, v)
+		}
+		return []spec.Parameter{param}, consumes
+
+	case forms == len(consumes):
+		return t.formData(pointer(ptr, "content"), body.Content), consumes
+
+	default:
+		t.fail(pointer(ptr, "content"), "a request body cannot mix form and non-form media types")
+		return nil, consumes
+	}
+}
+
+func (t *toSwagger) resolveRequestBody(ptr string, body *RequestBody) (*RequestBody, string) {
+	visited := make(map[string]bool)
+	for body != nil && body.Ref != "" {
+		if !strings.HasPrefix(body.Ref, componentsRequestBodies) {
+			t.fail(ptr, "request body $ref %q is not supported: only local references to components are supported", body.Ref)
+			return nil, ptr
+		}
+		if visited[body.Ref] {
+			t.fail(ptr, "circular request body $ref %q", body.Ref)
+			return nil, ptr
+		}
+		visited[body.Ref] = true
+		name := strings.TrimPrefix(body.Ref, componentsRequestBodies)
+		ptr = pointer("#/components/requestBodies", name)
+		resolved, ok := t.components.RequestBodies[name]
+		if !ok {
+			t.fail(ptr, "unresolved request body $ref %q", body.Ref)
+			return nil, ptr
+		}
+		body = resolved
+	}
+	return body, ptr
+}
+
+// formData converts the properties of a form request body to form data parameters
+func (t *toSwagger) formData(ptr string, content map[string]*MediaType) []spec.Parameter {
+	sch, ok := t.contentSchema(ptr, content)
+	if !ok || sch == nil {
+		return nil
+	}
+	resolved := t.resolveSchema(sch)
+	if resolved == nil || len(resolved.AllOf) > 0 || !resolved.Type.Contains("object") && len(resolved.Properties) == 0 {
+		t.fail(ptr, "a form request body must be described by an object schema with properties")
+		return nil
+	}
+
+	required := make(map[string]bool, len(resolved.Required))
+	for _, name := range resolved.Required {
+		required[name] = true
+	}
+
+	params := make([]spec.Parameter, 0, len(resolved.Properties))
+	for _, name := range sortedKeys(resolved.Properties) {
+		prop := resolved.Properties[name]
+		propPtr := pointer(ptr, "schema", "properties", name)
+		param := spec.Parameter{
+			VendorExtensible: spec.VendorExtensible{Extensions: prop.Extensions},
+			ParamProps: spec.ParamProps{
+				Description: prop.Description,
+				Name:        name,
+				In:          "formData",
+				Required:    required[name],
+			},
+		}
+		if target := t.resolveSchema(&prop); target != nil && target.Type.Contains("string") && target.Format == "binary" {
+			param.Type = "file"
+			params = append(params, param)
+			continue
+		}
+		if !t.simpleSchema(propPtr, &prop, &param.SimpleSchema, &param.CommonValidations) {
+			continue
+		}
+		if param.Type == "array" {
+			param.CollectionFormat = "multi"
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// contentSchema returns the schema shared by all media types of a content map
+func (t *toSwagger) contentSchema(ptr string, content map[string]*MediaType) (*spec.Schema, bool) {
+	var (
+		sch       *spec.Schema
+		reference []byte
+		dropped   bool
+	)
+	for _, mediaType := range sortedKeys(content) {
+		media := content[mediaType]
+		if media == nil || media.Schema == nil {
+			dropped = true
+			continue
+		}
+		b, err := json.Marshal(media.Schema.Schema)
+		if err != nil {
+			t.fail(pointer(ptr, mediaType, "schema"), "invalid schema: %v", err)
+			return nil, false
+		}
+		if sch == nil {
+			s := cloneSchema(media.Schema.Schema)
+			t.schema(pointer(ptr, mediaType, "schema"), &s)
+			sch, reference = &s, b
+			continue
+		}
+		if string(b) != string(reference) {
+			t.fail(ptr, "media types with different schemas cannot be represented")
+			return nil, false
+		}
+	}
+	if dropped && sch != nil {
+		t.lossy(ptr, "media types without a schema are described by the schema of the other media types")
+	}
+	return sch, true
+}
+
+func (t *toSwagger) response(ptr string, resp *Response, produces map[string]bool) spec.Response {
+	if resp.Ref != "" {
+		if !strings.HasPrefix(resp.Ref, "#/components/responses/") {
+			t.fail(ptr, "response $ref %q is not supported: only local references to components are supported", resp.Ref)
+			return spec.Response{}
+		}
+		name := strings.TrimPrefix(resp.Ref, "#/components/responses/")
+		if target, ok := t.components.Responses[name]; ok {
+			for mediaType := range target.Content {
+				produces[mediaType] = true
+			}
+		}
+		return *spec.ResponseRef(translateRef(resp.Ref, true))
+	}
+
+	result := spec.Response{
+		VendorExtensible: spec.VendorExtensible{Extensions: resp.Extensions},
+		ResponseProps: spec.ResponseProps{
+			Description: resp.Description,
+		},
+	}
+	if len(resp.Links) > 0 {
+		t.lossy(pointer(ptr, "links"), "links are not supported and have been dropped")
+	}
+
+	for _, mediaType := range sortedKeys(resp.Content) {
+		produces[mediaType] = true
+		media := resp.Content[mediaType]
+		if media == nil {
+			continue
+		}
+		if media.Example != nil {
+			if result.Examples == nil {
+				result.Examples = make(map[string]interface{}, len(resp.Content))
+			}
+			result.Examples[mediaType] = media.Example
+		}
+		if len(media.Examples) > 0 {
+			t.lossy(pointer(ptr, "content", mediaType, "examples"), "named examples have been dropped")
+		}
+	}
+	if sch, ok := t.contentSchema(pointer(ptr, "content"), resp.Content); ok {
+		result.Schema = sch
+	}
+
+	for _, name := range sortedKeys(resp.Headers) {
+		hdrPtr := pointer(ptr, "headers", name)
+		hdr := t.resolveHeader(hdrPtr, resp.Headers[name])
+		if hdr == nil {
+			continue
+		}
+		if hdr.Schema == nil {
+			t.fail(hdrPtr, "header %q must be described by a schema, not a content map", name)
+			continue
+		}
+		var h spec.Header
+		h.Description = hdr.Description
+		if !t.simpleSchema(pointer(hdrPtr, "schema"), &hdr.Schema.Schema, &h.SimpleSchema, &h.CommonValidations) {
+			continue
+		}
+		if h.Type == "array" {
+			h.CollectionFormat = "csv"
+		}
+		if result.Headers == nil {
+			result.Headers = make(map[string]spec.Header, len(resp.Headers))
+		}
+		result.Headers[name] = h
+	}
+	return result
+}
+
+func (t *toSwagger) resolveHeader(ptr string, hdr *Header) *Header {
+	visited := make(map[string]bool)
+	for hdr != nil && hdr.Ref != "" {
+		if !strings.HasPrefix(hdr.Ref, componentsHeaders) {
+			t.fail(ptr, "header $ref %q is not supported: only local references to components are supported", hdr.Ref)
+			return nil
+		}
+		if visited[hdr.Ref] {
+			t.fail(ptr, "circular header $ref %q", hdr.Ref)
+			return nil
+		}
+		visited[hdr.Ref] = true
+		resolved, ok := t.components.Headers[strings.TrimPrefix(hdr.Ref, componentsHeaders)]
+		if !ok {
+			t.fail(ptr, "unresolved header $ref %q", hdr.Ref)
+			return nil
+		}
+		hdr = resolved
+	}
+	return hdr
+}
+
+// simpleSchema converts a schema describing a primitive or an array of primitives
+func (t *toSwagger) simpleSchema(ptr string, sch *spec.Schema, simple *spec.SimpleSchema, validations *spec.CommonValidations) bool {
+	resolved := t.resolveSchema(sch)
+	if resolved == nil {
+		t.fail(ptr, "unresolved schema $ref %q", sch.Ref.String())
+		return false
+	}
+	if len(resolved.Type) != 1 || resolved.Type[0] == "object" || len(resolved.Properties) > 0 || len(resolved.AllOf) > 0 {
+		t.fail(ptr, "only primitive types and arrays of primitive types are supported here")
+		return false
+	}
+
+	simple.Type = resolved.Type[0]
+	simple.Format = resolved.Format
+	simple.Default = resolved.Default
+	simple.Example = resolved.Example
+	*validations = spec.CommonValidations{
+		Maximum:          resolved.Maximum,
+		ExclusiveMaximum: resolved.ExclusiveMaximum,
+		Minimum:          resolved.Minimum,
+		ExclusiveMinimum: resolved.ExclusiveMinimum,
+		MaxLength:        resolved.MaxLength,
+		MinLength:        resolved.MinLength,
+		Pattern:          resolved.Pattern,
+		MaxItems:         resolved.MaxItems,
+		MinItems:         resolved.MinItems,
+		UniqueItems:      resolved.UniqueItems,
+		MultipleOf:       resolved.MultipleOf,
+		Enum:             resolved.Enum,
+	}
+
+	if simple.Type != "array" {
+		return true
+	}
+	if resolved.Items == nil || resolved.Items.Schema == nil {
+		t.fail(ptr, "arrays must declare a single schema for their items")
+		return false
+	}
+	items := new(spec.Items)
+	if !t.simpleSchema(pointer(ptr, "items"), resolved.Items.Schema, &items.SimpleSchema, &items.CommonValidations) {
+		return false
+	}
+	if items.Type == "array" {
+		items.CollectionFormat = "csv"
+	}
+	simple.Items = items
+	return true
+}
+
+// schema converts OpenAPI 3.0 schema constructs which are not supported by Swagger 2.0
+func (t *toSwagger) schema(ptr string, sch *spec.Schema) {
+	walkSchema(ptr, sch, func(ptr string, sch *spec.Schema) {
+		for _, alternatives := range []struct {
+			keyword string
+			schemas *[]spec.Schema
+		}{
+			{keyword: "oneOf", schemas: &sch.OneOf},
+			{keyword: "anyOf", schemas: &sch.AnyOf},
+		} {
+			switch len(*alternatives.schemas) {
+			case 0:
+			case 1:
+				alternative := (*alternatives.schemas)[0]
+				if nullable(sch) && alternative.Ref.String() != "" && isUntyped(alternative) && isUntyped(*sch) && sch.Ref.String() == "" {
+					// a nullable reference is held as $ref with x-nullable, which generates a pointer to the type
+					sch.Ref = alternative.Ref
+					break
+				}
+				sch.AllOf = append(sch.AllOf, alternative)
+			default:
+				t.lossy(pointer(ptr, alternatives.keyword), "%s with %d alternatives is represented as an untyped schema",
+					alternatives.keyword, len(*alternatives.schemas))
+			}
+			*alternatives.schemas = nil
+		}
+		if sch.Not != nil {
+			t.lossy(pointer(ptr, "not"), "not is not supported and has been dropped")
+			sch.Not = nil
+		}
+		for _, keyword := range sortedKeys(sch.ExtraProps) {
+			t.lossy(pointer(ptr, keyword), "%s is not supported and has been dropped", keyword)
+		}
+		sch.ExtraProps = nil
+	})
+}
+
+// discriminators converts discriminator mappings to x-class extensions on subtypes
+func (t *toSwagger) discriminators(definitions spec.Definitions) {
+	for _, name := range sortedKeys(definitions) {
+		base := definitions[name]
+		if base.Discriminator == "" {
+			continue
+		}
+		ptr := pointer("#/components/schemas", name, "discriminator")
+		if _, ok := base.Properties[base.Discriminator]; !ok {
+			t.lossy(ptr, "discriminator %q is not a property of the schema and has been dropped", base.Discriminator)
+			base.Discriminator = ""
+			definitions[name] = base
+			continue
+		}
+		if !containsString(base.Required, base.Discriminator) {
+			base.Required = append(base.Required, base.Discriminator)
+		}
+
+		if mapping, ok := base.Extensions[xDiscriminatorMapping].(map[string]interface{}); ok {
+			for _, value := range sortedKeys(mapping) {
+				ref, _ := mapping[value].(string)
+				subtype := strings.TrimPrefix(ref, definitionsPrefix)
+				sub, ok := definitions[subtype]
+				if !ok {
+					t.lossy(pointer(ptr, "mapping", value), "only mappings to local schemas are supported")
+					continue
+				}
+				if value != subtype {
+					sub.AddExtension(xClass, value)
+					definitions[subtype] = sub
+				}
+			}
+			delete(base.Extensions, xDiscriminatorMapping)
+		}
+		definitions[name] = base
+	}
+}
+
+// resolveSchema follows local $ref's to schemas in components
+func (t *toSwagger) resolveSchema(sch *spec.Schema) *spec.Schema {
+	for depth := 0; sch != nil && sch.Ref.String() != ""; depth++ {
+		ref := sch.Ref.String()
+		if !strings.HasPrefix(ref, definitionsPrefix) || depth > len(t.components.Schemas) {
+			return nil
+		}
+		target, ok := t.components.Schemas[strings.TrimPrefix(ref, definitionsPrefix)]
+		if !ok {
+			return nil
+		}
+		sch = &target.Schema
+	}
+	return sch
+}
+
+func (t *toSwagger) securityScheme(ptr string, scheme *SecurityScheme) *spec.SecurityScheme {
+	var result *spec.SecurityScheme
+
+	switch scheme.Type {
+	case "apiKey":
+		if scheme.In == "cookie" {
+			t.fail(ptr, "cookie api keys cannot be represented")
+			return nil
+		}
+		result = spec.APIKeyAuth(scheme.Name, scheme.In)
+
+	case "http":
+		switch strings.ToLower(scheme.Scheme) {
+		case "basic":
+			result = spec.BasicAuth()
+		case "bearer":
+			t.lossy(ptr, "bearer authentication is represented as an api key in the Authorization header")
+			result = spec.APIKeyAuth("Authorization", "header")
+		default:
+			t.fail(ptr, "http authentication scheme %q cannot be represented", scheme.Scheme)
+			return nil
+		}
+
+	case "oauth2":
+		result = t.oauth2(ptr, scheme.Flows)
+		if result == nil {
+			return nil
+		}
+
+	default:
+		t.fail(ptr, "security scheme type %q cannot be represented", scheme.Type)
+		return nil
+	}
+
+	result.Description = scheme.Description
+	result.Extensions = scheme.Extensions
+	return result
+}
+
+// oauth2 converts the first supported OAuth2 flow
+func (t *toSwagger) oauth2(ptr string, flows *OAuthFlows) *spec.SecurityScheme {
+	if flows == nil {
+		t.fail(ptr, "oauth2 security scheme without flows")
+		return nil
+	}
+
+	var (
+		result *spec.SecurityScheme
+		flow   *OAuthFlow
+		count  int
+	)
+	for _, candidate := range []struct {
+		flow *OAuthFlow
+		make func(*OAuthFlow) *spec.SecurityScheme
+	}{
+		{flow: flows.Implicit, make: func(f *OAuthFlow) *spec.SecurityScheme { return spec.OAuth2Implicit(f.AuthorizationURL) }},
+		{flow: flows.Password, make: func(f *OAuthFlow) *spec.SecurityScheme { return spec.OAuth2Password(f.TokenURL) }},
+		{flow: flows.ClientCredentials, make: func(f *OAuthFlow) *spec.SecurityScheme { return spec.OAuth2Application(f.TokenURL) }},
+		{flow: flows.AuthorizationCode, make: func(f *OAuthFlow) *spec.SecurityScheme {
+			return spec.OAuth2AccessToken(f.AuthorizationURL, f.TokenURL)
+		}},
+	} {
+		if candidate.flow == nil {
+			continue
+		}
+		count++
+		if result == nil {
+			flow = candidate.flow
+			result = candidate.make(flow)
+		}
+	}
+	if result == nil {
+		t.fail(ptr, "oauth2 security scheme without flows")
+		return nil
+	}
+	if count > 1 {
+		t.lossy(pointer(ptr, "flows"), "only the first of %d oauth2 flows is retained", count)
+	}
+	if flow.RefreshURL != "" {
+		t.lossy(pointer(ptr, "flows"), "refresh urls have been dropped")
+	}
+	for _, scope := range sortedKeys(flow.Scopes) {
+		result.AddScope(scope, flow.Scopes[scope])
+	}
+	return result
+}
+
+// cloneSchema deep copies a schema, so the conversion does not alter its source
+func cloneSchema(sch spec.Schema) spec.Schema {
+	var clone spec.Schema
+	b, _ := json.Marshal(sch)
+	_ = json.Unmarshal(b, &clone)
+	return clone
+}
+
+func isForm(mediaType string) bool {
+	return strings.HasPrefix(mediaType, formURLEncoded) || strings.HasPrefix(mediaType, multipartFormData)
+}
+
+// isUntyped tells if a schema declares no type, other than with a $ref
+func isUntyped(sch spec.Schema) bool {
+	return len(sch.Type) == 0 && len(sch.Properties) == 0 && sch.Items == nil && len(sch.AllOf) == 0 &&
+		sch.AdditionalProperties == nil && len(sch.Enum) == 0 && sch.Format == ""
+}
+
+func nullable(sch *spec.Schema) bool {
+	isNullable, _ := sch.Extensions.GetBool(xNullable)
+	return isNullable
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
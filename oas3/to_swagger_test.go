@@ -0,0 +1,186 @@
+package oas3
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSwagger_Petstore(t *testing.T) {
+	doc, err := Load(filepath.Join("..", "fixtures", "oas3", "petstore.yaml"))
+	require.NoError(t, err)
+
+	sw, losses, err := ToSwagger(doc)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.0", sw.Swagger)
+	assert.Equal(t, "prod.petstore.io", sw.Host)
+	assert.Equal(t, "/api/v1", sw.BasePath)
+	assert.Equal(t, []string{"https", "http"}, sw.Schemes)
+
+	// definitions
+	pet := sw.Definitions["Pet"]
+	assert.Equal(t, "petType", pet.Discriminator)
+	assert.NotContains(t, pet.Extensions, xDiscriminatorMapping)
+	status := pet.Properties["status"]
+	assert.Equal(t, "#/definitions/Status", status.Ref.String())
+	owner := pet.Properties["owner"]
+	assert.True(t, nullable(&owner))
+	assert.Equal(t, "#/definitions/Owner", owner.Ref.String())
+	assert.Empty(t, owner.AllOf)
+	dogValue, _ := sw.Definitions["Dog"].Extensions.GetString(xClass)
+	assert.Equal(t, "dog", dogValue)
+	contact := sw.Definitions["Owner"].Properties["contact"]
+	assert.Empty(t, contact.OneOf)
+	assert.Empty(t, contact.Type)
+
+	// parameters
+	listPets := sw.Paths.Paths["/pets"].Get
+	require.NotNil(t, listPets)
+	require.Len(t, listPets.Parameters, 3)
+	assert.Equal(t, "integer", listPets.Parameters[0].Type)
+	assert.EqualValues(t, 20, listPets.Parameters[0].Default)
+	assert.Equal(t, "csv", listPets.Parameters[1].CollectionFormat)
+	assert.Equal(t, []interface{}{"available", "pending", "sold"}, listPets.Parameters[2].Enum)
+	assert.Equal(t, "#/parameters/traceId", sw.Paths.Paths["/pets"].Parameters[0].Ref.String())
+	assert.Equal(t, []string{"application/json", "application/xml"}, listPets.Produces)
+
+	// responses
+	ok := listPets.Responses.StatusCodeResponses[200]
+	assert.Equal(t, "array", ok.Schema.Type[0])
+	assert.Equal(t, "integer", ok.Headers["X-Rate-Limit"].Type)
+	assert.Equal(t, "#/responses/Error", listPets.Responses.Default.Ref.String())
+
+	// request bodies
+	createPet := sw.Paths.Paths["/pets"].Post
+	require.Len(t, createPet.Parameters, 1)
+	assert.Equal(t, "pet", createPet.Parameters[0].Name)
+	assert.Equal(t, "body", createPet.Parameters[0].In)
+	assert.True(t, createPet.Parameters[0].Required)
+	assert.Equal(t, []string{"application/json"}, createPet.Consumes)
+	assert.NotNil(t, createPet.Responses.StatusCodeResponses[201].Examples["application/json"])
+
+	upload := sw.Paths.Paths["/pets/{petId}/photo"].Put
+	require.Len(t, upload.Parameters, 3)
+	assert.Equal(t, "caption", upload.Parameters[1].Name)
+	assert.Equal(t, "formData", upload.Parameters[1].In)
+	assert.Equal(t, "photo", upload.Parameters[2].Name)
+	assert.Equal(t, "file", upload.Parameters[2].Type)
+	assert.True(t, upload.Parameters[2].Required)
+
+	// security
+	assert.Equal(t, "apiKey", sw.SecurityDefinitions["bearer"].Type)
+	assert.Equal(t, "accessCode", sw.SecurityDefinitions["oauth"].Flow)
+	assert.Len(t, sw.SecurityDefinitions["oauth"].Scopes, 2)
+
+	// approximations
+	pointers := make([]string, 0, len(losses))
+	for _, loss := range losses {
+		pointers = append(pointers, loss.Pointer)
+	}
+	assert.Contains(t, pointers, "#/components/schemas/Owner/properties/contact/oneOf")
+	assert.Contains(t, pointers, "#/components/securitySchemes/bearer")
+	assert.Contains(t, pointers, "#/servers/0/variables")
+
+	// the converted spec is a valid swagger 2.0 spec
+	b, err := json.Marshal(sw)
+	require.NoError(t, err)
+	converted, err := loads.Analyzed(b, "2.0")
+	require.NoError(t, err)
+	assert.NoError(t, validate.Spec(converted, strfmt.Default))
+}
+
+func TestToSwagger_Unsupported(t *testing.T) {
+	doc, err := Load(filepath.Join("..", "fixtures", "oas3", "unsupported.yaml"))
+	require.NoError(t, err)
+
+	_, _, err = ToSwagger(doc)
+	require.Error(t, err)
+	convErr, ok := err.(*ConversionError)
+	require.True(t, ok)
+
+	pointers := make([]string, 0, len(convErr.Failures))
+	for _, failure := range convErr.Failures {
+		pointers = append(pointers, failure.Pointer)
+	}
+	assert.ElementsMatch(t, []string{
+		"#/components/securitySchemes/oidc",
+		"#/paths/~1session/get/parameters/0",
+		"#/paths/~1session/get/parameters/1/style",
+		"#/paths/~1session/get/responses/2XX",
+		"#/paths/~1session/post/requestBody/content",
+	}, pointers)
+}
+
+func TestToSwagger_Cycles(t *testing.T) {
+	doc, err := Load(filepath.Join("..", "fixtures", "oas3", "cycles.yaml"))
+	require.NoError(t, err)
+
+	_, _, err = ToSwagger(doc)
+	require.Error(t, err)
+	convErr, ok := err.(*ConversionError)
+	require.True(t, ok)
+
+	messages := make([]string, 0, len(convErr.Failures))
+	for _, failure := range convErr.Failures {
+		messages = append(messages, failure.Message)
+	}
+	assert.ElementsMatch(t, []string{
+		`circular request body $ref "#/components/requestBodies/A"`,
+		`circular header $ref "#/components/headers/A"`,
+	}, messages)
+}
+
+func TestToSwagger_SingleAlternative(t *testing.T) {
+	doc, err := Parse([]byte(`{
+  "openapi": "3.0.3",
+  "info": {"title": "single alternatives", "version": "1.0"},
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Owner": {"type": "object", "properties": {"name": {"type": "string"}}},
+      "Pet": {
+        "type": "object",
+        "properties": {
+          "nullableRef": {"nullable": true, "oneOf": [{"$ref": "#/components/schemas/Owner"}]},
+          "ref": {"anyOf": [{"$ref": "#/components/schemas/Owner"}]},
+          "nullableInline": {"nullable": true, "oneOf": [{"type": "object", "properties": {"id": {"type": "integer"}}}]}
+        }
+      }
+    }
+  }
+}`))
+	require.NoError(t, err)
+
+	sw, _, err := ToSwagger(doc)
+	require.NoError(t, err)
+	pet := sw.Definitions["Pet"]
+
+	nullableRef := pet.Properties["nullableRef"]
+	assert.Equal(t, "#/definitions/Owner", nullableRef.Ref.String())
+	assert.True(t, nullable(&nullableRef))
+	assert.Empty(t, nullableRef.AllOf)
+
+	ref := pet.Properties["ref"]
+	require.Len(t, ref.AllOf, 1)
+	assert.Equal(t, "#/definitions/Owner", ref.AllOf[0].Ref.String())
+
+	nullableInline := pet.Properties["nullableInline"]
+	assert.Empty(t, nullableInline.Ref.String())
+	require.Len(t, nullableInline.AllOf, 1)
+	assert.Contains(t, nullableInline.AllOf[0].Properties, "id")
+}
+
+func TestParse_NotOpenAPI3(t *testing.T) {
+	_, err := Parse([]byte(`{"swagger": "2.0", "info": {"title": "x", "version": "1"}}`))
+	assert.Error(t, err)
+
+	assert.True(t, IsOpenAPI3(json.RawMessage(`{"openapi": "3.0.3"}`)))
+	assert.False(t, IsOpenAPI3(json.RawMessage(`{"swagger": "2.0"}`)))
+}